@@ -0,0 +1,123 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/elastic/beats/v7/filebeat/fileset"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common/cli"
+	"github.com/elastic/beats/v7/libbeat/paths"
+	"github.com/elastic/beats/v7/libbeat/version"
+)
+
+func genTestModuleCmd() *cobra.Command {
+	testModuleCmd := &cobra.Command{
+		Use:   "module [module[.fileset]...]",
+		Short: "Test modules parse their sample logs into the expected events",
+		Long: `Reads the test logs of the modules filesets with their input configuration,
+runs the ingest pipelines locally as processors and compares the resulting
+events with the expected ones. Elasticsearch is not required.`,
+		Run: cli.RunWith(func(cmd *cobra.Command, args []string) error {
+			modulesPath, _ := cmd.Flags().GetString("modules-path")
+			update, _ := cmd.Flags().GetBool("update")
+
+			testFiles, err := fileset.FindTestFiles(modulesPath, args...)
+			if err != nil {
+				return err
+			}
+			if len(testFiles) == 0 {
+				return fmt.Errorf("no test files found in %s", modulesPath)
+			}
+
+			// Use the fixed timezone of the module system tests, so results
+			// don't depend on the environment.
+			time.Local = time.FixedZone("Etc/GMT+2", -2*60*60)
+
+			info := beat.Info{
+				Beat:        Name,
+				IndexPrefix: Name,
+				Version:     version.GetDefaultVersion(),
+			}
+
+			failed := 0
+			for _, testFile := range testFiles {
+				fmt.Printf("%s... ", testFile)
+
+				docs, skipped, err := fileset.Replay(modulesPath, info, testFile)
+				if err != nil {
+					fmt.Printf("ERROR %v\n", err)
+					failed++
+					continue
+				}
+				if len(skipped) > 0 {
+					// The events are incomplete, they can't be compared nor
+					// used to update the expected ones.
+					failed++
+					fmt.Printf("FAILED %d ingest processors can't be executed locally\n", len(skipped))
+					for _, processor := range skipped {
+						fmt.Printf("  skipped %s\n", processor)
+					}
+					continue
+				}
+
+				if update {
+					if err := fileset.WriteExpectedEvents(testFile, docs); err != nil {
+						fmt.Printf("ERROR %v\n", err)
+						failed++
+						continue
+					}
+					fmt.Println("UPDATED")
+					continue
+				}
+
+				expected, err := fileset.ReadExpectedEvents(testFile)
+				if err != nil {
+					fmt.Printf("ERROR %v\n", err)
+					failed++
+					continue
+				}
+
+				diffs := fileset.DiffEvents(expected, docs)
+				if len(diffs) == 0 {
+					fmt.Println("OK")
+					continue
+				}
+				failed++
+				fmt.Printf("FAILED %d differences\n", len(diffs))
+				for _, diff := range diffs {
+					fmt.Printf("  %s\n", diff)
+				}
+			}
+
+			if failed > 0 {
+				return fmt.Errorf("%d of %d test files failed", failed, len(testFiles))
+			}
+			return nil
+		}),
+	}
+
+	testModuleCmd.Flags().String("modules-path", paths.Resolve(paths.Home, "module"), "Path to modules directory")
+	testModuleCmd.Flags().Bool("update", false, "Overwrite the expected events with the ones produced")
+
+	return testModuleCmd
+}
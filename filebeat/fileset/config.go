@@ -40,6 +40,10 @@ type FilesetConfig struct {
 	Enabled *bool                  `config:"enabled"`
 	Var     map[string]interface{} `config:"var"`
 	Input   map[string]interface{} `config:"input"`
+
+	// LocalPipeline executes the ingest pipeline in the beat, instead of
+	// loading it in Elasticsearch.
+	LocalPipeline bool `config:"local_pipeline"`
 }
 
 // NewFilesetConfig creates a new FilesetConfig from a common.Config.
@@ -52,6 +52,11 @@ type Fileset struct {
 	manifest    *manifest
 	vars        map[string]interface{}
 	pipelineIDs []string
+	beatVersion string
+
+	// skippedProcessors are the ingest processors that are not executed
+	// when the pipeline is executed locally.
+	skippedProcessors []string
 }
 
 type pipeline struct {
@@ -102,6 +107,7 @@ func (fs *Fileset) Read(info beat.Info) error {
 		return err
 	}
 
+	fs.beatVersion = info.Version
 	return nil
 }
 
@@ -377,7 +383,20 @@ func (fs *Fileset) getInputConfig() (*common.Config, error) {
 	}
 
 	const pipelineField = "pipeline"
-	if !cfg.HasField(pipelineField) {
+	if fs.fcfg.LocalPipeline {
+		processors, err := fs.localPipelineProcessors()
+		if err != nil {
+			return nil, err
+		}
+		processorsCfg, err := common.NewConfigFrom(map[string]interface{}{"processors": processors})
+		if err != nil {
+			return nil, fmt.Errorf("Error creating config from the pipeline processors: %v", err)
+		}
+		cfg, err = common.MergeConfigsWithOptions([]*common.Config{cfg, processorsCfg}, ucfg.FieldAppendValues("processors"))
+		if err != nil {
+			return nil, fmt.Errorf("Error appending the pipeline processors: %v", err)
+		}
+	} else if !cfg.HasField(pipelineField) {
 		rootPipelineID := ""
 		if len(fs.pipelineIDs) > 0 {
 			rootPipelineID = fs.pipelineIDs[0]
@@ -266,6 +266,31 @@ func TestGetInputConfigNginxOverrides(t *testing.T) {
 	}
 }
 
+func TestGetInputConfigNginxLocalPipeline(t *testing.T) {
+	modulesPath, err := filepath.Abs("../module")
+	require.NoError(t, err)
+	fs, err := New(modulesPath, "access", &ModuleConfig{Module: "nginx"}, &FilesetConfig{LocalPipeline: true})
+	require.NoError(t, err)
+	require.NoError(t, fs.Read(makeTestInfo("8.0.0")))
+
+	cfg, err := fs.getInputConfig()
+	require.NoError(t, err)
+
+	assert.False(t, cfg.HasField("pipeline"))
+
+	var input struct {
+		Processors []*common.Config `config:"processors"`
+	}
+	require.NoError(t, cfg.Unpack(&input))
+	var names []string
+	for _, p := range input.Processors {
+		names = append(names, p.GetFields()...)
+	}
+	// The processors of the input are followed by the ones of the pipeline.
+	assert.Equal(t, []string{"add_locale", "add_fields", "grok", "grok", "drop_fields"}, names[:5])
+	assert.Contains(t, names, "timestamp")
+}
+
 func TestGetPipelineNginx(t *testing.T) {
 	fs := getModuleForTesting(t, "nginx", "access")
 	assert.NoError(t, fs.Read(makeTestInfo("5.2.0")))
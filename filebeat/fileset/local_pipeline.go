@@ -0,0 +1,415 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fileset
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// pipelineTranslator translates ingest pipelines to Beats processors, so
+// they can be executed locally. Processors that can't be translated are
+// skipped, and reported, as well as the on_failure handlers that are ignored.
+type pipelineTranslator struct {
+	// pipelines are the pipelines of the fileset, by ID, the pipeline
+	// processors referencing them are inlined.
+	pipelines map[string]map[string]interface{}
+	skipped   []string
+	ignored   []string
+}
+
+// translatePipelines translates the pipelines of a fileset, starting with
+// the root one.
+func translatePipelines(pipelines []pipeline) (processors []map[string]interface{}, skipped, ignored []string) {
+	if len(pipelines) == 0 {
+		return nil, nil, nil
+	}
+	t := &pipelineTranslator{pipelines: make(map[string]map[string]interface{}, len(pipelines))}
+	for _, p := range pipelines {
+		t.pipelines[p.id] = p.contents
+	}
+	processors = t.translate(pipelines[0].id, nil)
+	return processors, t.skipped, t.ignored
+}
+
+func (t *pipelineTranslator) translate(pipelineID string, stack []string) []map[string]interface{} {
+	pipeline := t.pipelines[pipelineID]
+	if _, found := pipeline["on_failure"]; found {
+		t.ignored = append(t.ignored, fmt.Sprintf("pipeline %s", pipelineID))
+	}
+	list, ok := pipeline["processors"].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	var processors []map[string]interface{}
+	for i, p := range list {
+		processor, ok := p.(map[string]interface{})
+		if !ok || len(processor) != 1 {
+			t.skip(pipelineID, i, "?", "invalid processor definition")
+			continue
+		}
+		for typ, o := range processor {
+			options, _ := o.(map[string]interface{})
+			translated, err := t.translateProcessor(typ, options, append(stack, pipelineID))
+			if err != nil {
+				t.skip(pipelineID, i, typ, err.Error())
+				continue
+			}
+			if _, found := options["on_failure"]; found {
+				t.ignored = append(t.ignored, describeProcessor(pipelineID, i, typ))
+			}
+			processors = append(processors, translated...)
+		}
+	}
+	return processors
+}
+
+func (t *pipelineTranslator) skip(pipelineID string, idx int, typ, reason string) {
+	t.skipped = append(t.skipped, describeProcessor(pipelineID, idx, typ)+": "+reason)
+}
+
+func describeProcessor(pipelineID string, idx int, typ string) string {
+	return fmt.Sprintf("%s processor #%d of pipeline %s", typ, idx+1, pipelineID)
+}
+
+func (t *pipelineTranslator) translateProcessor(typ string, options map[string]interface{}, stack []string) ([]map[string]interface{}, error) {
+	if _, found := options["if"]; found {
+		return nil, fmt.Errorf("conditions are not supported")
+	}
+
+	field, _ := options["field"].(string)
+	targetField, _ := options["target_field"].(string)
+	ignoreMissing, _ := options["ignore_missing"].(bool)
+	ignoreFailure, _ := options["ignore_failure"].(bool)
+
+	switch typ {
+	case "grok":
+		list, _ := options["patterns"].([]interface{})
+		patterns := make([]interface{}, 0, len(list))
+		for _, p := range list {
+			if p == "" {
+				// An empty pattern matches any value.
+				p = matchAllPattern
+			}
+			patterns = append(patterns, p)
+		}
+		config := map[string]interface{}{
+			"field":          field,
+			"patterns":       patterns,
+			"ignore_missing": ignoreMissing,
+			"ignore_failure": ignoreFailure,
+		}
+		if definitions, found := options["pattern_definitions"]; found {
+			config["pattern_definitions"] = definitions
+		}
+		return single("grok", config), nil
+
+	case "dissect":
+		pattern, _ := options["pattern"].(string)
+		separator, _ := options["append_separator"].(string)
+		if strings.Contains(pattern, "%{+") && separator != " " {
+			return nil, fmt.Errorf("append separator %q is not supported, only a space is", separator)
+		}
+		return single("dissect", map[string]interface{}{
+			"field":          field,
+			"tokenizer":      pattern,
+			"target_prefix":  "",
+			"overwrite_keys": true,
+			"ignore_failure": ignoreMissing || ignoreFailure,
+		}), nil
+
+	case "rename":
+		return single("rename", map[string]interface{}{
+			"fields":         []map[string]interface{}{{"from": field, "to": targetField}},
+			"ignore_missing": ignoreMissing,
+			"fail_on_error":  !ignoreFailure,
+		}), nil
+
+	case "set":
+		return translateSet(field, options)
+
+	case "remove":
+		var fields []interface{}
+		switch v := options["field"].(type) {
+		case string:
+			fields = []interface{}{v}
+		case []interface{}:
+			fields = v
+		}
+		return single("drop_fields", map[string]interface{}{
+			"fields":         fields,
+			"ignore_missing": ignoreMissing,
+		}), nil
+
+	case "date":
+		return translateDate(field, targetField, options, ignoreFailure)
+
+	case "convert":
+		typ, _ := options["type"].(string)
+		switch typ {
+		case "integer", "long", "float", "double", "string", "boolean", "ip":
+		default:
+			return nil, fmt.Errorf("conversion to %q is not supported", typ)
+		}
+		return single("convert", map[string]interface{}{
+			"fields":         []map[string]interface{}{{"from": field, "to": targetField, "type": typ}},
+			"ignore_missing": ignoreMissing,
+			"fail_on_error":  !ignoreFailure,
+		}), nil
+
+	case "json":
+		config := map[string]interface{}{
+			"fields":         []string{field},
+			"overwrite_keys": true,
+			"add_error_key":  !ignoreFailure,
+		}
+		if addToRoot, _ := options["add_to_root"].(bool); addToRoot {
+			config["target"] = ""
+		} else if targetField != "" {
+			config["target"] = targetField
+		}
+		return single("decode_json_fields", config), nil
+
+	case "urldecode":
+		return single("urldecode", map[string]interface{}{
+			"fields":         []map[string]interface{}{{"from": field, "to": targetField}},
+			"ignore_missing": ignoreMissing,
+			"fail_on_error":  !ignoreFailure,
+		}), nil
+
+	case "drop":
+		return single("drop_event", map[string]interface{}{}), nil
+
+	case "pipeline":
+		name, _ := options["name"].(string)
+		if _, found := t.pipelines[name]; !found {
+			return nil, fmt.Errorf("pipeline %q is not a pipeline of the fileset", name)
+		}
+		for _, id := range stack {
+			if id == name {
+				return nil, fmt.Errorf("pipeline %q is called recursively", name)
+			}
+		}
+		return t.translate(name, stack), nil
+
+	default:
+		return nil, fmt.Errorf("processor is not supported")
+	}
+}
+
+// matchAllPattern is the grok pattern used for the empty patterns of ingest
+// grok processors, the grok processor requires non-empty patterns.
+const matchAllPattern = "(?:)"
+
+func single(name string, config map[string]interface{}) []map[string]interface{} {
+	return []map[string]interface{}{{name: config}}
+}
+
+// fieldTemplateRE matches the mustache templates that only reference a field.
+var fieldTemplateRE = regexp.MustCompile(`^\{\{\{?\s*([^{}\s]+)\s*\}?\}\}$`)
+
+func translateSet(field string, options map[string]interface{}) ([]map[string]interface{}, error) {
+	override := true
+	if v, ok := options["override"].(bool); ok {
+		override = v
+	}
+
+	from, _ := options["copy_from"].(string)
+	if from == "" {
+		value := options["value"]
+		s, isString := value.(string)
+		if !isString || !strings.Contains(s, "{{") {
+			if !override {
+				return nil, fmt.Errorf("override: false is not supported with values")
+			}
+			return single("add_fields", map[string]interface{}{
+				"target": "",
+				"fields": map[string]interface{}{field: value},
+			}), nil
+		}
+
+		matches := fieldTemplateRE.FindStringSubmatch(s)
+		if matches == nil || strings.HasPrefix(matches[1], "_ingest.") {
+			return nil, fmt.Errorf("template %q is not supported", s)
+		}
+		from = matches[1]
+	}
+
+	copyFields := map[string]interface{}{
+		"fields":         []map[string]interface{}{{"from": from, "to": field}},
+		"ignore_missing": true,
+		"fail_on_error":  false,
+	}
+	if !override {
+		// copy_fields doesn't overwrite existing fields.
+		return single("copy_fields", copyFields), nil
+	}
+	return []map[string]interface{}{
+		{"drop_fields": map[string]interface{}{"fields": []string{field}, "ignore_missing": true}},
+		{"copy_fields": copyFields},
+	}, nil
+}
+
+func translateDate(field, targetField string, options map[string]interface{}, ignoreFailure bool) ([]map[string]interface{}, error) {
+	if targetField == "" {
+		targetField = "@timestamp"
+	}
+	timezone, _ := options["timezone"].(string)
+	if strings.Contains(timezone, "{{") {
+		return nil, fmt.Errorf("timezone template %q is not supported", timezone)
+	}
+	if locale, _ := options["locale"].(string); locale != "" && !strings.HasPrefix(strings.ToLower(locale), "en") {
+		return nil, fmt.Errorf("locale %q is not supported", locale)
+	}
+
+	formats, _ := options["formats"].([]interface{})
+	var layouts []string
+	for _, f := range formats {
+		format, _ := f.(string)
+		l, err := javaDateLayouts(format)
+		if err != nil {
+			return nil, err
+		}
+		layouts = append(layouts, l...)
+	}
+	if len(layouts) == 0 {
+		return nil, fmt.Errorf("no date formats")
+	}
+
+	config := map[string]interface{}{
+		"field":          field,
+		"target_field":   targetField,
+		"layouts":        layouts,
+		"ignore_failure": ignoreFailure,
+	}
+	if timezone != "" {
+		config["timezone"] = timezone
+	}
+	return single("timestamp", config), nil
+}
+
+// javaDateLayouts converts a date format of the date processor to the
+// equivalent Go time layouts.
+func javaDateLayouts(format string) ([]string, error) {
+	switch format {
+	case "ISO8601":
+		return []string{time.RFC3339Nano, "2006-01-02T15:04:05", "2006-01-02"}, nil
+	case "UNIX", "UNIX_MS":
+		return []string{format}, nil
+	}
+
+	var layout strings.Builder
+	runes := []rune(format)
+	for i := 0; i < len(runes); {
+		c := runes[i]
+		if c == '\'' {
+			// Quoted literal, '' is a quote.
+			end := i + 1
+			for end < len(runes) && runes[end] != '\'' {
+				end++
+			}
+			if end == i+1 {
+				layout.WriteRune('\'')
+			} else {
+				layout.WriteString(string(runes[i+1 : end]))
+			}
+			i = end + 1
+			continue
+		}
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z') {
+			if c >= '0' && c <= '9' {
+				return nil, fmt.Errorf("date format %q has literal digits", format)
+			}
+			layout.WriteRune(c)
+			i++
+			continue
+		}
+
+		n := 1
+		for i+n < len(runes) && runes[i+n] == c {
+			n++
+		}
+		i += n
+
+		var s string
+		switch c {
+		case 'y', 'u':
+			s = "2006"
+			if n == 2 {
+				s = "06"
+			}
+		case 'M', 'L':
+			s = layoutVariant(n, "1", "01", "Jan", "January")
+		case 'd':
+			s = layoutVariant(n, "2", "02")
+		case 'E':
+			s = "Mon"
+			if n >= 4 {
+				s = "Monday"
+			}
+		case 'a':
+			s = "PM"
+		case 'H':
+			s = "15"
+		case 'h':
+			s = layoutVariant(n, "3", "03")
+		case 'm':
+			s = layoutVariant(n, "4", "04")
+		case 's':
+			s = layoutVariant(n, "5", "05")
+		case 'S':
+			prev := layout.String()
+			if !strings.HasSuffix(prev, ".") && !strings.HasSuffix(prev, ",") {
+				return nil, fmt.Errorf("date format %q has fractions of seconds not following a dot or a comma", format)
+			}
+			s = strings.Repeat("0", n)
+		case 'Z':
+			switch {
+			case n <= 3:
+				s = "-0700"
+			case n == 5:
+				s = "-07:00"
+			}
+		case 'X':
+			s = layoutVariant(n, "Z07", "Z0700", "Z07:00")
+		case 'x':
+			s = layoutVariant(n, "-07", "-0700", "-07:00")
+		case 'z':
+			if n <= 3 {
+				s = "MST"
+			}
+		}
+		if s == "" {
+			return nil, fmt.Errorf("date format %q has the unsupported pattern %q", format, strings.Repeat(string(c), n))
+		}
+		layout.WriteString(s)
+	}
+	return []string{layout.String()}, nil
+}
+
+// layoutVariant returns the layout for a pattern letter repeated n times,
+// the last variant is used for longer repetitions.
+func layoutVariant(n int, variants ...string) string {
+	if n > len(variants) {
+		n = len(variants)
+	}
+	return variants[n-1]
+}
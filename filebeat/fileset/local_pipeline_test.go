@@ -0,0 +1,194 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fileset
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/processors"
+	_ "github.com/elastic/beats/v7/libbeat/processors/actions"
+	_ "github.com/elastic/beats/v7/libbeat/processors/convert"
+	_ "github.com/elastic/beats/v7/libbeat/processors/dissect"
+	_ "github.com/elastic/beats/v7/libbeat/processors/grok"
+	_ "github.com/elastic/beats/v7/libbeat/processors/timestamp"
+	_ "github.com/elastic/beats/v7/libbeat/processors/urldecode"
+)
+
+func TestTranslatePipelines(t *testing.T) {
+	pipelines := []pipeline{
+		{
+			id: "root",
+			contents: map[string]interface{}{
+				"processors": []interface{}{
+					map[string]interface{}{"set": map[string]interface{}{"field": "event.ingested", "value": "{{_ingest.timestamp}}"}},
+					map[string]interface{}{"grok": map[string]interface{}{
+						"field":               "message",
+						"on_failure":          []interface{}{map[string]interface{}{"set": map[string]interface{}{"field": "error.message", "value": "no match"}}},
+						"patterns":            []interface{}{`^%{IP:source.ip} \[%{HTTPDATE:access.time}\] %{WORD:method} %{NUMBER:status:long} %{REST}$`},
+						"pattern_definitions": map[string]interface{}{"REST": "%{GREEDYDATA:rest}"},
+					}},
+					map[string]interface{}{"pipeline": map[string]interface{}{"name": "rest"}},
+					map[string]interface{}{"date": map[string]interface{}{
+						"field":        "access.time",
+						"target_field": "@timestamp",
+						"formats":      []interface{}{"dd/MMM/yyyy:H:m:s Z"},
+					}},
+					map[string]interface{}{"remove": map[string]interface{}{"field": []interface{}{"access", "message"}}},
+					map[string]interface{}{"set": map[string]interface{}{"field": "event.kind", "value": "event"}},
+					map[string]interface{}{"set": map[string]interface{}{"field": "source.address", "value": "{{source.ip}}"}},
+					map[string]interface{}{"user_agent": map[string]interface{}{"field": "user_agent.original"}},
+					map[string]interface{}{"rename": map[string]interface{}{"field": "status", "target_field": "http.response.status_code", "if": "ctx.status != null"}},
+				},
+			},
+		},
+		{
+			id: "rest",
+			contents: map[string]interface{}{
+				"on_failure": []interface{}{map[string]interface{}{"set": map[string]interface{}{"field": "error.message", "value": "rest"}}},
+				"processors": []interface{}{
+					map[string]interface{}{"dissect": map[string]interface{}{"field": "rest", "pattern": "%{url.path} %{bytes}"}},
+					map[string]interface{}{"convert": map[string]interface{}{"field": "bytes", "target_field": "http.response.bytes", "type": "long"}},
+					map[string]interface{}{"remove": map[string]interface{}{"field": "rest"}},
+					map[string]interface{}{"convert": map[string]interface{}{"field": "bytes", "type": "auto"}},
+				},
+			},
+		},
+	}
+
+	translated, skipped, ignored := translatePipelines(pipelines)
+	assert.Equal(t, []string{
+		`set processor #1 of pipeline root: template "{{_ingest.timestamp}}" is not supported`,
+		`convert processor #4 of pipeline rest: conversion to "auto" is not supported`,
+		`user_agent processor #8 of pipeline root: processor is not supported`,
+		`rename processor #9 of pipeline root: conditions are not supported`,
+	}, skipped)
+	assert.Equal(t, []string{"grok processor #2 of pipeline root", "pipeline rest"}, ignored)
+
+	var configs []*common.Config
+	for _, p := range translated {
+		configs = append(configs, common.MustNewConfigFrom(p))
+	}
+	procs, err := processors.New(configs)
+	require.NoError(t, err)
+
+	event, err := procs.Run(&beat.Event{Fields: common.MapStr{
+		"message": "10.1.2.3 [25/Oct/2020:12:34:56 +0200] GET 404 /missing 153",
+		"status":  "x",
+	}})
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2020, 10, 25, 10, 34, 56, 0, time.UTC), event.Timestamp.UTC())
+	assert.Equal(t, common.MapStr{
+		"source": common.MapStr{"ip": "10.1.2.3", "address": "10.1.2.3"},
+		"method": "GET",
+		"status": int64(404),
+		"url":    common.MapStr{"path": "/missing"},
+		"bytes":  "153",
+		"http":   common.MapStr{"response": common.MapStr{"bytes": int64(153)}},
+		"event":  common.MapStr{"kind": "event"},
+	}, event.Fields)
+}
+
+func TestTranslateModulePipelines(t *testing.T) {
+	modulesPath, err := filepath.Abs("../module")
+	require.NoError(t, err)
+	modules, err := ioutil.ReadDir(modulesPath)
+	require.NoError(t, err)
+
+	for _, module := range modules {
+		filesets, err := ioutil.ReadDir(filepath.Join(modulesPath, module.Name()))
+		require.NoError(t, err)
+		for _, fileset := range filesets {
+			manifest := filepath.Join(modulesPath, module.Name(), fileset.Name(), "manifest.yml")
+			if _, err := os.Stat(manifest); err != nil {
+				continue
+			}
+			t.Run(module.Name()+"/"+fileset.Name(), func(t *testing.T) {
+				fs, err := New(modulesPath, fileset.Name(), &ModuleConfig{Module: module.Name()}, &FilesetConfig{LocalPipeline: true})
+				require.NoError(t, err)
+				require.NoError(t, fs.Read(makeTestInfo("8.0.0")))
+
+				translated, err := fs.localPipelineProcessors()
+				require.NoError(t, err)
+				var configs []*common.Config
+				for _, p := range translated {
+					configs = append(configs, common.MustNewConfigFrom(p))
+				}
+				_, err = processors.New(configs)
+				assert.NoError(t, err)
+			})
+		}
+	}
+}
+
+func TestTranslateRecursivePipelines(t *testing.T) {
+	call := func(name string) map[string]interface{} {
+		return map[string]interface{}{
+			"processors": []interface{}{
+				map[string]interface{}{"pipeline": map[string]interface{}{"name": name}},
+			},
+		}
+	}
+	translated, skipped, _ := translatePipelines([]pipeline{
+		{id: "a", contents: call("b")},
+		{id: "b", contents: call("a")},
+	})
+	assert.Empty(t, translated)
+	assert.Equal(t, []string{`pipeline processor #1 of pipeline b: pipeline "a" is called recursively`}, skipped)
+}
+
+func TestJavaDateLayouts(t *testing.T) {
+	tests := []struct {
+		format string
+		value  string
+	}{
+		{"dd/MMM/yyyy:HH:mm:ss Z", "25/Oct/2020:12:34:56 +0200"},
+		{"yyyy-MM-dd'T'HH:mm:ss.SSSXXX", "2020-10-25T12:34:56.789+02:00"},
+		{"yyyy-MM-dd HH:mm:ss,SSS", "2020-10-25 12:34:56,789"},
+		{"EEE MMM d HH:mm:ss yyyy", "Sun Oct 25 12:34:56 2020"},
+		{"MMM  d HH:mm:ss", "Oct  5 12:34:56"},
+		{"yy/M/d h:mm:ss a", "20/10/25 2:34:56 PM"},
+		{"ISO8601", "2020-10-25T12:34:56Z"},
+	}
+	for _, test := range tests {
+		t.Run(test.format, func(t *testing.T) {
+			layouts, err := javaDateLayouts(test.format)
+			require.NoError(t, err)
+			var parsed bool
+			for _, layout := range layouts {
+				if _, err := time.Parse(layout, test.value); err == nil {
+					parsed = true
+				}
+			}
+			assert.True(t, parsed, "%v does not parse %v", layouts, test.value)
+		})
+	}
+
+	for _, format := range []string{"strict_date_optional_time", "yyyy-DDD", "HHmmssSSS", "TAI64N"} {
+		_, err := javaDateLayouts(format)
+		assert.Error(t, err, format)
+	}
+}
@@ -62,6 +62,11 @@ func (m MultiplePipelineUnsupportedError) Error() string {
 func (reg *ModuleRegistry) LoadPipelines(esClient PipelineLoader, overwrite bool) error {
 	for module, filesets := range reg.registry {
 		for name, fileset := range filesets {
+			if fileset.fcfg != nil && fileset.fcfg.LocalPipeline {
+				logp.Debug("modules", "Not loading pipelines of fileset %s/%s, they are executed locally", module, name)
+				continue
+			}
+
 			// check that all the required Ingest Node plugins are available
 			requiredProcessors := fileset.GetRequiredProcessors()
 			logp.Debug("modules", "Required processors: %s", requiredProcessors)
@@ -112,6 +117,35 @@ func (reg *ModuleRegistry) LoadPipelines(esClient PipelineLoader, overwrite bool
 	return nil
 }
 
+// localPipelineProcessors translates the pipelines of the fileset to
+// processors executed by the beat. The ingest processors that can't be
+// translated are reported, and skipped, they are kept in skippedProcessors.
+func (fs *Fileset) localPipelineProcessors() ([]map[string]interface{}, error) {
+	// Pipelines are evaluated as if they were loaded in an Elasticsearch of
+	// the same version.
+	version, err := common.NewVersion(fs.beatVersion)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing the beat version: %v", err)
+	}
+	pipelines, err := fs.GetPipelines(*version)
+	if err != nil {
+		return nil, fmt.Errorf("Error getting pipeline for fileset %s: %v", fs, err)
+	}
+
+	processors, skipped, ignored := translatePipelines(pipelines)
+	if len(skipped) > 0 {
+		logp.Warn("%d ingest processors of fileset %s can't be executed locally and are skipped: %s",
+			len(skipped), fs, strings.Join(skipped, "; "))
+	}
+	if len(ignored) > 0 {
+		logp.Warn("The on_failure handlers of fileset %s can't be executed locally and are ignored: %s",
+			fs, strings.Join(ignored, "; "))
+	}
+	fs.skippedProcessors = skipped
+	logp.Info("Executing the ingest pipeline of fileset %s locally with %d processors", fs, len(processors))
+	return processors, nil
+}
+
 func loadPipeline(esClient PipelineLoader, pipelineID string, content map[string]interface{}, overwrite bool) error {
 	path := makeIngestPipelinePath(pipelineID)
 	if !overwrite {
@@ -0,0 +1,520 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fileset
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/v7/filebeat/channel"
+	"github.com/elastic/beats/v7/filebeat/input"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/cfgfile"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/publisher/processing"
+)
+
+// maxReplayEvents is the number of events of a test file that are compared,
+// the same amount the system tests fetch from Elasticsearch.
+const maxReplayEvents = 100
+
+// TestFile is a sample log of a fileset, stored under the test directory of
+// the fileset next to the events it is expected to produce.
+type TestFile struct {
+	Module  string
+	Fileset string
+	Path    string
+}
+
+// ExpectedPath returns the path of the file containing the expected events.
+func (t TestFile) ExpectedPath() string {
+	return t.Path + "-expected.json"
+}
+
+func (t TestFile) String() string {
+	return t.Module + "/" + t.Fileset + " on " + filepath.Base(t.Path)
+}
+
+// FindTestFiles returns the test logs of the filesets matching the selectors.
+// A selector is either a module name or a `module.fileset` pair. All modules
+// are selected when no selector is given.
+func FindTestFiles(modulesPath string, selectors ...string) ([]TestFile, error) {
+	if len(selectors) == 0 {
+		fileInfos, err := ioutil.ReadDir(modulesPath)
+		if err != nil {
+			return nil, err
+		}
+		for _, fi := range fileInfos {
+			if fi.IsDir() {
+				selectors = append(selectors, fi.Name())
+			}
+		}
+	}
+
+	var testFiles []TestFile
+	for _, selector := range selectors {
+		module, filesetName := selector, ""
+		if idx := strings.Index(selector, "."); idx >= 0 {
+			module, filesetName = selector[:idx], selector[idx+1:]
+		}
+
+		filesets, err := getModuleFilesets(modulesPath, module)
+		if err != nil {
+			return nil, fmt.Errorf("error getting filesets for module %s: %v", module, err)
+		}
+		found := false
+		for _, name := range filesets {
+			if filesetName != "" && name != filesetName {
+				continue
+			}
+			found = true
+
+			paths, err := filepath.Glob(filepath.Join(modulesPath, module, name, "test", "*.log"))
+			if err != nil {
+				return nil, err
+			}
+			for _, path := range paths {
+				testFiles = append(testFiles, TestFile{Module: module, Fileset: name, Path: path})
+			}
+		}
+		if filesetName != "" && !found {
+			return nil, fmt.Errorf("fileset %s/%s doesn't exist", module, filesetName)
+		}
+	}
+	return testFiles, nil
+}
+
+// Replay reads the test file with the input of its fileset, as configured by
+// the system tests, and runs the resulting events through the input processors
+// and the ingest pipeline translated to local processors. The events are
+// returned flattened and cleaned of the fields that depend on the host or the
+// time of the run. The ingest processors that can't be executed locally are
+// returned too, the events lack the changes they would make.
+func Replay(modulesPath string, info beat.Info, t TestFile) (docs []common.MapStr, skipped []string, err error) {
+	fcfg := &FilesetConfig{
+		Var: map[string]interface{}{
+			"input": "file",
+			"paths": []string{t.Path},
+		},
+		Input: map[string]interface{}{
+			"close_eof": true,
+		},
+		LocalPipeline: true,
+	}
+	// Based on the convention that test files containing -json are in JSON format.
+	if strings.Contains(filepath.Base(t.Path), "-json") {
+		fcfg.Var["format"] = "json"
+	}
+	mcfg := &ModuleConfig{
+		Module:   t.Module,
+		Filesets: map[string]*FilesetConfig{t.Fileset: fcfg},
+	}
+
+	fs, err := New(modulesPath, t.Fileset, mcfg, fcfg)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = fs.Read(info); err != nil {
+		return nil, nil, fmt.Errorf("error reading fileset %s: %v", fs, err)
+	}
+	inputConfig, err := fs.getInputConfig()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting config for fileset %s: %v", fs, err)
+	}
+
+	pipeline, err := newReplayPipeline(info)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer pipeline.support.Close()
+
+	done := make(chan struct{})
+	defer close(done)
+
+	factory := channel.RunnerFactoryWithCommonInputSettings(info, &replayInputFactory{done: done})
+	runner, err := factory.Create(pipeline, inputConfig)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error creating input for fileset %s: %v", fs, err)
+	}
+
+	// Inputs run once return from Start after their harvesters have reached EOF.
+	runner.Start()
+	runner.Stop()
+
+	docs, err = pipeline.documents()
+	return docs, fs.skippedProcessors, err
+}
+
+// replayInputFactory creates inputs that stop after reading their files once.
+type replayInputFactory struct {
+	done chan struct{}
+}
+
+func (f *replayInputFactory) Create(p beat.PipelineConnector, c *common.Config) (cfgfile.Runner, error) {
+	runner, err := input.New(c, channel.NewOutletFactory(f.done).Create(p), f.done, nil)
+	if err != nil {
+		return nil, err
+	}
+	runner.Once = true
+	return runner, nil
+}
+
+func (f *replayInputFactory) CheckConfig(c *common.Config) error {
+	return nil
+}
+
+// replayPipeline is a publisher pipeline that runs the client processors and
+// keeps the published events in memory instead of sending them to an output.
+type replayPipeline struct {
+	support processing.Supporter
+
+	mu     sync.Mutex
+	events []beat.Event
+}
+
+type replayClient struct {
+	pipeline   *replayPipeline
+	processors beat.Processor
+	acker      beat.ACKer
+}
+
+func newReplayPipeline(info beat.Info) (*replayPipeline, error) {
+	support, err := processing.MakeDefaultBeatSupport(true)(info, logp.NewLogger("test_module"), common.NewConfig())
+	if err != nil {
+		return nil, err
+	}
+	return &replayPipeline{support: support}, nil
+}
+
+func (p *replayPipeline) Connect() (beat.Client, error) {
+	return p.ConnectWith(beat.ClientConfig{})
+}
+
+func (p *replayPipeline) ConnectWith(cfg beat.ClientConfig) (beat.Client, error) {
+	processors, err := p.support.Create(cfg.Processing, false)
+	if err != nil {
+		return nil, err
+	}
+	return &replayClient{pipeline: p, processors: processors, acker: cfg.ACKHandler}, nil
+}
+
+func (c *replayClient) Publish(event beat.Event) {
+	c.PublishAll([]beat.Event{event})
+}
+
+func (c *replayClient) PublishAll(events []beat.Event) {
+	for _, event := range events {
+		e := &event
+		if c.processors != nil {
+			e, _ = c.processors.Run(e)
+		}
+		if c.acker != nil {
+			c.acker.AddEvent(event, e != nil)
+		}
+		if e != nil {
+			c.pipeline.mu.Lock()
+			c.pipeline.events = append(c.pipeline.events, *e)
+			c.pipeline.mu.Unlock()
+		}
+	}
+	if c.acker != nil {
+		c.acker.ACKEvents(len(events))
+	}
+}
+
+func (c *replayClient) Close() error {
+	return nil
+}
+
+// documents returns the collected events as they would be indexed, ordered by
+// their offset in the test file.
+func (p *replayPipeline) documents() ([]common.MapStr, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	docs := make([]common.MapStr, 0, len(p.events))
+	for _, event := range p.events {
+		fields := event.Fields.Clone()
+		fields["@timestamp"] = common.Time(event.Timestamp)
+
+		// Encode and decode the event so values have the same types as the
+		// documents read from the expected files.
+		data, err := json.Marshal(fields)
+		if err != nil {
+			return nil, fmt.Errorf("error encoding event: %v", err)
+		}
+		var doc common.MapStr
+		if err := json.Unmarshal(data, &doc); err != nil {
+			return nil, fmt.Errorf("error decoding event: %v", err)
+		}
+		doc = doc.Flatten()
+		cleanTestEvent(doc)
+		docs = append(docs, doc)
+	}
+
+	sort.SliceStable(docs, func(i, j int) bool {
+		return offsetOf(docs[i]) < offsetOf(docs[j])
+	})
+	if len(docs) > maxReplayEvents {
+		docs = docs[:maxReplayEvents]
+	}
+	return docs, nil
+}
+
+func offsetOf(doc common.MapStr) float64 {
+	offset, _ := doc["log.offset"].(float64)
+	return offset
+}
+
+// Datasets whose @timestamp is not compared, most of them because their logs
+// don't include the year. This list and keepTimestampFiles are copies of the
+// ones in clean_keys of filebeat/tests/system/test_modules.py, they must be
+// updated together.
+var removeTimestampDatasets = map[string]bool{
+	"activemq.audit":              true,
+	"barracuda.spamfirewall":      true,
+	"barracuda.waf":               true,
+	"bluecoat.director":           true,
+	"cef.log":                     true,
+	"cisco.asa":                   true,
+	"cisco.ios":                   true,
+	"citrix.netscaler":            true,
+	"cyberark.corepas":            true,
+	"cylance.protect":             true,
+	"f5.bigipafm":                 true,
+	"fortinet.clientendpoint":     true,
+	"haproxy.log":                 true,
+	"icinga.startup":              true,
+	"imperva.securesphere":        true,
+	"infoblox.nios":               true,
+	"iptables.log":                true,
+	"juniper.netscreen":           true,
+	"netscout.sightline":          true,
+	"proofpoint.emailsecurity":    true,
+	"redis.log":                   true,
+	"snort.log":                   true,
+	"symantec.endpointprotection": true,
+	"system.auth":                 true,
+	"system.syslog":               true,
+	"microsoft.defender_atp":      true,
+	"crowdstrike.falcon_endpoint": true,
+	"crowdstrike.falcon_audit":    true,
+	"gsuite.admin":                true,
+	"gsuite.config":               true,
+	"gsuite.drive":                true,
+	"gsuite.groups":               true,
+	"gsuite.ingest":               true,
+	"gsuite.login":                true,
+	"gsuite.saml":                 true,
+	"gsuite.user_accounts":        true,
+	"zoom.webhook":                true,
+}
+
+// Dataset and test file pairs that keep @timestamp despite being listed above.
+var keepTimestampFiles = map[[2]string]bool{
+	{"system.syslog", "tz-offset.log"}: true,
+	{"system.auth", "timestamp.log"}:   true,
+	{"cisco.asa", "asa.log"}:           true,
+	{"cisco.asa", "hostnames.log"}:     true,
+	{"cisco.asa", "not-ip.log"}:        true,
+	{"cisco.asa", "sample.log"}:        true,
+}
+
+// cleanTestEvent removes the fields of a flattened event that change between
+// runs, following the rules of clean_keys in the module system tests.
+func cleanTestEvent(doc common.MapStr) {
+	keys := []string{
+		"agent.name", "agent.type", "agent.ephemeral_id", "agent.id", "agent.version",
+		"event.created", "event.ingested",
+		"log.file.path",
+		"ecs.version",
+	}
+	// host.name is only kept for forwarded events.
+	if !isForwarded(doc) {
+		keys = append(keys, "host.name")
+	}
+
+	var filename string
+	if path, ok := doc["log.file.path"].(string); ok {
+		filename = strings.ToLower(filepath.Base(path))
+	}
+	for _, key := range keys {
+		delete(doc, key)
+	}
+
+	dataset, _ := doc["event.dataset"].(string)
+	if removeTimestampDatasets[dataset] {
+		if keepTimestampFiles[[2]string{dataset, filename}] {
+			// The file name is kept so the exception applies when the
+			// expected events are loaded.
+			doc["log.file.path"] = filename
+		} else {
+			delete(doc, "@timestamp")
+			delete(doc, "rsa.time.event_time")
+		}
+	}
+
+	if _, hasEnd := doc["event.end"]; dataset == "aws.vpcflow" && !hasEnd {
+		delete(doc, "@timestamp")
+	}
+}
+
+func isForwarded(doc common.MapStr) bool {
+	tags, _ := doc["tags"].([]interface{})
+	for _, tag := range tags {
+		if tag == "forwarded" {
+			return true
+		}
+	}
+	return false
+}
+
+// ReadExpectedEvents reads the expected events of a test file.
+func ReadExpectedEvents(t TestFile) ([]common.MapStr, error) {
+	data, err := ioutil.ReadFile(t.ExpectedPath())
+	if err != nil {
+		return nil, err
+	}
+	var docs []common.MapStr
+	if err := json.Unmarshal(data, &docs); err != nil {
+		return nil, fmt.Errorf("error decoding %s: %v", t.ExpectedPath(), err)
+	}
+	for _, doc := range docs {
+		cleanTestEvent(doc)
+	}
+	return docs, nil
+}
+
+// WriteExpectedEvents overwrites the expected events of a test file.
+func WriteExpectedEvents(t TestFile, docs []common.MapStr) error {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "    ")
+	if err := enc.Encode(docs); err != nil {
+		return err
+	}
+	// The expected files don't end with a new line.
+	return ioutil.WriteFile(t.ExpectedPath(), bytes.TrimSuffix(buf.Bytes(), []byte("\n")), 0644)
+}
+
+// DiffEvents compares the events produced for a test file with the expected
+// ones, and describes each difference found. The order of the values of
+// arrays is ignored.
+func DiffEvents(expected, actual []common.MapStr) []string {
+	var diffs []string
+	if len(expected) != len(actual) {
+		diffs = append(diffs, fmt.Sprintf("expected %d events but got %d", len(expected), len(actual)))
+	}
+
+	for i := 0; i < len(expected) && i < len(actual); i++ {
+		want, got := expected[i], actual[i]
+		for _, key := range sortedKeys(want, got) {
+			wantValue, inWant := want[key]
+			gotValue, inGot := got[key]
+			switch {
+			case !inGot:
+				diffs = append(diffs, fmt.Sprintf("event %d: missing field %s, expected %s", i, key, encodeValue(wantValue)))
+			case !inWant:
+				diffs = append(diffs, fmt.Sprintf("event %d: unexpected field %s: %s", i, key, encodeValue(gotValue)))
+			case key == "@timestamp" && sameInstant(wantValue, gotValue):
+			case !equalIgnoringOrder(wantValue, gotValue):
+				diffs = append(diffs, fmt.Sprintf("event %d: field %s: expected %s but got %s", i, key, encodeValue(wantValue), encodeValue(gotValue)))
+			}
+		}
+	}
+	return diffs
+}
+
+func sortedKeys(maps ...common.MapStr) []string {
+	seen := map[string]bool{}
+	var keys []string
+	for _, m := range maps {
+		for k := range m {
+			if !seen[k] {
+				seen[k] = true
+				keys = append(keys, k)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func equalIgnoringOrder(a, b interface{}) bool {
+	switch a := a.(type) {
+	case []interface{}:
+		b, ok := b.([]interface{})
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		used := make([]bool, len(b))
+	next:
+		for _, va := range a {
+			for j, vb := range b {
+				if !used[j] && equalIgnoringOrder(va, vb) {
+					used[j] = true
+					continue next
+				}
+			}
+			return false
+		}
+		return true
+	case map[string]interface{}:
+		b, ok := b.(map[string]interface{})
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for k, va := range a {
+			vb, found := b[k]
+			if !found || !equalIgnoringOrder(va, vb) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(a, b)
+	}
+}
+
+// sameInstant reports if both values are timestamps of the same instant. The
+// timestamps set by the ingest pipelines keep the offset of their timezone.
+func sameInstant(a, b interface{}) bool {
+	sa, _ := a.(string)
+	sb, _ := b.(string)
+	ta, errA := time.Parse(time.RFC3339Nano, sa)
+	tb, errB := time.Parse(time.RFC3339Nano, sb)
+	return errA == nil && errB == nil && ta.Equal(tb)
+}
+
+func encodeValue(v interface{}) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(data)
+}
@@ -0,0 +1,170 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package fileset
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/elastic/beats/v7/filebeat/input/log"
+	"github.com/elastic/beats/v7/libbeat/common"
+	_ "github.com/elastic/beats/v7/libbeat/processors/actions"
+	_ "github.com/elastic/beats/v7/libbeat/processors/add_locale"
+	_ "github.com/elastic/beats/v7/libbeat/processors/dissect"
+	_ "github.com/elastic/beats/v7/libbeat/processors/timestamp"
+)
+
+func TestFindTestFiles(t *testing.T) {
+	modulesPath, err := filepath.Abs("../module")
+	require.NoError(t, err)
+
+	testFiles, err := FindTestFiles(modulesPath, "nginx.error")
+	require.NoError(t, err)
+	require.NotEmpty(t, testFiles)
+	for _, tf := range testFiles {
+		assert.Equal(t, "nginx", tf.Module)
+		assert.Equal(t, "error", tf.Fileset)
+		assert.Equal(t, ".log", filepath.Ext(tf.Path))
+		assert.FileExists(t, tf.ExpectedPath())
+	}
+
+	all, err := FindTestFiles(modulesPath, "nginx")
+	require.NoError(t, err)
+	assert.True(t, len(all) > len(testFiles))
+
+	_, err = FindTestFiles(modulesPath, "nginx.notexisting")
+	assert.Error(t, err)
+}
+
+func TestReplay(t *testing.T) {
+	modulesPath, err := filepath.Abs("test/replay")
+	require.NoError(t, err)
+
+	testFiles, err := FindTestFiles(modulesPath)
+	require.NoError(t, err)
+	require.Len(t, testFiles, 1)
+
+	docs, skipped, err := Replay(modulesPath, makeTestInfo("8.0.0"), testFiles[0])
+	require.NoError(t, err)
+	assert.Empty(t, skipped)
+
+	expected, err := ReadExpectedEvents(testFiles[0])
+	require.NoError(t, err)
+	assert.Empty(t, DiffEvents(expected, docs))
+}
+
+func TestReplayModule(t *testing.T) {
+	modulesPath, err := filepath.Abs("../module")
+	require.NoError(t, err)
+
+	testFiles, err := FindTestFiles(modulesPath, "system.syslog")
+	require.NoError(t, err)
+	require.NotEmpty(t, testFiles)
+
+	// The events are produced, but the processors with conditions, like the
+	// one setting related.hosts, are skipped and reported.
+	docs, skipped, err := Replay(modulesPath, makeTestInfo("8.0.0"), testFiles[0])
+	require.NoError(t, err)
+	assert.NotEmpty(t, docs)
+	assert.Contains(t, skipped, "append processor #9 of pipeline filebeat-8.0.0-system-syslog-pipeline: conditions are not supported")
+}
+
+func TestCleanTestEvent(t *testing.T) {
+	doc := common.MapStr{
+		"@timestamp":     "2020-01-02T03:04:05.000Z",
+		"agent.id":       "b5a8d5c6",
+		"ecs.version":    "1.6.0",
+		"event.dataset":  "system.syslog",
+		"event.ingested": "2020-01-02T03:04:06.000Z",
+		"host.name":      "example",
+		"log.file.path":  "/var/log/syslog",
+		"message":        "hello",
+	}
+	cleanTestEvent(doc)
+	assert.Equal(t, common.MapStr{"event.dataset": "system.syslog", "message": "hello"}, doc)
+
+	doc = common.MapStr{
+		"@timestamp":    "2020-01-02T03:04:05.000Z",
+		"event.dataset": "system.auth",
+		"host.name":     "example",
+		"log.file.path": "/tmp/module/system/auth/test/Timestamp.log",
+		"tags":          []interface{}{"forwarded"},
+	}
+	cleanTestEvent(doc)
+	assert.Equal(t, common.MapStr{
+		"@timestamp":    "2020-01-02T03:04:05.000Z",
+		"event.dataset": "system.auth",
+		"host.name":     "example",
+		"log.file.path": "timestamp.log",
+		"tags":          []interface{}{"forwarded"},
+	}, doc)
+}
+
+func TestDiffEvents(t *testing.T) {
+	expected := []common.MapStr{
+		{
+			"@timestamp":    "2020-01-02T01:04:05.000-02:00",
+			"event.type":    []interface{}{"access", "start"},
+			"message":       "hello",
+			"source.port":   float64(80),
+			"user.name":     "alice",
+			"url.path.list": []interface{}{map[string]interface{}{"a": "b"}},
+		},
+	}
+
+	t.Run("equal", func(t *testing.T) {
+		actual := []common.MapStr{
+			{
+				"@timestamp":    "2020-01-02T03:04:05Z",
+				"event.type":    []interface{}{"start", "access"},
+				"message":       "hello",
+				"source.port":   float64(80),
+				"user.name":     "alice",
+				"url.path.list": []interface{}{map[string]interface{}{"a": "b"}},
+			},
+		}
+		assert.Empty(t, DiffEvents(expected, actual))
+	})
+
+	t.Run("different", func(t *testing.T) {
+		actual := []common.MapStr{
+			{
+				"@timestamp":    "2020-01-02T03:04:06Z",
+				"error.message": "failed",
+				"event.type":    []interface{}{"access"},
+				"message":       "hello",
+				"source.port":   "80",
+				"url.path.list": []interface{}{map[string]interface{}{"a": "b"}},
+			},
+			{"message": "extra"},
+		}
+		assert.Equal(t, []string{
+			"expected 1 events but got 2",
+			`event 0: field @timestamp: expected "2020-01-02T01:04:05.000-02:00" but got "2020-01-02T03:04:06Z"`,
+			`event 0: unexpected field error.message: "failed"`,
+			`event 0: field event.type: expected ["access","start"] but got ["access"]`,
+			`event 0: field source.port: expected 80 but got "80"`,
+			`event 0: missing field user.name, expected "alice"`,
+		}, DiffEvents(expected, actual))
+	})
+}
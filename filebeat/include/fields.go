@@ -32,5 +32,5 @@ func init() {
 // AssetFieldsYml returns asset data.
 // This is the base64 encoded gzipped contents of fields.yml.
 func AssetFieldsYml() string {
-	return "eJzs/XtTHDmWMIz/359CPzbih5ktkipuxrzvRDw00N3E2pgxeHqnxxugylRVaciUsiUluPqJ/e5v6BxJqbwAhU3Zbg+zz+OmqjKlo6Ojc9O5/Af59eDd6cnpz/8/ciSJkIawjBtiZlyTCc8ZybhiqcnnA8INuaWaTJlgihqWkfGcmBkjx4fnpFTyXyw1gx/+g4ypZhmRAr6/YUpzKcgo2U2GyQ//Qc5yRjUjN1xzQ2bGlHp/Y2PKzawaJ6ksNlhOteHpBks1MZLoajpl2pB0RsWUwVd22AlneaaTH35YJ9dsvk9Yqn8gxHCTs337wA+EZEynipeGSwFfkZ/cO8S9vf8DIetE0ILtk9X/Y3jBtKFFufoDIYTk7Ibl+ySVisFnxX6vuGLZPjGqwq/MvGT7JKMGPzbmWz2ihm3YMcntjAlAE7thwhCp+JQLi77kB3iPkAuLa67hoSy8xz4aRVOL5omSRT3CwE7MU5rnc6JYqZhmwnAxhYnciPV0vRumZaVSFuY/mUQv4G9kRjUR0kObk4CeAZLGDc0rBkAHYEpZVrmdxg3rJptwpQ283wJLsZTxmxqqkpcs56KG653DOe4XmUhFaJ7jCDrBfWIfaVHaTV/dHI5214c765tbF8O9/eHO/tZ2srez9dtqtM05HbNc924w7qYcWyqGL/DPS/z+ms1vpcp6Nvqw0kYW9oENxElJudJhDYdUkDEjlT0SRhKaZaRghhIuJlIV1A5iv3drIuczWeUZHMNUCkO5IIJpu3UIDpCv/d9BnuMeaEIVI9pIiyiqPaQBgGOPoKtMptdMXREqMnJ1vaevHDo6mPy/K7Qsc54CdCv7ZGUi5fqYqpUBWWHixn5TKplVKfz+vzGCC6Y1nbJ7MGzYR9ODxp+kIrmcOkQAPbix3O47dOBP9kn384DI0vCC/xHoztLJDWe39kxwQSg8bb9gKmDFTqeNqlJTWbzlcqrJLTczWRlCRU32DRgGRJoZU459kBS3NpUipYaJiPKNtEAUhJJZVVCxrhjN6DhnRFdFQdWcyOjExcewqHLDyzysXRP2kWt75GdsXk9YjLlgGeHCSCJFeLq9kb+wPJfkV6nyLNoiQ6f3nYCY0vlUSMUu6VjesH0yGm5ud3fuNdfGrse9pwOpGzoljKYzv8omjf0zJiGkq82V/4lJiU6ZQEpxbP0gfDFVsir3yWYPHV3MGL4ZdskdI8dcKaFju8nIBifm1p4ey0CNFXATtxVUzC3OqT2FeW7P3YBkzOAfUhE51kzd2O1BcpWWzGbS7pRUxNBrpknBqK4UK+wDbtjwWPt0asJFmlcZIz8yavkArFWTgs4JzbUkqhL2bTev0glINFho8he3VDeknlkmOWY1PwbKtvBTnmtPe4gkVQlhz4lEBFnYovUpN+TtjKmYe89oWTJLgXaxcFLDUoGzWwQIR40TKY2Qxu65X+w+OcHpUqsJyAkuGs6tPYiDGr7EkgJxmsiYUZNE5/fg7A3oJE5yNhfkdpyW5YZdCk9ZQmraiLlvJplHHbBdUDQInyC1cE2sfCVmpmQ1nZHfK1bZ8fVcG1ZokvNrRv6LTq7pgLxjGUf6KJVMmdZcTP2muMd1lc4sl34tp9pQPSO4DnIO6HYow4MIRI4oDOpKfTrGFc+zxPMpN0v7RPed6TtPdfskHX80TGRWPNupGiibuH3HPfK07BQZZNdWoxFuACPDKaRi3jMenDSKCEf9IwxpT0Cp5A3P2MAqJLpkKZ/wlODboPhwHdQzh8GI0xTMKJ5a2gm66Euri5IXtMh2t9cGJOdj+Bm//ucu3dxie5O9ydZwsjMcjsZ0a3ubbbOd7Wwve5WO9zbT8Wj4Mg0g2vUYsjncHK4PN9eHO2Rza3803B8NyX8Oh8MheX9x+D8BwxNa5eYScLRPJjTXrLGtrJyxgimaX/KsuanMbccTbKyfg/DMcr4JZwq5AtfufLzgExAsIH30WnuLudVQVAFan1fMaaqkthuhDVWWTY4rQ66QQnh2BcfMHrDuDu3RbYvoSQMR7eU/DU2/F/x3q7Y+ft1BjbKcB/kVvHcL+tqYEeBOvIcA3fKyxvLsv8tYoNNGgW3GjL6zg5pQfAqlHGoWU37DQB2lwr2GT7ufZywvJ1VueaPlAG6FYWBzK8lPjk8TLrShInXqaUvMaDsxyBpLJE5LIrWWxEqqgDOEsbkmgrEM7crbGU9n3akCw05lYSezZlO07pOJ5R9eoMBSUdL4r+TEMEFyNjGEFaWZd7dyImVjF+1GLWMXL+blPdvnhZidgND8ls410cb+G3BrVXw986SJ2+qsLHzXKmlJjRoRRHHAav0skribaMzqR0Az4ZPGxtc71iaAxuYXNJ1ZU6+L4ngcj2fHuJeA6r87kdBEdgum3WSYDNdVuhlrp7qhmlZGClnISpNzkPQPqKkHgtD6FVQOyIuD8zU8mE7pdIClUggGjoATYZgSzJAzJY1MpZf7L07O1oiSFUjDUrEJ/8g0qUTGUE5b6atkbgez3E0qUkjFiGDmVqprIkumqJHK6rHedmczmk/sC5RYNSZnhGYFF1wbezJvvM5sx8pkgQo2NcS5I3ARRSHFgKQ5oyqf1xIQbJcArcx5Ogd7YcZAZbALTBbWg0RVjIOeep+ozGVQxhpb4UQCjkNonssUdGYHUWebnBoZvg4E73bRDfTi4Px0jVQweD6vJY5GmyigHs/ESWPdEemNdka7rxoLlmpKBf8D2GPSFSOfoyaA9XkZYzlidd5sJ11LnoDqrAodazTkPnWntQdvozXBfB08/CylpcHXrw+jM5jmvGUiHtbf3GMjHrg37WHz9Ei1I0BuuD0LSPp+m9wRdLqvBw5tP8WmVGVgE1iVXwo9iJ5He2DM0YvKpaA5meTyliiWWnO54ZG4ODxzo6JkqsHswGa/sI9HkMEB1EwES9A+c/6PU1LS9JqZF3otgVnQiVE6FtKZCr2FVrVrTOpNWAW6NtMWDmdkeSwZRYWmAExCzmXBgtlTaTQfDVMFWfEuUKlWaoeJYhPPrRwoorVAjUfP/ezMe9zZMQvmLZj3EQLcsbRgianf5nqKGH50VDgi8hNY6VXpyiLEjVrb1VxY8P5VCdwAMLPRcPYO6p7BavwKaTpDWsUK92sdTrT3DAZ/Io634ecJHmA4PKiq0SwjmhVUGJ4C72cfjdPq2EfU1weoRHmOoINuZyS54Xa5/A9W+0zsQpkCC05zU1G3HScTMpeVCnNMaJ574vMSwXLTqVTzgX3UKyXa8DwnTOhKOQ3UuZ2t4pIxbSx5WJRahE14ngeGRstSyVJxalg+f4S9TLNMMa2XZVMBtaNzxNGWm9DpP4HNFGM+rWSl8zlSM7wTGOatRYuWBQN3O8m5BnfkydnAmscoZ6Ui1AqWj0RLSycJIf+oMRv0wVo7wnOg6K2HydP9VeK+uEKUNbVMQbiJlMisQpcwisarhJdXFpSrBMG6GpCMlUxkTs1HHV2KGgjw1Lgdq7Wo5N9OgFOdPMvw2JM1N0w/oNpHe49+n+ZrDUB+tD+g0y5cnLkz6UgCWWd3q/a2G4AhYS/B6HA8HMdPGnNOmUxSbuaXS3IQHFqdvXd33lgbgTlXYgMcKQwXTJhlwXQaOSvCZB34TqUyM3JQMMVT2gNkJYyaX3ItL1OZLQV1OAU5OX9L7BQdCA8P7gRrWbvpQOrd0EMqaNbFFLDHh43pKZOXpeRBNjXvfKSYclNlKK9zauBDB4LV/0tWcrhBXH+5leyOtve2hgOyklOzsk+2d5Kd4c6r0R7539UOkE/LE1s+QM3UupfH0U+o8Xv0DIjzgaAWJidkqqiocqq4mceCdU5SK+BB7YwE6KGXm8HDhBTOFWpUKbMSwynfk1xK5QTPADwqM16rtrWEQvByUs7mmts//MVV6o+1jkA4lSa6nYdrOY5+hwIE5JRJv9quH2YstZFiPUs7e6PYlEuxzJP2Dma476Ct/+3wLriWdNQcTL0n7W8VG7Mmonj5AAzhgcYsJ2dBR/MMEWXFi5Ozm22rb52c3eyuNWVGQdMlLPjNwWE/LM3JBTVJe7G9Z7V/wasX1mZE0+fkzE7kDAEMIjo9uAhWNXnBkmniXEQ0j61/giak9x417ivCAYgMSWupgk9RTEkuaUbGNKcihfM44YrdWjsGDHclK3tMW2qrXXQplXmc1uo1F20U71dlY2zY8f8s+ECD9RFKXGPVZ/j2J6lsm004OnuyiCZ5936cuT24i/gty9GGKZZd9imLTyezrMUy49MZ0yaa1OMI5x7AQsqSZR5kXY29jhn2/6f64gZlTzScMzAnUkHIT+KeS1JZrBCuyUr8RftGCYOf3E1RxgxTBUjYUrGUa2tCgXuEolEL1+YQ9FWNc54SXU0m/GMYEZ55MTOm3N/YwEfwCWs6rSXkQs0trRqJ/oCP3Eo0lJrjOdG8KPM5MfS63lc0gnOqDVxXYOQT2ttCGgK23C3Lc1j9xeuj+qp+JZVJdb3SFZERNhpUYWR5CRTwBYiCTSb2DN8wO6vTVdw2vmAXr4/WBnhLcy3krfDerwZYxOF+4N2MgKOS1pTvxgPR16Wf9rxh2OiK0KIICOjPTThANHfRTL0Ti1EPfN+gm0ozlSyXZGJTCz3SUqGf106Ol08FA/+HnNzFNaggr48OziB0BVd8FIaKaWW1uzpWUJ4vaXFWrycwgddbki4AkyrPe1TEP6XHxS54VRO7JJgOLAd6Q3lOx3lXSz3Ix0wZcsyFNsyRWAM34ED9agQIsy+fAnGRSwuu6QaY+FgpXJ+/AwdX40aZU2O1kB5CRTiXaAfHO4GTdYGYUT1bmhmOmAK+Y+fBKDGlmFV/O9Fm1DEoQaiQYh6H+6IiF5HKe81clMoVrIJn6KmGD3Z1VyEcLZVigntF88acVGRWJNU3NMRHcfcR1VKCle6IVUKU9WzW0xm+X42jnc+swo3eEogF5aK76IilUWBpXVQombddyk9GuAdKUQjgBoKEmbyrCHIcmqHdLYBX/7lyzcdU0EuIplgZkBXFrNXCxfTSDogh0PfgrL77lhUCHq6+/Rd333xjBgzBMxZuSGAoAte5E0VDVHy9DLzCwqgqbztBbBW5M753Qt7UcZdcxwFgVJDjw00M/7LHbMJMOmMa3GLR6IQb7UKqayDtEW1mAjRCurkOgUVNENy4qhIuVluxQpoQhkRkZTTPWDRTGzKEiRIXTOwX5ElH1K86l14zaQEHrQeCqGk3ubdv7bBc16A6hD3mkjMFh/PyxNvqRY0gnAuixeOrH56FDADHuuYk45MJU7F3AhyXHOLercC3DGfdMEGFIUzccCVF0Qx7q2nr4NfzMDnPBv5aCeifvH33MznJMEYfwhyqNhftauK7u7svX77c29t79epVLzqX6Y3uItSzP5pzqu/BZcBhwNHn4RJVyA42M67LnM5jhSq2gTFbbz1jN/fzrQirqKHynJv55R/1DfGTM+poHmLnsfjBa2ngFMCAatbU4dWVXmdUm/VRy7Pr4hqXd8hOfDzryZGXJgCrZ21tQPn6aHNre2f35d6rIR2nGZsM+yFeIh0HmOPI4y7UkcsavuwG0D4ZRG88d41iae9Fo9lMCpbxqunMcXmtX4SlurliZtV3aBtH9Cy8MyAHf1ixXX/TkwwxX3eTLHpa/fq/DA/0GMBrlkXXjpyrufp+dlXMyePXf8OzhQJUPvvu26MAJkz8quM0T3qrB4TahQ7INC0HAUNSkYxPuaG5TBkVXU35VjeWhZdlS1qUuyv7RHYbK7kyY5eaTwW1CmlD25UZI+eNX+5Wey9mTLN2PmDD2gP9ccwFVXOYlIRJ9eKhyJg08oAJNpYyZ1T0oe1H/AkMYVqCCs4x/trBYtHnov26loVRFXvAdqhB1YaaamnBcAdZxl2oaxfLQOlMWf6WWksfQenJT6nQjHdZgVOrDKdqXho5VbSc8ZQwpaTCtN3OqDc051l8Uy8VMarSxs9HXjN6w0glomhOPIb+1foVfz7r8cOwt1ZFE+mMpdd9yWfH7969fXf5/vTi3fvzi+Ojy3dv314svEcVJqAv6UL7HIdvCOxA+oHf1eFBPFVSy4khh1KVspGe8+BSAI1sEQl6z/FYPTdSMbT64q3s2R6Szpq3Xn+3e0ohArh+/a73IOcQ86x9yOMA7EHLx8KQaOC5+Egp8nkz5XY8J0bKXLvcRvBSQrYcS6/R4kM67JDM4w4yEOtn4rWf76CHFkRKkwPdMGV1k4zQqTVtI2/QjNU8VJimzdF73GgD+Q+cpUUQUwsOYPKOjIPMiL+8Jz8gPNiMAXfR2Z3yDVFCuUtOdUAGKJAI3P2au9CXk3iQqBZIJKtmLC8jpyi4DzAQIAytnWNCzK1kNTxoPYtIrGX6LevF86yp/POCTpdqjMRKFUwWQgsRIEtomLQrRR9ohk6XBFlNWQ4uOm3dUkUVSu6fPqpUck+tkraZBrO6sh+NeZe4HfWi6+ipoIcizS5LEcXRSUEFnSLz57omhI4ShRVSIj4SpSLEnOSo9fU9vCR69P6UFWS40dMQjoiRNRvNQiE9Y0ZZKg/lpyD7cfkp32ICRSP/Y6EsiiBlXHGhJ8qiCMNCNsVzFsVzFsW/dxZFfDB9XJ6rJNbery+VShGzwud8iud8iqcB6TmfYnGcPedTPOdT/InyKWIZ9k0kVUQALS2zgpd2tnjpD6QTsEYeQan4DTWMHL35ba0vkwCOAhgX31QyBUTvRx4Xt1Lww9S4MZKM54CJIwZl4p5+hctIj3iELvblciTupOWvnSiRddTE52yJ52yJ52yJ52yJ52yJ52yJ52yJ52yJ52yJ52yJ52yJ7zVbIstRjvr7u9ev4eP9deQXiRmDiJmcjxVVnGmSzQUt0Gb0CJU086X6XVVwcCq5n99QMXf1N+Oq4q4YniQrekYh07sxz4qr6B5CwcFV5aMBx1Vo3wIhKszgeNA8hea5R/pE5rm85WK676H5CznCBaznXFy7+ebkxVWS5fnVmivp6e1hKcivXGTyVtfvnyO4bzG26MVVomXfe+8F/7gOymln7R1YGmDMcz7uG7Cg6dvzxS8zm4GFyZ8ocq8F+XMg37cfyNfesu8nrq+1sucwv2WF+bUQ/Rz1dweerGqcFNnOkhjim6MdnOJR8OgZHS0JoPNfDkafBtHmzu7yYNrc2f00qHacs3opUO2MNh8H1ZI4dMOsd8pNW2zWRYILWmrv4Y95OvTmk4JkXF93j801U4LlW5uJ13wXSXWhZln2609VniPEdpLO2lvAH+5/cIrlB6ymv7X54ZMWxBKq0hk3LA1ZIksIbzx7T+JpiKFqykxwZdhld5b4cXf7EauwIoqK+ZIWcBIqiOE0HTIb+KSkjEBHrKLkOVuHWOMnVSdKlkSALXu1rVCFT1jsGY3jOR5enB3+sreXxdOv7qbZ2OKRK9tNtpJXu8NhMnq5Pdp5xBJ5US7TDXaAzq8Q211KZVwO+dkxnjRyIIiDgqyvQ1kDeIxEcBH7S9rszDjhYspUqbhwmWDctXcjdGKg0DpizAXn+vxyq5lhpfZaI1JU6GAtaTKzOpBM00opq2JiTCc2VXHNxqAbh1E0WFsAPeb9NbUpJfBhWvdOvb29TSZcMTYHRrExzuV0w8wUo2bdmpyWN21sDkfbG8PRhlE0veZiul7Q/JYqto7IWbcTcjFNZqbIu9JkmO7uDbfSbfZqc3Nk/8hSuvNqd4vSbGs3yyaPIBDfsewSDsNSM5LdSfgcbnZ+dnByepEc//fxI5boGhsue11ums9Z30pg1x8+Hhx7bw78/Tb4ZVAEr9yPgOBoE42+OEen5/DxHkfbT42geDvh0ek5+b1icACtPUaFvmVRS1X7u6tL4uwyxuEshl4IddMaP9aclIpLcKlNGXaNc8O6QV9cZUJDPvo+PH+15pobzv0k8ehwi+R7KaD7u24z6UbEaUOMv8bLT6pjF5yDAa3HW6ZYvXeoPnCN43ShxFev1h4Twt9Y8cLJJS0WLAgFp65bMaLSvYF3uzSdubmIdr1JFDOVEtEthO9E7Op6RtovI3Aldc3mDi919LzfAMSzZr4pbiM5YDwnx4fndXOrd9hoBccCXgwcNHZoFfVy8Ec/uSC39q3jw3M3fDse0O6lpbGodSH2FoNfmgke9jlPy+TAkIILXlTFwH0ZxvWLKiptGu1Lr+wsVxY4KG/TWQbX9YXmwBoOYUhqR0tBcHLje2hTTUqpNR/jJWEGfUOs/kdrt59zgPssgH5AqSYp9p1rZJW0yC5Jc7q0/BEsIUAxrC5siM/0yZBioE+kCwbD9jsdjnhy2gt6VJtoKYEpAG3EAjHUqNWm2x0ORrEwkI9qxVdLJjLtL0yxM5zlSh4l8YB+7R0xPxom/v/1YmHZNSCiy2hLcVHxgBbopMRmNLrZ3o86x56ckMPTgzfH9kCMmUWWfT+/sdpXxJxWVzW5whvOmsWYKJtICt8eUSrFdCktioOXOhoEzmVCTgKvEtL48Jj2mL6z9BU0UfKpK1dWvDDoCt/ZFqvg3RU95bfGmEUCRe4KMbzw13EQ/XkD7n7LumHBgIHeXfAOVJrOYs7OJsCYGmlPXKdUZSxLyG9MSV/SogAH5MxdCCIPrRE4rrGGU/SkmfQT6hLLylzM6pIyn8hjgDab7i9GM6YuJ7lvzb0M08nfxG6SnBlr0Vg2iTMTmLlRcKXEjoN17ZF9cnAwIBeHA/LuaEDeHQzIwdGAHB4NyNHbDs26j+vk3VH9ZzOdY2lBJXaH7NIw6jaOCKAa7kAyr3yUSk4VLZAC0eNmIkK2j4Dyhblu0UCQRFryOj0OuYPuMaQ3R6NRY92y7Anzf/LFu2tVKfDuB/UozE53dyvXXEDoK6qpDc2VhIbyccwhdMI2Hnd1DzfM88BhUBMGzMCNdzzmnTj62/vjd/9o4Ciwxi+mMrhufE5coPXxoHbQ4ODLFIwgEVugxYIv+IZbVQeFFOvg0YC2uumMKpoaa2+8GLNc3pKtTciDtRCQ0ebu2iCifakbb9S8PNhB2DWR6ZSW9kxRzchoCCJkCnN8ODo6Wqv18B9pek10TvXM2XW/VxJyDMPIbqiEXNCxHpCUKsXplDnjQaOSmvMoG3bCWBaPkEpxw5QL6/9gBuSDwrc+CKA/5i7oHidkwz5/9TD259D1byl0PdBFwP8y6SFMAnZe7UNwK6y7/XaotMss3EAzsAtzw8vcJeYBMwwzDWrc6Gq8adc5ahDLoIH0GsLGrbk3YesxVgZII0ISoyjPoREwU1z2a7/9SH9OHEAW+Jw48LjEgZqAvoyV4Iyl+zWLg4ODpnrsLdbLz0kBPOg46vKcnJxZRY5Bfb6r2MFx1fI0+B+vvMPP0Q6fTHha5eBHqjQbkDFLaaWDE/qGKs7M3FtIMaUW1GhrGdqhHFgJOf5olG/PC/BFVT88oGbGFDgFwAEaIeeq1lnpNYPBvVMLWyBl7KN9u7BUEg+NegG+BL8zqjkEXYYR60a4qK5YDXciuxWEg4nTdqA0vxu1NxjU4S9hDfi5+tN/T99CdFsDuiWejdX4cARfvg9CygYO0VYxBfprSi/oN1yX0IruAiA4a8pvmIZOw9EtQqP1MDyWKhYH/2VCh1EmCFv7OmBRKGoAvG/fef4bQLTml8LXvimZcut/IUv0weZzO4SWMggWZ7Lh6VhLyIHIoDJ5KkVtvTqsNs/+3XcS3qtvjTnHEzq8NLh/Q8XLtHHbc3z40G3PG2boeuyy9hXTnE968WKbvdfoUXiOYr9XXLEMig8+QczO8eF5uFMHORbwaxejiZEJuWKpTtxDV5ic48GomSBoRsB6Km2wZihcYOedVt+E/DpjAvcMNjBVUkcKGxcZT5km6+vOVequMSxAFp8659OZyfvKr0ergfejcPGcwY26YVPl7q9p9i8Lqs8yTmesoC38k0Ygfw/pjJJhMowpRynZKM53HL5YOCifiuhOzsUQA/nOwbkR8Pgem88XqD/gc+4yqCwZFFnKGRb7t2j2jADyZ1JqpdAtSp/gzMC950azfFJb21Tg6I+4kVtSwQdAJnp+WpcKCOC9jrglpeP4cKgeCJy36QEwosSZnsV6j1VjYG1oen1ptYvvIYPxAsOJ02sCKwo3QIBRS6xlDjeF7GNIMgD1p7ci65fRfcOGD2I7BeOxwfUWBy+wjykr69oBEff4F72hSU7FNDmt8vxMwjXFsX88Ziuhm75nK+GL+9mKO9J99T4hqvmjuSMDIpfegsHSoIqnDfYQuNCBfZRAGRpXxA45RltMe+EMrT8LZmZ4dAO7qo2H1zIwK5AlXKR55ZqYwG0ONeEODYwvMa3HCDXV7UT1Itx4fijqc5Ys4UHeFbZPwb4sNNRvc752tHFCoQo3pr8YB3MwLsUwwCp8fpC6ISkZM3NrNX/qW1xSp+Kom3D/jJNxwQ2HyHK7VbnUdm0HficeRrdVvaQfEm7URYU1sXJSMKorxQpsgQOZAX2YjR6DaHZDr1mg4RjNMXnUOC5YISE+hWk7jB8uqzENc2dQncSfTlaAg79SLCHnDPf8CrPorOy7wmVz4+qwA5/wsRiQIRqu+MMRjkMVHKR2Xm2s6d6Q64u1olmgKNYnmw84erAZ/KVEs0ymc3yEsk8YMxjHS4joLXICFXqBBGqtdEaFx2tKDZtKMAX8+GFzLcO4AoSs0yy7GpArd27W4dww+GrCc7aOmn92hXdK/malISBA5Y+iWVyoYw4U1tfAptJMrZdUa4vMdQxKaqoZDvTlbAdmecFBmpCJtYysenmIc/pahhjmhUY3KK7U4I7U/jGwX5yTy22NHcgDT2acKarSWRws396bWiPE7V4Z8ykZV1BBZ8XCF43ImW462iIlPTdMOW7XmmLf7ewVmTthETR3bKzlHF/usTAm5AZxM3d3aqhsc408K5/HTbncjHZTrny8KHetgGhcvUxXYw9Wm+rD+N6yc/OCW43muby1EFpzM21ulJM7bkmRd44aq0fA1gQTJMJk11qszMxqf1F5vLvV3qfzLpw4lTONrj9DLB0sCio/gMkNaZ8R5qIitD6Wq9IsCI2M6UYrJqdzalKJqCLtgCg2pSrL490H7g9PE6vHVPYPqYhdHph2YGKhoJE3TIGUgVBmrzJ5ZY/HW8J8yCbqOeTkqLsN27vbe03kIwd6gBdktX+iiV93GnCQTi82tgHy8dYaroG3glSccBVljSlGgbdZ6pzCnkhlP4NjpeQly6FFzx00nXGrQ6SuHNb/gTK/hhYlsg1q4q9M3PDcxJ7zIM0ZOiCtvufLa4UwnbZIORGksCJZc1OhfTxwsYjmVpIwrTtoY9ZjhSPr9x/TOMqlEZGe0jyFrDlXWyuHcBtUjGIHlItccIGYSOI1k4jVFtgWeBWQjnsS0tIzwo3jEi1ICim4kXXgXz3E6ipYyn7H7EffcstIcs1YSaoSbxbgpfhwNbFqLW2EtIlHK1rxxKU0H8Q7W1/7RpUm4tTBzeFod324s765dTHc2x/u7G9tJ3s7L39rhidm1FDNHqqJ9vn1W3CaVsSaaGAEb1vgghxTAqz6IaMmVtaEkMqLG6zYR9OGnMnldOBMwlxO1wbx5EGKGOl0nHldYjo6r6ksoiqZ2OuxBhs2HdIhCuDZUHBASBOcXTC81Xsac4OpF6LnCplVeU36WJEHKxKg1kNJJrH3o+gM0yNsSprOWBLhImxvpRapz9pT8671JhdlZS79j4IK6SLkvP1XmfgBqt/wPOe9z+CdG9DIqJdwjtzUDbcagdvBMG2TkpBPIdbtmcfPzJpNirl7SVPfAzYCHvt4kWc0MLvIvClg95R3agUxsUgw110ipQa1I03aggTpzQpO/71XqwLgVtbANaIcg7nYaj6xxOykX6iekRclUzNaanv4tLHfRIlFa3AfSG+dJDPSbgDFq6rIHVRIoY2yyweXAfhirebYJvq67V/fXwc/Hh59MUffyZFdTehscHdNlz26PdkZDrMmZGLKupUDFtdJLoJMALoIXJUqxW98ZCaDGsGK5i7Q1EjV0TBAt/BFVUAZuKoFTqyLt+jSqwv5PCR6JY5T1pI417IzekObiicoGBUmTs7H9B4rr6OGGSQoUETT214b+EQ4o9KeLjT6rRmmdVVYjUFIYtcG1s4gaApO9vrbqpmSQuZy2qhsY0WNvPaRAlzvN3BF/t/24upv/HZfLSSzd5LRcPTbwiUArnmbGX1jdq6P6/okQxedO3jJaAda96O0fZOQt+LVhvhn02mX4bkuBuVAJzz040V3c8bVkg93pLXfpNeCdnHD3mpBfodq+7TiekZozpTxigychYZ3rBWKgEKrOVpLR8U1kpm8dfq4RRVA0MgViwQcmVGR5RBvOGNzuD27taayMNExVcyuGZyV9ZeoZgBClMzrVXMDo8BJh95NEJSljSWG2xmDJLUQ6Y79tOHuz8BN4bTKqQoh+LXpqKxy1aPy5O1qXg2dammKLM4SJZ9ANDSspa0puotyZz6AgYK8qioxc9eRVcpKCIPCodGiyKspaAJdT0p9U0/hJAivPaM+fACqIMjftYE/NzjyVSskrWEK1lcR4Aa0z9+lZzaw7nn/MvD+zjJ19tEE54ElZ2G4CqfvvSP/e7SGO4xoq7HD/RBD7S6T6WXUajTj2momGThGsbgfmLOQT8yymuit9u9CeiA62CjObrwtfXWJe9PD6s9ZSUavyHBvf3N3fzRET/fh8U/7w///f4w2t/+fc5ZWdgH4iWBGM3RyYgq/GyXu0dHQ/VFrgZYX6ArO6aSyclkbWZYs8y/gf7VK/zoaJvb/RiTT5q+bySjZTDZ1af462tzaDKr/HddosjLWVvqm5Y21qD5V3Lj1XfmQvYwJiNmOmRkKkcjvSj3i4XqnNiMpz60iE3wsJVM+IjuIFOi/gD4czG9mWa9WcyqNy2pAjc/n+0LuvbsdiHz/WcNriQwEc8FastCyb1+sKGL4tThrIWYA7dPRiYdiktdukmiBEegHVjqIAL/XTSkG0IFcKGXlTTjyIqwNP7uENBTZYdA6ihe1NLdGMP/rSoB1Im2oxxQMchSxdvRIROoQl4W8Wt5AVZp4gxfa1ps4+MRtbBzY9VOlgJ5qtAiXXOuYPXjTIHnXKrxay9Rd+uE+3KGFmAbDq2vq2MFrFExaN7eWMvysZhZ74/etknHV6LpMxTxoMWCXcsgv9ICRTDJktQW9rndHM6F7pItDa4PFLLkB9ep5iGLrO2foV4ZThRLbB9yez7VzRnXd0K/lNHK7Fqg/NWRtHTrnbTUvZnpavBAtJ+aWKnZfopY7LKABnM91YRW2mTFltgauZThZuhq77mRu4HbxyTDiCyw3NKjr2ay7Ja57sbR+UFlrSkzX7qq+1NhGxaheWtWX1XcwOrmdzePgNH/Z32VSXQ9sz1WpHc01IVSGp6CdOtZqMeoIPNzBNm5Tw7i/QuiUO0P49lWTp7ghA/9wdzTuFcTbVU/zHlysq7pnFx+u3lvlr8ktG9tj9NGHuIsWPNGQ9vRmTHAndhSDaPFaqw+yoQVeYKONfUYgkSivxrlMr1lGNDfsqodoLiAiHzgSFaQSzOdgNvXfBw1gqPUa+fKWQGxuAvL+3WuSc3HtY/3vLxfq6bJNdX4UrE8LAQc8jQMY3GmNRiAHkfk4CIpPo7xEZDHvg61khbViKGELKeBqD8RuuB7E/o2dnfGVdlznwyjbAhtzbvzHcAiOt4W3iOvrSx3piXdpjpNc0t6gt3dcXxMYAYwlxaXiGHLfZoba8SuiZV6B9yfKyXuvmbtKgqXBZY67+EJ9wJ7e5A7YL4VUxQJEduciVk/BMcX/YBkM+8CCBhgRo1MK96FhEUNLN6PhsMeZV1DuqgS7GudzWcG+N69XnFRAbgJJwjoCSDdv0+wQt845p5mlJ1EvA7HmInVBU8Kqxi2HubZ8ZbEj+rieR+duYN9/8w6xDqGErUchXhnh99dQcBGjO5fiA7gTpNfNygbsI00NkSpzkRPB8RLdjsd34+FYB+dtuBbpYOuGRW3inqRtEebVYqhXmKB5fhpC877by19DBYNgMIQR40oHUQINPuUvWXywAY3i9z130om7catKL7yjYKCwExA65mblzoRPpdBcm1j3dpQZ+91AHbDaVm+5EafnhfWMmUUz1Fy7yuU00fB74n9PUpmxq8QzX/91LWJj13YdvY2lgNwUHWWlcUWKXM233aqP5snR+Vri8xsbbwQV3JE14UYTeSvCjJiaYWV8nXMRxk1liSFYdy83itkJC+5KkZdNmjZ0oZZn91+a4Y3cg9dmLggtvjiLKAIv0OogjTtuzuw5/aNuRbyEtKD7DdXGkuyBqBmH3eGwIPRruVBYB3NTH8kVo5nXy5yw9oRe335EYhIPoCcOrPx3y3XDqk9TVmKefZjUJ7xB9Qxqj78UYP6dHLnJV44rJUu2cVBow1RGi5UoB5+Ox4rdoJ3rHz+/WFlDs5P88st+UdTMhNPcP7U+3NkfDlfWWmy0G/P9jXmqzIyrTwwAhFi5phOqFde2oqvxOkYCroCkHyBJYVRdJDtIrcx3oguRPJGnDwgTdr91FC7o+GoGt+0ycn7hoiAZtlR2S0HpdI4dn2foGufd4a9daiCf8y3NS9ZWVSq1rBZUq23zQcDYUNzQa2TSdTCu7BG+YdrwqV9d08uzgGUhsGKnGxpzerhYz1hpZp3RUSS5G7Da4YOXuyLOvnDZiwKMT1LmNGV32id32CX1kf8s+6SY91goMMXGzubLUcay8fpkZzxc394c7a3vvZwM17dpur33cki39ibsfuvF08OEuysml2Hxk/98T4LFAdZ+bkXjQzmZzu0kJDpoMrZ6UTNU0SUM2F8hctOHyNux3cL9/v8ExbFdeTqndkVeQzjgcN/gd8jnIPjPVGQbUtWLJY2Yq4GrjxJc1OM5Tnnib13Im/rO658/nbz5H1+uU9fZBlbI8pTptQRfdsknzuHXisgHTwnkvrMMsdlajz+OUUyC82o+KmofIwE/QzFZfU1djIILWcixxr8futeJ77299VZqDB6EerXghUKHc0/wETVG8XHVaX+/hNJZiPcwXyz+w5fYiwfZ8w1Vc0sboXMZ+YUpDJKE2jzs44xWGjzlUFFBTpxsaXJryxWCN8hnc7jjCZXHb9gArg0gsz0b1L3irIyCXivxhR37yNLKsAGZ8SxjYgDBuPivFPl84DjkgNwqbnq81Kv/XPHPrgzICj79YCum5+Y7z813zHPzHfLcfOe5+c732XynN7HkcboD6EEwDiiDULN8QXUB4jmR2BrvN5WFNAqefCrtplYInM5FMb4L8vD69R38LdRVhmHcBqLmUJXgx7kq7FRXzuTj9qwwTa5gFdG1lUs1wSwirPsevHr20YG1NNMwnLcmPdxx9fgWvhpZp08t4rDhvguD0K2LYXNX6xSd0SaIXtlZFpShWW4oAxHMmVwC64pL/8ZZ2JniN1EgDpRddW6HyBXQWeHGTBZsg+Ye82GldrhLHOZzF9tL3EcKVFEsD3vPapuOCWDMiuXshkae5ro7ZG8sZ5S8U5ZMWTsXBUDDfQfiMw8XAnER3UW5EqBmiR1XgGWFSTp7WTq/kgdnurS6t2eKF1YQYOvkkyPy4ueTo7V7j9LqaDgcNQ98bR8uG8J234qefrXtA/BF27t9pR5uX7FR21fsxlZngiwvNfjEjl37iL2iitxNhL+9K6l9VjZ3drf2tpqnpeAFu1xiLZU3J2+OMYrfSxefewzQglHYbAiniDaKUQhxGs9N5EqoNBQAiboGcSpoItV0A++8If14o2AZp+vgCY7/Tj7OTJH/8+Tg9KBm8ZMJTznN0W/8PwMnMnz9vQTrV/VkMlr9owS9f+zqW4YxMbk2ZB5ES/d5nosy/mJ5lPTGElKMdi6ITK3aHqiL9hayWR3ubg9bJPSZGmmPQho0SQqB7GA6NI/ZEgtWn7Z7FKIwDwWovKSss03Q7HFKVgdl3pfdFqTyViwtKBLdx3aCVfCgKEg6fVg+PW0Hxa9WaAq6NUKjxsg+GbQ2EvYWi9V2lN+GfZpFSuXjlN+Nu/b+ubHjc2PHu1f73NjxubHjc2PH58aOz40dn6CxYxSxxv94ZLxqj2/XDmKPNZgm0Ql4G/uQUEmAemkusIdrsmI/9hR4H+1u7W03AEUxffmdKGMXqHSAOgYxQ/MCQlpawXnLs0Fh38AQe4FUmHEFgRgOkrUO9YWoiRBDtNRmT1ZBB3/Xe/B3qTrkPSo/++K85QxD/X4Rl9jHneGrhOZwOg2/Qea2rGvf1y4OwF1UkmheF1nw4vzgdC1BOwsM7xBm0He1Siszw1B66M0U3f3Alo4r48KN6gJZrTL5R6fnJF4xIS8gn53nWUpVptfQb8sKyvP6vS5i/5KwnGrD0ySVC98pAe651hVTCcK5TNHike8CsIABvzg8BbqxQMDteYTCgNzOal1lSPCxkV/4dEYOtK4UFSkj51DFlBwefBoSKmGWdtdRIwBmIS8O17DuXXt9788/BfioAATLlrmRR/FEbh+PPmUfD//6/nxA3v7V7+eJSAfk7fu/ttpFDcjh6V/v2fNwdD5r73OZ0ryTB/Hkm++n8fzm9VpHfbLkYTnF3zm7/ZSVSDWlwgWqLnk18VSavHj7GYf5RKSfu1iaX1aCL0uF7FszzYmd0S79/Sesva8v2iPXDxWEL6W6BPV1eYmJQXRCxWLIIsP5guC8GJBzUF3OOiR9SHM+kUpw+qglCmkuwYxcYE13eXAvOhWl462BSh2gVYNRKjTPoIcbhKF0tmtzuDlcH75cH+2S4db+aGd/69V/Dof7w+GjV4VtXJe5LEw2WWBJo1frwz1Y0mh/e7i/ufMJS8ImVZfXbH5J86ml9dkiuYufQocHfvzggvAp61gfATtqXbPuYXt3/ji5EC0qrdTNMiv6w/i4IF9sO8/tA6n7qV4WCQjGSIEg/KBvnceNv+PpIEFwbcqdzdGnYoJ9LKWoc94+xVY9dkOEDcwYOLFb2xeCLBdY1e7OztZLj/V2OZlPWOVnWuOQAGptcWcRRbunS5qijc5NV43fHLpywovCrJniNL/EJNMlEagrQohT1fmsuqqptV/aQaWAkCaZzqNSXpO4XCbscTmjLmF00OxujS5BH4gvwaTKoXOOyOrwljB03VW1g92dnZ9+/PHV4cuj4x9/Gr7aG746Gm0eHh48jiuE0MGlc7qTZnuXRkByiF+MuMGvrK4bi/fRtY8ERPQECt9wQX6W5DUVU3IIscok52NF1Rx7HXj/6JSbWTUG1+hU5lRMN6ZyY5zL8cZUjpLR9oZW6QYGO29YxMA/yVT+x+utrZfrr7d2tjr4x5CI9cfyYWesfx0LVQcT1YPRXpWeUcWyZJrLMc2DNifYwlccrUV+DQv0Mw1QD/y3YIF2YvedqweLX91hgp5f/LVWUQfk9V/PqSA/WeOS61RGJurAmikJGKRPu+/fjPXZWPknLeVrm593HdTGFn72yr4BW7O10Met5Xu2G90t7nLVor/XV8V2UqendKhu637IQ2Qow8Pm8j5/dh/vSfv8mcm4WV9KlZpjyVBMYqJ1oBeEFltYozYoIXeimdsLSveUyfBKnB0V+htj4WcscMHSGSiIdfUyC9nJmdf2pHL3xWpdV2WZ85ALsVAPP27my8onOvSMsHuDKYVRjDaLjGGuNBNLy286beQ1ucm6DWWlMjNygG20WgCCVL/kWvb0vX0alDnF4eT8bX+728ODXpCWtYMOnN5NPKSCtrIZPFU/AMqUyctSxlEqMUOTYsoN9G8TGcmpgQ/dG5n/S1ZyKVb2yfrLrWR3tL23NRyQlZyalX2yvZPsDHdejfbI/zZvw5aoM62+t0fQp4i3wnhoQM3A57dgUQU5IVNFRZVTFacqmhmbW5bDkNlEd82HceuD6JKdK1eYGSrrYF8XMsmlVM6kHASrsFuNDsHLSTmbayzACdrcANgDCpJmJlBUIRG8DFxYu1QWwP0i9ta98R5LbaRYz9LGvig2tQJliSfrHcxw38Fa/9thH0xLOloOnt6T9beKjVn6Q19eg5df4Yu7JdjFjLlkhagxZE/5InhG18narWSYuIzR4h2OG33+n/yoNVrThAwnExYM1cAK5oqIxaVaG7UVBXl9dHBmJegBVnyts6UQ/rhfy12NKJ7aD9TTdRYXheXvXX77RsjK/1L8LcY5AJT80NOYxNHnL/7zA41LZ9hjBMizpsi6xhj8HnwwoY8lV+0wNKjPE/wwyrsY7PvM9xp6c7QzgISVNaDzUjHHrRNykGUejEkocYGhdG6I8RxqUauUah9E3AQOmTH1viFXPR9qAmpWUkWNVJ7jUt2opvNCC3qN5VIGBOsezujW5c5oc+0RqtyXTi368llFXyeh6EvmEoXzJHWjE/Av/vO9dWqgKEy7To0rHA0hd5XBpg3aUBEVyzs+PId3k7/4Q3Bnse1uXReYFMr3upuy2O6JqvhKhQbNQ61nYa0uNqgZkT+jKrulig3IDVemojkpaDrjAuJ8ZHqNV4yGcgEKkD2K/1WNmRIMKpvIjD2qB+ydMfpPIv/ftqo3N+brBubv7V7ubn8tCYuyUE6ivfOk5sXsXTK2TqRF3TON1Vc7yOqqvkv6hhGlIqfM/Hjy9rwhl2Gm11xUH3vGroGOZgojgtz3xcl78nPfnl68PX8bMPOAU2TKZPINGdIAzrduTCOQ35xBHYP1jRjVFqRv3rC2QD4b19+mcW335ls0sCO4vqaR3dS6lgTJ6i9u7FgiNfqS1t3TQ0XcW1+a+cpDdgWGjT2/iplKCe2tQpDHTh16wGB9mvU4axX1gLhOzIEOePSNmmh+S+eaVPDKAEpDusrSwelQMCq4mEKhc9fll4kbriQkdsc9PULHAYzrURjp4tpLXY0ZNcCIrtpYKB/AQnig2RYT1le2Q8ODzUXTJSD3F7eZd826LBo9vZc+4RbEBdkDZUZUGVHje8E/+sLxjlFCC6vfK5pDMncYM9LlwDygyHLdtUod/VJpphJX9d0a1SRjKc+gkZNVR4GUauYu7fOtzZc6mdCC58u6/n17TnB88sJf0iiWQZnejI05FQMyUYyNdTYgt6gOdxNP8MkO3FX+hCVsv1oiUMfcwV1vZmWH7FBMYLxD5aWpxfcb+S96w9rYinrXLGGX22vA2QLYYG4reusK93cg3062k+H6aLS5DjY5T9vQP60C9a3tdVwxwaHsrs397zZmvLfzS+2sn8+dZ6v3ST0g1bgSprrvDFN1yztneLnJ1R3gF6XH0TAZbSejBrRLK7Pumrm2xIq14A9zWWXBGPd+grqZltNqMOULGvZemc2kYBmviitomnBTtLqmNTwBwSc0AM9w7ZrwydLxFXyth4QR+/SRVpXxcsEyKHcFtJ5jU/RakwtFpNHN3ty2rc2d5vRWPn6tCxfIX1zmfQusDvLzlrQ4a1o2EwCTLgBWDD9xxN1X4892wasa1DIvhieE3lCe03FPUZCDfMyUIcdcaMNazA1wg7dB3++NX7TIb/ryL4LzS98DtoBYZrENhyngO3ADB20WFIZeNXj5BGwKZFCCUCHFvOB/RAYIojB8fB8abV3BKnh2ZSkFP3jrG+2fVIoJ7lW7wLXIXL/hMKwv/dVDVEsxzbuk5HYLpuwC8XTW5FfjaOczqXzJCSgVXnv+60U3il+N2+3H4Tkl86Xlxoc6/ECQMJP3VkIBtGazsxbAq/9cueZjKuglzQouVgZkRbFSKqv2XdoBH6xgH3xcxjQiSX65uDiDz3ffLP7k7+dDcKN9KfRegjbe6KaqVO7bzGiGPeZMREt2O1TuV+raUy4eU+JfGMtsnsTlAR/ZgS5+tUlGcX2PFpgEZm3vy97ey7tBdJXsvgON4cJ5cXDj78XILyzPJbmVKs/6MbOEfbuQWHT8nt17YYEF7jxj1JoZXdtttL3Vv5kFMzO5LMG/2kApThXJpDPFJfTJOz48J6NkNxm64pl5Lm+tzTeteAaFGW5p6L6S7dcDrMDe1Z2fSFFp6IUf9X00MsS2YL+e3yum5tZkXGn4deWkBgNde2F2uPkoFXONglhKK8cUQk9O3yS8UTAT1uvr6ftOlyCsCwot2w2DNrcJIW8bA/my4QUVWaN5KhcA5GYyTIadC5Kfjy8G5Oztuf33vf1Hnl/07jn0oVVLC25/54avuyPFZNAhTZ80Ns7ltNEfqQW7LqXQ7IszSJx2UQ4ZA/n9ssj7cHI3j/S4+dJM0kH7aVzSAY39KnquFz+/M2wTp66rR+8t4/awGSWzXNcbwHWXL3MErrW6NrRhakJdHpBTvk4aX94fyhsGiMN5fYq3YqlUGeFiqpjGMEmGfzbnJQ1jBSqVoR6Jty1U+PaZqt2qlChZQQ3UXNKMjGlu2b5aC6MGVxv7GIo6h7FmVGS5lVY09CpLpRCBtZ+411FCuDGp7zAYhqlRgMD5sTQTWirXYLmkgtgVrWFp/xiOxOGnBxU9AW+LK6Y053RZZkMgEZwFr5bqHaudAoOesAG/e7Vw9M13XUofOEQtKjnUsRgQWRn3hyJZ8QfYUinYuB4MQYs+Z7J78X4j5AtEUdf4OjlqI6tB3jW2zk/fnHXOCSEnRz3cb+G6HUv0wJzEe8HupohuNWozewD+OpFnGvOp1+7jPdGnR53A0NDq1rfuKlg6o4LrgkT9vKAsqYU+SpFj9tc6GNUyunq3HgxI7UznxvW8Ejv9+CaJYf7I+m46DbFrcpgIe0j7MeE+Om6u/JerxkL8W3VR+J5u3K0VCmlgESyLx/9L6LQ5rgxR1F1d+I6cfwFfFRfuTsOqwIi+R4S8Qs3Kp6082aqi2a7NbREL9Qwb3UgLBqHCrevhcDDvK969UNHu+ojHDbdvqRarqwZaCWKoMg3wDUgmsQu3o757W5pu3FC1kcvpxqQSUOJUJ/5ALcA54rK9T3oHFwwou6oQIee3od2D1uGm2dMIMeXMTO0Q5IZSoE1XVskMrclNq4IOSGPhuiZNJatbscMgeJ0H5yNqfw67ggdobt+uL/t85/OyMvGpCmfacp/QmR3aO6Di0OoLvxYt+xy6DuNOIuu5uqVKXA3IFVPK/ofDP7XuQPOefunQmLC5rfZEqyXs60UzVtFN5CQ6tMrCbhWoa9WtFStgNvHBikdJc6p9hA0X3HDvKwgzgI7g29OStNJGFv0hG1JNfflMLPycjKU02ihaJj/6vxrIQqcBlCZPcr5Q23YrwGsEdzBkR/HFdeJCqs6j70NQHNlB+Aku3vkvYhdD68i0Vru9eedSlhkh2yaDp1pd+L5uAGcaHbQsWwxphb4hYMzcMXYL7mhSg+/Vk/W/YscFthBEUs8ZC6ST/Ive0F6kVyJdYpmMDsrddK6T3kxmHSw/QDvcl7xoLoQuRR54VtDw0lnYCqYhoBIus3wwqo/ki58I24j19oguc24wx8iQqmw0aS+pMnGg8wlGEyroAIPawJUb1t8hIPLiuEPssg4FqDIYsdlKncXADWI6bSzDL3bQWVDiAh3DmFAln+ZWJ5hjR3rsSZM6A4pixQWMS2EilaCtSEUEuwWeY5XzQt6wJslD/8yqbIN8Z/t87CuXsY8sg13JZHrpQmitiMq4puOcZURLi/mUgsgcM3DkxiGXYx9/Ba5gx7wVM4qzUHHi6hLZRM+JO2clGb0iw739zd390RAD2yFg5c2c1CpOp0RcSKUDubvAacTu53edOSe+Q7PKWDkZ+F6kQalDdaDgJmZyN5y6YRJyljOqGdGMkXc/HWqys725bbdwa7S7nfTAn0xoynNu5skyfF2r0QpdxTbiJ+zoa+3QjbC+gzSVCjVnGa3K0o5d1iCuD1f7PqjwYpSMmbllTJBhGNK+u7nVJYrNrXtxtESZF2HKqp7rY2pNvIWR1VoHEPPLvrWUiku1WPGox211a5v9PF2C/sQtZvWQXJM98pcaOf8ZtN+kyXNCAUL7vkK+Hvq3Q6yFY8WOegKhwMyjV6OeLgdbO31oDQA8/hg9eGKC1r/wiWnYgk5RgsKS0IIkYhix+VNnqrcnrjkNYKntTT05Ol8bxJaONVU6wLuTOZUW8c7Q9z9eJfeCbg0nEBvecLLAasNFaiL7zBpQVgrIEi2ZvIY7lSU6k1rGUi8onS3v5Qlhw5etB39tYggTNnMTFiICcKDfQQGRofwVNz+Cotvt3tm9wQ2KLvrYmXgaffVAeQjv4G/mtONNQ1FUwqlh6FKSN9D316qMtE6gJ6iM4ThxTrpu+OncE5+UAe9H9wExbliqtUx5/aLVXW/q4OGFLhZqy31Zx+UALZgpv2EC65bFszrfTqmkkanMnfvAG/1qzI2iikeEg804rRTGi20x1agbF9DTh6kbnjI9AEWU5lrCZHM0AOqH9fW8jNw8PP19YCUXG0t5PSDm1upyygFzG/fXtBaH5qZy2nndBfqGiSwU9iDYZwVgqYuuWSmUhSJrWHwt2MwbGdOGnJxh4xU9gCsmPSDRmLdcsVClLpKpnxF+ARVjMZs9rcK1TRhb4wUaWTnx1zqWOR0fnvd0GqK8aJBWzxVzx6p8zPXyKt4v470y9hpjSuGOjKU9NxBpa7elyWevEMF4530FSsSVRba1l7kU4XvFyLWQt2JArvxhdT+hqsLrndBV0SORdvcaCHAcxMwvl3YXFTWG845+AdnLfnHk5Awvax01UU1uWZ47JhfW449fnQ7c5H9RLW9ipMzX6VRIbazkM1RkVAGN+e67YdhJ3uxv3t/ILSpUbAkk59OZ2QjIW+fZuhUyPUrf/uztf+rT7V/+883PO2/+sbE3O1H/ffZ7uv3b3/4Y/rWxFYE0luDlWDnyg3vp79m1UXQy4WnyQbzzZZ1ZRmqrev+DIB8Ccj6QvxAuxrIS2QdByF+IrEz0ibv+Z/jJUlD9qRJAuB/EB/HrjIl4zIKWpT2zIBi0v3WwwssZM4UU3EgFLS/wcn3QdwcRjxk4FxS90AQKAtjF33B2myAMd0zsUSMVKZniBTNMISANoBeDqQakAYH9L6g8brJ45DBpstL1jAG2G3QzkeqWqoxll5+T3Vs3jMKroshRGv3k/GSlkh+7AT2jV5vJKBklTc8vp4Iut0bqycHpATnz3OEULbcXD3bJ9vxkHYHrfoH9stdqJfPc8RGQV7krOevf0o7/0JxPheNgoPGcMvNTLm+Bw2n4y8UQh3FzOfWXDD5esG9N3YYETUSLxbop3+1VcjppAiPF9840yxzLzbANpuWkXv7c5FS4h2Pvni8BgR5JGBIag//99cEpktjv61ys/45fGIrX1VwTV/8sIQe5VQiiRAUEyN9xEjtxwtEVCH+721CAPoKqdbFsxUetrlhANBOZu4W3vBC3Lbhs94abyeh3wkRKS13lTqmySmIr9KZl4fzG2PWA/MoV0zOqrpO1gPKHokLsAhK3uiWdGUB6NzakESfUOd8Lh31EK1iikfvWWWy4mLuiQO5cziNjdZadTIS2x3hOJGSySgU05pRbXZdg8ceuvZyfIXT6Vz7hDbBLml4z8wgdt0+fdYN8kkbr3u3RaetferRa/2Nt/jj9tl+v3WwGPHqmvARVavX1S88oa5UUOQ/7mIDCOCA5MOx/0dQaaiG2JhiQ355hFBJiQpiwh3oZKDx3Z9VvdqQjoFEMWZbUF5G0S/wvnCc+hsTrsjWGczq3sr/KygExaTkgvLzZXedpUQ4IM2my9u1h3qQtxC8p99xFl749PyFvZMZy1FFv4xxxT9avLRYTi7ttxGDkhCg1Swek5AUg9NtDpwW6gc8/sxz9HiRouMN3o8DTzgn6Nv7uvqKeUchqu7InOHdp7nnJILQOxmo6Hd9hxsDhVbcFMyw1Az8+hvNgbOSDI643FXlnRVo5VzCjeKqbDQ9CPYYQJ+RreeKgkEIE2bpuqRBmEoogQLbttFL1vkuiKrE4AoiWE2OnS3z9qHZtUe+U1wNyy8Zg5nGmB9auVxVU0wipSBulgvXCuL7OkdeHa9v4B3+CrYLsho1BimaES+xcarAAOkNbrB6cvXGoCW23LmL6jNzWFPOi7vBaO7nhQ8b5hFARGocB1nGdOtCF9pGySBu6Vv7vwTeswo2KwTCKpwl54wJLfq9YhQOT44vXUJoWWtbp4OEqlUyZ1pGLIgwTiigrBnccUQ9Gjw/tssAe4WpncWbApxmR/kwnLvViJtFoq7MMwLkdhdKjwW7RAHUYAtu33A83/g8pmkntRhKMzeOTuc/x8J4sQs4xY4KqouE7quWJ8263DbhW7oS//cAUCmuZ35FC4QK4DJtKxf9gAZJF2TwuIAkoSZ5TKR5tnnVw+N3nVnRW/OdMtugs6M+ssMVL+JPrbZ1FWSa8LAeIY8PA5+Uk3CoEj9w9qyNGhgMV82BIO6nvmKhiECLnhIUf2XUaOHEXFwNy7C4tajF09Oa3Afnl3YC8ZlP7hLUj2xg9w66uOMzizfmeS2Y/l8x+PEi9G/pcMvu5ZPZzyezvr2R2u2J2U6jXFy5PaLj5/PnlW25+pj+v6eZGe7bdyOekwXeQ+N0bb90l/9mtN7+iP7P51ljDd2O/+VV9QQOOi1QWcUjFpxlwdWEAiqM2jbfEs6uO8QZGWxj1AePt6M1vC6Py0yKs6giqutxQvyBfTiuFNweHdwPQmH+ZqvhhnRzdRULYrDqIEx4Eb7yLTo7Ds8ObjWDsGcvLSZXHJa5rcTepY3rCtUO4CqCY1MjyuqIQZlrGzdGbEQ5CxvnekKDIWMYyp+VjxiXClbOJIawozbwnRPQSwunOf25sxHOzBvfDt1bA/7lZw3OzhudmDU8M/Oc0ayiVzKr0CasndrJr3Qx3SK4WiHpzOGzAp5niNF9uCLS33d1kzjJvqhZLa2oxc10p2vXuwAVPDSUQ+wDq4ETJohn9plx/rKgRcQitrkeal0wnfVVpfPC7uqrVvSsv3aFETabhPyX8ByQt/CHznEEhG/Qf2L/q8IKelL6G9VzXVYzyqZ4SqX+HgRcjuPN5QYVpeaR6z+/TtGz1mxIxxLpOR60rwbs+zqf9/QMZj/E4PqaDCcXTGRIUBHM0Cs6HNMRUFiUVXmuyaiA4TRvE2MpJjFMgdSgsaVVJSA6lSlExhcicCc8Ncy5dqOntlUSo9QDBuwIe9IpmAKNez2NKkX2FRgtNdZcszTT4eqI+pi2vrtWSr0G2QUydg5h6gHQvILzS04+vENBPprIlARcvs/mntAqeTYIWju42Cf7E9sD3wiGe2Bj4E1sC37wZEKe5+FJcjnufRV/dy7RrmX83zwYZrw3Nsb4UxtH6WT18J6ausAXno90WB4fyrw3CbRYSWMQ4NP8jHhVqBIShHSA4pgtprcfCNioqXG0/ooDznb3+n2zH3Z48us3/uOJ5drlcalw9cMmNvbtmTz1AUW/TxGU2OrIIfCZQRfgmqqQbMjxTWRTckPNfDjAUQWA8OYOEZz9ET/7+ZHvyku29yrLd0Xj4am9vPNpkbDgcjl/tvdrd3dt9+XI0TLMfHmB5If9/xtJrXS2LNx264TvI8isEvfOGqVBMrpvkujfe2nyV0Vd7r7bY1vbw1av0ZbZHs510/Cp9td20taPJl7Sio2YICWRDN7lAgPxtyUQom6PkVNECjOCcimll126kIykNV7EbiuWcjnO2wSYTnvI6eJzUoftN+wDRealT2bbtn/DyMIOtEVMyk7fxgqGsXNhRF0lXaabWIW5lQKa5HNO8gxf8um8hbBF7J6Omv/eEZXyQz9sLXxNzOU+Z0Eu76niNw7vK1Zja3cacP+zN3muEEh36eDmcQmCSGzE22ZQsyPnZ0X8TP91rrg2We6mZkdSaj3NWJ8TrMvsIyfBuSL2x1uUzByVNZywMvJkMl6jp9YqIaIqacmRTsVpeke4zamZR4Ry/b7xDUHHB60qrDSD9jUOW51RtTOXGKBltJq/aLWqgQla6LBT+IgsLMvoswmTk/bvX4brLazDQsoLrWiXhdSXRu4sEhqoo0vIyS0yLyhur2Cyw6kcVEPQU0+jq0pUjm5tbD3X5fcL6a84h2tUF4LrShSd5fTMmMSwEPy/ZwJe3NzPafKSggtZFnonLPvY5XftElcWAZOX1dEDGit0OiLBfTFkxIKKCr/9FVffMq7JYdBuXq4n5DW3OEreU2Uxexcp/U+8/Jr9A355P0fx/ReOInEllLOmT448srfDPF2fHa6Hc6jelVh+evW9MQwxVU2aCUw/qR3fU7N3thbXEhlN1KeFJ0NIMp2m4vbHvgG/pRqiBp3jOoKVA1wCHAmtyYsihVKVUzczPB5a5fO0xLDXrqpGPXOkZjcO1H1iZHXvJ5lNYWss+euSydpOt5NXucJiMXm6PdhZdHy/KZXbbrSuYgRFTQKEyLEF2duyqux8IDwVZX4cuJPAYieAi9hcXEeLzjydcTJkqFReGjLmgijNsAULoxDAFPa0sutAWDd3aU5mx9bhHBnHFObzZqrFot0zTSimrnaMSivn+6QxuNKDImVE0mL0APdb+erAi2u3tbTLhirE5NuEb53K6YWaKUbOuGHY42NgcjrY3hqMNo2h6zcV0vaC51TvWETnrdkIupsnMFHlXIA3T3b3hVrrNXm1ujuwfWUp3Xu1uUZpt7WbZZFHq8JXOL+EYLDvQ0iLyczjY+dnByelFcvzfx4uub7k34GFRfdfgj1zcSuDPHz4eHHtpC3+3L1tW7l99tPbUh3N7BSD66v6LxoU8f36K/mtCe5zDVWHdgdslaTcbzUH9Uz8c4dlGRIpRq51QhR9ulK789CV04p4YJog2dK59uzmcinCjWT4hVITdtasqObIZ+yDa3b4sHVxPILh1Sshi+sx0WfHtq6E9tEcSVVMoCKIHdtHQ6RnxaBdEx1rmlWG+mVLNCmeMsKC4RazsDbZYxXtcxEyppNWaII+AQzf8aM86PMl9XEdjb8zFhg7sfZ2s5+HPSgcFfZ2Mhon9v9FuB5GXkDP2OIuo5WpgYmqCbPLEYseGm+t5fzeFWgr5cElfjMWVPbQosJ/GVXrNDKGC5nPNNZGCzORtGLKw+lrYJHJrDebADaDzNVXxGSJvQIyEF1zP26jXBHf+JtQgdKVLnnJZ6brTdkdOPEKPzdil5lNBwfHMPnL9YGmssZQ5o6IP9z/iT3H7Fj6Bjo1uhricXRvoVaMq9kC78jshx+afSzuFDznCU6YMemx9H9KeAN6ItnwzulTNSyOnipYznmJ3KF0f53jUG5rzLM69gyZ1lTZ+PquV3DBSibrEh2t54V+tX/HZpvX4YdhbqkklwAvOenqYHb979/bd5fvTi3fvzy+Ojy7fvX178albVkHm1bIy1s5x+IZwhutnqEStntROaq0MkLyQ6/aes7R6bqRi2tXzqje6Z/Os+srjWOy/2x1H3aF+/a73PMuxegpUarHKMRVZs0uba9+PbhpIImtUkxnPocSwxvB04Ewsn+NtCjrYkEo7BPVZpx4o+zPR3M+zIDqKTzk2R464F17NWM1uSrnQpiFiwV6ZE9e+umkxdM8mbezFAwfvsXgqCiqyywWbpH2dAISeJpAObmzLBqQE8tI1yHIysx1f4rWeMFfcSrLWepCoaZ7X0rbd4K8jhj9dL2roQ2QdinSrlt6zSKkJCNZbYi3yuwPf2lo+at/NHElkKijeXC/TOp8JowLhug+LGOo4XLUWZBNyCzktjYr9cNMAmeUeEIywgcPz/v3J0cCaRYUU3rohP78/OdKDWD7SqM56YY+fXWo+DyXPsVR1KDIFt87dVR9KoY2qUoOdqtFoyOduuBhzkKRjSVgKUirLBFO40yy44dNYyJ6dHBHFKs0apd3rWuy+itsEuv/g8qCPhbUhB4RaUaXbMZXEpwdb7Eltephtuplu7+xkryavXm293Fn4Trw+Q98sL1k8mOmgZSPFtN6wke45zy3scPMJ7e27Qb52IFRRmrZLXRIBS/kza4hEBdV6q6dG3bvGVt12Qi1El9eT+fOOXVCwVHPsRLD/Ay7ccys6cl3/FyAiexSTIttZEiN7c7SDU3Qn1TM6WtKs578cjO6ZdnNnd3kTb+7s3jP1zmhzeVPvjDZ7pv5Ooh1XvUDBONWGhgBdm0nqInYwhMVZGIpoXvC87x6xzTFKquyxffYbfabfaBFPcI3qZ8/Sl/QsOcT/eR1M/Qt49jN9+36mO3bu+3E39S/w2eu0LK9TP76fnU8PoevZB/Vd+KDcfj67op5dUV/dFeVp8dv3SC3H6fQYFD27pRbH1hf1Tj0SrC/nv3o8YF/Qw/V44L6gD2xx4L5pL9kXcoQtjq2SJd9BuHi9mH+TwPF6wd9vCHm9xu89mLxe6XNY+XNY+SJ08t0HmIeV/juGmnfxMF3IK/CoJMaT2ph164Uo7OhOi+mGGTVmdnxrvD5WJSvb0N/XDnaB9MsQz94tF7O5vflY4DrQPUWCqB3aY26VlP2gjh4JKphjC8B6Z8L6jGG1jnhbnfOte5uzORztrg931je3LoZ7+8Od/a3tZG9n67fH+imBl2aLVeh+FJYvYGBycvQUZOCgXCIrdeD2VmfC2dcXrhvugebmz+KhCcYOwNzyXVhahO8H6L5D6yeUSaY6UCtmHh9SgSVqxoxkfAL55mY/DBkVYyaUjJW81VCp0gAL5sYB4f1E0HmSThkBFUOYHFpii8hRv+h+VKWF/HF03rR7WSpF1uS7oR9nVXbrEm1tPlbLvJXKajCX2EZbqie0lZZJP5ZMHOgkgN4OFWijZ2MmC7ZBc56yhbH0fRjE/z6W8HdtAv8b2L7PRi95NnrvJ5Dv3tr9tzdzv0X7NgD35a3XMPXXtk1DFaVvyPIMGuVXtCtbMHwLVmMA6Zu2CT8hTPzPZzB6/Hw9c9BD8Ocx9hYnjCewBOu6eFOujcOKK+bxLv7u7moeP2E1Dqy+Acqgr+TlB/Al16XQi9fugkpfUE9uWerwW6dMYdU6cqu4MczVChlTzXa3CROpzKAMcticn6QKC1TdBdbVgM+Z+bvVQY8/QijeOzb9W8XU3H03aIafQj0QXSKNyzqSDDoDY3TZVV5e2u+ukhB/LX0zu3FlvN5SjzlmxqveN0zRMc+5mQMsdWxMHalpT/67458vfzw5PXj3D1w5y7wa3VFqf/vbj9XB4fDg73/78eLg4OAAPuP//rqosgNbjNLnoUj9T+t6hgGqWJnUbi/Uu4b5XF+TelvPAiKoJpZHQlpL35uwL26PPAEkQBYaOqiGId3zgUhgSvLCIvn8twEg+/i/zw5Ojy7Pf1tDeoijlgIM3NSWlxTMV+bGKdnvFRMptpZzEwIB29HfvH99cQJzwdh+uDwn4xrKG6qg8i3JIecEhxUVtP+GtdYUbcc8+vXtuyMk6OOfL/9mPzVAj6gvIq6QAJCxlBc0J4q53Ak0CF+wZEquVkYrVz0xVqv/XDnc/6AM/aBYdmlM+WHMxYdiTssyYR/Zyv8s7JwEgltSM55zQ0VGVdbcbxSojov4iGndXiGSxKKrmPGbZSzgYDxW7AZ7s4BV5F1wdr6OGPnlv16/WRTgazZfAry/8Bu2jkWUbly4o5zYkboy7/ztTxe/Hrw7/lBbbJ6Fn158OETd5e/o8/lwUliF5iceKlBaAsW2ofrDLRcWUEt3C5t0nVK5T7J8iCC3Y8cB4narBnY4OKHAu/s27sNnIyQc8x7EfDhi42paV0l9uKRpBOdToug0su1hDi/ju31IF4K4VpaAqzV1pfqrewufhWQ9zYwV4QWjwoAHjaZWQFPDSMlvJAZeK1mJjFBScpbapXj4oAqq+wCx/PCAxk6tdTqXc9JpqyRDIoyYkzKn9klsnnR8eO5CaMlFDIIbGt1f0D0MeUExwOZLtXSSE0gygClQV3CykatIqantS1w8F+TKYTG5Cis5sAwyVcyEgHmLobiDq/f/ee8j1PieSW0GoUnXwEff1xRhXLTwgKQ5Z8IMiH8U+qdjA93E9zPLLnmZkJMJdqAqS+byKE7OPN82soael1cDLECHlYKFQxpgjLq+qSdnxCh+w2mezwdESFJQUM3ieuHcwGQUvJzjeZ26GU21P3q1mQyTzWS0c/WIsnFL9Ckf5DnKCKpnTCMZSGERojxhOc0K81c8+UOj1pqLVBrNS8gurfHnRg2F/rggmpvKeYaxRvhcVqvKkoKuFIOkitrecoARmk+l4mZWWHp6gblfTLGJhDcsQVmWCUIvALC2cGwH5B0sEb92fDuTrv3m9qsoCaMf8cftLrzR8ygyGPnpb0enekAyWVCOPbnsGZPqWpu6TZeGNvTQ976u7v3ohs29OOlv2mxX7fj2yVnv4preBb207o6eviGfCTfhLmgeFhuV2wwvM/znewSGfcbXuwzdkaMcPnD0uKwZTOYR87p5Y2iQSKfWDrIAuAxGn1ZEaM6UiShLSKy4DQurDSRf4dxOEaU4udHwOsar+2gZRYA7Ytv3rNYDlRVcwzWb1YuVzEP7JD3wj1rAgNhPjs43Ts7O6x9CH+kBuWVjP2SJKZ7YvDA8UKncJbfpAWEiA6uaZMywFNOehVXbraTSjLw4Pnq35toihdQqZtLH1OmszKzdnPLpGr5Dd4q4WSAcz1KzKpNiHhq+IBBwcuEvyzAlSRWjJuqYE/bKU1agDGDWDfruVFQ4N1Stv64X8HC1MGwyv6y7+IO6iz3SAGp9bihcosvTc70pUfB4JASsWOFTk4fP9+tFBjkwhhWltZpOItXrNaPXC5ulS7+2vwDTu3NjDxvvNtzjoX+RP+YyvSaK/V4xbUDFK6txzlNydHqOWXq/XFycnZMNcvH6HJJHZSpzvbCsWFaq5wGu8eQIGRXXPoPxlpuZq+ILLXyQdyKjjJTJ2vHiGWQv4TyKYEbDhcMdl9srJ7aP8jva5tzNGwJqMG/O2jI0Y/e0LnGNbXxDmwWWv9TbJNa4+4V1gg/PZ8Evdi5evz38r8uj0/NLewguL16fL7q2ZXeiWX3X6D5jpLWi7q/5Ee912N1eeRB+tWi0w1sVHaWq84xif+bVVU0ymVZ17nRzNrCz7MlcXa3pSUhTU9HAWgVpdGlFSc7FNawHgzl8uz+4h0IUjL2xUYs51xQG1J2ui9FHgzCR3PJrXrKMU2jUZD9tfNL2Wl2LLSuM4bRFuZqZASllztP5AHUT1AnwhttLXWs/wcl+lPTHpNuC1W3NY8+a83penjmWf/kT6lmL4qmqvhHeDw4ZqUJsRMARiARdywS0hiJhwJleSBw0GWZXLIyGQ/z/i+JuucFwF1FD3Q2i2A3XbdVhzOyqgXbA3eHqSXWXljywphBdARiOjaTz+pt7zKQD95zdZN/Knmp3RQMeKPubIDSYD6kUwm3PJKjqaPQQxaZUgT9VMzBQ9CB6Hvd/zPHGFfnpJJe3cNGmstpm+kkqcnF45kYdIL0FMBG2lPGbOi6HC244zcn5P06h4xQzL/Sa+9ENagesYcHbGqTFoHS1Z3IMMp938PFDzQU8XiD8jrrBwbXoLCFCU1NhDQjXRtMwVZCVMN6K5R8g1aJhPRSiBbhOgL7cz85OdMyb+c6qtbBwI7petdSVpdCtKeJ1OB/IeWMCtKBhFW7EqFINmKH/qgQSBdxYobvQvd03WI1aIU1nyAmwYLuNGOPYNqoPcfgNv4TmpRj6vWiWEc0KKgxP8f7oI8hYKgj7iAGQgwZT59g/f1Ll9rEbbpfL/2D1lbJdKFPQcqN2pnmHpwpzTKzp7McUyEK9IEGPp7ur1IbnOWHof8MqNth401rVkfcVEDbhUatJWpZKlopTw/L5Y8xrdAcvS3ECqkfR5zYm+J9hDYHBFGM+rWSl8zlSM7wTuDxctOqQwZ5zDb2MT84GhHqHG/iIK8E/Ei0tnSSE/KPGLM1v6Vyjx70psumth8nT/VXivrhClDV1NGG1qPpuOat8JSzwZSe8vLKgXCUI1tWAZKxk4LYn0ukMRIrIlWjFaSvGh+pEVFZJWGBf7grzcYV5cBxCc+ikXLdRoZWRQhay0r53P+C9/joA6NuH40AvDs5P1zqlcCBEmaaz2teEqMQYUdYjoXdGu6/aa44dMd92yYXFA4veRmvqD7j7Wcppzsjr14cNfPTE6ywSIxq/1qzCCJE5UL4FuvRE/N6RBLLo7lbtNbtYI2E/ANknXfsjNDh+0y09ZTJJuZkvqxDgITfz/t15I4VRrNXoF8CRwnDBxNKKE542ihK6yTrwnUplZuQAYkxoD5CVMGp+ybXsKSv0NKjDKcjJ+VvIQfj/2Pv258ZtpMHf769AKVU39p5ES/J77su35ZHsjb+d1449yXe72ZIhEpIQUwBDgNYoV/e/X6HxIPiQLXnMscfxVCplSSTQ3Wg0utGvCoSDk5VgNbWaBqTaBR1ghqMqpWyj+TvAmRI+AuO8bt63nE2pzCJ9XsdYwodqKPL/Ra2Ys9Zr1DncDQ56e0e73TZqxVi2XqO9/WC/u3/cO0L/71UFyAYvcV59FiTt2PO4dMGJXR/+NsL6ykFrYXyCpilmWYxTv/yonJElCqH6mlI7C8XQzLkpi5dGNNUaVUiYdi1AEkHMdQDVmKR54Sqr2uYnlAYvRslsKaj6Q18stlFot7UfnvaeS0Un9aDWwEFhVQffHA7IKeEW2+rtxpgLyVknCitrk5Ip5azJnfYJZrhto3X+MVgFV0NbzcBUu9P+kZExKRKq7MiswFDvxMzjFlzrZ31WbJ1/vNlT+tb5x5uD7eKZMcdhAwi/OxnUw1Iuqy6Dr/DavrpUtqOxpiC9xNf+x1gx7fuTS2dUm1Jr1Khb+UbkKEnpDZYEDd/9c9tTZIsbAEy0mOMIjXGMWQhb0PP68RSlPFM7s6SpKjwTvlYax0bpEj4BIGnu6ZJAm6UbqGqVLtFE3k8xK+X1VJbhK3OKDNlXsbgOziQpiUZ1KuEDdiGHwMnpjAjpTWpppOduAyJJQiIHcja2mqRb8rM8JaPtBR3DcMaMnPAUtSacB+a5IOTzFqICtfwvygW8tXPUhFJFRJdVhCJrJKRCGUqmbSaYrjG9NklL2vEnssmEfnEjwjNbMymT1zs7+hH9hDKQtgN0qYOZJNdW/xc6d7fM4yUSdJ7ESyTxdb6u2tSNsZBILjiK8ZjEQlvVjEsIUtFlRBX2l2+HwsUpt0IeZNet6kHoUaPAFZInI+CAb8AUZDIhUD5azWo0ErOMW+Ty7XC7rV0d14wvmL3jKoCFDO3b9hoRaJTgnPO9cJ6gyj/led2wXvKPIhEw0PfNOMA0q3gmX4n1uAe+L/BNJkgaNMsyvkGV5/i4kCTPN4P4ZJXUwAy9HZ58VMfBicZ46IbyeeVVFTsyxzRuCDmlvSOYwGon1biuYJLF8QNnOj/avYpC+JVACiWYDuyDWxzm8ZikEp1SJiQxLFagDVyTPhoDak9Z4xyokWzMS7i6FrjxBBpHIVwo7tgItRpG1XA2aO36K6EnqwLRZPkIQymQOxBWK7nXdtsPKdCxz1pAMYQZZ8s5/cOLOtMkdB8/6z4idIKuAAtot52aDwq7K9clPORsoteqHMbAoAFO7odBtj5FHVPdmcn+MKxkVgumrALxcObto0m0i5lSuJkpxxvzKWVVpD2RhkGkVUmR8rixRE/XoQoYEmayF0KQiW/grffKv/pX65qOMcMjHM0pa7VRKyXKaqFsOlID3pl+5vu2bfklz7ltv7q9Xb59u+Iqlf5vOtwTDLI8givCEhsIF1igkMcxCaHagPm20FTfNdSfUBbpTeW2eMynwuxtV6nfzg35RjpaYANPHklmZE5SHDfY7OHUzlHZmFQ48LfoBHIrdduo7Uqvowi2CRje2ukqbEOClED1BqG7PVyZAUGERZwIpXdWVckjvDfZ73YnBWI0IpNqel24CA7GdIyDhliHYuXcRAWUP0mp8AQ3n+gsIsYjYi48CyjnPkZXggAYBhTwiFSbSLlk5EqjCh8Yk/I8x9dEICpRwoWgY12HwPFnblIoPlUMOScypaHmWcicLXFtMRdHbRhlRdEwi3EK8LohyZxK25ilHAP3nkvj+KY6aYgR0yeNkPwFofdlAQyIbOcFsucRp56LXceua1UES3Sl3jPnojom4aOiPiiKuKatTrR7SPbJeEK6mByEe8eH/WhMjifd3uEe7h3sHo7HR/29w8lBgR8bun0taJSW2XRkgiedgFqlQENW8yI0czA7E+Q7ZFwZfsFxzBd6+SMqZErHmR/7bsYwSQxpBmkdtsGITusp6jj69sXGiwiJIfMarvXyHcKcm8AD/1x/G2IBGJwq65SGJtWpsIusugMFrozSo+/TMiGdcx15xv0bgqWoG0SbyOZYgi4tiSsP4R5VC3mVK2Y6PW+iNgaQ2+9xU3Oh4uPRMdutyEQ8Io26gCw3YccSMGVJznicIBdcyyLHSmoE+7KVilbtV7/BNvVCYv3SKZAvDWEIOp+s7S2CRd2JxdyDM7adedyg5jhxkNncQTvaerxUEskeCFWOKgGgntVr7sVHFhnV8GCgQFDT2xy8wk7mRLBXr3L9Egq4GX9wSBKpkXOzaYiBxFaNNECajCu/1Fyux3LY0ZRNMypmbtXyTQlbWp0XKEsKR70557hQoCLfXDAFNAxdGBH2et6JhHz4khQqck0uYCz3bKOOlgqOxgapOWY6qE6QGjXBztfpmn+9ooQWXq7ug/qidQK4Hr+Ea9GOaaiYAqi8NmZ743MCXiwVKNTGfI0+W9AT3AntKeYWE2+SU7tA5xM9CE/dGDglJejKO3SF6F1YzemqIFWv7pC6heWojQh+mBX5uVgS0y6ICyss2BbVVcllsOQo5vxamWDY5BISqVsulmwLrwqnk+5VauwG/WDPt7Mg+rBgZuXf3GJl6afujkW14Y26cxp4wnaKKmFxJC/o9I5wU99XaGJOn2RQpAnvfAmKfAmKfAmKfCJBkXpP2tJhuSB5xMhIDdJLZORLZOTDgPQSGbk+zV4iI18iI7+nyEh9VjyNyEiApeHISIPwHRGBODZhdPlW5C5YsDYq0MuwQzLFYEGx6ZOPklxJjuAr6fEEoyTX19S+YahkDc8/eqikrz++hEq+hEq+hEq+hEq+hEq+hEq+hEq+hEq+hEq+hEq+hEo+11BJ3X8NnjUuvMv8m9UuvJZpRaI2W4yFoJOljb3CUMQZSp3iMOS6ug/UENNzIYm/cMbny18NhL86JUch/O788tMpOrm8/J+Dv0ODr0mK5wTKRv/KCvGTUOSEp0DAAiT5wAYOsAixq3RLU1dTVZu858OLNnr/t7Nf2lB9dNuGZWAU8vlcyVoDcpAPDd5vQCiQOJQ0DP4CELkq437dWGVzGe3WVQgzC6zHyMfVEP3aovMEh/LX1nZQmIqEM9jPwV98MlQmBZdZPug1ZWDNgbKKwxlU6HIlOuFqUGpvsp6nDQsWhnyexFTosKkpx7GGLh/315ZX4pUp4ccn1n2sQNfN2NbxqbpV/gbHlOFDN2XeWjFLda9EW9pU30dbvipo8nrR4Xe3KC7eE/aio2aAztxUZixauDNH1mxxDfEgmAyKmrGpK5CLiLJxdOcUiSibKjNeCQt9r0JkykWijYfYAxZPpxo9WxOpJEz8HVc0QDVfN6bktBRjU33NoKlZ4ElLvP9jSr5ngiBckQ+/OkR/NaO0CyYj2iJfAld1EEuJw+tgTmVKoOqgfkXsXJ50u3vdHbTdKpNH/1JHmAa1qlaBX210zrpE8mlSkadfT6QqjbSwqqNR07U3gYfcJFB++glRyh++SrV1RynS1Z0A32RfOtH2tVvTDrQZOe1bYueyt3d8XMN68P0KCj0TA71ViMjeeEX8ZfC5u6kVObUqwdCpBH72TXWZHklGrE1In4BVLn8oQt5XVpTH8ZV7U1bQavampu4mDQYxVBn3y2AWdPAJDzNhbyPymr22QCaiUpB4AloShYZcUMQzXiJ8wyn0K+hEJJEzV9A0V6E0CF+C/e6xGTUkqdSqk24gvEGLw5Ams8Y6V1zoZnCURaDWmeq0ekrNXFGWuq9NwK9H0opIe3sxOh0Mfzodfbo4Gf1yfvnT6OT0YtTrH40Gbwaji59O+vsHt5t3Hua6OIhHu4ao8PH0Xce2MhQSs6iDY85IYdU4hPK7zgAGNri/d9wPpomO4Zxnug5qh3wJ40zQG5CAV1WURuEMU3aFBGWhuYb3O10h7bzQGWeuxGZMRdWyfnd+HgRrN15ZBUnTdwY+rb3JK7H4BernxsYMYj9Xr8W91iAPr7argKVxyhRT1SY0FbLAFjbvZubC12o6YBRWpnO/hZphMQvm0X5D6zMoCKi8TX9esvrdcB9FFAw3PkHD009uGYvx5JD+t8bOOdM5HIIKSVhoXFymcVHemr/tWa7OU5Yvir6uzBtyZklCUsh5AXqVt0j37PBgcHjWH+zvvzkbHg6PTo/eHJ3tvTl7c9YdHJ8O7rMmYoZ7j7YoFz+d9L77VTk+3T3eHR7v9naPjo6Ohv2jo/7BwaA/PO7t93t7w96wNxicvumf3HN18hPnUdanv39Qv0KOhl4Gw9evUD6qXqmH2TcHR4dnBwcHJ939vdOz3uFJ9+i0f9bvHfRPT97sDd4MusP+wf5pb3h4dLj/5vRw783Z7uCw1x+cHPeHJ2drt/IwOFIhssZUnmGeEWZ7mPIJEtn4NxI6h7+GwH4CTa72PDKluCurVCbg4P2PJh0IfeJcosFJG334/OM5m6RYyDQL4erzkuB5Gw0HPzo333Dwo42tWJ98v+Hdpk5x46eCNOY8FUDPa3JclUo94wsdD5qQVLGaYrGLi7c7uZqN0AyzSMzwddVNG+2R/XHvKDoY7++Hh73+Yf/oeLff74XHB2Pc39uUmxiXIzyRazFUlC9ukWmwJDuXdE58VRn6Ppvq7wWdQCDGIcyKmK0aqY3s70waVR0j/W6/1+mq/y673dfwX9Dtdv+5duNiD98xpJl+Q4SNZrQ2sr3jw+5DIKtL5T1wPEOpuZ/gKMRxrIQlQxfvz41MlSSOC80FdLKPbcSoTNBqHxVDPSoQ1h3BjIvJ2FRI8gD9osjsiW31cKHTTamJ9pQoyifU5CT50YImK6lC/8ViEZgEwSDkm9Jcy8rHlM8ViZxLYkeWOyXyfGk7vX74/OOw0IDogSSxyBLtThlpk7pxj6yZpl53KNjy+psZiWO+0m5ZYc339w9Gfxu8U9b87tFezdOng+Eaz78KgmDT7f5lv3sc4BjCfCW9IbDxm6LqW6p1Nst73rw6wQBtXZy83w60h05C4/4bnC4V1euUBd1hkUrdd9tnXnCTjDNpnLY6RBvCM4p9jYfvL5CPMdJ9ahc0jkKcRmJbNysuhICRqtvs1V+8zX+vJdD6UaDBbVL62jUw3mwQB1uD99DvRgEBDfM9SjoaV5C2+pdSydFPdDpDJ0JkKVYGv6nPP9jUxCjSAhKQGqeDTnPaGmxDQogoo/l57eZjNThEvuxtcllrhPzW8D6rOvjx80UbfXDa9TkLQZzDAZeH3rZ9DbyGA9x+eghOgMSkPDWqKVaw01hZ9Ha7TJx3ilmUFPmZksVXIOQn6jaMlD+VQFsfvmKjn7PwgXDG8ShjtCmFpw51HCM1o6LA53uQoMT9X0EGKO4x4ukI4juac3S5s1YXE0mRnc+dtJdtdAHRIh8rfD7AMZ3wlFF8H0wfwj4ESwlLr6DeGgbhCtuo3+13O93DTu8AdXdf9/Zf7x7/LzCQ7ovcVxuDd2JXtv5WYtY77nSPALPe673u6/7+/THTqQ2ja7Icuc79jZmAZvy6DpguD+OaVDfip4t7HSQebmGW3jS16S61U++GFJtex7F6IDQ/5dghR+eq38v95AqzVGjBqJDJfn/txpUrCEK+JJzl2X33qZVxaoZwyxmRlN5UFtM5lNZA7mB/f/fQEp9F5Es5KOJ+yAr6xzqLvwpRqAlK/3DhWN5aigSH4L4a05rAun537+g+oAuSUhyP1q5m8hVR4XoqW6cEjqvc3q09JctX57kXw6aZ5/ctcTLDLIMKC+1iBZj86nxB5YyD0RYrZUVZXu4e3Q0dznCKQ0gbLRN5f//szZvjweHw9M1Z9/ioezzs9QeDk3tJDNeRunFheF5M7PBJnbfF9iTFL9DBlM/nRNFH+Gll+mif8AxiLNDfOHqL2RQN0mUiOYrpOMXpMkAXhLggkimVs2yslJqdKY8xm+5M+c445uOdKe8Fvb0dkYY7IQywowgD/wum/Ie3u7uHnbe7+7vV7sTgo+ncU1Sby4HHMYWFs4UtGGXkxAynJAqmMR/j2OmEeReZe+L6GKbuw1i6FoenYOqWRZW9aNKlLFbYuheXP+b6bhu9/fECM3SmrFgqQu7Zwm1lAQVg+TbCBU/GzC0Q4Gswemw7d9UmLizoQyH4BIzaEr73QulPYKCa+IBmtSqvcqOa1Kg5FVbcXRuBBu2WFVGLuSXjMk6hOK52irS1+xInUMCvLj1YkDDp7x+ka1soREg8jkGwr4HpmPOYYFaH0Bv9E5rEuIAWndjoVMTIlEuqvVMLDNn1IRFiksVK8XQqFXRmp+opEwTLEGGgD6nPGWMkXnu7MfJFjmw87DddSheEOybwFcBNogB9JHphdXAL8motQPnBk/cnppqH0huszrhYLAKKGYagYyyUljonTIodGYsOYKI4X+HQ0eOu/CH4MpPz+AccJ6xjYezQSGyXAqJ0PRXPaIj5ApKzRJXrFJQ7vWBtpkuJyOaNMhwVpchpYDgzL2QkOmyhwbxWcMpcujabmQ58TzLM18C2aZhvFaXHCvNdBUlDJG4yzNdfi3utwZMO8zXgPpswX7ta33OYr78mzyPM9zFX5aHDfEur80zCfNdcoXzU7zDM1+DYaJjvxUYBvZVA3vyo8PoDf/OAXjP5b3i3scix+ohePfGDRfTuHu/t7fXw+GD/cH+P9Pvdw3GP9MZ7+4fj3YO9XrQhPR7KYysknieVAFcTzfkUIno9fB/EibsJwt88otcg22x06cXacaQlgVwjACoxRo0JgJewx8cLe/SX4M8e9lhLi+8s7LEGh6fgC/rOwh5rqPhk/EH3CnusQeix3UGNhz3egfMT8BB9k7DHGjI8U6+Sj+mzC3ssI/d8wh59zJ5b2OMK3P68YY8rCPI8wx5XIPs9hD36oL+EPX7DsMcC4V/CHr9d2GOB8M887LEe1+8r7LEOh6dg6n4/YY91FHwyZu69wh7rMHpsO/dBwx7vQvAJGLWbhj3WofQnMFC/y7DH2t7aD1cKXKtmhd5E1q2c4FTYXuLqe57SKVXMp4PRahw2QX/tS3C7Fg1HA75X1I/pHyTSEXPgqnbBgHCI+GjehaItIroSQVdtNMWh1dBsxVHvq9VVR9Upl9JxBtGV+g0IbhJKeU64EHQcEx2HiePlH8S6T7GOP0p5NlW6tIESozkNU267puM0nFFJQqNaxkpZ5IygG0oWnpXmat0bQ8ADHHmtA1BKfs+IkAJ1ciahjELjjwUZ299trNMk5Ux2lPZa7HPfUej8npGUEoHmOHJ46CrDSUzQGIfX/psbVDsVCWbNlYd+tbKjiJrX9k6AzyHXlcuV4FYk9Tv7n+jHU2KchRBbwROSmvBc24IBgnExhFC2XacI7AgtuRsRMx3aYQjW1u3NIyzxWG0BRe5lTVP7yeQYHx0f9caHYRjtry1lNT6PQOUqIeEbzRMiZyDTj0N3OsuJaKIHx0QZ/kjyKVFEAwPXDZl3SDLtSQyxZ5hFsd4hbhpoCtkxca7EY9cKpffGk+P+ZHf/8HC8uxfhA7wbkuP+cdQlXbJ3uHtQJq+F+JGIbKffgKP9t0wbPNtu0bX9hTYQc4JFlpp7AGBzx7SKxXOWLrK6eY6kVeJ2u5PuwSHG3TE+7vbHh55gztLYF8qfP729QyB//vTWFng2HSmQKcKkzXYlKonRZHAKsu/zp7dCO5DNk/bQUDQYpwRa4aGIL5hiGI5EOCNz0nY9JxMsZ+Z9jmzc8zqyrtk+Y0PdBcw2D0rjXAS1iuW//H6E5wwJPicQqq4WUNFzjpe6BrlJKDj/qLDdUSRUdNVNzOJl290M4XIjRAaNE89NVTM1tu6c6PX4XcBF05TbnqdXpnSZplyVaWoqmLm0DBu53xRpL2emiriNVxAmbFiJLjt5jSZmdoMjS5bGhVaQlSGoQBAWIYhEVO1cEyHeVqvIuFSCMl1CWfgZ7Lfi+6XBY4Kho1FCUsojNM+EhEHGShKGcRaRqKa7pb4chIfHBLUSNm3lN4jq9VagvquuUGKUEK9PznS+3g32vVblI0+lUeUNxyPwb2l2+uHK43/Jk1aJOFc/XGmHVbHzpwW61PNsksUPqAM/WnuG84nunahEIHRjonO1pU1HpiXPoAh8vmGX3oWwkNyPCqMMXSl+VuNdQa4VKDSw4fVeoQJuRJmOjiKRtiZBgbI6J5z7bki/33BNP4GiBHi9t7e7I4jSmf/6+4/me/35B8mTwurZDfkMVvDVZzbnkTr/o1zOAOsLJAhhBco6irpp3nNp8iAoQ4xIfcJzRiVXFpWWAHwMJ3fkDoMxUaLGMA6sdUqw8FkBQ3IaivnUtPVXr0KLBEkY+i2DJs55qB3ILnWOljvjOs5xbcLca25YDMbWAgsHaLtwzjMuq8LpXkykRlvxc4G/EiyExzUPnsdkhi8ZdkEJBtlU48qPWM5Kc3uy1RCoVQKngR7xfm/yChx7e1WXx97ebgEoMKuaVBJgAsPE+tcx0bqC/sXkCdbh4OvRrRKzVc6uv8LZBflPkX8H5M8SKGmvFTqntTCu3oUdmuayR8dteLDDq/CM7k6r5htn0j3V9ibTyGo1xY2o21czROaJzOEB0PWTV+Zt00zOOagpJEcwSbEkaEzkgpBiyqdccK2rlg7ox+5Lr0TwS1P6J9WUXtttTfHBBYy+WizCgdMqHb06VOHqda3qqeFdcXQVLxxe2u2jl3b792u336AL+LMZvkZP8SEoXPDYz6tveIAL4WrP3PPYg7XYvXrMM+naJhsVF9qDkRvsbAxz12A6FZsvTScxxR8zfEPgyp2AS56n3hUlkyklwpyqtoc3mnPom4v1TT2NrKlsL6MwQxhypI3SDSe28K7p52bhHv8CaXWjet2IvME+9ZDJ0SBXFlNFgopp/8CBEY9mHSpcXwltqqnp2ohO8l4CFf34BJqJo1PKhCSGsSycukH+Y3EczN4oy2n8Gruur97Wuxo1gJrpQ6j7Je8kMZa6pX8VxAYFtk9/PVlhfi/vuClvhpWjM90TI9W9FAuSfQKuMS2CGMKMs+Wc/uHdRGnCuY+fBZlksWL8K+iMT6MrxRr6g0LsymWdhZxN9ArhuHiasEidB4zn+9JYKSUuKvNPmEegPyTv2CtOYRMTXcmeCnPcF4JHk1kXM2XWa70hRTGfek4MUZOIiUFoFY0/HjeWHekqnGjvopoJYa1pSJpvH6NSlGB99a/WNR1jhkc4mlPWaqOWbrhO2XSkBmz9+46QDF9xGuGpvS711CeUf7uGEqXHsKoUg/gLyMOcE32NgNE45QvPk+q21uWMLM2FnpjxBVICmkEkgQ1giPlUqKGUAuzuJEwAR+ZAtfcBG+g9RA3/rSShma28lvTjjDNyx+5rBKCcdNWgXjzBKS0A9eQvu0uyzuOPUYE/yri+43/QOMY7+0EXbenV+N9o8PGzWRn04QL1+qOeNuDe4VB98d/b6CRJYvILGf+dyp2D7n7QC3r7Drytv/90+e5tW7/zNxJe820bIrbT6wdd9I6PaUx2evunvb0jQ+6dg+6eqd/liC6CCZ7TuKnbxQ8XSI+Ptqzdl5JohmUbRWRMMWujSUrIWERttKAs4guxXe2zBk9W4H4erq0POhyHTY1OZfVfsLhs3RZXkiWFUGutF1b4TLPOO/4bviFlal2TlJGmTJUKDno2B7YOscCLVTtkL9gLup1er9+BxC0alqF/JmbOirW24QjeSq9a3P8uU8Zq4N9qZe18Zj+HhEku2igbZ0xmt+1hnC5oZQ83G4VaAX5dfux1g15ZUjYLqhfee8fJqaS7p1/dxEYyGs3q57cn79fRqdRzVpvCae7JMMr7Eh11+0HvdyTxdEtANDhGCQ6vibQ3RVjoKz4sEGVTiI2B4hb6TxgfC8FDaoLo1RDMuj7BJgKjSWHtAgyxy+Azk2mJl7dxN8+91x7gQGFfh0VKQp5GajjKprHBVuIpxCuCqziDgAsoKmkXb6YDCBSgv3co6/yOCAtxIjINpWgbk64OMlTw6splQkOln5qhzaUaBPVh574WhAmeoi0STAP0T0Ku2+gXmhIxw+n1Nvhm6Q2Jl8hp3mB8p3gCCY4lSlDGSLpyVfUQSD9kkMsXWKAte11oRjW/FfHfXoHk7ehp/My4m2J5C3qF3pIQfGT9ccrajiJqOMvCU+AVxei6uBCx5JB4OgVZYIb8MLbVvzzmttwb+FxuarzW8J993AzpeNs32aGykdsVJqDUGvoRFWFK4GKhvMPMmACBN96qdZnQlCxwHIs2SoH5RVubrThCYxxjFpJUbGDaNHYBBQidD7WmqJtS2rRRR/2qvL7dGP0mls+HxCTRAAZwL7AJDjyTgkZ3JCQ7qZ/FjKR4TF2ClxX/lR9WnwPqGCgMtIajAtdMjSpeC1vQOb9bWIel1G6c8mYDHaCSE59YhUDJcz95ARCRFbpg8GC5DGG4UhDEhhhZlajj9vfWxL/nHYL5oua6+Hxxuq3+0PUJYnjQDZq/YMPpeYrOzL7dLjgY85rRv2c4XopphtMo0H9D8u3vCzKekTjZmfARRMrFO9eML2ISTYkaeqeA4MiQnhIRzOT8X/+AgRxgRWLkz/57uzYKyoZgWhdS1cP36l8ti9edF0l+qXl1WEAuZnOKGNRcKEzk8pcKVBAhT3PNsrA4uZHuB29BDQ6o7B3eCLFTzUH7+WLthFkP4idrFVWo6n1RT1LYfObMEu4IxzGchv5sdW+v2B7hDQnmVKZE11RXMmxngn8HNo9/CG/ICDymIw84MQpTgiWJ/jWATG43rS9bKdFn8emXhAslOQY/n/oY/ruyvucMzXH44QLpci+oH/T6wUHbD1cqksMERH76ONigOjOBoghNbxArRb2bfq+dARW3LE11c9QtUc3uOF2XBI1pJgpzi7ERDVvnw20bHWIqXSR5dHf9YYm0kz5A575fHWVF54mZwAxqfXBVupZPj3VZfzHDckTFSG0BGm0bXi/zuBu9wuvnw3/XrFFHlxDqdrsbtAmAyLnGEoNPUEp0OPFqAVPQn4200ZkycyrpVJs/jhZ2MRz3R6V1KROmfkXCKe2MKVPfwnVeOKV/VX/86Oh40OttQEbFeKNGmd9YkTxFIsSsnlVri0r1ur2jYBOmUOMzkgY3hEW8qZRqhZIf9lM+4AEEpEGooHVJGB7H69cPCnlKgnFeeeY2ZCYxx7JWhb1Qw+iQnxSzqXF9dYOu0rh73aCrLxPhT9uTZEbQnAuJBLkhqR9T/0apmMKMyJX1qTQ2IYgQc/C1gdROYk6lJcqcyJSGAm1hKXF4jW4gXCFPs9Hh7F+oXLZRktIbGpMpMUlrxhMuSaqz97bbiM4THMp8VN+vrcZw46rXpikMq4YykSEAk6mpClmDK5SAGvXLqurAup2Ih5lCebuiqe4H+5stMWE3NOXQt2UtV9Y3WutTH6y7Fh2zJXLJGsAlZoXa6D4rBA5ZmhLoZfMElkiSecLTp7Q6lwaiuxYGfD9zLDNNaEXSyLRaAizahfParlX4cPtiTQo3e1cOhvx7W7KkcOORm85b738ebueHvTKNqYTa0I5GsAzAn5hdUzaFK+rWW75otVHrHYloNm9pbm79RKezFiyBMtPQTV8tqhOfbkTgBFG+gNTFud1cEqbKx9oNuib8eAl3iBGZUFZMWFMj5A8X1sjjIniCCsQXjERae8EMT/Xd09n5p4vL4EM61VVq0BZ8oYQn+nzR0eXzGYduURPqmVpefZg2Wsy4EgZU2KRPydGMxAnIfbhRFyQE5lSaLcgJpX0lnHnOMknwXCAcplxoxXnB0zhawaLsJgoYFTKY8hu4s+gYUQTsWhUG2jmyHquaJWlQu3CrXqthQOCuoh4ICnsIYii2BpXMY0ezJKU8pdIsBErJFKfgHPZEwP0oWFHi1TShm/qOe8gv+91j//oRStMMSrXVb7uBpEIpAbE+G7QLRhsial/Z+0i1V76U6t+LQo1L/6KS6noM8RLFfDo1+f/Q9EvJUu3IieiUwkFoK+LlZe4cQUiYSaXioTFlOKVKjbnYeXf+7rQ4GzMxumMewTNwfuJ4KSCLEBKdLZQcLvSvA/QL3PmzeJl7qEoVWw3oEDio71GLZQ7NiIwISSKUsYik6ErNdWVGn2ExI8Iynt+Ip1B8MSV5mG3b3eYSdKXev4LCHpDUnafTJjzJYmySIcEBqB1YMLvf5OZq2zdQjWMDTDAXxlhoXFWMnBxzOUMCZLetxOacbT6gwBdc12ULLYwFsuiIfiU0IcZWjVkdsoaPDFoyFoFX7elqe/0L7ZdGCI/SCOHP3vzge2148NLkwPy774o/mYqP92ps8GdpZvAnbmDwvJsWPLtGBc+rOcFza0jw0oSgSITn2Xjg+2s28NJg4Js1GHhpKvANmwo890YC32vzgJeGAV+x2k/GZLxfk4Bn2RjgmTQDeN4NAL6bov8dNfNrNCbgyMYsnPFUf+yENr7ReG/e6GcKIPwnjD2whdjMmaRed3km1n8A3oo4NiUc4ZpZgVp7Mw6pTTMupCeoNZ1wTF2JxgTLmX3Ye7AGQPVvSJKUhOCa6EA50PxF8MrAJ1rMcsLMplkV4FP4BZLOyR82dXo1eDrKvfTwnE51FOZrJNOMFEfXFCkMy/1e0vrDqI5vVqDu1geCbMDxP81SWBQ9WR1+a5BerZD/3K1owaD3XdNbR1bEFVDIP6BMSO+y9E4awfWDfhfZdxGN7LYIY55F+Q4YqI82aiBFcyJxhCWu3xTvzK869CMsvArhhbk9gqNoBA+M7JDqyZAIoUPL/D1SwBxeCugcT706+HlJiTnt4HEY9fq7tfIjZ5BzNQI6H7rgRQ2upYhhjx/QiVopeIjHkc+oFiAFf6ChsrjesdS1D9+63N4cFsA8sPH2aRxC7vmNZ1qDe0tzrcvG3mxzHM4oI7DH15rMvBB4L6w7lx+LNVpDoN3+1rqzJikHKbbmwpnHN1+3lExzre/2OQqP1o5vxULEw2vgVSMXhvZzzfbSv4Heoc7HONY9GkAo6N/UDhcznsqRlsy5PmGPYz1fx8mEFcemAwvVeKCLrxSEiD4doKaQ+7GOWB7B6l+pJdqKqZTE2Xw2kHTehtpw1tKb6016/+lMGVD0A7r8MPzwGv3EF0q9mONECVlB/lqBpXDQo9sPe7RaniMn0zUIgeVcdf7mfPuT/lQzyDmbcJ9bzbGgXkdW1ngMqr6vZU9zbpwOLvy8Y2ozbQMSimA5jwPznE6cw6m+U2WcdfI3S0W8uGtAsJrTVy9NodKWHWLMeUwwW5O8k5wikJ6TL3t1Xi6CcUbj6pTVFXWnd6t3NOx1j1vrgfPhAsEMfoxKPSAhj0jtPrgNFiFTIsPZ+sDYWXSpPrZ0HHidjUnKiIRQAMOHf/e/qxk3/93pXEUFKh8U+Vx4u1TNX7pTshaAvp3nyhRPeFQvdjbazB4FEq6vlaqLq6bKamT4fWf6yCP0+XxYnQhM5gSHD4dUPmJ1Mh5VRP5XTmZr5FQnM+LyL18tmL2fR3OcJJRNzbOtv6y5izyIzUEyx0kVZKjnpy+cnhzcHmz1wKckiWmIBZEPu8T5uCsWOiJJzJfzkpn59RPn466YGC6gJln84Ch7A6+Y+g496L4Tu2HvnLZe6fv6efW45oAxsjw/XT66L2rGNT/m54ozauvOgXxstNEhQL6sq3aaGYI8wPgW1dNg/BuP+TXFHZxJHlEB6SE5+v+lf0VD88sS+c8hz/K+8xKjZij/FDZwuCFXXe+Z5wJ9y1PMBtngVstefxoXL584ALzSSPVz0tsuX1dMd4rDman0qJtUuZRcE7xiKrETCh2DXHWVKNOVACROZZZYP5AeSLdDmOtsYHcvJ00fVDwnUiGWmgwhWDciQSXXBbvhC/WxbVJOATTIK8Ax1KIX2rN//lE/YdgL0agNMeCQMlQACRIMpADK1JPQhDsnKY+yUG5OyEsdWm32rhlGqYkOt9umvTe7FKZ9JVy1ry1v5u07pvbSTTecWb/rRZYb9D1eECjNGFMLTVk9HLaN4Mazf/70Fs2U8TlTpgpMZ7gVILmN6GGWllwVRTNpxay/uMZZFr8FFo7FjUmJMzkjTLpKGrrJkRVrMZ/mUuwtn+qucJB/ze5yRMT28Ziyop+hgGbMp4FpJmXCzG+hr8lTvK2CX47/hc4pt20BQXzmMW4KPO0HWUARIByhHQTdoNSDQRVIPpkIUtxrXiDPRpC5/nx6TJMAYcoQKXJpUSUQLrs9lBGI5w9FIbWiekRd5Co1bf3yvaHbbhlGfSVkxDP5SnGJ+puk6asieJQlmfRvQHNw4LS8kyowgE5iKK1XvlY2Ui8KSp29gJTcazVoYr6KFb119oya4grxxKYISTe5MPXijJw4ozEBZ5reOLBu5UVZCsUgExwWSi59PYuYARH5IiFCyevHZPLNlvWg2F8fDBQ7oOsoA/MU2l2UQLCZdSMwnB6KY6FHUjbHmlfBfWYnun1RGgfDTlQCwyqTScqn6cPt3HJlBDN8jdiaxHgq7hrM7aJiF1L1aqkMeWH8mZRJYNNHA3MsjGLCpq5j3Eq/ZeHVMY+WwXiZX+7c6lsoVSy/2wrIr94cztVXVpsO5T4e1RUsgAcJhaTGe7u2ruBkjhlKJ+U5uad1iLoFsVPPeZTF67nFC4/eSnbF6iNw9Eo8T9Ya3NRpWmd07TkJsJTpgzre/XFz9ja3OZDilhdeQDc4pWo3C7RIqZSEKbtKj/BKoP+6+PAe1gaSP6GVc0q9SoG26px3za5TSF38BZyyLo/cU8XMCVQc1xxP5dAAGs6TwAvJ24AY54N3H+FeuG7Iiqdz/SG1pVIccnr/If+WD1kYE/+RlVpQrGX5K1acZeOVuzf//pZbD79svh0xqMxVUBJR3dF7xzRq++tBqoMT9ntGMqI3YWWOyE8QvXMOOxZEblSngo7m6t1RzWX2Zti4oVCeM1K4mRLZnKSjoii+1xIZ8xLG87vOoEL4++8ZgSACPyzsvrjZ0VxppSK/XuPJNd6YXyVPaPh1lPi7mtgMtHptvwJ5PUG+tIaaD78f9ETGTuImCWBOhMDTmnvOa7J8CMJdk2Vb9wxS+klkuu6pna9/N9YFtK22onslTOOYh9eVcxPdY98aWkAZj62QzxNIyo629RQon6ICw4zgiKSiMjdU/1xv8hPbbo5PDCB6UFOhXeSlBQ0l2pYyXvF7/a/1H9dk+Z+v0X8AHf+zFfyP/x8AAP//lTSbPA=="
+	return "eJzsvX1zGzmSJ/x/fwo8mohH9i5ZIvVmWXcdsWrJPa04v60lb9/O9IQIVoEkRsUCB0BJZm/sd7/4AQkUikXJsmx2z+woYqLHKlYlMhOJRL4Cf2A/n3x4e/72j/8fO1OsUpaJQlpmZ9KwiSwFK6QWuS2XPSYtu+WGTUUlNLeiYOMlszPBXp1esIVWfxW57X33BzbmRhRMVe75jdBGqooNs8NskH33B/a+FNwIdiONtGxm7cIc7+xMpZ3V4yxX8x1RcmNlviNyw6xipp5OhbEsn/FqKtwjgJ1IURYm++67PrsWy2MmcvMdY1baUhxj3O8YK4TJtVxYqSr3iP1I3zD6+vg7xvqs4nNxzLb/zcq5MJbPF9vfMcZYKW5EecxypYX7W4u/1VKL4phZXftHdrkQx6zg1v/ZGm/7jFuxA5jsdiYqxyZxIyrLlJZTWYF92XfuO8YuwWtp3EtF/E58sprnYPNEq3kDocfsciFzXpZLpsVCCyMqK6upG4ggNsOtnTCjap2LOP75JMHP/8Zm3LBKBWxLFtnT86Jxw8taMGkSZBZqUZcgjMDSYBOpjXXfJ6MALS1yIW8arBZyIUpZNXh9IJ77+WITpRkvSw/BZH6exCc+X2DSt3cHw8P+4KC/u3c5ODoeHBzv7WdHB3t/2k6mueRjUZq1E+xnU40hxe4F/88r//xaLG+VLtZM9GltrJpDCnc8TxZcahNpOOUVGwtWY0lYxXhRsLmwnMlqovScAwhkmmhiFzNVl4VbhrmqLJcVq4TB1Hl0nPgC7klZMjeeYVwLZqwCo7gJmEYEXgUGjQqVXws9Yrwq2Oj6yIyIHR1O/tcWXyxKmTvsto7Z1kSp/pjrrR7bEtUNniy0Kurc/f7fKYPnwhg+Ffdw2IpPdg0bf1SalWpKjHCSQrBo9okdfpXgTfq5x9TCyrn8Ncod5ORGilusCVkx7uDigdCRKxjOWF3ntgbfSjU17Fbamaot41Uj9i0cekzZmdCkPljupzZXVc6tqBLJtwrCOmeczeo5r/pa8IKPS8FMPZ9zvWQqWXERp/MJm9ellYsy0m6Y+CSNxZoTy2bA+VhWomCysoqpKr69OpE/ibJU7GelyyKZIsun962AVNLltFJaXPGxuhHHbDjY3e/O3GtpLOih70wUdcunTPB8Fqhsy9ifUxHycrW79ZdUlPhUVF5SSK2fxAdTrerFMdtdI0eXM+G/jLNEy4iUK2d8jEnGn0ZN7C1WDxSoxQY3oang1RI855blqixFbk2PFcL6fyjN1NgIfSNMEFcFMZspzJTSzPJrYdhccFNrMcfCJrDxtdXVaZis8rIuBPtBcOgBR6thc75kvDSK6brCjkrjapO5Hc0Rmv0LkUogzQxKciwafewkG/hzWZoge+5bwK2wTqCFZsLhltCnCeTtTOhUe8/4YiEggSB2JlJSnYUABlQkjROlbKUs5jwQe8zO/XA5LAE18URjyWCpml6DXwZRYGSJjAUnMfLr9+T9G2eTSLOGIJpxvljsgBSZi4w1spFq30KJMD9O7TpDg8kJdnaOsbG/MjvTqp7O2N9qUYNhZmmsmBtWymvB/g+fXPMe+yAKaZwELLTKhTGymhLk8Lqp8xnjhr1WU2O5meHlk/dv2AXESRPL/EJ0Qu7+bsyVZnWMa1kWWdBTNMrqil63pu9c1asr6dUnK6oC2zOGarFsQvPOp6n+IkPGYQu+yYoAWBVXIa+Wa+C5lcY9w739EUFiBSy0upGF6MEgMQuRy4nMIS1zbp3hI2FLeFOBOJhomrmwWuaQnWiLvoAtyp7xeXG4/7zHSjl2P/vHfz7ku3viaHI02RtMDgaD4Zjv7e+LfXGwXxwVL/Px0W4+Hg5e5BFF0GPZ7mB30B/s9gcHbHfveDg4Hg7Yvw4GgwH7eHn6F3q5EBNel/bK8eiYTXhpRGtaxWIm5kLz8koW7UkVNB3fYGLDGEwW0HwTKbTXCtLQ+ngmJ25jcbuPeb46xRIWip47qy8Y5jzXymAijOUaanJcWzZy4DJZjNwyg13TnaEjvg9GT1qMkMUmZPpjJf9Wi8fQTbrr2Gker68cv26dvTYWDCKUyeJO8ooWefjvJggkaxTgW4q+M4OGcef60C7nLYupvIGvomAC+Znzb5PhMRPlYlKX0I3QAERhBGxvFfuR9DSTlbG8ysk8XdlmDAZ2ew2EhKwk1lhJYsG1U84RtjSsEgLaSFXsdibzWXeoqLBzNcdgcJsSus8n0B9hQ3Gk+p0mPFITKypWiollYr6wy+5UTpRqzSK06yZm8XK5uGf66JkbgPHyli8NMxb/jbyFiW9mQTQdrcHLcvCckRb2UobtOGzFkavNu17EaaCxaF5xlomctCY+wuwIQGvy5zyfwdXrsjiFE/hMinsDrP4P2hLazF7BCZGLQV/nu6l1alqmaW1VpeaqNuzC7fSfMVNPKsabT7xxwJ6dXDyHHPJgdBJiuaoq4QIB55UVuhKWvdfKqlyFff/Z+fvnTKva7YYLLSbykzCsrgrh92nsvlqVmF9oN6XZXGnBKmFvlb5maoF4jtKwYwniWMx4OcEHnMGMKQXjxVxW0liszJtgM8N+KdQcfqpTJBSO8ETM56rqsbwUXJdLAlyIifNdIraqlPkSOgeISiIwe7AdVNXzsdBtyVi7VZaqmq6TANoSPBzEFxS8uSJg1JkmMiPjY4IZTDxCCJP59jmrHfBy2ew4xvtEkfXgm4gT2xG94cHw8GWLYKWnvJK/OvWYdbeRrzETnPd5lXK5GTa67Ws8efwP9oBJLZp7zZ2VOXiX0OTI7PDhj0pNS8Fevz5N1mBeyhUX8bSUD/ART+hLLLYgj/BanABKK7EWvOiHaaIlSLZvQA6+ECyeKdcFZNnA5FeV6SXve39gLH0UVaqKl2xSqlumRQ53OWp22BWXp+8Jqt+ZGjQ7uOEBXk8wcwvQiCp6gnjn4j/fsgXPr4V9Zp5nznrxQYwFqZDOUD5aCNOuNSjBVNrZ2gIBp+BkBS5ZzSvDHZUZu1BzQWvCxQTcm1boOdsir8UqvRUwVUyLidAtVKoVAo1fevQzufdejsYiurfOvQ9gZwEFBrSqaZjmZogUf8f6jJ22BsDuVZsati5BbfxqWQG9v9aVw8+72fA2Y4xoHbCGv5WyHZAwrPx89d2KJnmIYkLwdsI4MQLsFo831RBkNGLOKytzIIiFChbziolP3l7veSOKgEoTbTurEJqveSl/FSEgjWgly4V2HpyRtuY0HecTtlS1jmNMeEnRVcbCjgBtOlV62cOrwSgxViKQW5naxRV4DDvDcCmEsRAPsBQMm8iyjAqNLxZaLbTkVpTLL/CXeVFoYcy3U5ZtleKk3U1VkC0akOyfqGbmYzmtVW3KpZdm9w2BZOwWbDFqLhAuR3DBuHDk+fse42GfRRQcG8snZhDQtRlj/9lwNtqDjXXE3DxqfhtwCnI/yujByMtnFDJ48qJCbIWgYn3VPiTs/flRJhcjaLZR5tEaIUC2EFVBZr4TL/iQEaSL1GTb7Vkx2T/dBs5N9k++h2MPb7AaL60wnzHtk7n3cZ/2Zy1EfgA8H7SLiTNakyQSXnV2p+pov4WYF+zPYPYYbUE63MPPWmNOhcpyaZdXXan4NkNLu1w/O2/gIwhedtFRSC+Kym4Kp7dJsCIO1sHvrdJ2xk7mQsucr0GyrqxeXkmjrnJVbALNUz8EO794xzBEB8PTkzvR2tRsEkprJ/SUV7zocqpUeRpauQudqVBXCyUru27c16qaSot0Bfbrklv3RweD7f9iW6Wqto5Z/8VedjjcP9ob9NhWye3WMds/yA4GBy+HR+y/23sCkPy2OrGF+/ZHI3Q/7MfJT97iD+zpMYqBOAbht6nmVV1yLW0wBFlIy2nhs0rJBnoa9s0YYfISLrUPU+UCLh8Z35NSKU0bD7JQPiQZTNug5RihV7LFbGmQdI+Jqzws68afYOytskl2HhEfbPzYD+dug5wKFajNtlfnbqyMVVW/yDtzo8VUqmqTK+2DG+G+hdb/99O78NrQUiOc1q60f6/FWLQZJRefwUEu1o1y/j7aaEEh+r3i2fn7m33YW+fvbw6ft/eMOc8/M9hjCH5zcroel/bgFaLeiwes1fUEb19qXhnv+py/x0DkCPgiorcnl9GrZs9ENs0oRMRLwoaAupx7iB618hVxASSOJLOau5hiNWWl4gUb8xKxSm16bCK1uIUf4xx3hKmEDsUmKdELpe0DyF5juRirm8TgndwA/H8UfniH1bTZcZ8R16L6vf/6USbbbhuPzpw8xJK8ez7e0xzcJfxQOcYKLYqrdcbiWoF4zFrchqc4k9MZKuGaQQOP/Ng9R8higRzJxDOtHgcbk6D6xDmxz+89CThyMBGCQMlPRu+hLG8LQait9EEqU01BGGWKUCuh5y7Qu9Ail0aUSx8e4d6pdWlzDL+ox6XMmaknE/kpQnTvPEN14PHOjn/FvwHX6XnGLvUSsoqYBuIBnyR2NL9rjpfMyPkC4St+3cyr26sZagtdusJXPnl/G1l/58vdirJ01F++PmtS9Vu5yurrrWx7VfgSbrSkwqrFlRO/30AoxGQCnXYjmFULL3ckDuyZuHx99rzn60euK3VbhehXCy1GvO+FMKPj0YI3kk/wIPJZV35Wx41gkxQhJAjgt/6xBccJzV0y08zEw6THPW/JTW2EpmjKpkQmdbV8RFppH+fF4JgizubCBVLU5C6twSv2+uzkPbaDE0/xWQSVykp7j8AAmZhzWW6IONj1zA0Q7Ja2snYITOqyXOPH/kNGXEDwtmEgyTHceQ78hssSWfTOXnlSjoW27BUSs0JWXd64AOrvJoBu9M1LoBsm21hxTbfAJNRKuYFDuNCHGncWJbewQtYIqnt9k35wOhN+sC4SM25mGxo+lOKAWBSbz2DA50prAWegU23GSUFVjFeqWqblvt6QS0TloxFUpTLCR676CJFq9wc4OorlaLmqJj41y8vWmIhr5LxqMjQsVHGvE6qNFCt1RYlmy9HRRaIrK49F43fTaBczGNwYCEu7VFNZdYlOVBp3Kq3LCq1KYdq8+GaCe6I1dwXcmAbmRgqhIiRtVkq7VxDe/vPWtRzzil+5agoU1GsBT09W0ysA9CXQ9/As0JmXqi7aqe/w4O7M94/gPzLWZZohcaDAcFlNNI9V8Q0ZPoXlq6oIO7hp2T31vRP2pqm7lCYtAONoDNr1pcZYZhNh85kwLiyWQGfSGiqpbpCEWgiL13RLuiUqpn1hURsFgqvrimq1tZgrG8uQmKqtkYVI2LGKmceJMyomDgQRYEqouU8ppNduWnC/JIDsrBk8+LcyR0NNgyox7EuSnHmOiPDmtrfty4ZBfizITZrOYrKIHQCkupaskJOJ0Gl0Aj9YJNMQkfRJq74VFa8sE9WN1Kqat8veGtk6+fkiDi6LXkgrnTqs3n34IzsvXOjGlznUq1o0215dlIeHhy9evDg6Onr58uVadm5wF17D0KD+eCm5uYeXkYcEl30lLzHuGm4W0ixKTjmGDu8EvEWZ9wtxc7/eSrjqLVRZIs31q6o2xdqTZByGccAfn5Z2/h50S6KaOrq6Nn3Bje0PVyK7VNe4uUV2TiOw87OwmzhcSV90EJX94e7e/sHhi6OXAz7OCzEZrMd4g3IccU4rj7tYB5TCw24B7TfD6E3QrsvFPQglbLS72VwUsp63MKW+1t9EpdJYqbJat2hbS/R9/KbHTn7Ftt086aq6+bJPgzx0tdLrv5EOpNEoQ/1Q2vH2KvXr1dV8GQj6AvrRe6I3RHvqhUUWuAGzQHXa5slvTY/xX2stemyaL3oEkmG/KORUWl6qXPAqWyWc35oWWYj6qmpDRFGu7JHqNjVyVSGujJxW3NZatKxdVQh20frlbrP3ciaMWO0HbHl7zn4cywq9mcjtszioyR5sffmmkTZPOy7YWKlS8God237wP2G3z/kCdLmAToML2EfVfh32baMtffuhUm0st/UKqt9s+rdPikJSqWuXy07ShUbbEUoD0blga7OmP6X2bjx1BU5hDOd6ubBqqvliJnMmtEbNuosOr0K94aUs0kw9ojC6NjaMx14LfiNYXSXVnH4Zhk+bT9RkFX4Ei27HuspnIr9e13z26sOHdx+uPr69/PDx4vLV2dWHd+8uHzxHtev43lTVzYUHn9YmNKIv9ColbyT6u9TEslOlF6rVnvNZUhwbRdGmYq283bM8ti/Q4+69vnQq10wPTotoZb3+A3PKXQVw8/ld37mew7FzeEPJI4LehdNjEaQjNtRHqqpctltu0W2jVAl00dvo4uRIukJS3LAkh9tft5CdsH4lX9frHeBIW0pbA90IDdukYHyKfvzGp8MXUYdWtu1zrF1uvMX8z6ylhzAmsIWUvNDtPSN9ePd2sR1fDHsGtl7niEEZdY5vaPRaaE4lJCMWXggov0YJfTVJgUROtfYqFGUnQVEXPvCFERG0ocBEtUTIABGobPvBO5YsNqBYKG7ZEC+LtvEv5zir4DcKbbvBYmmhRwiC5pt21Ur5pXs7s3y6IcwaySK8+HQlS5WcUHL/8MlJJfecVbIy/rkblY79aI27weloiG6qp8KwJLMbGvmDh87mvOLOgIAGbwShY0QVKKjXiR5JWhFSTXK28vgeXZK8en/LipPRtPXBlSP6ypodiiYTvmtgJl0qn+tP8eqH+lP+HhsoUiY8rIuCIFJL1jfroohg0ewknroonroo/rm7KNKFaVXrJLHfq5UiVYVP/RRP/RRP/RRP/RRP/RRP/RR39lOke9jfRVNFgtDGOivkAqslJf0z7QSiMWesYgstbxCgOXvzp+frOgncUnDOxd9VM4Wr3k8iLkQpYli24Y1VOBkHnDgTqOzIvj2Fm2iP+AJb7LfrkbhTln/vRomUW0/dEk/dEk/dEk/dEk/dEk/dEk/dEk/dEk/dEk/dEk/dEv+zuyWKsmzl716//lze7oE1Y4insFKONddIahTLis+9z0iIwSMOR/XTqeAuqEQ/v8GB2P78zfRUcToMT7EtM+NwNtrjbHnvoCkFB5UmVAOOQy8ElagI9IxPhHaXp+BseGL6RJWlwi0JxwGbf2FnnoB+KatrGm/Jno2yoixHz+lIz+APq4r9LKtC3Zrm+wuP7juXnMaHRq377mMlP/WdcdqhvYNLC41lKcfrAM55/u7i4cnMdmFh9g9UubeC+VMh399/Id/qlP3PqetboeypzG9TZX4rjH6q+mtV/TV8QiNxNi8OHsCbx6ytN2cHzvrOvggfM+PDDSF08dPJ8HEY7R4cbg6n3YPDx2F1MNzdHFYHw90vw2pDGrrl1pNxk6yZy1nrEOY5X5gQ4U91Om7EgeVTSHPdXTbXSOKUe7tZsHwfQO6C2035rz/WiPsBYwzSoX0F+dPjX8iw/MWfpr+3+8ujCBIZ1/lM4t6mWosN0Xb6/iNLh2GW66mwMZSBieqQ+Olw/wuowBbFq+WGCDiPJ4j5YVqmA7DvhaakAr4bkJGl6MN/y76pObEQWYLYpqlNnj6S2Pc8ref4PHEAf7X2LotvTx0N80jKDrO97OXhYJANX+wPD76ARDlfbDIMduIUeCBKzpHMpB7y96+cgsnYScUIC9bvw/b3r7EEL4ZfKIcSGqwnspoKvdCyok4wSde7MT6xiF0IzzEqzg395bDM/EntEbZLLkdvybAZOh9Untdao5PEtyDfOoPSH8bub+OwmkdvC7hS31/bmtKVf5k3d6fe3t5myLOLpVMUO+NSTXfsTAtu+3A5oZt2dgfD/Z3BcAdXiiJd05/joHAt+p45fQwoq2k2s/Oyu5sM8sOjwV6+L17u7g7xjyLnBy8P9zgv9g6LYvIFAhJuLLvCZH3j3ML6lfA12uzi/cn528vs1f999QUk0sWGm6aLhvka+raiuv7l08mrEM1x/34X4zJ+C966nwGB/KJq3Ytz9vbic4G2H1tF8XDdzt5e4Jo9BLqcP8YrcyuSK1XxO51LQn6ZkHaW3oXQXFoTYC1RxIINWbGpsI4uAktAn42KymRO3Nz7o+d0ueEyOH8pdJdFCncpOCRDiNDGanQHpqnxN/6oOG7SEBzh4PuDboUWzdx580Eax4plF0v/6eh59vCoV5viBzeXtOcLVxe5oC5R7FlJXzijx1V2+7GYobtJtLC1ruIo8XLgcK5nfH7pDkaRFdYGedXEf/jdNAG+8h4XVrlR280B4yXuxgyyjluE3UUrHpbTxU6DpgGteUOOV69hcHQwcwt4BD6Nb6EeEHMJGUuuLvR3i7lZbjd44D2agoydWIbrlOb1vEcPI9xA1BzRi4AWpGWEUUbgjLt6o0OGNE1Cs8fmPNSFMUjfHAcSounMhju0uWELZYx0b0OGeYFzb5aMN2E/CoCT/3EHotyw3N871+oqWRG7LC/5xvpHIDYOPlZWnBBiHsIO4CAO+xBUDOav3+loxPO3a1FPzib61pi7whTAp8fjEL0KqK4uDsH9wUChqtV/iqYRExKmwMZrpcCSFCBdPZRtrxI/HGThf2u5sMHd2nOhSUZDRpPDA1ZQZwt/GU26Gs9dYMkF9tSEnb49efMKweexALPwfXkD6ytRTtvbho0w2Cio+LFI9DdD3w3dYYNMq1moqkii1AkQTN8oY+dRV+F4UCqPWYVJ9g8b/a0WJraujLB5CUTIutMCA++u6qkwNdaWD5iZu0oML0M6zh0mfePC/VDdjmDHgbWzEAKoPJ/FgZD5nTjFlCruQpqc60IUGfuT0IpMWyfLAT4tgoSB44ZrfojOah0erRfUDR4rcxmWl5o8Vsc42WzhPRO8EPpqUoarub893tsxE7vLSmHh0UBN+pGZGzlZTK8+LfyNgzRRXOMA0ZMeuzztsQ9nPfbhpMdOznrs9KzHzt51ZJb+7LMPZ80/2+0cstgQpZghkOarbtOKAG4Q0qaoPYriNFJQqKflliJuTfrCR7ad8eVvakoAuSbShWza47x2MF0L+3B3OBy26FaLNWX+35x4SqsqFG0U4Yot351OuZVrWRXYGRyF1MtIEFm8UD6tOXQ3YdvAO9Jj8aY8D8btOZ4z7nL6FOadPPr3j68+/GeLR1E1/mYmgyYj0W8XIEaKz1oHLQ2+ISzdxojhVlGjlxkswRgbXjl1sFJV30U0YBEi66V5jvp09mwscAfh3i6cHIcBG+4ePm/OE7IzZVpfNLo8+kFwoA0TJuc4c37MjWDDASodxNSN8cvZ2Rl1VeB/P/D8mpmSmxn5dX+rlRUpZAKVsUs+xiWKXGuJfnXvPODkI9w2I5Nu2IkQRQohV9WN0FTW/4vtsV+0/+qXCrsXlJpL0H3ZJhvn+XcvY38qXf97Kl2PchH5v0l5iIMw2YohEIXNbb8dKe0qCwI0c35haeWipMY8pwzjSL2GN6Ye76J0YdgSllRhpBi2suakjhIYWz1fwFQp3DwiS0zxQmip1lu/65n+1Djw1DjwmMaBRoB+Gy+BnKX7LYuTk5O2eRw81quvaQE86QTqypKdv4chh4MpKjYKHhN8r1FLZET8cRQCfiQ7cjKReV26OFJtRI+NRc5xwy8J8g1qIVF2NWFJ3CT0khlEoLB7EFo45xBZCdvgFzoSRIOonSE4ahVzYdqEOaMIfs6vcZ6wjUEt4CerQnwCVnPYKilobxf4j9zvghs4CVZFiM1FuHgVU7cEER0hoz/7nQBK+1nbDwjm8G/hDYSx1rf/vn3nqtta2G1wbWyniyPG8kMRUtEjRsMwdcKZSGW4b5j86/R7xL7KpQu9GryUZhFaVw+713JkxwKBjBWViVAmHrfVdMBDsWgQoNUTIv8tJFbGR6DJjY/AFtH/TDl+uRoQbphRKm4s5LL51fEcGc+CcYrXRJjE1fbavzsnEaL6ahKjKB1dGsO/QUpE3sr2vDr9XLbnjbC8n4asw4lpFJN++GGba9PoSXkOLimXWhTHDAVkXy+0SAGEnLrbxyJ/QQwqdDI2ErnJ6KURNmIe0SCYRItXPQjvu8pjaGJedq76Zuznmai8OLgJRK4uMdhkVchcGOSyfaiU0hhACPw0pZzObLnu+PWEGvd9Ui5eotfYOXHaTZFhvPgrUKVQh8lnYs7D1xEi6X4ioSM6w2yQDVLJQclvS3bigwcX5fMqyclRDbET36ULbkQ+fjQwPcQcuju8R8mgxUIgxYPCJHfYP9gcFIHGtOQcZ0/d+t0nBjPcK7igQJSTsMTg03ro2faDpbir+79JhdkroOGU/WpSwSN4byDum2Bwd2fXGgwo2vQZNJLGmTXEhohVC7CxPL++gnWxAvwfsoMR+6ajiDmKYgbIcRTCuijhZgGHsMc78ycF+Rvs7+n2Hie8l/opdNo1Qm9p8YL4lItFc3ZAoj3+ym94VvJqmr2ty/I9jtER+lV4PVUr8Tb9oFbig/vVCm2/6877xPoWn+wdHRClCh6ME0JcOEKwvHqIWugEzQ/YFEKe2u+6nW06bM7ww9Vc2JlfulFdNc7DaxWVlUsVyyova7rExGVzuI05NDwFoAgjnqkO7jdEELwAioeeJRThu1tqkGLkdC8Lj+e3Uazd+zjxoAqCGRLj2JN4ehSDOzKjAdJcSMrGwt7C8ufhiktOJg6qBQisH0xW0krkzQArLxV6DtlJmInPsxumF208zOf+q9rfMV4iPWVqLXATiaHOgHWcTV5zbSSWX4sowymbU/FoeDwXc7SVYyPDaAFc0XDajV2400kIqhVzF+CvtcjYhcDsCjZyk5dh7xt5sl0WP+alQi0GhLpJ8RPEaBM6ySZMMS4aGFb29YfsbHDo7jHPHq9etqFfPPToM4SkRPuYTAp80A5IaY90FVNZhf8K2VvcHwQRaKzSGa8CX9EhMlXOFWBsZXJRfzJyDOnzohj12IjWTd+tG+Eeofis7y3/YuRzSiGzEiFig3AmfxBbogyBTidh6y6wQW9lf8GNga7u+6Kk1mQE1DczHb7Lyy2kCZvAP4N5eerHDGcZ+jIv73Q7w5Uj9p/mh7z/QkEumhoACsizmRQaxYzLZIZX56axCB1wtjWWUzauoZ3MFtZgAlEK0w60RagTWVqhSdutDHFMMztiS9osouXuL9aiwBe9FmFCZG+kXVJOza0Z8M3prHKZXspFI2KNjEK9qKSrgHgjKxwB14DWqtRH+MGzo3HdeRwcjZ9Q7XA38/ZE0b5DJEWgbgeawEuRVeOChG/FGpOf13aGjGlyPN7dZu83sz62z8nkzJP0Z6ylc0T10IQEcXXOV+pvJYfQhlouxLXCplFAYpOrmMjmRP9SciJtDxkbrosynX01CTlVBjumRlpLaRyKUkBevIuF9W2YukEUCuWaKGUmdkZjL9EVkG8q2fR2Djs/607D/uH+UZv5XgO1+d/RBUUTn2jzl1aDBxJ2Ugp4cyt23P54OxOJbnW74kTqpGtMCxwUhoQx41M3J0rjbxdYWciFKN0VPXfIdCFhQ+R0HNa/YUhj+Xzhtzpu00fNiX2Ea4QZd3PxCQY1bIh4vFYs01ndUs5RgI2mRGlrJ2GmR7WIyDbFYWmhjcUaLxwrUcQ/457OVivSc17mtTvfAZgWArdwBcMoDUBR5QIVYjqEG1XWMlvctLhPHdNxeJmxsS29YNKSlljBZK4qaaOVxBIQKH9SzYzhz3DlllXsWogFqxc+s+A+ShdXm6vwtMHJVT5ia/UrLudlL51ZiqURnl3J394dDA/7g4P+7t7l4Oh4cHC8t58dHbz4U7s8ETFqI+xn1sNXN3rRMCnRkzhfYSpdtsUlyJ0pameorE0usYILoYiL4cQ+nrf2mVJNez4OAYfjeS8dPO4iiA45G2dJ24uiewiDqpuLBiIWRYq2xSwjqzGfuzC1O3AAmZoQ7IKSdHZPa2xwu6mem6uiLhvRx4/wEbExoZhg6U7q9nc/Vh0w3bnmC1SIZQkv4vTWrSakLzjzbuVLWS1qexV+rHilqEKOfle1TV/g5o0sS7n2HZ8qd/p0uFZwzmjo6BrfUNlzMmxbktzEZZ7rWPP+bwG3SQvKS9omD9isHbteFwVFg58dFO8K4ETF5o6JwGNRFW32rt3O79pSGlQ7u8nqRuLlTenmeTCrCDBze41LI6qxcxeLrIVq0gX0rU2Pn9C082wh9Awtm6WaGosnSWPRc8wnjpD3OxmOnhTM1eYkWadCzFVlrAb5WO8IdkxxSm62KvTNtX/r/nXyw+nZbxboOz/Dog+uVjNjHZyP+P7kYDAo2phVU9E9OeDhNsll3BOcvEStigKim1CZiRuCKqt5SYWmONN/zWkVYS2QcTFqNpzUFl+Ry2AulMvY6JWRpowDuJMFV6G3rKl0AJTG2rQ5HwT4/Tq5MINFA4oZfpuyPb5wXrkT6V17Z+WdflRSGVPjOl9XgsERTJDVlCoNAr2xsCqfaVWpUk1bJ9swVip1HSoFpDlu8Yr971XimidhukcP2rMPsuFgSHv2PcHSIEsIf3xGjn5fPzfUdT3K0QV1I0oyAlA/QFmNTbq+lWA2pD+nqITd3mtdX5Sj6hjHS3Jz4Sz5mCONkrbeg6a64eC1uNkis31aSzNjvBTaBkPGrQWKOVGkqaG8iZO0oa3YqJ5GNlO3ZI+DVS6MSoN4YY5gx4LNeFWUiBdezsTSHQdyiyRoZeOGCJsGR1q4YGXz0JsZWFBWq7KhWloHxa10d3eTK8oyFsJwOxMoYoi2DN2nDd2Ek0PgNNYlR9LJl+BHoEqjGr67VBwHW6Lfsqk2Zsj6UZLmE/gLnpZVS5ES5eQ+4A3SVfUCXaeGDqepEMjHTHnQ3qMo66nzK7uRFJpPpPrcSqiC9ezt4RNnCsL4Nc97Yd14yLHRg0Q+gowFtEHE/PtrmO6At7gedP8m+P4BSh3JhxA8gDhXVuq4+j6S+N9jNbS3uOhEw2J3+SFElRBgVvlVU+6PxQrLpHBtLf5wP1grvp9YFI3Qw/qnkp4xig+tluIm+NKjKz83a1T9hViw4Us2ODrePTweDnyk+/TVj8eD//8Pw939/3Uh8hpmj/+L+Y5md5OT0P7ZMKNXhwP6R0TqFjlwU7t1imbNJTNW4bTn8IH/f6Pz74cD5KazISuM/X43G2a72a5Z2O+Hu3u7n0ujqdrCV9rE1H+z/QYe1WO3G6JvFEr2CoGr/k1Lmbk307grD4xnSDREkBMuS+Q3YoxlIXSoyI5birt/AWF3S/3NomgGSfB7i9v73WvOEov9vq73nrIDSey/aEUtHcbG94JFiO6hU9/hsKJkF2i2sxXG9Ny17bqgoK+7kCKCTAhMUD/B7lBF/GlGXPjDqbRczReqDi4cexZpcyOHhjSnvxqdGGkjK41ofN5Ld0xSfq0DrKJD7kh00CPQMcwUsj+9rkZsEjHgZIIfNK0xC4v/0cSmXb8/1tptjg1boG6amhgfTXPNuzB4jVE5Jf38PNwRx09ob52pA+ANCyYrmVvTa0a1szDjbveEkTFq4EO+q2V4G3B8NAVRG48YK5Qw2L9dpWGcHSMqs2Z3Iba2VAw1hOu2jvlmTuv2RaxiW7fOfFzZrSq/Y4eC24uloWBUNwyNxHQTdkVUu4miUKV4HDT4amGbCSGJZjfG2xOLwyrua9SixeIsgIulmcNgQ31y8dxlmTES0iV0OxkBXj18MkJ85o8b6jXn2fSJxH7YlvonNbypavq8O4/+69Y0asGNqjY1iR8cdHY7WyZ5jpjs7yopmoD7U6WARpcQaouKX65D4lXpKOCkH6KTTRIU4P7sSqdoDfmvR22dQiCj/qAcDX3i+TZqUIsYA7146p6qktR7Sxkwzm7FGLvJp1DiXq3gk4DE6i1EJWnbQURTmMSqD1pjFb2oRlvzzNyMeLN0NC5x0WCBzIEYrRGaS9dI47Qar1hdidCD2bZ/P+sAa9GO5W1A2GgA9vHDa/RjXZNgJecGdB3SRi5XpS5AccY+AjPcyjwtYKDVmkBgJ4n72IuGTyDEHS9BMwQ38Nj5SqOeM2w5nR4Lz9FvuzE96HjanZlw0g7dfEjP0W3hL+bc+cNg4AJvD54iaa6vTGIn3mU5TkrF7bpJ+CDNNXMQoN3c2SYwnNSkowwN6StmVFnjY5P05KE00rlonrRt0yS+vD2A1dsOoDa4XyGu1CZgrZDdScT2WwQtSvmrKJj+PEE9ZOM5MznHyXfh0CHGBpCb4WCwKleo5uCSTgmmM85Rg415b6dXaFfw2sQ1CZsEoXiwMqJtBVxadkvBOSNQI1U1ZHiuUaUu7Bc61TjbbjHRQK88bIl+0WU82xcEONy/mfKvxR94cu1XUStAsx7SUC4R01QD0K6BjKfy9kwrmPSJ55YpXVDlRAy8JNnxNDcecIsxQ2pFaq5Pa7iFO8ftss2th6Rw7uHU5SyWesUBWuxqb5r3ZS9/jicYRIchQiTPAeHcYN40bkVIsoRiAx5DlyxqJ5NRxq1ehM07KQaKM2Fgg9Ooklz4XFUGraOJ7U2SGSzWsOcaPl9rE4hg50V6xgICieo1NirVNDPu9yz8nqFKYpSF7TE8brbYNLQdfUYno+HdrrGSsp20Wrh2q1ma52cXz7PQ39j6IprgJNYo3Gbo0wsj+tYM7PFNz0WEm6sF7AxxD7lJzU74YU2840VbppFLawv0I5JmPt/32bQZFaGlibNOXVJTpHFH5gzr9NfmKuJvblZcfsZRbZGEBdEoDswwwUSNdVIKSzi3A+Qlqk+CXUabdRD0CDTdJv0CDMLhT/67lSZdKyc5gpiImDWDhoY3d3oGx/JXlXP/zs9o8K1XNYqwdk7maNgt+Hwr6cHn47EWN97PDa9fXG65g8l4xX766Xg+b5SJ5GV4qz84OB4MtoKNeXfNd0eF/r6RKjuT+pEFgKCtVfzHWd4eHt3HfV8JuIWd3yLZJiqqqkv2DtYY8wQ8IEAXfFNyo8dEhfk2Sbkg6dUC2gXGbATpiXLNsAuNKcVmHgI7oc+QLs67I1670UI+ii0tF8KsSE2ty02t+FX3AdVudLhhsMgU3WCMXpLqBo2700BdO8rzAM+icus2GHu+p0dW/UIs7KwD3UlfqAOOUCm5W6XdF9S9WDnnky1Knos7/ZM7/JII/+v8k/lyjYfihtg52H0xLEQx7k8OxoP+/u7wqH/0YjLo7/N8/+jFgO8dTcT93kuQB1Qxpx0WP4a/72mwOMESEavV+O44mU520jU64CAWUa2UKlLDAA4tcJWboUQesInwMP9AKh5PR2ZXEjV0C9zlG8IMhR6E8Devih2lG2Jj1smp2B6djxJD1OOlH/I8ZF3Ymybn9ecfz9/8hd6F5RFCedhk0cD3PPMfU/MJBfyaLs3Ya8LdpXlIrciyQw8BbTb9GNX8oqp9JExE8YAVf2cxxmtONQrxRFJnWgTQa4P4IdrbTKXxxYMozLzGcqSU65riI26tluO6c/39Bo7OAnrJeAkpJ/Ghw4rU8w3XSyz5eHMZ+0loAVsCTmPVF59mvDYuUu5OVFAT2lsiXMcdaIUYDQrdHLQ8sR/KG9FDUAObn8Ehd/GyQuxR7q6VNGEnPom8tqLHZrIoRAWfjBf+v2iO7pGG7LFbLe2aKPX2n7fCu1s9tuXf/uxVTHdeZvF0+c7T5TtPl+88Xb7zdPnOP/jlO21r7VG2g7ODHBzY+G6zf6i5YCC5btbb37eNhTwpnvxW1k1jEJDNxV1ZlO/DW2/v+N/iucqgI0ygtxzqBTBgozmGGpHLh7gfYnsjR0WStqJWE99FBOua7r1HVA+v9uBp5hFc8CYD3mGVAo0VfrW6Tr/1Fucv3KcyCJPkJVsIrQqlKXgbxWDsbArLAL85BiK6M6XCPlakR//G0BNicSg/JpiMjl2lsEMSCugYJTszNRc7vAycj5QC3JUH87XErqN0+wwDhONh76G2HZhwilmLUtzwJNLc3A65tpaTuIUCzsVCaOTh/AbQCt9hNasyJgQSHp0+VCs51nSPivlm4uFUVhykM5eLatpCZyqLDSHyXss5/A3nh7sQ4x/Pz57fu5S2h4PBsL3gG/9w0ximNtJa7LoL4De93u13usPtd7yo7Xe8jS0MLavNtQafA3YTIw6GKmQvhJsbg6K7VnYPDveO9tqrZS7n4mqDZ6m8OX/zyn0ed5fQe+ywdU5huoZggBirBZ/j6XjZBEWQUATFIVaI824lr3im9HTH57xRqGV25qKQvI8xW//OPuFqoD+fn7w9iRAVDgJE3sG98ZcebRnh/L3Mn1+1ppMR9sfC2f1jOt8ywvTNtbHzICE99Hk+VPHPNydJb1TRUl0QH5XDbI/SRbH8VSEaHO4PVkToKy3SNQZptCRxvKsqnOvQXmYbPLA6bRUg3mAzD6st7pRNtwkex4hVh2X0j2x1I1W3jUP9rWlwe7obYNtFUDSGfMD+9G1vUPzdDppytzWCltQ/6a1MJJ7RYbUd4zeOGI3gRxm/O3fN/dPFjk8XOz5d7Ph0sePTxY5PFzs+Xey40YsdGwYY+etDpjSppGmRt+2MKgDBsnauSbIC3qUxJG8kjCBI7ghmd5buFv5cc8D78HDvaL91wLvfpq/+hxhjl44aBmqc5WGWc9TMmOwztV1fQ2wLATdvgM+eYQpcdrnHGkyeZ6tTEqsmAnb1xoJeqOKFge7iXR9dvEs3Je/J8bPPLlaCYagAFbqD+5qQ2KeDwcuMo8Sr4kgxOeVmNkTQa6oDMJRJTMalyoJnFydvn2fez8I4uJHFlxkkmR0CzdwpbAoVqS64muZ+8K1r3vXlRs0BWSvH5OMg9ZRixp4B1K0sC9zphiZr/C3mXJbNd13G/ksmcC6LzLNcbX/3mUXQ4r00phYae+BcVZvcWgLzqQALI7Fnp2+d3AAJ+D4pCyNzO9TSyZAuxsZ+ktMZOzGm1hyVYxfuFFN2evI4JtSV1cuNM8CNwp6dPneGkFml7+PFY5BPDoAQxSYn8iwdyCHCnp09Zh5Pv/940WPvvg/zeV7lPfbu4/cr10X12Onb7++ZcwLLvm7ukX8ppd305Idhgr55/XyVK29U7SrO2X9IcfsYSpSe8ooKVTdMTTqUYc/efcViPq/yryWWl1d1Je1vSDMvGUYE6R8fQfu6e9G+kH6UkIgrpa+cl/qwrqevod6NBwMljBc3zsseu3Cmy/uOSJ/yUk6UriT/IhIrZa+cG/kAmu6K4F52TpROp0YanNEEq9o5pZVBM7i/mVMW2SoZu4PdQX/woj88ZIO94+HB8d7Lfx0MjgeDL6bKX+O6SbL8aVYPIGn4sj84ciQNj/cHx7sHjyDJ9f7kV9diecXLKZT9bL4hOTwJ8GMIIrSspzdq4RbvVVI/XJw8lqi81jdiQwTByHbwPUHhsO2yBMU5/dSQxSKDfd0KgXS9X/GnmOPpMKGSxi4OdoeP5YT4tFBVc97EY3zVVwQiTiB6Gm860xeLLB9A1eHBwd4Letg5TuYRVH6lN44pBYjgESWzZxY8R2MEG0vbNeN3B/tHX4SzEVry8sr3oz4A4684hNAP1fSzmrqR1vW7nTspILZJ5sumSFpSe0e8+5aXixmnhtFe+3Zr3+cVCvGR0nJFKyiyKprylgi6uVW1w92Dgx9/+OHl6YuzVz/8OHh5NHh5Ntw9PT05+TKOh9LBjWu68/b1LimPm/rFiETGfhbNubE+H01QGW3RE3fwjazYHxV7zaspO3W1yqyUY8310t91EOKjU2ln9Rhu4c5U4VTtnalCkHS8M1XDbLi/Y3S+46rf1Q4Y4/6TTdUfXu/tvei/3jvY6/Af7trBYf9L9TA567+Ph2qiixrQWKXKzDgOpJyWaszLaM1Vwj6SyN/DA12l6ePFo5D/e/BAV9UR4UaHX3Vmz7ugF5ffNyZqj73+/oJX7EcEFKTJVeKi9th5lWfOIX3IvP8/9r61KY4caff7/gqFvzCebQqam8ER74ceYMbEmsvQeGbeWZ8AdZW60bq6VFNVDe49cf77iSd1KdUFaDCFsYfYiB3T0FIqlUplpjKfXJx1z8b7rKz8QVLg+0cdL6fV/bR01FdW2cIvXtkz8DVrC73fWr5nv9G84nZrFv1WPhVDSIyd0pC69dspt3RPhPLrPn8R6q6yz1+EskWNIcFfZNkc7iI3RUzcmct06kG03wbF1U5Ua3vJ6J4I5b7iV0eZt19trxsMykKEl2QgluhloOzgxFp7aD2hnxGW8xny0kR0j5rJUBbzruqJdq0ibGzaIbBnBY+rpFCttEg6q2/yk7vcZA3ajlRWXLIBmcrVXH1zq5/LXLX0vX0clhnD4WB43N7udnfQSlJXO2jIad3EXZ7wWjWDleo7SJkIdZ4qP0vFm/O9SiayQAI9HJCYF/RDY/al/8texSp59ZYtv1kPtvob2+urPfYq5sWrt2xjM9hc3dzpb7P/V30Na/Lp0fTV0gc0srIl4t6vIHLc6YierW8hscHvJhlPAE9XWidUGjWHyhFa2XhvzbvWb6vhDMrMADMTsg5QjvBUGCsAMJOa7jmvsIlGp8mLWXo5zwnjQ1tzPRY6W8Yj4UgVHkIiRRmAAz0r1JS0n6femi/eI5UXKlmOwsq+oOu/Sro8Wac0w20Ha/nX3TaaOjpahp7Wk/XrTIxE6N9eTofb+8t9cPMNhigJ/dZeYxCnFvgi+htbhpgJl8hgqDJZlOYaW/ReqfT5f/Sj5mtyl3xLZBJVFKtjUwGxZ6rsYslq2IoJe783OEFIfQAcIeFVS2n6/X4tdmUy6jYO1NJ1Vi8KNYGXFu5txVXlP5V+83lOBAWegLosNiOf7+zPtxhYkE98z4pnKZElxhj93sVgXB9LmdXT0Aifx5i8SDQxIQZ8X9heQ4d7mz28b/Zfk5ynmTDaOmCDKLJkjB3EhUZcMUOM5oRFjVovm0RcJY4mJwIpNmTQ86ErWC5SnvFCZVbj8tzPImY/5AnQVxBn6zEiNb/k6+eb/TWLgrTIkXvq0qKnryr6OgVFT1lLZOcEvEvlPNmfbzlPAw1CX8epMcDRCLqlM0R1mEzQ1MEDywOgOr4b/GgPQRkeLuuvER5uwXXBFwFl417KzKB1FF8A0gCxr3xRa289y95hQAir6zJrRrzkWYTs0B67klkx4zGb8vBSJpTnA3jazCYBicxAdf1rNgKaMSGbIBPkHsfp5hz9R7n/j2vozZVk/YZF8Hl763xro0LfE96wNBN+KvfOipq9Zm+6Y8tCWm17hr75ikGAJ3PD7etGVBk7EsVPB8dDSwtOiYZNfy+T2eeWsc0fqrE/kxuR7n2TkNrSk3b3+OjseHh8e2ih3IqJUMEzcqSJnOfuTGsin51D7ZP1TJxqkGT9qTvI+XqONYhs8uvFuX4OzjX25jk62B5dX9PJLgnCfdQRJUvvzNhWZ2Iub9sPCgPEXWYy56YN2qVgF5ayC5hxUzgZmShmWZJbrxB/YM2hYKmyKhl1sR7jrdK80seJGeSOj7ZRE4p65jleV/6aiR6E2rhvZdABcQmZTAjo3HT5FcmVzFQyreICmVcml9cD9Fg2s+2lLkaCFwFxqs6F9A4uyLRtndg2JtN6argddcrDO4Z9CHPfmc28adauZPToVvnE64QRTS2ZnlR60vghkZ+NTWsVJbWw+muGxybpLgPm23LkHnBiqn1WKbNf8LaBZCxAhMOpZpEIJdqZa3OURMkNWijIV23zVR6M+VTG8yrXHu16Oh4yPT77wT7SZCIimN5IjCRPemycCTHKUWinzeFm4Yn+ywbdszj+DgqBGu4Otrpele2qQ+HIRaZAu86mQx6y4yE7VP/hV6LOLa93TQe7XF+Dns2RjagONcjVwP2NDd4INoLV5X5/bZl8chnWqW+e6+9pr33EBMOymzb3jzpnbLTz8bhzO8V2PnOeYfepvMdmo1lSzG47wzy7lkmderPapyLeTHenPPZXg/5G0L+jFO9xrpYz08y1dq3Ag9+N1Syy1aGZjROUzbSMVUOz64a9F8VaAHSX2fSCmiZcTcvUx2YkwNxZAC8bV0ITtljaf4Iv7RA3Yps9Ur1eZumCMCg3JbQOdZ/10pJzINKztLlt62ub1elxPz5hOMiFaezl3Ol7CyYIqD6vo8XBtawWAFatLSIAbThbAgLfpH7GgpdyMsuMapZjxq+4jNERoCFvg3iEdlX7CBuLmnIj3lBMOvh+X/y8RT7rxz+PzseV1Aqlre+ANSI8UIvHnt46nphHA1IAhlZlOvWqosuh7blRUAnjiUrmU/S5MsMysLhE3Wfsg2u0dYEvBTK6gKToH6z3TXcJXPSx3qs6wDXqlKnfsBvWQn+1CFUnrnlTlMxu0ZRNIpqy8lAyvppGG16qzEJOEFS4TJqL9lQaJ5XWZEWmYpFXefFogutw+EERo5lstBLWg6XXy7PwCF7696tPcsQTfs6jqUyAXJ8J4MfIZHKOAe9EsLfrRP1B5WXx7OzkjpfFn+37vEtufHd2duJ6LwU4OCRgF7Mstm1m0B4DPeYKT5Ygg1lsV2raUy6eU2K/MFLRPPDhARc0uWxfNf+rlYUOfXyPGpmMZq3vy/b2m5tJNEh2CxD53M/XmYni6I2/lSPvRBwrdq2yOGrnTAf7dqaAXpjftns/gFjSzpeCIzGj6bv1N9bftJI8FcWlihag+SFqYanCUj2VdyedoDkm8onxRt8PtoJVA54Zx+oacZPJTEZ4zKc2BMaJit6WA7yivSs7P7EpgPFHwu/7WCiX20L1YOyvmcjmAF56VQ40IJ46MnRoz81OLx9ogUgqYiRCPjNKwfXktE3CK4CZtF6Lp287XdJlPeXUsh0v4ogUMHZcGcjChiMY7gG3oz6R+m6toS9+44Hkl/2zHjs5HuL/P+D/1PCsdc+pD23WWXL7qRneWHkyr5yshmjaorERuviZT1GvtVKjPU8VWnM/tYLU0y6qIX0iv18VeRtPbtaRljdPrSQNtQ/TkoZoVKDM8pbnxUVJv7nfaZWnpqtH6yvjxmo1S6bb0BvRZaZocopCa5YQiZfjMQ+Fb3wdVD682QKDcLkBjG1EOYy2xBuFqRleiieIX9A9J/Q/q/OyirNCSGXajoTSxs7b9plZvVUpy9SMMFBjxSM24jHUfmYrigwCNR3nz65xihvrkidRjNuKu15loUoSp9oPzNf1DWHG5LbDoBumZIEmzo6ViyQH9CEAF/OUJwwres0IFsqnIzD8aWFFS8Lb4oYpjyXPOxIxJyJA7EVEN6/sWBkU6LWkDdjdMwOzsvkuCQBKljOdcCoJx6KHxvjmHxmLpv8lXwo5CyXrEz5tCyabL/7jDq3pjkPUOb8O9urMqoh3ya3h0eFJ45ygJ2+L9lsYt6PDCEy5RExys0Q0qBfF5R30W+pjNfH11Hs1uUNDLe01EkNdq1vbumsq0DlG5lPjpFM/ryLjSQ7qndELZQc7xyWjQtGVu3VnQmpjOjOu1ZXUYUfYJolufs/7rgYNdddkN9FIeFcXJXlXmiv/eFFZiP2WqxVs68ZdWyFSJrAIEfnj/+g6bQL2LuPm6cJ25PyRYlWIe9AvYAJr9gVLC+sxQB8Gj4s8WRWSBjY3mhZj1mo30qmgVOHa87AZkd0K3r0QaLcbqdJw+5rnydJSQa0EaX7K3JoY9NJIUW9mK323tjRdueLZCvBux7OEIE7zwB6oBTSHD9v7hQG5KvedAwWuuww5uw0m8FTnjZFQ84f0R/atLmfcHyojAxtp2baPeK/aEE3ibU9Qp2XK+5koUbZiJ3r0cx69tpl5IyX0rugDNMe3y8c+2/k8nRX+qXJnGsnFlhhGHYG04VDrC//aW/aQug7rs69Lpi6ueZZc9NiFyDL8R9L/lbYDj1v6pVNjwuq24kRnHezrWTVX0UxkbnSYdBxVwSZl0KH+zNC1OZ5XDpY/Shjz3GbYyEQi0I3YuTcD2QjmnZazcJYXatqesqGyiYXP1MDPwUipIi8yngY/2X9VmKWDBgRNHsRyobbtuMBLBjc4hFFMsqZdoms3wWViU1CM2MG3MIs38Qs/xFA7MrXVbqzduJQOjYKluhg81upaGsCVus9m+LmyQtsQ0A4CKnTuFr3RhIX+XjlZ+1cwLt2q7kpqOWNOdIL/8CveyvRZEjbTph+N5w2Wm+lMJz1EtupcrnO3tiRpIS+qC+Gd3AdWFVSidNiBqcgpoRJupJGg3GXy+X9hhmVM4+2xPI1lQakosmCztNKkPeVZ4Sc6HyQknRl1gNE1phdmWPuGoJnn5x3yBJ4CAVBFNGK1lbrwiet5d3R1GXaxvcaCApPo6MYklHwewyaY6470uidNaBwo0q0i0nkpIglVhNUjL1xco3u7gHE+VVf++VIsRAtTMKhG8o3t83HGCHgNVV1JxCIVnpsUWlxRkczxdB+xXAHcK+R0ZY4EBXL9lMuRsZHpuzbJJkOjbeEQJy7OtZpoOXFDkbL+Dlvdfru29ba/qhPbkdLDDufOZ2iBiLPSrG3kqiy3nkbd/bxNanHmzPXtmlWa40fq2GTiW1MFUXEyB6ay8JXcleRmmICdxAKBh1wIdvrzbs42N9Y2cITX+1sb1cdtY+OPeShjvHx1Eeta8lZoENuYndAqGqdA6qkbZkDGBiECQpDFQnmrwonGsszZqHcz5Im9RtlIFNdCJMw6jIzkbm29KRRr67fyqMM7z+MUTM/lEYeLtzCzausgYX7TtpYUjzBlBcnjbXVtm+08lvIv3mJRDilzts1+LJnzT2f9BlWd4wAI8f1M63XXv71QpSo2SsQJCs3c3+k3JaS/vtnGVkfA/Y/RnSfGjn2nENT9nYpfTsCS1ILEUxi++1NWqtcnduNqLtWjqQd7w9c939OBq9Ig3pzMicKlYRx9+8uL4FbS4TiRx2odJxAL+LiwcOMTAXQLKGIlj12GPGOhSsk7sKu2X2olpbHlrTrB/n3ndrAh+asJg5uwWpuwkBBAk90kAZ6j/BU336Oise/7xu+1O29C9H4w8cj76JaAIg61DfBXa9qx3FBNp7PEeLU6pKQQ1tUmIy8L6Akgy47j16SXtqg304Mq4O3oNiHGDMvzXIWy/CJs16syeXihh4XSc+/quAxoo9hEXgnq6F6NF5jYTpqpQoUqNs2TrdOfjWSR8aysqwGyRS4nAEAyD9vJJNe28ZRasIkMTbPzHhmiPM4VTTbHxP4f55/mqRfmkeFfPdxcYqTUpx4rrmHLZYaYa7tP9tEjl8XMWOdlF+grkUQq8yvKDS3WCI4EbqHIgayRKVz6zCsRMl4OTnTjlbxHT0x5j3ljXsvMoul6muSL0i8IMRZERCqcuWcbN3auH9DYqwP7rIOban932NJpiMtpRbRanpgbXuV9npeXdBIODWt6jSHDgZ6hRgrnhjJtpfIOHunZC81g/eZ9QUbEBZgNfxnPqvbzTLBPibpOeuzCHlbzKx1XlOVO5LNpkwHrW9sVBhgNUszPZdR9YzijmLE6b3Hs4ERj2hhp4jm7FnFslJwZkrnj50ScV/WfOQmUhV8oFS/zSaIQbWMA+oh4RoabSQgtz+o4Vtd3N3LzgIohILGcXBYrjnnLMlrGJdPkd//t5fE/86ONd/88/GXz8H9Xti8Psj9O/go3/vz1v6v/U9kKJxrVfXiUKMerPTu4vf2tui4yjhbAwcfk1MI6C3NKKfD79mPCPpohGfvIfmQyGQHi+WPC2I94KfV+kqb/mf6d+Oz/NEtIcD8mHxP0FPbHnPI0xZmli8koHX15GWdmqhJZKPiK9nG91/YG4Y/pNBeGWcoZAQJg8VdSXAeahhsmtqwBjKjI5FQUItOEVIhejKaSkAoFoIRMHjOZP7KbNHhVFyfD+4rcjFV2zbNIROcyvUN0ZNomHFRoWzaM0gkRXqDU+5WJk6WZ+txM6OnvrAX9oB9UI7/oUN0tRioaWrMTqx2OaCr2w51dsq0+WdbENT/Q/bLd8zdjQ6NH6L6KDeSs/VZu9A+P5SQxGowsniNR/Byra9JwOf3L5BC7cWM1sY8MNl+wbU0Nhm9VGZ0s1k355qiSsUkDGsmagIis8SgyKjfSbTChSY3kBlcxT8wfm0EZfm0hIHREkoacQtB+ez840iL217JMlv/SHxRcP1fLnBn8s4ANkN3psckQZN84GSYOpA4F0r/NayimYh5VtYflWe6NSYSgoNq8wkMXUlfrMmS7vboW9P9iIgl5muN44/bGCktNoVNv3KDaw/lTiE899rvMBEC8PwWvF336JF4FZnULbOhDzgwxvZkbUskTqotbf/UBK+jQyT02HpuWoJuyQG5czj1zdTpcyFHpe4zmTFElq0INqLLGrU1SlO7CaCznF2Tsst/lWFbITnn4SRT3sHHb7FkzyIMsWvPdFpu2/E2LVWt/6Ya09m27Xbu2UV210Zx3LPshm7X0/o1VlKVJCuEImPgcMFw7PRbTDfIfHn7qle/w7s+foWPkCmIsBx3VXbBwaM6q3WzPRtBOMVVZcgsiiWP8Lz2Pj/rBrC1bcjjmczQKnUVpjxVh2mMyvdpaluE07TFRhMHr58f5IkyfpPbcZJceDw/YoYpEzIpK3ACLsWL9HlwMwLsNzUEvCJHmIuyxVE6Joc+PnSC6ws9v+R79Hm5QuxY7ih8EPfY/uyUKOvBSVqtRUNN/AC2jtPD2XOtghKVaYoeRQPDNawuG2pqeHZ++ZHIj7xxxuWrIGy8S99wUj8lheSOeVfAYXJ6QxfLUZCKXnGZgZqmUZuJAEKjadjLLyn1XLJslizOAoZk4pgtsl5I6tqgNyuc9di1GuK8+S+CKyqTIZoTu4kqRVtKM1osPHc6RIcHzjc3A2kA2w/okeTPSI3as8py1DQ2uDk4ODWsMOAEY68mnF7bmui7qhqi1GldSxvF6nLjGYcR1vc7cyUVuM2W1bOSML8BvWoUZVSfDZDIM2KFOc8A9DvQvrGz/7D2CWbplXe4iXGmmQpHnXojCDWMtOvg2iar0YLT8yE0V2D1C7cKvDHiYE2nPdGBKLy4VvEi/yoAi4V4qPdlK4C8RUd410H564/+LbEl/iEIxnZuHZx0zkY1kMTbUFRM8m1ZiR25gG93mt9dO2NcPXUIBz/yGEgqTwFUIIHT/V7iY2u2Ksc71wLEkeCmluHcpRYOHMuqcgV+3tqKx4g4NhXLNj15s0VjQt2yw+Uv4xu22xqKghDtaj3U8MAWcOvuq4CJyt6yOFcoMySo6+FJU3ph4JjiGNpeFHdl0GjgwDxc9tm8eLcpraO/wzx57d9pj78UEfwE/ss7RE6TIhOd6GFG8QGa/QGa/QGa/QGa/QGa/QGZ/IWR2HTG7eqlbAqr+yG2HaAHHzQz2BJ6bnenbdd1kUrfCX3y3e/tuMvnbOW/NJTf1x7flvcnk23ffKmv4bvw3mTy5AyeTUE39lIqHOXA2X9D4bmYhzGlpq64azhs5bW7UO5y3vcM/F2blwzKsygyqEm6ourvdtlI4HOzeTEBl/g6Ffmm3LI5uMsF8g3lJnPSHFI032cl+erb7ZiUZ+1LEKdBLPYhrN7Aclzk99i50zMgx15TeRR2iEPKdkkpzdI/MgzFLlF/vDZoTISJ0eSvcY6ihKxbjgolpWsybtncfzdE/z4e/vDRreGnW8NKs4aVZw0uzhns1a0gzFc3CoiNS8cBsZrjh5qqRmK+trlboy0UmedxtCrT13c1kxjMPniSxCBwq5mmDM8Qmin5R7gOZg0iGr9z1ItMXp/IaEbvU6nIk9EEN2lBpbPJ75nChGLuwtztB1EQ5/Sel/9BNS/9QcSwIyEbHD/CvMr2gpejfjllhaaWe6jGZ+hsNvJjADedTnhS1iFTr+X0U0pyomSn8hr2+rVTJ86l/fkfFoz+OzekQSYYEeRIo6OVKVKksQ0QWBU+s1QQzkIKmFWGs1SQ6gTy7FLkx3HIyJak4lGcZT9BYLQMIViFMSJcwva2RSFgPeAol/ydzhqYjo1zPfaDIOnOjb2604JMadOgafL2r3pcta67ZmVVeEVt3TQ3pjr1DdKEIjy3gnEMIaBdTVbsBF4fZ/Ca9gheXYGGX4Bv2B16cgVZn4Bv2BMw6n4ryRcWwdAMcj3n4yUJxGe194n10q9LOxd06mzCB8oLHGl9K59HaWS19B0WJsEXNDettcchK4fZrPfeahTX03OpVRgDl3qiEEeCGNoRo8kxKazkWkDZhq7jH3oVvFr/3fkc7bvbk3m3+RzMZR+eGQR3RtjQwxY2tu4ZTT1SU2zQ2lY1GLMyYrJSKtvZbrsIzVNOpLNjw3QAjcZbofHKAcEVuiIYbsr413hi/Eds7UbTVH63ubG+P+mtCrK6ujna2d7a2trfevOmvhtE/7lB5lrHhpQg/5bOudNOuGb7BLLtCsjuBqmLB5BrSsLU9Wl/bifjO9s66WN9Y3dkJ30TbPNoMRzvhzkbV1/Ym72hFe+UPdlF2s+qUH6cisU8YaaYmGZ+SExzzZDLDKSiUEamcnmJXgCsABLMVgdcNWSaPszJ1v7Jcw87zPFSp6GjBB0lEW5NM2KW69hdMzVbcjppMOrRhWobuiXtsEqsRjxt80R+3LURECywi4oVoI/QMio/qeVvpq3IulqFIcrHAdA/h2dJ7PbxBrtal3XXO2cPu6Qm0kOIsd328DE/xTUNwxWUDssDwZO8PZqd7j8AJwb24IVOV53IUi7IgPk+jz1QMb4bMV1439cwg5eGlcAOvBasdWnqtV4Q3RSk5qkJFhyDdJ8BSKoFz7L7JhkB51K3McgBehzxe2RVxzLOViVrpB/21YKfeooYQskLREfHvECdLQa/KysnYh9P3VmU5C4ZaVsi8NElkiSTqQYLVVmpFaaKgyyBMi943MGwWWPW9AAStxFS6ujRo3lpbW7+ry++j7YDr9Nu0Bei50qQnGZOuImLA+qWZexbevrjk1T+Z8oSXIM/MVB/bmq63LEunPRalnyY9NsrEdY8l+GCC7hjJjD7+D8+aZz5Lp4tuY7eWmN3Q6iyOTn2kfOO/avfvs3fU3ewhlv/v2jliJyorcBWz/c8inOl//nCy/xrVVxwxyGdlVu+efKhMwwqeTUThgnpj2XKIP29tLLrd1aDqY1Nvk+/tNJWwN0jvWXzBCGm6+CsZC2op0FjUIQGsqXHBdlWWqqwMOS+wTI+qrpfqffrAlZ5wP137jpVh7I7dJ7c0M80Dl7UVrAc7W6urQf/NRn9z0fXJadplt90SwQwrklOUPsESYBzaBisM2CCxVLDlZTjg+s+YRxfDb0xGiK0/HstkIrI0AzbUSCY8k0K3AGF8jLeGDKhcqUTWvUrKbu3IlF72e2QwA85h3dZcg3arMJwBrqNn8IV0vT9awkyQ7wJgnIw7txe0GuyvOxHRgKuERzExFwSLhq58K8UlymOXgeADfbSyttrfWFntrxQZDz/JZLI85THsjmXNnGVMiAAPgJaaF9JquLW9uh5uiJ21tT7+EYV8c2drnfNofSuKxotKh0U6P8dOtaSMP/4Z+BINNjwZHBydBft/7C+6PvM+3fWizDRfsrhXTj9//DzYt7ct/bsMBurHlle3r95be2jTua0B4H108/W/tGjkz07hTkT1izwpnwrLDtymSLsyHgLI5XBMRiueKBrsuQoKP70oXdjpU+rEPS5EAkDDeW7bzempmCxyEaOQ3e0uVpVKrWYgiNrvNolpMA0suWVJyGL2zKSr/PYl1x7aMolnE0K2yXtYNHV6pjdbWjkf5SqeFcI2UzJDasQx4Qw3T5Ud6har+h1XcwagPIIQgZNcUjd8b88aOsn8uKydvZFMVnKn3pfZstXGywjzWQN9GWkr+F+/CtgGRp5TKdsC7LwRoem9SCaFu5ussGBsermet3dTKG8hmy5pwVgM7CFYAGaPZsBhYjzh8TyXOeosLtW1G3LKk3m5SewaDrPTBtT5Gu0pnNAH7JCuEfcF0/PWPBeatgkWP0GNWT7LUxlKNcvLTtuNe2LjdlVRchy35jmgeTmM8UB8lvmd0FgjpdCnoo33P+lf+e1bACXB3Aw+nF2d6KUim4mlB1Kum39WKX/CQHgoskK3/7B9SOtSJnNftmwzujCbpwUCoemlDHV3qLw8zv6oVzyWkV97B08mAziWmQ9WyZVgs6SE+DAtL+xXy6+ocX18NywCcbOEouCipYfZ/unp8en5h6Oz0w/Ds/2989Pj47OHbtmMKq+6qlgb6uErlzPEnYRRZPWFfZGfVFsZMVlE1UW1SuMtZ2lpiHya3OB5lRvdsnksvOTSz8X+DTuubYfy6zd9z7bZhhFGyDgwjlGqVunSZtr36zANFZFV0GRQwqBUDHJ5oTWTiOeM5IimNVK69KinniT7C9ncrrNAciQnEmB/bj5oL/00A7N1grfH8kEC3yB/Zc5M+2pvQ1rPJq/sxR0H7758mqID+vmCTdK+TgJCdR+oCaShm1okaVEiI0dE/l1ezy+xVo+by28lWVo9Wqh5HJe3rbdDlErZuIYfbhdV7CG2HEP7ZzW7Z4GNBOpTp1jkNye+WQul5BGs72qNpFZGeARwvUzLeiZEBc1znwYxzP10VTeqGrNr7LRDA6NIAL00IKPVmUo6w4a0zYcPB3s9NKGYqsR6N+yXDwd7eZn0CqgvD2d9iuOHpcZzu1gy7jyQKTUuJ/NWvauSvMhmIalTbpwGlJE2OIdMSrh7oCpFNzLgqhWKTWUhJ/4le3KwxzKBh28f2r3EYrcobsDeNQTpPhZwkHuM46rK6zmVzJYHg3sqL1qUbbgWbmxuRjvjnZ31N5vRwkLoztDjSeFXS2Ya1HwkX9a9lQa3necad2TRAgVwP6cFR0t8RpM2mChq7FNVQiKQgBUCjogHqFY7oZWbGq7EKEd7ExqjzC4vJ7PnncYyzWjMzG5c0sItr6L99TeLChGOYjCNNhfg0kMU2eHeJp326qs2fZJf8n5Hsw7fDfq3TLu2udXdxGubW7dMvdlf627qzf5ay9RNQ/6bVBBL9kLBXN7ZgoUA/YvaSjx32xQW42EgR20q47Z3xLrGSDnaQQUvcaMvjBstoHU8Vr9Elp4ysmQY/+0GmNoX8BJnev5xpht27vsJN7Uv8CXq1FXUqZ3fL8GnG4JPjl0vMajvIgZl9vMlFPUSivrqoSgri+5EPZ4wdqlZHi3odB8WvYSlFghLGW49aXTqnmQ9Xfzq/oQ9YYTr/sQ9YQxsceKedZTsiQJhi3MrFcF3kC5eLuZvkjheLtijr+tFe58+RQp5ucbvPZm8XOlLWvlLWvnNaeWlnHz3CeZupX/HVPMmHyYyup+bcXcR40HpzJr1Uha296ZlwpTmJzYScGPQJDa4L/kyuiMifC/KrdkkmxWxG2sba/clLn183p7Q0JaPSyxtJ7V/T1LJHVuA1hsL1hF0RtG6v60m+Nagb2lttb+1vLq5vLZ+trr9dnXz7fpGsL25/ufSPakmXRoFj8/lMxqYHew9hhgYKjtUpYbcVnQmPfvy6n2JRv3J45H7JM4O1cx4t7LBuwzp854O3+EayUuYZJ47aQUxAXrPaIiaEQL5YypuLyzFzAdjZpyNMnWNuGwuClLBsjBE2DgRdZ5E+S9VriVFTC2xEy9Qv+h+zFJQvsCGeHJe4dJQhCqJqnrX9eOcpQ256a+v3dfKBGy6TCbnuo22yubfhvxATAzppgO4yub25jKsarBn5VJNxQoH7sTCXPo+HOK/jyf8XbvAfwPf98XpfXF6b3V6/wbe7t/ezX2O/q0j7um9Vzf11/ZNLSHPyfO0NH1Nv7JGw3PwGh1Jz9onvEUZfD8Oo+XP13MHLQXfjrO3uGA8gido6czEROZFNvfBPE79z25G8/iZFs4IzkIbg+YmdANYyHUg8C+MdYFEqoDw5B5vpyp0Lx0bY4rRLOw6kwUQPihZecRzsbXBRBIqZN55h+5nlbkFZs0FlmjAQ1H8Bjyf/c+UbXoqJr8C58F81qumnxIeSJ5qGVdlJhl1BtbZZRdxeo7PLgKXf61sMzvUPBq7pRxzJAprel+JjI9kjG4MPPFzY8pMTYSKTvd/Of/p4Ghw+r965SKyZnTDqP3z159mg93VwW+//nQ2GAwG9DP+MRj8zz/uEOPKFmv7oLbJDcPiQRu8qxNUNTIpthcHRc9n+pqU23riGAEE7ESXWbR+E1TbPbICEBDOcE4dVN2Q5u+dkNCU7Acwefhnj+G/+3+cDI72zod/vtby4GctORqkg9pEU1Vh6DBTir9mQJjMYc2ZCUmAMfrhh/dnBzQXjW2Hi2M2Kqm84plEwiiLCc1ED5vMpoCYp3YbpURjzL3fj0/3tEDv/3L+K36qkO7GrQiXKwCIRCinPEZHQiBV2YxKJF2xi1f9VxctOVZL/361+/ZjVvCPmYjOiyL9OJLJx+mcpynS8179n6V7CVxHzXiGBU8inkVOJmgsfaEaLWIzpvP6CsHY4cKdEC/lVRcLGIxGmbiStF84ny4Eh/ka18i7f70/XJTgT2LeAb3v5JUAbDenCjDKglZjrLx55w2Pfz77fXC6/7H02KwKPzr7uKttl990aOnjwRQR8J+lQ6CEgB4Tk/KP1zIBYyF3i66+CZX7KMsnFAOM7SeIY6t6GI5OKOnuOi+wcR+/mCFmVNbGmI97YjSblCipd3LIp/MxWXTk+fY0h73jGwKyGMWWXmPqVG2l8qNbgc9csV4uCqSzTgVPClwnYx7igkaNRCqvFNnbPFOzJEKCthQhlmLpgx6zdxfl8tMf0CXgl3OZIF0OI5kKYZI5S2OOvwSCYsL2d4cmhZad+SSYoTWUICgxumCKmk6VebcT8sfjWE9BPLb2izQgZ2TUlP4lCQFijheGi8GFW8kACjLMROES5sEhv4Orjf/Z6CNhfF+qvOi5Jl09m31vBo1EXphs4R4LY0C790yvsR6dEtPNPLD9zKJzmQbsYKw7UKWpMHUUBydWbxeqpF6mFz36S5BUwFzQTCPtyU3f1IMTVmTySiKFvofk4ykn08zHC5cFTcYpyjmal6Wb3lRv+ztrwWqwFvQ3L+4BG9dhTHkQx9hs+GKXItdioBIwJLOCZSwrLIXsBKIQNoOwYXzGZjCdmKSD4PHPjOqA/mTCclnMaDNzgxE+V7MltI1NcrweoajCjWoJYzyeqEwWl1PI0w/YdESfxRiSrAUKKhPMKgl4HdyuDDz2qrzoykkBfyHfmCkv4+b4yCvCaGe8QaU2w7LK3+srQ7Cff907ynssUlNAMNIsPfIpc2OZmY8gzOiuL/KF2SLTBXjS3rQZqzZ6++CkdXGVmbzC7S74DzHCFDTbzdTcyBJLZjaLReXOsD/fcmGczmJT0ABAxvK9xdbwgTZbNUPqH6CAVhUy1yCRTxDpBAGIDfHC9MgpBOOxyApPshJF9SF6YaWDZBHOMYVX4mRG09C21tynfcs8wo2wvbWq1hIVTWUOCwNqv8hU7Non5T37pxB5EvaDveHKwcmw/IXtCpn32LUY2SHTNLaF5t4fzLLYFLflPSaSiLxqFgm8OWN+aAR9U+WC/bC/d/ratEVypVWiCO+hcPmsuFRdiSSsml6lWSB+YmkuZpFK5lN7cjQR+JX+FxSmYiFethwVrNwrK1lOMkhZV+Tbmkvmx2U2LHi2/L5cwJ06wTSZn3fEmkHZxZ4Yo6MXdih9eEydnulNqS8eywQzJj1eeOKhxrcwgw2KAu3FRcQOPNPrveCfFuWKt4aOGINIofeBFRGs2W645UP7In+KVfiJZYg25AWZeOlsFMuQ7R0NNWDUu7OzkyFbYWfvh4g9FipUcb4oB2TU0cIHeo0He1pRARtTVzAiImFQfKmFD1gCoxaK0jMmzZisVJCtgnMvgemvLpzuaPrEdMQc3z+Kb2ibc7NuMCMyUzcHX4ZH4pbWJaaxjW1os8DyO31NEpW3X1qnyrwq+AXOxf9n7+ubGsmRPv/fT6FgIg7YM4VtMC99MbFBG3qHWJrubdMzz+7OhpGrZKOhLHlKZWjm4r77RaZSKlWV3Ria6rfhiXk2GrAl5YukVOYvM1+wl2dv+v8YHp8PhrAJhhdng1Vp881hGiJw/V2p+0yu4RXlEBKLa36EsqYhWVnmjgv+r3CwQHMbMNHtrUqeUdufeX3dsETH8yJ3ujwbvrNgZ66vF/qkdF5oUQteBXEQtOJQsvEaTiBuwRyu3R/GoSwLRu6x4cd0XbLR3InWq2J0aBCholt5LWcikRwbNcFP248SL9haIm9IuOHOBT4akbfYTKcyvmtZ28TaBDbC7W5deHbjzn7Q7Q9vJs6momhrHjDOeT2Hb+nIH76ydtaqfJrPv5KzH4KcwDOHjaARyXY2xZ1gWpXLANpGrHId+BGXXAuddtv+/6q8axYMdxE01N1m4BoOIXFI5kgA1ag7cAG6elJ10qJ7bjxHkb11w0fSoPjNR55JR/Q50FXXyh6qC4B6o10Plxq4vPzzIdZKkXjG3lRHwUB7uAnPIOzHjMAHimkFn7fyH0kbcbXn6TjVtxhoy5LizQSBlIv+W3pKoceDCIRlwk+ZiIW8KXA5Uslc8pQN/nWOTalEvmE26Y80KAxYrMVGa6wueqOrOhMdkOldjR80Jvza8SXPuDKcBkfXIr2EoOjNHBxYvo0m5OGzNT/eGpwfeKsFw7pVqMrCDQQx/Z/pnUiHt3CdVYuriUa0S4GVgHC4qUwR0kE+kEFpAvuCRipoRPdmTWCJsVa/zVVcdIyw7kL69qLBCtYqndeGhD1hxWj7xVQf1X07/LYjoRwUg+p/Ci5tZsSUq1zGoISAHgBGc8XEB9tMhpyiNKi0/fOhAlyu2Y00c55CdzYfUgZCRZbzkjPNOTwzP8eYp95+R97y4iKxHk+KVZpcpikT1v8Gdzn5BtC5Gnhf0X8xlkGrST6bZXqWQcgpvXvI89q6gxs699ZR61FUTjDe/4w0+ANmOpKTuZ6b9M5qM36HhmQ20Gp8BnsKDSI5+IJbjDuHGxyacCt9YEaDnkSM/avgLIBM7yAvqQiG0JXNb92anN5fRvSLS6ufXskQJqTAiqJRIVNk7iphgSpdRnJ2CWfaZWSXdQkt58HvDbtMk80AoWc/pITrNFovS8VEag5GwgpyWQbzocI8dhzwumu/SnJpaKWn0JKCevezi9KvaUx/UtBAG0eD881aKRy4twWPr/yZoS0rLUZULLihe529wyrNoSPm6y658BGLoiKGNwFNiwF3f9d6kgp2dtYv8WMBXqcWyVuAQAy/VlrIS/gD1PnMbZee4LwnlbBHdF1UB+Uu1lax71nZY04LuhPs+GW47EToKJb53QKc9pNM3Qc4z0LpvAZ/qqg0+sXlaJVLKPzU1JrCh4mfrLa+c53lV+wIMSZ8wSLnKs/uhtLoBWWFnoZ1UKA1u2OngzeYg1BbYf9o6bKakiYtaaFA+1zxpM4p12j+nuVMhB7i43zRvGdaTWQOsSC4ryFQl88XMGT9/7K1VKu1F2xrfyfa6+we7LRbbC3l+doLttuLeu3eYeeA/b/ynQCLfNozsbT29ffQ8trdx8GfQAW578PfglQF0EhUIfjbJONqnvIsLD+aX4k7FsMFj2ZncIH23b2Zl51GMsNIPIsF3Bhkd49TbQFUI5EVhaucaetOOUbLS9ns6s7ImIMFBY7FFovdti4MRcbOdQ58gg9aCxwNVrgPp3hBToR21EbrVdmNtMm12krimmwAq6RVkzsNMKBafWyjbf2zv2xdDW01WtPCnfbPuRiJ+KOBzNoaFgcxC9yCOxDp5to4fXuzC/bW6dubvc2oNNeUx/dM9hiCXx/1F6+lPLnieSRnK+zVxQSvX2RcGXpNgQulZP0DojZh50cX/lFNpdYkmVs0JMIrZpm8AZ/j8et/bxab4KK8AfCJlmqesBFPuYpxCwZRP+jAreewMyuWKtAJSW0rUPqgdImQATD+V8wC+yw1ZQ58zFQrEQpdokX+OMOsnDZSF8Mq9uJyEbwlti9TcThYDBaSHy4yCRfqwGN23Dq8S67k5EqYPJjU8cjODWCqTM5mIvFLno+cJUmjWjcSsa9FHhw/HD0jwdGwNtY6os9FsZ6ugetnLfxFMCYCyG1wlKBUEO3MpniPzjIRSwMPJWqbiU/XVF5T0pIN/Jn5eCw/+BHxMxvgR3+xvW1jg/YT4EbfjNhFhpVHwXMBr/4Pcuq9zKM7aGgwA/8Uvy7kijcyS7nJWX6rWcpHIoVS4mmKEQJ8sWEZUaD+4uzYeJzyWqyj+fVatF5VvoAbJa3I9WyIe+AzKIUYj8EFdgNFUmdkkZAYN8TF2fFmyxZ0uFb6VjkfV2lZjHjfcm5E5NGMF5pP44HKR3X9qc67KPkHNAiGX/u2FQeVZpnOFJJYTXvw9yW9AVQQ+UyaUpnwQVXk+HhIUhCbYXq87NTgip0dH70Fm+PIUnzshwp1pXxHwASRmHKZNkQcWO8MJ3DWSfmwxgWM52m64LX6TfpVgOB1w4AkZDi+D3yos3ZXHqUjkeXsBLr/CanqvEE36RdTQJy9eQ3EaVZLbn0MgctrgVMkkAKF6G/cdgi1BYqKH2/ytRtKwk5WX0SDUF9XNR2IRbwvWHquj3WpPDxhn/GD8EpVAEuTf/g1WEMuUJX3to+IHLNL+FKE7bYz+gE4eum7hMdaja3/tgpjUNgAp4jDMFefYpFSyeQeq/NpVImkhXTUF1HXlccu44udaIMrMLhdOd5UT6SqEx0caRyPtDorMl1Ux39qxfUdqkAMDGdyDiEIzbj1Lo7Kr/9n7VqOuOJDnkylWmtBxBNeLZBMDAPem37m6ATTX8YlBPAg+NVHotsuUgqV9auhUvfQwb8BSC2zD7ICwQUuKlohNN+IdZqKGFIXFjbV9w31xxLyHlQSbPFUTwztbV+p380NzkNCCzwgkidmV2IqMp422OzhxM1R25jS+OVvyDEEuJhtG7UZnE34VJIJBlTw4W2DrsY1JMgEloQwtvHoJQ2IR1iihYG3SrRe1aoDvjvutdvjEjMaOZMW9Logfc/mSoFl7VbMTotnM0hUgq5MZ5k0XgoM9gp2B1M6EeTwLJFcxBh9CQJUGDB44CsLGEtfqTWqCBdDKc9Tfg2pSzmbaWMkwNTDK8iPjHoKCjkVeQa4eFiCVgVS3g1bzsWBDQOvKBmDaxjX64cUU0gOT8KDwv/tXOcU+JY2aUgJG7I0QhRfMHZflpaByHY9DiktenkEIXaLXYekA4yvX8L30NKw1yT+CAqHhiJP6vqW7OyLnhiNRZuLvXj3cL+bjMThuN3Z3+WdvZ390eigu7s/3ivp49NdT8stSqKakAnB6YTcKmlLGa/pvihNsTPhOLYZV6QvEEC+teJPICdXjuYh9p3GgEc0h+wITF1yDUZsO5GyjQMTUzYW8hqcjsa69fygFESuVLI/tb+NuUFj+gSe7DKmVKfSLnLmDoQcnNEDH4hTaLrlguuMynmAkf1S8NwsGsQ+kelawobBM18ewn8UTtZLPyql541hY8AgpR43db0SIR1btN3KSgRO/7omPd3x7rSJe5WAjVvSnLImgDMJPlK4wmEE92V3KpIY8QQDTQghsWHpFACvJKBulE/WCoTgSPfHYhHBGbnOPH5Quk78ylzuoBttNV2qHMme+4s0qrIA+CwKLcRHlhWVdDBip5BbCngYm4NX2slaGLW+XtiXWMCN4sGxmKGTihez2RUji50ZSYukjCvnaJqHuyzXuKOlmsylufJSKzYlbmm4L9h8Vrrq6Z7TBpYawLaYK6BBfFFQM8dGKPyRUAyvxyWiy1rjR/Tas8m24A8F1Y6oKVcIRwP0aX17ufm22vR/nb3S5jJBru5THtGUAA51qfLqiVt+dDZUTAE9pQ6z/eB7Ar8YaA0c4mheL7JnS3aCv6EDw9xREkxC2XAvQJXQ2NCZHwMCyOXVVXfokqP31llOl6VT9bKuFqW/l8RBFngTEqFqAFWBeFjhLf+oVIozONcs1foanmCccgkBeQ0tFytvC6KmdLrXubETdaPd8J2F6MPSM6v4zUdeWfZTtQdWDYvq4I24KhtH2y6bhOWRAtDpPXDTMFZImNOvEhRJ8M5nUOQzKPIZFPmVgCLtniSVCA6SL4iMtEt6RkY+IyOfkZHPyMhnZOQzMnI5MtLeFV8HMhLX0jAykgi+BxEIXSUwv4EGRWCgAwsuRAUGGXZQEh9fUGry1aMkl7Ij+kR+fIUoydUttc8IlVyg818cKhnaj89QyWeo5DNU8hkq+QyVfIZKPkMln6GSz1DJZ6jkM1Tye4VKYjOhPAzhXRS/WR7CW6NWJLDZUm4MgMgIewUePCp1ymMo0+MMJZqL5fwDRFHufqUV/uqNHCD49enFuxN2dHHxv/r/wAZf44xPBaSTRL+qEn4Son1gHAIDSyspBqZ1QIgUYqLEVJnRi8e5AE6PBy12/vdXv7Sw+uimg2UAjmc61covOSqGvgCbFQmKcqiSE0d/xeicrzIe1o2F9DSybn2FMBKwZUoxrl3Rr2tyOuNx/uvaZlSaSsRXeOBFfw3ZUJsUQ2bFoNeAMAV3JBirEHKSJijRiZWcoEArYOlwnhawE6Q3naUA+gAaJpqnll/FuL+uBSVeFdRTAg+DjbnD0tdWjql6KTe03cJrivTQT+mRC+N5hvWdSEZQywe02ekVjWufgFboeMB7obgJLGzZczNir/xUNBZVzvUj0rOFcDEoFypqpiZ0y0M9XXjjoDeH50yqCWQ8wmFhXUsizzQE9MGQ8VWXGMv5ZAJL0bRBa4dJuONKMiG9bszIWYM9JFExiZslnXTM+xeVfJ8bKElYPR+cMoI62lFapScj2xAfIl91kOc5j6+jqcwzgVUH7VfM9sVRu73b3maba1X22L8sYkyDVtVaSV8dOmdVJoU8qfLrCZhU55E9rBbxqOnam6hDfhIsP/0VcSocvs61VUcp89XfAJ9lX/qj7X5e1hgYUu+X/TB2um+Z7YvO7uHhdp2J+PslHPpOHuhrJUS2o24F7bYSCcUQandTEjlxJsGxNwnC7Ju6mL7QGbEyI0MG1rX8qRgZzlJn6urjhMZ9akqW/dngHrO+3mCQY5XxItYVlXOYxjqeG+eNKGr2ugKZ0MZSpGO0kiB4pGAIjBbxGy2xX8FWImb5lS9oWphQ8H5P2Ieo1z6kUWORgTMErDPoMeAaZKxiXsZydiWyhvRrgAE4JlUi46KKtZ3SKlcyz/yvCfAbsLQq64uzwfCkf/zTyfDd4Gj4y+nFT8Ojk8Gw0z0Y9l/2h4Ofjrq9vb/cc4Z4yjGqGQW8a4gLb09eb7lWhgZqFW/xFAC+odQ0dkGlzeYLl6D/noZk4LpzGM7pPMd/bIkPgIeHbHo9Zpd1kobxFZfqkhkJRkDuwwF+UAxe2IwzX2ITgkMLjOHTKIoez1y7koZY7H0GIa+DyWtY/BL3aUQGzdyl+pgsHiWDAl7tpMBzCsoUyE+YaSwzk4cLcxhSXFdVIvTjVlkyW48TFORSR9Ok15B8+gFNYZv+omT16+MeSyQ+3PSYHZ+882Is48kZMHmFnQPu7FgrA6FXFVOIixoXFa35i0y3YmsEcFzwe/K8aMg5n81EBkkn6FCtCoS1X+3v9fdfdfu93stXx/vHBycHLw9e7b589fJVu3940n+MTMwV73wxoQx+Oup881I5PNk53Dk+3OnsHBwcHBx3Dw66e3v97vFhp9ft7B53jjv9/snL7tEjpVPcOF9EPt3e3mIJ0YjMSeppJFSMaiX1NPtm72D/1d7e3lG7t3vyqrN/1D446b7qdva6J0cvd/sv++3j7l7vpHO8f7Dfe3myv/vy1U5/v9PtHx12j49etR8oOWnMvDGT57jICHM9TMHzNx/9JmIf8LcrcD+hJRfKhsYFaxFLcdekVGVg//xHSgdi77TOWf+oxd68//FUjTNu8mweo+vzQvBpix33f6Tv4b8dtmJ19v3Gdxri3RHFqSBtzkdlDM1LOa5gUl/pW2DjHZuJDFQNVGwwONsuzGzI+FOJueLX9TBtsit6o85Bsjfq9eL9Tne/e3C40+124sO9Ee/uPlSblM6HfJyvpFBJIdyy0vBcbF9AHYjAVMa+z1T9Pdy4mG2MMCtBWzURmZ8ImimnMqlRvd5tdztbbfjvot1+gf9F7Xb73+uPoHeEaaafkWCyjFYmtnO4334KYiHhS2RPjGcoceII7G/ADYPvWrHB+SmdqblI01JzAYxc+EaM8ASt91Eh7kGim+0IRiEmelOxXEfsF9Cr4NiWpsA0tIp0JD/uRADnZ5JykkK0IGUl1fh/e3sbCYA5yTiK9UN5bs/Khvi90vlcO5GLk5jGZPefyNM71+n1zfsfj0sNiJ7oJDbzmQ2nDO2TuvGILE2z2HYoveWRcmgCkeoqb+jHrWWv+W5vb/j3/mt4ze8c7C749En/eIXPr0dRtP5Ahn7otQ8jDnUuAA57I3DjN8XVM0DSBboXzEtdPjcGR+ebkQWSwDxgaGV3wPVANWloRh0WIT0BnEqh8sJ3sTKMDdpaiDZWsipQ8FBJ5fh8wEKKme1TeyvTJOZZYqAspErKEDBhqvJl638NNv+jRGDtI8B9ThfmGTy5DCiaDcSzjf459ruBRYA+h5z0PK4R7ewvMMnZTxDVPjJmngHS29Xn7x99Ei8wAalxPuAsbKO/iZlXpkrm+8En0BBUSxBJk2JdcMhvHD9Gqv0f3w9a7I23rk9VjMc5XnDkzo71tBVa4As0gIZlT6IJmJgk86ZVwU3jzqKzzSpzXkPKHZwiP0tx+wkEhYm6DRMVTmXYxptP2OinKn4imnk6nCuZf0bSeQo51zlw4P0jWFDR/k9gAxb3GOpsiPiO5gJdjglUTCRjbj5/01602ADRIm9ret7nqRzrTEn+GEqf4n2ILyWeU75/xXu97EG45G3UbXfbW+39rc4ea++86PRe7Bz+b3wgPZa4T34M3ktd9fW3lLLO4Vb7ACnrvNhtv+j2Hk+ZTW0YXou7oe/cvwKNj1HOIzf+og6YPg/jWtQ34rvB0SfSFs+zG9EQXRCcx/GDOLJgIk3hAzH9qaCOeT7X417+T74wS40XSpp81ut2PpEh4sNMqyK772M8CTLdSnSf0BBenInI5E1NmD6gtAJxe73ezj79UqpEfAgpejyxRv4hPoFQEDAM4Z7NgSzNjEP5RsVGcgGwrtvePXjM0o3IJE+HK1cz+QRUuJ3K1SnB66p47y68Jauu8yKKIcdVf0s6u+Jqjr2nApdL2XUOESso9xPrFIwVeIl5P7ofOr7iGY8xc7bK5F7v1cuXh/3945OXr9qHB+3D40633z961InhO1I3fhieFmh8wIyErC7aYvtFROwXQETA800Af0yYVgb6A+X25oixYH/X7IyrCetndzOo/yRHGc/uoKOm8CCSicyv5iN4eG5PdMrVZHuit0epHm1PdCfq7G6bLN6OcYBtYAz+TzTRP5zt7Oxvne30dmq6Ds+B3t7WI49qcg58maew8W9ht4wqceaKZyKJJqke8dTbhEUXmUfS+iWeulXS3g8+hYav4albPapobVTKoiZL+9YdXPxY2LstdvbjgCtAaatYmlgHb+EWO1VxhC/fRrTgq3nmlhjwKRSFL7CGqVr4znXrqBJYEuhTEfgVPGor9D6KpD/BA5XwAc1aVUHlRpiUzJyaKu6sTECD75YlqMXiJeMzTqHMM8EZoQG2UJBHBAX8FqUHGxHPur29bOUXijA5H0GWkUhWoHSkdSq4WkTQS/snNk55iSy0SxGdypSY6FxiVA+raJp5HAtjIM2TKzcRg3Iuykj4FIFgFRMK7SH4ea6USKNVyVPiQz50eNgVCHw6UXoQ7kjgr3DdIonYW2gddyUI3FI0p7fB1dOj8yOq5pHdsQ1nM4I3THLFMc2BG7BSp4BX2M5Ts4WUAPwGts6WHXfpH6IPV/k0/YGnM7Xl1rglIe4SrAOKYVsFLR4Nqb4FLAA3da2DVW53opWVLhNmPhXJCvJ4rMJJU0FOo8LRvFijhoZkEBBHaCpQW9HSldWMOvAFhtAKtH0mmC+t7aEw3zpJXwrmu2wlDbG4SZgvkbIqzLdO+VcN86XlfjcwX6LnPriigyn6UQM/zCKfy2eF+YYy+T5gvl9SKk8N861I5zuB+a4ooW8a5ks0NgrzHZAvZTVAbw3IS0Myp2VVVn0eQC9N/hvfMQ2xaQmi1078ZIjencPd3d0OH+319nu7ottt7486ojPa7e2PdvZ2O8kD+fFUEVuT8+ksNH/xhUhozhUCuPeBXD8Z0RvQ+yRB3IcQXI3p3kfsJyN6iVhy7KxA6RMcC/cfBE7nqvT2z+sYo8YOgGfY45eDPYYi+LPDHhfy4huDPS6g4Rn2+GDY4wIuftuwxwUEhbGLholaGA5qHPZ4D81/FtjjAjZ8p1GlkNLvDvZYJe77gT2GlAXgsO8C9riEtj8v7HEJQ75P2OMSYr8F2GO49GfY42eEPZYY/wx7/HywxxLjv3PY42Javy3Y4yIanmGPD4E9LuLgtw17XERR+AJrmKqF71y3jiqBJYE+FYHfIOxxEUl/ggfqNwl7pEU3tNpza5qVehPRjPA7iNX5FmI6kxOpeEpgtBpJ652ou/5AsppGA54D91P5h0gsYg7BBG5OXEqJzPtIdEVElxLoyMszHjsLzVUcDX61vOoo5LFncjSHeBENguAmAzlDM22MHKXYxg2LhP4hXPgU3e/5VabnE7ClaZWcTWWcaapCz6ARvIRK6ta0TAGFBHi1Gylug1ear3VPD4Fg4SxoHcAy8ftcmNywrUJJpJLY+ONWjNzfHdZpnGmVb4H1SuuhEbeAnN/nIpPCsClPPB22yjC4IEc8vg6/+YBqp2bGVXO9aVzLFR/p9ogAmNf1TgDqTaxt5XKZGwDVxiJor3JkP54JChbCI4jpGWSEujbt0KYFvqcMFMTWquU7RXDP6Nzd2oCjtJAoYhiep7bvPUTgkd130XpVv3fG40N+cHjQGe3HcdJb+ZS19HwBLtcZib+xOmEKBaJ+HLbTWcFEQg+OBPgUWa4nApiGD1w/JDGn5frlOGZfcZWkdof4aaCub7ZFOFcRqGuN07uj8WF3vNPb3x/t7CZ8j+/E4rB7mLRFW+zu7+xV2etW/IWY7KZ/gEaH36I2eK7dom/7i20gpoKbeUZ+AFRzr7Sg4n7IiqrT50RWV+N2e9ze2+e8PeKH7e5oPziY51kaHsrv353dcyC/f3dG54rrSMGoCBNc7fCInKWCLBme4dn3/t2ZsQFk+qS7NIBro0xgD0WW6FsFDUY1M/GVgMYVrufkjOdX9H3NtFr9rGu2z9gxju6UYp6lxRG0Vi7/FfYjPFXM6Ck0IzTQ1Qf5OeV3tgY5JRRATSKVbIMxCHy1XfTSu5b3DPEyaYwaJ55SVTMYG2qoiSDMz24RszbRrufpJZUus9KM1leoYOb46pH7TbEWHMi4LIdXsPsFCnsXky+wxGg30JgMyrkHrL+oDyGhQHl6x4zIwVknc0KIt0CKSudM3IjsDsYBGBfjle9XBk8FT0CVZyKTOmHTuclxkBHYAXE6TyDDotRlzqda2A+PBFubqcla4UGEr69F8Lu6hGZqUhLLOOOT6Woe7EdJBVrVSh1qPMP4Fv50+cNloP+5npWbcAp2+cMlWFNKlzt/ukVH62Va5mn6dHR8sfYMp2OkBHa57cYkp1Cvjjoy3ek5tqgqNuxd4BA2uQ5RYVKxS9BnGO8Sc63gbrYbHikD7oJHVFl0FPTozRzaytmceO/7IcMe24FeOZ9o+QR4sbu7s20E2Mx/+/1H+r39+Ydcz0rScxvyO5Dg+ns11QkYWUlxzsB5ACliQqgSZ6l3WKD55zqnPAipmBK5veG1krmGDCZ7SusR3PAi8ZfBCBp0OMVBWWeCu1sTVYFjchr0r6O2/vBVOM3GuVDsNzhMvPVM6GS8R0ubMtQc3ybMf80Py/GxBSlKbqGt0j2vdF4/nB6lRKCxS/5c0q8ZNybQmifQr5LM39Lw7oyia6XcEBG42dj8+VVl7uBsJQatRff0Jb8vovew3uS1dezu1kMeu7s7pUXhs2qFVT2GSetwqeAEpMSWhSNhbQX7F8oTXEQDjcmQpxVlq91df8O7C/OfEuccqc4SsdMxGXTealGaXf7tEneoR0owwm0Ea8ev4mfgbxy+g7BN96lWQBJ+gcwUPyIYhvBGhoK3xXpw6faTl/RtaibnA9QS01cUpBQKNhL5rRCFVQmT5rdQnNf4N5sT7ZfuSw9H8HNT+q+qKb19tzWlBwMcfelxtAZMM6F04Oq1WJTLFwtNT7veOn040nO7/ed2+0/Sbr/BEPB7Gr6yKaLQv2NEVnLwuJ+Xe3hQC6FxrvPzuIu13L16BJW5XNtkMnHhAZKKG+7fGLkOOxXTL6mTGOjHFYeeQUpARZ7sDsy/wkWpcvCA063qenizqc7gwAN/sALnnHsqO2cUV4xjjrRdkcIb2wRu+mm0/pU4kJY3qkdqm+xTj5kcDdEFWllOFSlbqvCueWJgxBd7HQKt68a+8WG6FniRfC+Bmn18hM3E2YlUJhdSldiCGzL6UhqHszeqcjhDc+76ekjE16iBiV0fQtsveXuW8hzeP2XNtEts8MAO+W8nK80f5B03Fc1w5+gV2JCxzmwvxdLJDnyjAxccDUqruylEjGlYNAOmgW68NwKK5Mgxu4QvRTK5BNWwPwAzL33WWazV2MZTeFq+TSBxjSuli31Jr5SKFlX1Jy4Q6E+pOyQjJKE0f105HruCL3ZmDa4gqAATgZhSPZGqTm9waHE8tEpcyHQqTJkNT1/hBBZjZ4IMCFge9DN3SyWTorLW9f+sXcsRV3zIk6lUay22ZhuuSzUZwoBr/13/y0fZ5UiEDw/5xLlLA/OJFb9dwYiyYzhTCgSHNVViiAGhG4GzUaZvg0iq31oXV+KOHHrmSt+yOWQWI5KAAoLo/gNXHBjA3idBAI65X6rzBzzA7hGAKvhcJyHNVpWlfHullbhn9zWyoIJ1tUUN+Jhn8ttydpfofK8C/RiW9KNK62v9h0xTvt2L2mzDSuP/sP7b9yQZ9mbAOt1hx/o0XvMYfvE/m+xoNkvFL2L0D5lv77V7USfquGo7jG3846eL12ct+52/i/habzqI2HanG7XZaz2Sqdju9E46uwfE7u299m7UKTPdRGM+lWlT3sU3A2bHZxvu3ZeJ5IrnLZaIkeSqxcaZECOTQFRWJfrWbNYYaD9ZW/f3Edp6Y+E4akI2lbN/8cXl6rY47BwiAxKLaazrmVWd1/o3fiOq3LoWmRJpU1Ku0mBn88vGkG/Gb5ftkN1oN2pvdTrdLUzcknF19U97YH1tsnZwhEDSy4T7P1XOOAv86bjz8RW7+Wg/x0Ll2rTYfDRX+fxje5hnt5WXmjYRUfu5Fk/T3auPnXbUqZ6UzS41gPfec3PC6R7YVzcpV6Fl9fPZ0fkqNhV8zllTPCsiGWS837GDdjfq/M5yPtkwiAbnbMbja5E7TxE31sUHYU01Af8EoG2YsP/E8bkxOra1QCGkCi9eCn1mYILhowmo9gBD7jP4aDIwrYv6jt6kO7cR4AioX0QFxO+zhHHoVj5JidqcTxCvCAzWcwRcYBVTGhN+DREaWOjvW1Jt/Q4VSfnMzO0qTQtebIwvXBkrRXXzu5mETox3Zacagvq4D18boYzO2IaIJhH7txDXLfaLzASkBF1vYmxW3oCT11ve+PjO+BgTHCuckEqJbKlU7RDMfoiIKwRs2IZzF9Ko9Lcy/ZtLiPw4eZY+GvehVH6EvFJvSQQfuXgcvLaTBGvLgi6oBbqSa1dcSDh25HwywbuJhnxDihqFyk3UZ1Go5VTjdYH+uY/TkF63wyc7lnJyH3SAUvfQT6SJM4AH1HcYjYkSD8ZbJpexzMQtT1PTYhkqv8G9kGqesBFPAeedmQc8bRpzQCFBp8ega1Zri7RRx6X6mbhyhnqDL583M0qiQQpgogfRoOc51B34OCGOjJt5CgnuI+kTvNzxX/vD8nsAroHSQCsEKviCqVktauEKOhe+hVVUCtKBJ7pZoAOsAw54Mgh0Vk5eQELyGl84RrBMFIIZjHAQI2cSbfn9vRFE+FrsGJ8vsNsG7wcnm/APNHN5ih/0gxZfcHB6nbFXtG83SwHGomb073Oe3pnJnGdJZP8N8eTt32/F6Eqks+2xHoIC8nT7WunbVCQTMeJGbJcIHBLrIU50lU//808cyC+szIzis//drAQsUaoegulCSNF6VdfX/7Pm6LrXkRToRwqXBaaNN2eIgZKUJ3I2WZkLJtZZYVmWhEPDsnJhb6zBAbHW7fjGmO16DtrPg5UTZoMVPx0bnvhVVONq8IvFLMXNR3eW8Vc4T9HtHc626NtLtkd8I6KpzDOBnMczbHvMf0c1T3+Ib8QQI6bDYHFmGGeC5yL5Tx8zuf204dkKOUVwF598mGkDJ0f/55NQkf5bk++pYlMevxkwW+6FdaNON9ojSBMcnpWj1QEi373tP6A6s8BeUE1vEHeKBp7+oJ2BNGVK7tkci0S0YHecrMqCxiwToNxRTEfDxunxpkOHUKWLWYHuXnxZMgzJ30XsNIyrs3k5eEIT0KAuBlfnazHow1T/9ornQ2mGsAVkskm6XrIfpI9NsLqunx7/9y+liVFGW7aEULvdXrmMDCZsiOYSg6HRgoUTLz9gSvYznTYACUjYVOZygn8oeOGE4UQlkopcqoxZLJF4IrdGUm3HNwIUN4on8m/wjx89H/c6nQewERRv2Kjy0ytSZ8wA6GShqtaIB0o67c5B9BClgPGVyKIboRKdNUhSCPspCdEtgdkl1Mi6EIqPUrE6QToT0aioPPMxYsap5vmiFa8PIEpqIMTLMq4mFPpqR22wuDvtqA0euPwK/+l6klwJNtUmZwZycMJcupdgYhoaUUOBTbDYoBG7gUwSgqnNUi1zx5SpyDMZG7bB85zH1+wG4QrOI8QIzv5B5nctNsvkjUzFRFDSGkXCcwFtBqVWmy0mpzMe58WoYVwbxvDjQgLkBIoE2aEIGYJropqqmDW4xAhYYH45Ux239lai4zmQvFmzVHtR72EiFupGZlrBaDz9emR9Ei7rPqFzdcd8sgZqCUmoxR4jIcSLy0zA5OYrEFEuIHnoa5LOBa3oPsFAmR02hRJOyGhgaUKtlvA8KcQBu8TJKhZPxvQVOdysrxwxAeeuZElosdwVT+eN85+PN4vLHp7GMueAsqIhoWL+jYAzBY5SgLWii3rtTN8C3OG1SOR8umYPlzWoR7yGB2L/58GA3XThePXHpx8RNcFUHZAg92Au8HGaYKydqE3w4zv02SZiDMB3Pyi9A4oPl2QUaBF+AnKXbgEkDOuecsUn1vf06vTd4CJ6k01s2SG2gb+Aw5O9H2yNOJjvSmO3qLGvOsNK9WEggUrDYSCNS/rMNQMvA5774FRkRsSonGDZgu7lYH3NtCI1gf9ywadQDSLTBqlmtzpLkyUqqm6SCAoTRhN9gz6LLTqK8IyoHwY2OLKaqpJIGtLSi1DqCy0MODuQe3hQEF2ob3CYZgUUgsFdCkXjSBCQc8EzTJENjoDHcbDKwD5ME/P041x0PIRSNKH7EX4O25Dc64GE0iAwMRztVhuofBqcI84fCXvlQ6X+vSnVuAwdldIgKiK9A1jPhPL/sVEYWDZgybegAYrMeVpUxCvK3NGI4oOI59A1T7CRVBzcXS022H59+vqk5BaVijC6I53gZ8ClCDVUDOzEMSY6u1VqdOhfR+wX9PlDzMJHqMI6Y0CBXbpU3o9aLnNIIyphoKLLXCUiY5cw1yWNDhhIYZxpGzbiCWeC84au6lS42wOkcAnfv8TCHpjUXaTTzvQMAkIi8QFADPDY2cMmN5eb4QOVAhv4BPMwxpDmgKcg75HOrxg4xD0NRbAtXCjqhcZaUyx2ayyxBT4FhxDlIMCY9SFpxHAVRFaemohc9jDX5ebqDu3nRghfpBHCn735wbfa8OC5ycFjmxwQ577txgZERGhxNkzIwiKPjTcz+BM3MPi+mxZ8d40Kvq/mBEHZ9u+iIcFTNyH4/+xdbW/cNvJ/n09BpC/SFrb+/yQ9oGcUOaR20rqNY+Pc4F5uuRJ3V7daUacHp9tPf5jhDElJlFb70OAcBDCC7K44vx9nyCHFh5lHmYTgs0888PiSDXxJMPDJEgx8SSrwCZMKfO6JBB5r8oAvCQMOSRhAWnvkSQKoFv6bzV9ck+A7I2uzW6mW4Y6p1GNMBvB5JwB4NEH/zwH5QswVbmTLPF7p0nw8NwPME3sr+0fzTIvCKwS75EBsNCZBcd6dsfsHMIGE+DC41I+1xilPcGUcrzatdFV7jhpKXAiZpTZEI8QG5Ie9BwME4e8KIi6C303EOczgvYJgdfMpbd9ygj32pQuqw/ygfhFck/9T57voYXW7D2/SJVw9g95Vl41qSzcaoSeNWO3nkjYfZqF2M1B1ax88ZIMb/8umxN0rAxaq3wTVg4X850arhUo71KajkkG5MN1XVQQhX7zF0p06wgUVU1ZwWZEm3C3iTDeJ6wGX8JFPDZRwMEnCFli4U9zQr+YQVtwqiueX3fuITJIZPjBjkQACoUd12e0jrZpjoSjdyKUXB992fLlJz+U8Tp6/ePndeAO5Bgni+soeXkTBViPUPL4Sr8FS+JDOEr+hMiHgH2HhiOu6w9TBh0fN7WEwQXewcRzGVihNDkWa0Ho7WFObsYe2kfEqzRX28UlgVCDyCkzFIgeNB2RmExzaeKmpqEWp0YtNNBw97hr5VBwIoKnzSRitR4Py2S0kOl6r0vmFK/4c6F7mN5x3wPiYZSZHAzoF8xv08AqixcyMZ3bzCR6ODd659QkDw6alFdqBbhfxi9EeLcYUsj+GlOUpLFwkqLQBKPA4+6NBKX9c2BO1U3Ia6OFwGPewEuIr8dvt1e2F+Fl/hOnFRhbgZCv1D09sYKDfMdiP+HPn0w2FiFsujL+u3f5sPgWEXOcL7bdWGhaguGBf4zVQ+D7YPGnceHN5T1/hGbY0p0+Riqtou8kieu430Ao+AsMiHDhzJTtBvHRV72zpw6ZpRdpiEXOtMyXziepdOI3AiqBn9j6urqJ5k2Z9yL5F7ej99Pn3V8///+9Pp9G5vReI4J+UCROBYz7BfjDGpapLVcer6WQYxYTqy7e2Ba6bOUT/qFXl2uGv/ncBue53O+dqT6CcUDdx2ulVXaGdntU9urPNdTVe6CSaqO4RjXoaKLRZVuobF6CaNDkZ0p1OxIfrqz4Q/IsZiE8G5ST2wXTSc/lHgnGMnD4Yuctvj3bM3s+zjSwKiIxmWD/99unejGkg2ciiTxnj+eH497/H2+MWJl+qIkthQ6T1tufo9wlOA3ZyBwydqCLT243KTwzs5A4Aw0QQIjmevMqe4AFoN0KdFNiK3QkbnvQdj2vk0gBDvtyNLnf2i4Bc+tGNK/alNjQOONn7DQLqj6nTTkKI3AHjkakn1fjfOtPrVJ7LptYQEgQua7nq/2J+FVf0y1b4z9kliSmLGAFR/ihMPKzIoeU9ei4yS27t2yChJhHgBX+8/ElbvHphCdCa3TBmmuwP90ZCgDqQTEmq7JVcOrxCkdhVWq+cXhORNCYSQC3LGiLkmnkj8oAlUdgvhi+lW5cDZAgkLzcQ1B0WJ80NIbSbgmAUkOEKAnbjF/DxjK6cIjW8VyAzEFFXZmf/+s48Qc0LInrDoyuYprUpwZleOPkFhgirkI47F6VOmrjeX5HAx/VdEgPTRFu3MdiDm0sL9lnFS/Tiaw/5mx3Q3nXTPZFNWVa1q77XFipRNnkOhk7zMA9OI7g3OqQtWsHLJ5xiMHDUWpHJmNLjxqXHCr0mDaD+yybO4vpBZiNu4vRKCWcv4DonXRGmJEfsyDO9dF7snV7iZQnoJbU5xjO2EZHx41mat/cZWtXM9DKiZFKUwG9Ev3RPsbXfM1j/e5TKh9eN+3Rn3IAebgygVkolE/F/EBGrxgejPkm9WFSqHjrIsxczm5/PyKSUBhSGCNRlXFUlZHfbA14C5eZUGgKLGokmyFVJaf1c34C4u2fcUJ9VdaKb+hm4Q/i/KstnbXppXjS1vwLq6OBouVMrKAC127WXsxWf1EuiTmYvmHbRbT5zTIjOfKmkFxngd4D4XeiCrwjVFryieHHkJ96mmYJ9E+o42KS7RtlW0IoXMm6FXDq+iZBA2EcupVuzBK5032wbpsK/nqy1skD2ngaHdtaCFPhm3QxfnE7VYqHnrJoN3K5XMoG5mb3CN26Uv5wGA3Vo0GgPWzHL8nQ9txsZgcR3wFEBmVxWu4TZXtTOQgpFGSFkargDGvH10YiGhVmm8qXNGDe4b9kqOtfJNppv3eJOcIWXYV1A54lvAVxQs/hQkW6h4TwefQu26IGH6Lxahuw9Ylnrc0iUiYti/Z6ZQ4QMwtAbnTSZ2mECI6D16KjaoanPcKO3lptiknCK0zRFutk5iWRdlywpsHwysnQyoE9fLg9sdjUHZtpePAjxIMsUenMlPpZpDfHo51ti9qwSv9zfvkfbwHwAQlGKpEy9SIEcdc5bZsewl7RRlykzytp75N5UjEagtlwanrpHA9J4U0Tekbw9lHF9eXMnoGhIpDds7ysSnu+KXB4u8icnsiVT/tmUamKfd6WwKa6auf0hTGaEEPz5YfNZYtTDak0Sg0PvDhhw7EZIX7jK/9OoRplO6JXqXe+YgMGyBMjqQ8F7K4ZHcNv6h9bGihJp0geCWyUQEG3WdsUHmYheL1Gen3XGx6sgMyYeIvCPhR1aN5ZGCyDd9rrImrFBakA4ZQ3D+S9dyi5VrNIHt8xjBM8Wuvwoy4Sm3Tu6QS2Xx6u3ljbH6FvkACf2kcNGVRXsDPcXH+GceQsj4NcnQHPEXq8LtgOzr9UWDpXhtfc2G2ayVNniGGv89ObdWxbdNwpIn2aK9gLKEeZAQnyzBQduu6ZCemorwieBsxr62RMciB25kwZ0GtYLRkGAF0Yaa4UUcxmv61LGIRYuxttRDeS1FUNK53ZiWXktJS1tAnuMy4AhYogb81rLxVoOtpXBPqaLNO5VZC+z/grAJKgn3/rSI/yWAXBemc7H9rCOHsoMEC1xaLq/Q/boN4S12vawDlDcWm0hBlZpXi0SSpgJLcHQoYUBzDjP7/2DnOaZjte9Ke8hQy7pAiPwfB3rTQFLUir5xkAIB9HjsFISmqgnjabcELh3GvhrzhSpF0TECKXkChWtijjrnLFmvLwV5u/pD2u1fXUhfkA9vuKEqEy2yjfFrC5lMdhzJnjZ+/cw/StlwaF2gEXf3VqsT+xzkV7Yy56J52fiRQzrYy+jJz0KRdL4xwmP4MDhXUEPd1cfzlBdAGuUFcCmlFvHT+dIEJxU1QtUxsOLGA2F/38J68d2NKoCTIDqTKfJ8Vq4NRxACT4oBrUxzJ5TO0pxbb8uZV5xZJyWNIroBc3+n28vxcu/ff/dEPPRdYDJ1P21G587BOPS2YO/0ndz/WN11iV8c3v14d2biwuQE2DaFMFXhP0sDSIg+FGsOlsIuDgNG15pLlZNnpQqqVcYBUhCeDGdJwFKCiJmFWVa9WntrT0ni9uAb+0AOG5/zNr7Cw4/LaZD0w4d7SnsRqZ0Sb5TPNQeJAoraQM874DnueEp8FkWggnettxBoOE0zEcZ/MP9DUztS7vmSb6G/G6w5hTnzqQzP54Cy6OE4X0OZyLXr5t69V7flenDmZD2/8LuWLroTfBMgLXKl3Do/BTekW9VmyhTRrDvtX3m0JHVHzJRcbqRWYAXhVyenZAfiTwVs9P4ZSY1vZ09yHKe5knVw/ZnPROQeekRgtklHOuyOzzgy1Va2cQNQvP7rL88au+xh+ZDPvf2KDymth0VCIzIXJ/oSRC6Y64TYLPJfPCB4XSAU2dudgJO0Hysq7b7kgPw+Ntp8VFkVymRuI1rxckwKeIfHPjONQRfT/PumSfyWqUfWaHbL/87AP+o/G4="
 }
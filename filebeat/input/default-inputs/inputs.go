@@ -20,6 +20,11 @@ package inputs
 import (
 	"github.com/elastic/beats/v7/filebeat/beater"
 	"github.com/elastic/beats/v7/filebeat/input/filestream"
+	"github.com/elastic/beats/v7/filebeat/input/fluentforward"
+	"github.com/elastic/beats/v7/filebeat/input/gelf"
+	"github.com/elastic/beats/v7/filebeat/input/lumberjack"
+	"github.com/elastic/beats/v7/filebeat/input/redisstreams"
+	"github.com/elastic/beats/v7/filebeat/input/snmptrap"
 	"github.com/elastic/beats/v7/filebeat/input/unix"
 	v2 "github.com/elastic/beats/v7/filebeat/input/v2"
 	"github.com/elastic/beats/v7/libbeat/beat"
@@ -36,6 +41,11 @@ func Init(info beat.Info, log *logp.Logger, components beater.StateStore) []v2.P
 func genericInputs(log *logp.Logger, components beater.StateStore) []v2.Plugin {
 	return []v2.Plugin{
 		filestream.Plugin(log, components),
+		fluentforward.Plugin(),
+		gelf.Plugin(),
+		lumberjack.Plugin(),
+		redisstreams.Plugin(),
+		snmptrap.Plugin(),
 		unix.Plugin(),
 	}
 }
@@ -0,0 +1,51 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fluentforward
+
+import (
+	"time"
+
+	"github.com/dustin/go-humanize"
+
+	"github.com/elastic/beats/v7/filebeat/inputsource/tcp"
+)
+
+type config struct {
+	tcp.Config `config:",inline"`
+
+	// MessageKey is the record key whose value is used as the event message.
+	MessageKey string `config:"message_key"`
+
+	// SharedKey enables the handshake phase of the forward protocol, clients
+	// must authenticate using the same key.
+	SharedKey string `config:"shared_key"`
+
+	// SelfHostname is the hostname sent to clients during the handshake,
+	// it defaults to the hostname of the machine.
+	SelfHostname string `config:"self_hostname"`
+}
+
+func defaultConfig() config {
+	return config{
+		Config: tcp.Config{
+			Timeout:        time.Minute * 5,
+			MaxMessageSize: 20 * humanize.MiByte,
+		},
+		MessageKey: "log",
+	}
+}
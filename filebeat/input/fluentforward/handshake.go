@@ -0,0 +1,98 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fluentforward
+
+import (
+	"crypto/rand"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ugorji/go/codec"
+)
+
+const nonceSize = 16
+
+// handshake authenticates a client using the shared key. The server sends a
+// HELO message with a random nonce, the client answers with a PING message
+// containing a digest of the shared key, and the server confirms the
+// authentication using a PONG message.
+func handshake(enc *codec.Encoder, dec *codec.Decoder, sharedKey, selfHostname string) error {
+	nonce := make([]byte, nonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return fmt.Errorf("failed to generate nonce: %v", err)
+	}
+
+	helo := []interface{}{"HELO", map[string]interface{}{
+		"nonce":     nonce,
+		"auth":      []byte{},
+		"keepalive": true,
+	}}
+	if err := enc.Encode(helo); err != nil {
+		return fmt.Errorf("failed to send HELO: %v", err)
+	}
+
+	var ping []interface{}
+	if err := dec.Decode(&ping); err != nil {
+		return fmt.Errorf("failed to read PING: %v", err)
+	}
+
+	clientHostname, salt, err := verifyPing(ping, nonce, sharedKey)
+	if err != nil {
+		pong := []interface{}{"PONG", false, err.Error(), "", ""}
+		enc.Encode(pong)
+		return err
+	}
+
+	pong := []interface{}{"PONG", true, "", selfHostname, digest(salt, selfHostname, nonce, sharedKey)}
+	if err := enc.Encode(pong); err != nil {
+		return fmt.Errorf("failed to send PONG to %s: %v", clientHostname, err)
+	}
+	return nil
+}
+
+// verifyPing validates a [PING, hostname, salt, digest, username, password]
+// message and returns the client hostname and salt.
+func verifyPing(ping []interface{}, nonce []byte, sharedKey string) (string, string, error) {
+	if len(ping) < 4 {
+		return "", "", fmt.Errorf("invalid PING message")
+	}
+	if kind, _ := toString(ping[0]); kind != "PING" {
+		return "", "", fmt.Errorf("expected PING message, got %v", kind)
+	}
+
+	hostname, _ := toString(ping[1])
+	salt, _ := toString(ping[2])
+	clientDigest, _ := toString(ping[3])
+
+	expected := digest(salt, hostname, nonce, sharedKey)
+	if subtle.ConstantTimeCompare([]byte(expected), []byte(clientDigest)) != 1 {
+		return "", "", fmt.Errorf("shared key mismatch")
+	}
+	return hostname, salt, nil
+}
+
+func digest(salt, hostname string, nonce []byte, sharedKey string) string {
+	h := sha512.New()
+	h.Write([]byte(salt))
+	h.Write([]byte(hostname))
+	h.Write(nonce)
+	h.Write([]byte(sharedKey))
+	return hex.EncodeToString(h.Sum(nil))
+}
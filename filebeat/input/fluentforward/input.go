@@ -0,0 +1,266 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fluentforward
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ugorji/go/codec"
+
+	input "github.com/elastic/beats/v7/filebeat/input/v2"
+	"github.com/elastic/beats/v7/filebeat/inputsource"
+	netcommon "github.com/elastic/beats/v7/filebeat/inputsource/common"
+	"github.com/elastic/beats/v7/filebeat/inputsource/tcp"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/acker"
+	"github.com/elastic/beats/v7/libbeat/common/atomic"
+	"github.com/elastic/beats/v7/libbeat/feature"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/go-concert/ctxtool"
+)
+
+const inputName = "fluent_forward"
+
+type server struct {
+	config
+}
+
+// Plugin creates the fluent_forward input plugin.
+func Plugin() input.Plugin {
+	return input.Plugin{
+		Name:       inputName,
+		Stability:  feature.Experimental,
+		Deprecated: false,
+		Info:       "Fluent Forward protocol server",
+		Manager:    input.ConfigureWith(configure),
+	}
+}
+
+func configure(cfg *common.Config) (input.Input, error) {
+	config := defaultConfig()
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, err
+	}
+
+	if config.SelfHostname == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve self_hostname: %v", err)
+		}
+		config.SelfHostname = hostname
+	}
+
+	return &server{config: config}, nil
+}
+
+func (s *server) Name() string { return inputName }
+
+func (s *server) Test(_ input.TestContext) error {
+	l, err := net.Listen("tcp", s.config.Host)
+	if err != nil {
+		return err
+	}
+	return l.Close()
+}
+
+func (s *server) Run(ctx input.Context, pipeline beat.PipelineConnector) error {
+	log := ctx.Logger.Named("input.fluent_forward").With("address", s.config.Host)
+
+	log.Info("Starting Fluent Forward input")
+	defer log.Info("Fluent Forward input stopped")
+
+	client, err := pipeline.ConnectWith(beat.ClientConfig{
+		CloseRef:   ctx.Cancelation,
+		ACKHandler: newACKHandler(),
+	})
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	server, err := tcp.New(&s.config.Config, s.handlerFactory(log, client))
+	if err != nil {
+		return err
+	}
+
+	err = server.Run(ctxtool.FromCanceller(ctx.Cancelation))
+
+	// ignore error from 'Run' in case shutdown was signaled.
+	if ctxerr := ctx.Cancelation.Err(); ctxerr != nil {
+		err = ctxerr
+	}
+	return err
+}
+
+func newACKHandler() beat.ACKer {
+	return acker.ConnectionOnly(
+		acker.EventPrivateReporter(func(_ int, privates []interface{}) {
+			for _, private := range privates {
+				if b, ok := private.(*batch); ok {
+					b.done()
+				}
+			}
+		}),
+	)
+}
+
+func (s *server) handlerFactory(log *logp.Logger, client beat.Client) netcommon.HandlerFactory {
+	return func(config netcommon.ListenerConfig) netcommon.ConnectionHandler {
+		return func(ctx context.Context, conn net.Conn) error {
+			c := &connection{
+				server:   s,
+				log:      log.With("remote_addr", conn.RemoteAddr().String()),
+				client:   client,
+				conn:     conn,
+				timeout:  config.Timeout,
+				metadata: tcp.MetadataCallback(conn),
+				handle:   newMsgpackHandle(),
+			}
+			return c.run(ctx, uint64(config.MaxMessageSize))
+		}
+	}
+}
+
+// connection handles the messages sent by a single client.
+type connection struct {
+	*server
+	log      *logp.Logger
+	client   beat.Client
+	conn     net.Conn
+	timeout  time.Duration
+	metadata inputsource.NetworkMetadata
+	handle   *codec.MsgpackHandle
+
+	// mu protects writes to the connection, acknowledgements are sent
+	// from the publisher pipeline.
+	mu  sync.Mutex
+	enc *codec.Encoder
+}
+
+func (c *connection) run(ctx context.Context, maxMessageSize uint64) error {
+	r := netcommon.NewResetableLimitedReader(netcommon.NewDeadlineReader(c.conn, c.timeout), maxMessageSize)
+	dec := codec.NewDecoder(bufio.NewReader(r), c.handle)
+	c.enc = codec.NewEncoder(c.conn, c.handle)
+
+	if c.SharedKey != "" {
+		if err := handshake(c.enc, dec, c.SharedKey, c.SelfHostname); err != nil {
+			c.log.Errorw("Fluent Forward handshake failed", "error", err)
+			return err
+		}
+	}
+
+	for ctx.Err() == nil {
+		var v interface{}
+		if err := dec.Decode(&v); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			if netcommon.IsMaxReadBufferErr(err) {
+				c.log.Errorw("Fluent Forward message exceeds max_message_size", "error", err)
+			}
+			return err
+		}
+		r.Reset()
+
+		msg, err := parseMessage(c.handle, v, maxMessageSize)
+		if err != nil {
+			c.log.Errorw("Invalid Fluent Forward message", "error", err)
+			return err
+		}
+		c.publish(msg)
+	}
+	return nil
+}
+
+func (c *connection) publish(msg *message) {
+	var b *batch
+	if msg.chunk != "" {
+		if len(msg.entries) == 0 {
+			c.ack(msg.chunk)
+			return
+		}
+		b = &batch{conn: c, chunk: msg.chunk}
+		b.pending.Store(int64(len(msg.entries)))
+	}
+
+	for _, e := range msg.entries {
+		event := createEvent(msg.tag, e, c.MessageKey, c.metadata)
+		if b != nil {
+			event.Private = b
+		}
+		c.client.Publish(event)
+	}
+}
+
+// ack sends the acknowledgement of a chunk to the client.
+func (c *connection) ack(chunk string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.conn.SetWriteDeadline(time.Now().Add(c.timeout))
+	if err := c.enc.Encode(map[string]interface{}{"ack": chunk}); err != nil {
+		c.log.Debugw("Failed to acknowledge chunk", "chunk", chunk, "error", err)
+	}
+}
+
+// batch tracks the events of a chunk, the chunk is acknowledged once all
+// its events have been acknowledged by the outputs.
+type batch struct {
+	conn    *connection
+	chunk   string
+	pending atomic.Int64
+}
+
+func (b *batch) done() {
+	if b.pending.Dec() == 0 {
+		b.conn.ack(b.chunk)
+	}
+}
+
+func createEvent(tag string, e entry, messageKey string, metadata inputsource.NetworkMetadata) beat.Event {
+	record := normalize(e.record).(map[string]interface{})
+
+	fields := common.MapStr{
+		"fluent": common.MapStr{
+			"tag": tag,
+		},
+	}
+	if msg, ok := record[messageKey].(string); ok {
+		fields["message"] = msg
+		delete(record, messageKey)
+	}
+	if len(record) > 0 {
+		fields.Put("fluent.record", common.MapStr(record))
+	}
+	if metadata.RemoteAddr != nil {
+		fields.Put("log.source.address", metadata.RemoteAddr.String())
+	}
+
+	return beat.Event{
+		Timestamp: e.timestamp,
+		Fields:    fields,
+	}
+}
@@ -0,0 +1,286 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fluentforward
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"reflect"
+	"time"
+
+	"github.com/ugorji/go/codec"
+)
+
+// Implementation of the Fluentd Forward Protocol Specification v1.
+// See https://github.com/fluent/fluentd/wiki/Forward-Protocol-Specification-v1
+
+// eventTimeExt is the msgpack extension type used to encode EventTime values.
+const eventTimeExt = 0
+
+// entry is a single record sent by a client.
+type entry struct {
+	timestamp time.Time
+	record    map[string]interface{}
+}
+
+// message is a decoded forward protocol message, independently of the mode
+// used by the client.
+type message struct {
+	tag     string
+	entries []entry
+
+	// chunk is the identifier the client expects to be acknowledged, it is
+	// empty when the client does not require acknowledgements.
+	chunk string
+}
+
+func newMsgpackHandle() *codec.MsgpackHandle {
+	h := &codec.MsgpackHandle{}
+	h.MapType = reflect.TypeOf(map[string]interface{}(nil))
+	h.RawToString = true
+	h.WriteExt = true
+	return h
+}
+
+// parseMessage parses a decoded msgpack value in one of the message, forward,
+// packed forward or compressed packed forward modes. Compressed entries are
+// limited to maxSize bytes once uncompressed.
+func parseMessage(h *codec.MsgpackHandle, v interface{}, maxSize uint64) (*message, error) {
+	arr, ok := v.([]interface{})
+	if !ok || len(arr) < 2 {
+		return nil, fmt.Errorf("invalid message, expected an array with at least 2 elements")
+	}
+
+	tag, ok := toString(arr[0])
+	if !ok {
+		return nil, fmt.Errorf("invalid message tag of type %T", arr[0])
+	}
+	msg := &message{tag: tag}
+
+	var option interface{}
+	switch entries := arr[1].(type) {
+	case []interface{}:
+		// Forward mode: [tag, [[time, record], ...], option]
+		for _, raw := range entries {
+			e, err := parseEntry(raw)
+			if err != nil {
+				return nil, err
+			}
+			msg.entries = append(msg.entries, e)
+		}
+		if len(arr) > 2 {
+			option = arr[2]
+		}
+
+	case string, []byte:
+		// (Compressed)PackedForward mode: [tag, msgpack stream of [time, record], option]
+		if len(arr) > 2 {
+			option = arr[2]
+		}
+		compressed, err := compression(option)
+		if err != nil {
+			return nil, err
+		}
+		stream, _ := toBytes(entries)
+		msg.entries, err = parseStream(h, stream, compressed, maxSize)
+		if err != nil {
+			return nil, err
+		}
+
+	default:
+		// Message mode: [tag, time, record, option]
+		if len(arr) < 3 {
+			return nil, fmt.Errorf("invalid message, missing record")
+		}
+		ts, err := parseTime(arr[1])
+		if err != nil {
+			return nil, err
+		}
+		record, ok := arr[2].(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("invalid record of type %T", arr[2])
+		}
+		msg.entries = []entry{{timestamp: ts, record: record}}
+		if len(arr) > 3 {
+			option = arr[3]
+		}
+	}
+
+	if opts, ok := option.(map[string]interface{}); ok {
+		if chunk, found := opts["chunk"]; found {
+			msg.chunk, _ = toString(chunk)
+		}
+	}
+	return msg, nil
+}
+
+func compression(option interface{}) (string, error) {
+	opts, ok := option.(map[string]interface{})
+	if !ok {
+		return "", nil
+	}
+	compressed, found := opts["compressed"]
+	if !found {
+		return "", nil
+	}
+	switch c, _ := toString(compressed); c {
+	case "", "text":
+		return "", nil
+	case "gzip":
+		return c, nil
+	default:
+		return "", fmt.Errorf("unsupported compression '%v'", c)
+	}
+}
+
+// parseStream decodes the entries of a packed forward message.
+func parseStream(h *codec.MsgpackHandle, stream []byte, compressed string, maxSize uint64) ([]entry, error) {
+	var r io.Reader = bytes.NewReader(stream)
+	if compressed == "gzip" {
+		// The stream can be made of multiple concatenated gzip members,
+		// which is the default behaviour of the gzip reader.
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress entries: %v", err)
+		}
+		defer gz.Close()
+
+		// Guard against decompression bombs by limiting the uncompressed size.
+		out, err := ioutil.ReadAll(io.LimitReader(gz, int64(maxSize)+1))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress entries: %v", err)
+		}
+		if uint64(len(out)) > maxSize {
+			return nil, fmt.Errorf("uncompressed entries exceed %d bytes", maxSize)
+		}
+		r = bytes.NewReader(out)
+	}
+
+	var entries []entry
+	dec := codec.NewDecoder(r, h)
+	for {
+		var raw interface{}
+		err := dec.Decode(&raw)
+		if err == io.EOF {
+			return entries, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode entries: %v", err)
+		}
+
+		e, err := parseEntry(raw)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, e)
+	}
+}
+
+// parseEntry parses a [time, record] pair.
+func parseEntry(v interface{}) (entry, error) {
+	arr, ok := v.([]interface{})
+	if !ok || len(arr) != 2 {
+		return entry{}, fmt.Errorf("invalid entry, expected a [time, record] array")
+	}
+	ts, err := parseTime(arr[0])
+	if err != nil {
+		return entry{}, err
+	}
+	record, ok := arr[1].(map[string]interface{})
+	if !ok {
+		return entry{}, fmt.Errorf("invalid record of type %T", arr[1])
+	}
+	return entry{timestamp: ts, record: record}, nil
+}
+
+// parseTime parses a time encoded either as seconds since epoch or as an
+// EventTime extension with nanosecond precision.
+func parseTime(v interface{}) (time.Time, error) {
+	switch t := v.(type) {
+	case int64:
+		return time.Unix(t, 0).UTC(), nil
+	case uint64:
+		return time.Unix(int64(t), 0).UTC(), nil
+	case float64:
+		sec, frac := math.Modf(t)
+		return time.Unix(int64(sec), int64(frac*1e9)).UTC(), nil
+	case codec.RawExt:
+		return parseEventTime(&t)
+	case *codec.RawExt:
+		return parseEventTime(t)
+	default:
+		return time.Time{}, fmt.Errorf("invalid time of type %T", v)
+	}
+}
+
+func parseEventTime(ext *codec.RawExt) (time.Time, error) {
+	if ext.Tag != eventTimeExt || len(ext.Data) != 8 {
+		return time.Time{}, fmt.Errorf("invalid EventTime extension")
+	}
+	sec := binary.BigEndian.Uint32(ext.Data[:4])
+	nsec := binary.BigEndian.Uint32(ext.Data[4:])
+	return time.Unix(int64(sec), int64(nsec)).UTC(), nil
+}
+
+func toString(v interface{}) (string, bool) {
+	switch s := v.(type) {
+	case string:
+		return s, true
+	case []byte:
+		return string(s), true
+	default:
+		return "", false
+	}
+}
+
+func toBytes(v interface{}) ([]byte, bool) {
+	switch b := v.(type) {
+	case string:
+		return []byte(b), true
+	case []byte:
+		return b, true
+	default:
+		return nil, false
+	}
+}
+
+// normalize converts binary values into strings so that records can be
+// serialized by the outputs.
+func normalize(v interface{}) interface{} {
+	switch t := v.(type) {
+	case []byte:
+		return string(t)
+	case map[string]interface{}:
+		for k, val := range t {
+			t[k] = normalize(val)
+		}
+		return t
+	case []interface{}:
+		for i, val := range t {
+			t[i] = normalize(val)
+		}
+		return t
+	default:
+		return v
+	}
+}
@@ -0,0 +1,254 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fluentforward
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/binary"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ugorji/go/codec"
+
+	"github.com/elastic/beats/v7/filebeat/inputsource"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+func encode(t *testing.T, v interface{}) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, codec.NewEncoder(&buf, newMsgpackHandle()).Encode(v))
+	return buf.Bytes()
+}
+
+func decodeMessage(t *testing.T, data []byte) (*message, error) {
+	h := newMsgpackHandle()
+	var v interface{}
+	require.NoError(t, codec.NewDecoder(bytes.NewReader(data), h).Decode(&v))
+	return parseMessage(h, v, 1024)
+}
+
+func eventTime(ts time.Time) codec.RawExt {
+	data := make([]byte, 8)
+	binary.BigEndian.PutUint32(data[:4], uint32(ts.Unix()))
+	binary.BigEndian.PutUint32(data[4:], uint32(ts.Nanosecond()))
+	return codec.RawExt{Tag: eventTimeExt, Data: data}
+}
+
+func TestParseMessage(t *testing.T) {
+	ts := time.Date(2020, 9, 1, 10, 0, 0, 123456789, time.UTC)
+	record := map[string]interface{}{"log": "hello", "stream": "stdout"}
+
+	packed := append(encode(t, []interface{}{ts.Unix(), record}), encode(t, []interface{}{eventTime(ts), record})...)
+	var compressed bytes.Buffer
+	gz := gzip.NewWriter(&compressed)
+	gz.Write(packed)
+	gz.Close()
+
+	// Entries compressing well above the 1024 bytes limit.
+	var bomb bytes.Buffer
+	gz = gzip.NewWriter(&bomb)
+	gz.Write(encode(t, []interface{}{ts.Unix(), map[string]interface{}{"log": string(make([]byte, 1<<20))}}))
+	gz.Close()
+
+	tests := map[string]struct {
+		message  interface{}
+		entries  int
+		chunk    string
+		precise  bool
+		hasError bool
+	}{
+		"message mode": {
+			message: []interface{}{"app", ts.Unix(), record},
+			entries: 1,
+		},
+		"message mode with event time and chunk": {
+			message: []interface{}{"app", eventTime(ts), record, map[string]interface{}{"chunk": "c1"}},
+			entries: 1,
+			chunk:   "c1",
+			precise: true,
+		},
+		"forward mode": {
+			message: []interface{}{"app", []interface{}{
+				[]interface{}{eventTime(ts), record},
+				[]interface{}{eventTime(ts), record},
+			}, map[string]interface{}{"chunk": "c2"}},
+			entries: 2,
+			chunk:   "c2",
+			precise: true,
+		},
+		"packed forward mode": {
+			message: []interface{}{"app", packed},
+			entries: 2,
+		},
+		"compressed packed forward mode": {
+			message: []interface{}{"app", compressed.Bytes(), map[string]interface{}{"compressed": "gzip", "chunk": "c3"}},
+			entries: 2,
+			chunk:   "c3",
+		},
+		"compressed entries too large": {
+			message:  []interface{}{"app", bomb.Bytes(), map[string]interface{}{"compressed": "gzip"}},
+			hasError: true,
+		},
+		"unsupported compression": {
+			message:  []interface{}{"app", compressed.Bytes(), map[string]interface{}{"compressed": "zstd"}},
+			hasError: true,
+		},
+		"invalid message": {
+			message:  map[string]interface{}{"tag": "app"},
+			hasError: true,
+		},
+		"missing record": {
+			message:  []interface{}{"app", ts.Unix()},
+			hasError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			msg, err := decodeMessage(t, encode(t, test.message))
+			if test.hasError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "app", msg.tag)
+			assert.Equal(t, test.chunk, msg.chunk)
+			require.Len(t, msg.entries, test.entries)
+			for _, e := range msg.entries {
+				assert.Equal(t, "hello", e.record["log"])
+				assert.Equal(t, ts.Unix(), e.timestamp.Unix())
+			}
+			if test.precise {
+				assert.Equal(t, ts, msg.entries[0].timestamp)
+			}
+		})
+	}
+}
+
+func TestCreateEvent(t *testing.T) {
+	ts := time.Date(2020, 9, 1, 10, 0, 0, 0, time.UTC)
+	e := entry{
+		timestamp: ts,
+		record: map[string]interface{}{
+			"log":        "hello",
+			"kubernetes": map[string]interface{}{"pod_name": []byte("web-1")},
+		},
+	}
+
+	event := createEvent("kube.app", e, "log", inputsourceMetadata())
+	assert.Equal(t, ts, event.Timestamp)
+	assert.Equal(t, common.MapStr{
+		"message": "hello",
+		"fluent": common.MapStr{
+			"tag": "kube.app",
+			"record": common.MapStr{
+				"kubernetes": map[string]interface{}{"pod_name": "web-1"},
+			},
+		},
+		"log": common.MapStr{
+			"source": common.MapStr{
+				"address": "127.0.0.1:24224",
+			},
+		},
+	}, event.Fields)
+}
+
+// ackingClient acknowledges events as soon as they are published.
+type ackingClient struct {
+	events []beat.Event
+	acker  beat.ACKer
+}
+
+func (c *ackingClient) Publish(event beat.Event) {
+	c.events = append(c.events, event)
+	c.acker.AddEvent(event, true)
+	c.acker.ACKEvents(1)
+}
+
+func (c *ackingClient) PublishAll(events []beat.Event) {
+	for _, event := range events {
+		c.Publish(event)
+	}
+}
+
+func (c *ackingClient) Close() error { return nil }
+
+func TestConnection(t *testing.T) {
+	const sharedKey = "secret"
+
+	serverConn, clientConn := net.Pipe()
+	client := &ackingClient{acker: newACKHandler()}
+	c := &connection{
+		server:   &server{config: config{MessageKey: "log", SharedKey: sharedKey, SelfHostname: "filebeat"}},
+		log:      logp.NewLogger("test"),
+		client:   client,
+		conn:     serverConn,
+		timeout:  time.Second,
+		metadata: inputsourceMetadata(),
+		handle:   newMsgpackHandle(),
+	}
+
+	done := make(chan error, 1)
+	go func() { done <- c.run(context.Background(), 1024) }()
+
+	h := newMsgpackHandle()
+	enc := codec.NewEncoder(clientConn, h)
+	dec := codec.NewDecoder(clientConn, h)
+
+	var helo []interface{}
+	require.NoError(t, dec.Decode(&helo))
+	require.Equal(t, "HELO", helo[0])
+	nonce, _ := toBytes(helo[1].(map[string]interface{})["nonce"])
+
+	require.NoError(t, enc.Encode([]interface{}{"PING", "fluent-bit", "salt", digest("salt", "fluent-bit", nonce, sharedKey), "", ""}))
+	var pong []interface{}
+	require.NoError(t, dec.Decode(&pong))
+	assert.Equal(t, []interface{}{"PONG", true, "", "filebeat", digest("salt", "filebeat", nonce, sharedKey)}, pong)
+
+	record := map[string]interface{}{"log": "hello"}
+	require.NoError(t, enc.Encode([]interface{}{"app", time.Now().Unix(), record, map[string]interface{}{"chunk": "abc"}}))
+
+	var ack map[string]interface{}
+	require.NoError(t, dec.Decode(&ack))
+	assert.Equal(t, map[string]interface{}{"ack": "abc"}, ack)
+	require.Len(t, client.events, 1)
+	assert.Equal(t, "hello", client.events[0].Fields["message"])
+
+	clientConn.Close()
+	assert.NoError(t, <-done)
+}
+
+func TestHandshakeSharedKeyMismatch(t *testing.T) {
+	nonce := []byte("0123456789abcdef")
+	ping := []interface{}{"PING", "fluent-bit", "salt", digest("salt", "fluent-bit", nonce, "wrong"), "", ""}
+	_, _, err := verifyPing(ping, nonce, "secret")
+	assert.Error(t, err)
+}
+
+func inputsourceMetadata() inputsource.NetworkMetadata {
+	return inputsource.NetworkMetadata{
+		RemoteAddr: &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 24224},
+	}
+}
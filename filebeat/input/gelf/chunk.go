@@ -0,0 +1,176 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package gelf
+
+import (
+	"bytes"
+	"container/list"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Chunked GELF messages start with the magic bytes followed by a 8 bytes message id,
+// the sequence number and the sequence count of the chunk.
+// See https://docs.graylog.org/en/latest/pages/gelf.html#chunking
+const (
+	chunkHeaderSize  = 12
+	maxChunksCount   = 128
+	chunkMagicFirst  = 0x1e
+	chunkMagicSecond = 0x0f
+)
+
+// isChunked returns true when the datagram is a chunk of a bigger message.
+func isChunked(data []byte) bool {
+	return len(data) >= 2 && data[0] == chunkMagicFirst && data[1] == chunkMagicSecond
+}
+
+type chunkedMessage struct {
+	id       string
+	chunks   [][]byte
+	received int
+	size     int
+	deadline time.Time
+	elem     *list.Element
+}
+
+// assembler reassembles chunked GELF messages. Incomplete messages are dropped once
+// the timeout is reached, or when there are more than maxPending messages or maxBytes
+// buffered bytes, starting with the oldest ones.
+type assembler struct {
+	mu         sync.Mutex
+	timeout    time.Duration
+	maxPending int
+	maxBytes   int
+	messages   map[string]*chunkedMessage
+	order      *list.List // pending messages, oldest first
+	bytes      int
+	lastSweep  time.Time
+	now        func() time.Time
+}
+
+func newAssembler(timeout time.Duration, maxPending, maxBytes int) *assembler {
+	return &assembler{
+		timeout:    timeout,
+		maxPending: maxPending,
+		maxBytes:   maxBytes,
+		messages:   map[string]*chunkedMessage{},
+		order:      list.New(),
+		now:        time.Now,
+	}
+}
+
+// add adds a chunk to the assembler, the complete message is returned once all the chunks
+// have been received, until then a nil slice is returned.
+func (a *assembler) add(data []byte) ([]byte, error) {
+	if len(data) < chunkHeaderSize {
+		return nil, fmt.Errorf("chunk too small, got %d bytes", len(data))
+	}
+
+	id := string(data[2:10])
+	seq, count := int(data[10]), int(data[11])
+	if count == 0 || count > maxChunksCount {
+		return nil, fmt.Errorf("invalid chunk count %d", count)
+	}
+	if seq >= count {
+		return nil, fmt.Errorf("invalid chunk sequence number %d for %d chunks", seq, count)
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	now := a.now()
+	a.sweep(now)
+
+	msg, found := a.messages[id]
+	if found && now.After(msg.deadline) {
+		a.remove(msg)
+		found = false
+	}
+	if !found {
+		msg = &chunkedMessage{
+			id:       id,
+			chunks:   make([][]byte, count),
+			deadline: now.Add(a.timeout),
+		}
+		msg.elem = a.order.PushBack(msg)
+		a.messages[id] = msg
+	}
+	if len(msg.chunks) != count {
+		a.remove(msg)
+		return nil, fmt.Errorf("chunk count mismatch, expected %d got %d", len(msg.chunks), count)
+	}
+	if msg.chunks[seq] != nil {
+		// duplicated chunk, keep the first one.
+		return nil, nil
+	}
+
+	payload := make([]byte, len(data)-chunkHeaderSize)
+	copy(payload, data[chunkHeaderSize:])
+	msg.chunks[seq] = payload
+	msg.received++
+	msg.size += len(payload)
+	a.bytes += len(payload)
+
+	if msg.received < count {
+		a.evict(msg)
+		return nil, nil
+	}
+
+	a.remove(msg)
+	buf := bytes.NewBuffer(make([]byte, 0, msg.size))
+	for _, chunk := range msg.chunks {
+		buf.Write(chunk)
+	}
+	return buf.Bytes(), nil
+}
+
+// sweep removes the expired messages, it runs at most once per timeout period.
+func (a *assembler) sweep(now time.Time) {
+	if now.Sub(a.lastSweep) < a.timeout {
+		return
+	}
+	a.lastSweep = now
+
+	for _, msg := range a.messages {
+		if now.After(msg.deadline) {
+			a.remove(msg)
+		}
+	}
+}
+
+// evict drops the oldest pending messages while over the limits. The message being
+// assembled is only dropped when it alone is over the bytes limit.
+func (a *assembler) evict(current *chunkedMessage) {
+	for len(a.messages) > a.maxPending || a.bytes > a.maxBytes {
+		oldest := a.order.Front().Value.(*chunkedMessage)
+		if oldest == current && len(a.messages) > 1 {
+			oldest = a.order.Front().Next().Value.(*chunkedMessage)
+		}
+		a.remove(oldest)
+		if oldest == current {
+			return
+		}
+	}
+}
+
+func (a *assembler) remove(msg *chunkedMessage) {
+	delete(a.messages, msg.id)
+	a.order.Remove(msg.elem)
+	a.bytes -= msg.size
+}
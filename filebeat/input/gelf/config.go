@@ -0,0 +1,88 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package gelf
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/dustin/go-humanize"
+
+	netcommon "github.com/elastic/beats/v7/filebeat/inputsource/common"
+	"github.com/elastic/beats/v7/filebeat/inputsource/tcp"
+	"github.com/elastic/beats/v7/filebeat/inputsource/udp"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/cfgtype"
+)
+
+type config struct {
+	Protocol common.ConfigNamespace `config:"protocol"`
+
+	// ChunkTimeout is the time to wait for all the chunks of a chunked UDP
+	// message before discarding it.
+	ChunkTimeout time.Duration `config:"chunk_timeout" validate:"positive,nonzero"`
+
+	// MaxPendingMessages and MaxPendingBytes limit the incomplete chunked UDP
+	// messages kept in memory, the oldest ones are dropped when over the limits.
+	MaxPendingMessages int              `config:"max_pending_messages" validate:"positive,nonzero"`
+	MaxPendingBytes    cfgtype.ByteSize `config:"max_pending_bytes" validate:"positive,nonzero"`
+}
+
+type gelfTCP struct {
+	tcp.Config `config:",inline"`
+	// LineDelimiter is only used when framing is set to delimiter or rfc6587.
+	LineDelimiter string `config:"line_delimiter" validate:"nonzero"`
+}
+
+func defaultConfig() config {
+	return config{
+		ChunkTimeout:       5 * time.Second,
+		MaxPendingMessages: 1000,
+		MaxPendingBytes:    64 * humanize.MiByte,
+	}
+}
+
+func defaultTCP() gelfTCP {
+	return gelfTCP{
+		Config: tcp.Config{
+			Timeout:        time.Minute * 5,
+			MaxMessageSize: 20 * humanize.MiByte,
+			Framing:        netcommon.FramingNullTerminated,
+		},
+		LineDelimiter: "\n",
+	}
+}
+
+func defaultUDP() udp.Config {
+	return udp.Config{
+		// Chunked GELF messages are limited to 8192 bytes per datagram.
+		MaxMessageSize: 10 * humanize.KiByte,
+		Timeout:        time.Minute * 5,
+	}
+}
+
+func (c *config) Validate() error {
+	switch name := c.Protocol.Name(); name {
+	case tcp.Name, udp.Name:
+		return nil
+	case "":
+		return fmt.Errorf("need to specify the protocol, either tcp or udp")
+	default:
+		return fmt.Errorf("unsupported protocol '%s', you must choose between tcp or udp", name)
+	}
+}
@@ -0,0 +1,196 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package gelf
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/v7/filebeat/inputsource"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+// severityNames are the syslog severity names used by the GELF level field,
+// they are used to populate log.level.
+var severityNames = []string{
+	"emergency",
+	"alert",
+	"critical",
+	"error",
+	"warning",
+	"notice",
+	"informational",
+	"debug",
+}
+
+// dockerFields maps the additional fields sent by the Docker gelf log driver to ECS.
+var dockerFields = map[string]string{
+	"_container_id":   "container.id",
+	"_container_name": "container.name",
+	"_image_id":       "container.image.id",
+	"_image_name":     "container.image.name",
+	"_tag":            "container.tag",
+	"_command":        "process.command_line",
+}
+
+// decompress detects the compression of a GELF payload using its magic bytes and returns the
+// uncompressed payload. Uncompressed payloads are returned as is.
+func decompress(data []byte, maxSize int64) ([]byte, error) {
+	var r io.ReadCloser
+	var err error
+	switch {
+	case len(data) >= 2 && data[0] == 0x1f && data[1] == 0x8b:
+		r, err = gzip.NewReader(bytes.NewReader(data))
+	case len(data) >= 2 && data[0] == 0x78 && (uint16(data[0])<<8|uint16(data[1]))%31 == 0:
+		r, err = zlib.NewReader(bytes.NewReader(data))
+	default:
+		return data, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	// Guard against decompression bombs by limiting the uncompressed size.
+	out, err := ioutil.ReadAll(io.LimitReader(r, maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(out)) > maxSize {
+		return nil, fmt.Errorf("uncompressed message exceeds %d bytes", maxSize)
+	}
+	return out, nil
+}
+
+// decode parses a GELF message, payloads can be compressed using zlib or gzip.
+func decode(data []byte, maxSize int64) (common.MapStr, error) {
+	raw, err := decompress(data, maxSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress GELF message: %v", err)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var msg map[string]interface{}
+	if err := dec.Decode(&msg); err != nil {
+		return nil, fmt.Errorf("failed to decode GELF message: %v", err)
+	}
+	return msg, nil
+}
+
+// createEvent maps a decoded GELF message to an ECS event.
+func createEvent(msg common.MapStr, metadata inputsource.NetworkMetadata) beat.Event {
+	event := beat.Event{
+		Timestamp: time.Now(),
+		Fields:    common.MapStr{},
+	}
+
+	gelf := common.MapStr{}
+	additional := common.MapStr{}
+	for k, v := range msg {
+		switch k {
+		case "version":
+			gelf["version"] = v
+		case "host":
+			event.Fields.Put("host.hostname", v)
+		case "short_message":
+			event.Fields["message"] = v
+		case "full_message":
+			gelf["full_message"] = v
+		case "timestamp":
+			if ts, ok := toFloat(v); ok {
+				sec, frac := math.Modf(ts)
+				event.Timestamp = time.Unix(int64(sec), int64(frac*1e9)).UTC()
+			}
+		case "level":
+			if level, ok := toFloat(v); ok {
+				code := int(level)
+				event.Fields.Put("log.syslog.severity.code", code)
+				if code >= 0 && code < len(severityNames) {
+					event.Fields.Put("log.syslog.severity.name", severityNames[code])
+					event.Fields.Put("log.level", severityNames[code])
+				}
+			}
+		case "facility":
+			event.Fields.Put("log.syslog.facility.name", v)
+		case "file":
+			event.Fields.Put("log.origin.file.name", v)
+		case "line":
+			if line, ok := toFloat(v); ok {
+				event.Fields.Put("log.origin.file.line", int64(line))
+			}
+		default:
+			if !strings.HasPrefix(k, "_") || k == "_id" {
+				continue
+			}
+			if field, found := dockerFields[k]; found {
+				event.Fields.Put(field, v)
+				continue
+			}
+			// Additional fields can contain dots, avoid expanding them into objects.
+			additional[strings.TrimPrefix(k, "_")] = toValue(v)
+		}
+	}
+
+	if len(additional) > 0 {
+		gelf["additional"] = additional
+	}
+	if len(gelf) > 0 {
+		event.Fields["gelf"] = gelf
+	}
+	if metadata.RemoteAddr != nil {
+		event.Fields.Put("log.source.address", metadata.RemoteAddr.String())
+	}
+	return event
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	case float64:
+		return n, true
+	default:
+		return 0, false
+	}
+}
+
+// toValue converts json numbers into int64 when possible or float64 otherwise.
+func toValue(v interface{}) interface{} {
+	n, ok := v.(json.Number)
+	if !ok {
+		return v
+	}
+	if i, err := n.Int64(); err == nil {
+		return i
+	}
+	if f, err := n.Float64(); err == nil {
+		return f
+	}
+	return n.String()
+}
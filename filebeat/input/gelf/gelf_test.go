@@ -0,0 +1,218 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package gelf
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/filebeat/inputsource"
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+const dockerMessage = `{
+	"version": "1.1",
+	"host": "docker-host",
+	"short_message": "hello world",
+	"full_message": "hello world\nstack trace",
+	"timestamp": 1600000000.5,
+	"level": 3,
+	"_container_id": "abc123",
+	"_container_name": "web",
+	"_image_name": "nginx:latest",
+	"_request.id": "r-1",
+	"_count": 42,
+	"_id": "ignored"
+}`
+
+func TestDecode(t *testing.T) {
+	var zbuf, gbuf bytes.Buffer
+	zw := zlib.NewWriter(&zbuf)
+	zw.Write([]byte(dockerMessage))
+	zw.Close()
+	gw := gzip.NewWriter(&gbuf)
+	gw.Write([]byte(dockerMessage))
+	gw.Close()
+
+	for name, payload := range map[string][]byte{
+		"uncompressed": []byte(dockerMessage),
+		"zlib":         zbuf.Bytes(),
+		"gzip":         gbuf.Bytes(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			msg, err := decode(payload, 1024)
+			require.NoError(t, err)
+			assert.Equal(t, "hello world", msg["short_message"])
+		})
+	}
+
+	t.Run("decompressed size is limited", func(t *testing.T) {
+		_, err := decode(zbuf.Bytes(), 16)
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		_, err := decode([]byte("not gelf"), 1024)
+		assert.Error(t, err)
+	})
+}
+
+func TestCreateEvent(t *testing.T) {
+	msg, err := decode([]byte(dockerMessage), 1024)
+	require.NoError(t, err)
+
+	addr := &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 12201}
+	event := createEvent(msg, inputsource.NetworkMetadata{RemoteAddr: addr})
+
+	assert.Equal(t, time.Unix(1600000000, 500000000).UTC(), event.Timestamp)
+	assert.Equal(t, common.MapStr{
+		"message": "hello world",
+		"host": common.MapStr{
+			"hostname": "docker-host",
+		},
+		"log": common.MapStr{
+			"level": "error",
+			"syslog": common.MapStr{
+				"severity": common.MapStr{
+					"code": 3,
+					"name": "error",
+				},
+			},
+			"source": common.MapStr{
+				"address": "127.0.0.1:12201",
+			},
+		},
+		"container": common.MapStr{
+			"id":   "abc123",
+			"name": "web",
+			"image": common.MapStr{
+				"name": "nginx:latest",
+			},
+		},
+		"gelf": common.MapStr{
+			"version":      "1.1",
+			"full_message": "hello world\nstack trace",
+			"additional": common.MapStr{
+				"request.id": "r-1",
+				"count":      int64(42),
+			},
+		},
+	}, event.Fields)
+}
+
+func chunk(id string, seq, count byte, payload string) []byte {
+	data := []byte{chunkMagicFirst, chunkMagicSecond}
+	data = append(data, id...)
+	data = append(data, seq, count)
+	return append(data, payload...)
+}
+
+func TestAssembler(t *testing.T) {
+	t.Run("reassemble out of order chunks", func(t *testing.T) {
+		a := newAssembler(time.Second, 10, 1024)
+		msg, err := a.add(chunk("id000001", 1, 3, "lo wo"))
+		require.NoError(t, err)
+		assert.Nil(t, msg)
+		msg, err = a.add(chunk("id000001", 0, 3, "hel"))
+		require.NoError(t, err)
+		assert.Nil(t, msg)
+		msg, err = a.add(chunk("id000001", 2, 3, "rld"))
+		require.NoError(t, err)
+		assert.Equal(t, "hello world", string(msg))
+		assert.Empty(t, a.messages)
+	})
+
+	t.Run("invalid chunks", func(t *testing.T) {
+		a := newAssembler(time.Second, 10, 1024)
+		_, err := a.add(chunk("id000001", 0, 0, "x"))
+		assert.Error(t, err)
+		_, err = a.add(chunk("id000001", 3, 2, "x"))
+		assert.Error(t, err)
+		_, err = a.add(chunk("id000001", 0, 129, "x"))
+		assert.Error(t, err)
+		_, err = a.add([]byte{chunkMagicFirst, chunkMagicSecond, 1})
+		assert.Error(t, err)
+	})
+
+	t.Run("incomplete messages expire", func(t *testing.T) {
+		now := time.Now()
+		a := newAssembler(time.Second, 10, 1024)
+		a.now = func() time.Time { return now }
+
+		_, err := a.add(chunk("id000001", 0, 2, "hello"))
+		require.NoError(t, err)
+		assert.Len(t, a.messages, 1)
+
+		now = now.Add(2 * time.Second)
+		_, err = a.add(chunk("id000002", 0, 2, "other"))
+		require.NoError(t, err)
+		assert.Len(t, a.messages, 1)
+		assert.Contains(t, a.messages, "id000002")
+	})
+	t.Run("oldest messages dropped when over the pending messages limit", func(t *testing.T) {
+		a := newAssembler(time.Second, 2, 1024)
+		for _, id := range []string{"id000001", "id000002", "id000003"} {
+			_, err := a.add(chunk(id, 0, 2, "hello"))
+			require.NoError(t, err)
+		}
+		assert.Len(t, a.messages, 2)
+		assert.NotContains(t, a.messages, "id000001")
+
+		msg, err := a.add(chunk("id000002", 1, 2, " world"))
+		require.NoError(t, err)
+		assert.Equal(t, "hello world", string(msg))
+		assert.Equal(t, 1, a.order.Len())
+		assert.Equal(t, 5, a.bytes)
+	})
+
+	t.Run("oldest messages dropped when over the pending bytes limit", func(t *testing.T) {
+		a := newAssembler(time.Second, 10, 12)
+		_, err := a.add(chunk("id000001", 0, 4, "hello"))
+		require.NoError(t, err)
+		_, err = a.add(chunk("id000002", 0, 4, "world"))
+		require.NoError(t, err)
+		assert.Len(t, a.messages, 2)
+
+		// Adding to the oldest message drops the other one.
+		_, err = a.add(chunk("id000001", 1, 4, "hello"))
+		require.NoError(t, err)
+		assert.Len(t, a.messages, 1)
+		assert.Contains(t, a.messages, "id000001")
+		assert.Equal(t, 10, a.bytes)
+
+		// An incomplete message over the limit on its own is dropped.
+		msg, err := a.add(chunk("id000001", 2, 4, "hello"))
+		require.NoError(t, err)
+		assert.Nil(t, msg)
+		assert.Empty(t, a.messages)
+		assert.Equal(t, 0, a.bytes)
+
+		// Its last chunk can't complete it anymore.
+		msg, err = a.add(chunk("id000001", 3, 4, "hello"))
+		require.NoError(t, err)
+		assert.Nil(t, msg)
+		assert.Equal(t, 5, a.bytes)
+	})
+}
@@ -0,0 +1,145 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package gelf
+
+import (
+	"fmt"
+
+	input "github.com/elastic/beats/v7/filebeat/input/v2"
+	stateless "github.com/elastic/beats/v7/filebeat/input/v2/input-stateless"
+	"github.com/elastic/beats/v7/filebeat/inputsource"
+	netcommon "github.com/elastic/beats/v7/filebeat/inputsource/common"
+	"github.com/elastic/beats/v7/filebeat/inputsource/tcp"
+	"github.com/elastic/beats/v7/filebeat/inputsource/udp"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/feature"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+type server struct {
+	config
+}
+
+// Plugin creates the gelf input plugin.
+func Plugin() input.Plugin {
+	return input.Plugin{
+		Name:       "gelf",
+		Stability:  feature.Experimental,
+		Deprecated: false,
+		Info:       "GELF server",
+		Manager:    stateless.NewInputManager(configure),
+	}
+}
+
+func configure(cfg *common.Config) (stateless.Input, error) {
+	config := defaultConfig()
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, err
+	}
+
+	return &server{config: config}, nil
+}
+
+func (s *server) Name() string { return "gelf" }
+
+func (s *server) Test(_ input.TestContext) error {
+	return nil
+}
+
+func (s *server) Run(ctx input.Context, publisher stateless.Publisher) error {
+	log := ctx.Logger.Named("input.gelf").With("protocol", s.Protocol.Name())
+
+	log.Info("Starting GELF input")
+	defer log.Info("GELF input stopped")
+
+	server, err := s.newNetworkServer(log, publisher)
+	if err != nil {
+		return err
+	}
+
+	if err := server.Start(); err != nil {
+		return err
+	}
+	log.Debugf("GELF Input '%v' initialized", ctx.ID)
+
+	<-ctx.Cancelation.Done()
+	server.Stop()
+	return nil
+}
+
+func (s *server) newNetworkServer(log *logp.Logger, publisher stateless.Publisher) (inputsource.Network, error) {
+	n, cfg := s.Protocol.Name(), s.Protocol.Config()
+
+	switch n {
+	case tcp.Name:
+		config := defaultTCP()
+		if err := cfg.Unpack(&config); err != nil {
+			return nil, err
+		}
+
+		splitFunc, err := netcommon.FramingSplitFunc(config.Framing, []byte(config.LineDelimiter), uint64(config.MaxMessageSize))
+		if err != nil {
+			return nil, fmt.Errorf("error creating splitFunc from framing %s: %v", config.Framing, err)
+		}
+
+		log = log.With("address", config.Host)
+		cb := s.callback(log, publisher, int64(config.MaxMessageSize), nil)
+		factory := netcommon.SplitHandlerFactory(netcommon.FamilyTCP, log, tcp.MetadataCallback, cb, splitFunc)
+		return tcp.New(&config.Config, factory)
+
+	case udp.Name:
+		config := defaultUDP()
+		if err := cfg.Unpack(&config); err != nil {
+			return nil, err
+		}
+
+		log = log.With("address", config.Host)
+		// Uncompressed messages are bounded by the maximum number of chunks.
+		maxSize := int64(config.MaxMessageSize) * maxChunksCount
+		cb := s.callback(log, publisher, maxSize, newAssembler(s.ChunkTimeout, s.MaxPendingMessages, int(s.MaxPendingBytes)))
+		return udp.New(&config, cb), nil
+
+	default:
+		return nil, fmt.Errorf("you must choose between TCP or UDP")
+	}
+}
+
+// callback returns the network callback decoding GELF messages. Chunked messages are
+// only supported when an assembler is given.
+func (s *server) callback(log *logp.Logger, publisher stateless.Publisher, maxSize int64, chunks *assembler) inputsource.NetworkFunc {
+	return func(data []byte, metadata inputsource.NetworkMetadata) {
+		if chunks != nil && isChunked(data) {
+			var err error
+			data, err = chunks.add(data)
+			if err != nil {
+				log.Errorw("Dropping invalid GELF chunk", "error", err)
+				return
+			}
+			if data == nil {
+				return
+			}
+		}
+
+		msg, err := decode(data, maxSize)
+		if err != nil {
+			log.Errorw("Dropping invalid GELF message", "error", err)
+			return
+		}
+		publisher.Publish(createEvent(msg, metadata))
+	}
+}
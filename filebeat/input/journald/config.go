@@ -46,7 +46,17 @@ type config struct {
 	CursorSeekFallback journalread.SeekMode `config:"cursor_seek_fallback"`
 
 	// Matches store the key value pairs to match entries.
-	Matches []journalfield.Matcher `config:"include_matches"`
+	Matches journalfield.IncludeMatches `config:"include_matches"`
+
+	// Units restricts the entries to the ones of the listed systemd units.
+	Units []string `config:"units"`
+
+	// SyslogIdentifiers restricts the entries to the ones with the listed
+	// syslog identifiers.
+	SyslogIdentifiers []string `config:"syslog_identifiers"`
+
+	// Priority restricts the entries to a range of priorities.
+	Priority *journalfield.PriorityRange `config:"priority"`
 
 	// SaveRemoteHostname defines if the original source of the entry needs to be saved.
 	SaveRemoteHostname bool `config:"save_remote_hostname"`
@@ -70,3 +80,17 @@ func (c *config) Validate() error {
 	}
 	return nil
 }
+
+// includeMatches combines the include_matches expression with the units,
+// syslog identifiers and priority filters.
+func (c *config) includeMatches() journalfield.IncludeMatches {
+	matches := journalfield.IncludeMatches{
+		Units:             c.Units,
+		SyslogIdentifiers: c.SyslogIdentifiers,
+		Priority:          c.Priority,
+	}
+	if !c.Matches.IsEmpty() {
+		matches.AND = []journalfield.IncludeMatches{c.Matches}
+	}
+	return matches
+}
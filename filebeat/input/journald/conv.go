@@ -20,10 +20,12 @@
 package journald
 
 import (
+	"strconv"
 	"time"
 
 	"github.com/elastic/beats/v7/journalbeat/pkg/journalfield"
 	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
 	"github.com/elastic/beats/v7/libbeat/logp"
 )
 
@@ -37,6 +39,7 @@ func eventFromFields(
 	c := journalfield.NewConverter(log, nil)
 	fields := c.Convert(entryFields)
 	fields.Put("event.kind", "event")
+	addSyslogNames(fields, entryFields)
 
 	// if entry is coming from a remote journal, add_host_metadata overwrites the source hostname, so it
 	// has to be copied to a different field
@@ -55,3 +58,22 @@ func eventFromFields(
 		Fields:    fields,
 	}
 }
+
+// addSyslogNames adds the codes and names of the syslog facility and priority
+// of the entry.
+func addSyslogNames(fields common.MapStr, entryFields map[string]string) {
+	if facility, err := strconv.Atoi(entryFields["SYSLOG_FACILITY"]); err == nil {
+		fields.Put("log.syslog.facility.code", facility)
+		if name, ok := journalfield.FacilityName(facility); ok {
+			fields.Put("log.syslog.facility.name", name)
+		} else {
+			fields.Delete("log.syslog.facility.name")
+		}
+	}
+	if priority, err := strconv.Atoi(entryFields["PRIORITY"]); err == nil {
+		fields.Put("log.syslog.severity.code", priority)
+		if name, ok := journalfield.PriorityName(priority); ok {
+			fields.Put("log.syslog.severity.name", name)
+		}
+	}
+}
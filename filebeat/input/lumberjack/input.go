@@ -0,0 +1,227 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package lumberjack
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/elastic/go-lumber/lj"
+	lumber "github.com/elastic/go-lumber/server/v2"
+	"golang.org/x/net/netutil"
+
+	input "github.com/elastic/beats/v7/filebeat/input/v2"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/acker"
+	"github.com/elastic/beats/v7/libbeat/common/atomic"
+	"github.com/elastic/beats/v7/libbeat/common/jsontransform"
+	"github.com/elastic/beats/v7/libbeat/common/transport/tlscommon"
+	"github.com/elastic/beats/v7/libbeat/feature"
+)
+
+const inputName = "lumberjack"
+
+type server struct {
+	config
+	tlsConfig *tlscommon.TLSConfig
+}
+
+// Plugin creates the lumberjack input plugin.
+func Plugin() input.Plugin {
+	return input.Plugin{
+		Name:       inputName,
+		Stability:  feature.Experimental,
+		Deprecated: false,
+		Info:       "Lumberjack v2 (Beats protocol) server",
+		Manager:    input.ConfigureWith(configure),
+	}
+}
+
+func configure(cfg *common.Config) (input.Input, error) {
+	config := defaultConfig()
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, err
+	}
+
+	tlsConfig, err := tlscommon.LoadTLSServerConfig(config.TLS)
+	if err != nil {
+		return nil, err
+	}
+
+	return &server{config: config, tlsConfig: tlsConfig}, nil
+}
+
+func (s *server) Name() string { return inputName }
+
+func (s *server) Test(_ input.TestContext) error {
+	l, err := net.Listen("tcp", s.Host)
+	if err != nil {
+		return err
+	}
+	return l.Close()
+}
+
+func (s *server) Run(ctx input.Context, pipeline beat.PipelineConnector) error {
+	log := ctx.Logger.Named("input.lumberjack").With("address", s.Host)
+
+	log.Info("Starting Lumberjack input")
+	defer log.Info("Lumberjack input stopped")
+
+	client, err := pipeline.ConnectWith(beat.ClientConfig{
+		CloseRef:   ctx.Cancelation,
+		ACKHandler: newACKHandler(),
+	})
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	l, err := s.listen()
+	if err != nil {
+		return err
+	}
+
+	server, err := lumber.NewWithListener(l, s.serverOptions()...)
+	if err != nil {
+		l.Close()
+		return err
+	}
+	defer server.Close()
+
+	for {
+		select {
+		case <-ctx.Cancelation.Done():
+			return ctx.Cancelation.Err()
+		case b, ok := <-server.ReceiveChan():
+			if !ok {
+				return nil
+			}
+			publishBatch(client, b)
+		}
+	}
+}
+
+func (s *server) listen() (net.Listener, error) {
+	l, err := net.Listen("tcp", s.Host)
+	if err != nil {
+		return nil, err
+	}
+	if s.MaxConnections > 0 {
+		return netutil.LimitListener(l, s.MaxConnections), nil
+	}
+	return l, nil
+}
+
+func (s *server) serverOptions() []lumber.Option {
+	opts := []lumber.Option{
+		lumber.Timeout(s.Timeout),
+		lumber.Keepalive(s.Keepalive),
+		lumber.JSONDecoder(decodeJSON),
+	}
+	if s.tlsConfig != nil {
+		opts = append(opts, lumber.TLS(s.tlsConfig.BuildModuleConfig(s.Host)))
+	}
+	return opts
+}
+
+func newACKHandler() beat.ACKer {
+	return acker.ConnectionOnly(
+		acker.EventPrivateReporter(func(_ int, privates []interface{}) {
+			for _, private := range privates {
+				if b, ok := private.(*batch); ok {
+					b.done()
+				}
+			}
+		}),
+	)
+}
+
+// batch tracks the events of a lumberjack window, the window is acknowledged
+// to the client once all its events have been acknowledged by the outputs.
+type batch struct {
+	window  *lj.Batch
+	pending atomic.Int64
+}
+
+func (b *batch) done() {
+	if b.pending.Dec() == 0 {
+		b.window.ACK()
+	}
+}
+
+func publishBatch(client beat.Client, window *lj.Batch) {
+	if len(window.Events) == 0 {
+		window.ACK()
+		return
+	}
+
+	b := &batch{window: window}
+	b.pending.Store(int64(len(window.Events)))
+	for _, raw := range window.Events {
+		event := createEvent(raw)
+		event.Private = b
+		client.Publish(event)
+	}
+}
+
+// createEvent converts an event sent by a Beat, its timestamp and metadata are
+// restored so that the event is indexed as if it was sent directly by the Beat.
+func createEvent(raw interface{}) beat.Event {
+	fields, ok := raw.(map[string]interface{})
+	if !ok {
+		return beat.Event{
+			Timestamp: time.Now(),
+			Fields:    common.MapStr{"message": fmt.Sprint(raw)},
+		}
+	}
+
+	event := beat.Event{
+		Timestamp: time.Now(),
+		Fields:    common.MapStr(fields),
+	}
+	if ts, ok := fields["@timestamp"].(string); ok {
+		if t, err := time.Parse(time.RFC3339Nano, ts); err == nil {
+			event.Timestamp = t
+		}
+		delete(fields, "@timestamp")
+	}
+	if meta, ok := fields["@metadata"].(map[string]interface{}); ok {
+		event.Meta = common.MapStr(meta)
+		delete(fields, "@metadata")
+	}
+	return event
+}
+
+// decodeJSON decodes events keeping integers precision.
+func decodeJSON(data []byte, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(v); err != nil {
+		return err
+	}
+	if p, ok := v.(*interface{}); ok {
+		if m, ok := (*p).(map[string]interface{}); ok {
+			jsontransform.TransformNumbers(m)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package lumberjack
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	client "github.com/elastic/go-lumber/client/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	input "github.com/elastic/beats/v7/filebeat/input/v2"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	pubtest "github.com/elastic/beats/v7/libbeat/publisher/testing"
+)
+
+func TestCreateEvent(t *testing.T) {
+	var raw interface{}
+	require.NoError(t, decodeJSON([]byte(`{
+		"@timestamp": "2020-09-01T10:00:00.123Z",
+		"@metadata": {"beat": "filebeat", "pipeline": "my-pipeline"},
+		"message": "hello",
+		"log": {"offset": 9007199254740993}
+	}`), &raw))
+
+	event := createEvent(raw)
+	assert.Equal(t, time.Date(2020, 9, 1, 10, 0, 0, 123000000, time.UTC), event.Timestamp)
+	assert.Equal(t, common.MapStr{"beat": "filebeat", "pipeline": "my-pipeline"}, event.Meta)
+	assert.Equal(t, common.MapStr{
+		"message": "hello",
+		"log":     map[string]interface{}{"offset": int64(9007199254740993)},
+	}, event.Fields)
+}
+
+func TestWindowIsAcknowledgedAfterOutput(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	host := l.Addr().String()
+	l.Close()
+
+	cfg := common.MustNewConfigFrom(map[string]interface{}{"host": host})
+	inp, err := configure(cfg)
+	require.NoError(t, err)
+
+	events := make(chan beat.Event, 10)
+	ackers := make(chan beat.ACKer, 1)
+	pipeline := &pubtest.FakeConnector{
+		ConnectFunc: func(cfg beat.ClientConfig) (beat.Client, error) {
+			ackers <- cfg.ACKHandler
+			return &pubtest.FakeClient{
+				PublishFunc: func(event beat.Event) {
+					cfg.ACKHandler.AddEvent(event, true)
+					events <- event
+				},
+			}, nil
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan error, 1)
+	go func() {
+		done <- inp.Run(input.Context{Logger: logp.NewLogger("test"), Cancelation: ctx}, pipeline)
+	}()
+	acker := <-ackers
+
+	var c *client.Client
+	require.Eventually(t, func() bool {
+		c, err = client.Dial(host)
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+	defer c.Close()
+
+	require.NoError(t, c.Send([]interface{}{
+		map[string]interface{}{"message": "first"},
+		map[string]interface{}{"message": "second"},
+	}))
+	assert.Equal(t, "first", (<-events).Fields["message"])
+	assert.Equal(t, "second", (<-events).Fields["message"])
+
+	acked := make(chan uint32, 1)
+	go func() {
+		n, _ := c.AwaitACK(2)
+		acked <- n
+	}()
+
+	acker.ACKEvents(1)
+	select {
+	case <-acked:
+		t.Fatal("window acknowledged before all events were acknowledged")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	acker.ACKEvents(1)
+	select {
+	case n := <-acked:
+		assert.Equal(t, uint32(2), n)
+	case <-time.After(5 * time.Second):
+		t.Fatal("window not acknowledged")
+	}
+
+	cancel()
+	assert.Equal(t, context.Canceled, <-done)
+}
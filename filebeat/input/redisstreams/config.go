@@ -0,0 +1,104 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package redisstreams
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/common/transport/tlscommon"
+)
+
+// Supported consumption modes.
+const (
+	modeStream = "stream"
+	modeList   = "list"
+	modePubSub = "pubsub"
+)
+
+type config struct {
+	Host     string            `config:"host" validate:"required"`
+	Network  string            `config:"network"`
+	Password string            `config:"password"`
+	DB       int               `config:"db" validate:"min=0"`
+	Timeout  time.Duration     `config:"timeout" validate:"positive"`
+	TLS      *tlscommon.Config `config:"ssl"`
+
+	// Mode is one of stream, list or pubsub.
+	Mode string `config:"mode"`
+
+	// Keys are the streams, lists or channels to consume from.
+	Keys []string `config:"keys" validate:"required"`
+
+	// MessageField is the stream entry field used as the event message.
+	MessageField string `config:"message_field"`
+
+	// Consumer group settings used by the stream mode.
+	Group        string        `config:"group"`
+	Consumer     string        `config:"consumer"`
+	CreateGroup  bool          `config:"create_group"`
+	BatchSize    int           `config:"batch_size" validate:"min=1"`
+	BlockTimeout time.Duration `config:"block_timeout" validate:"positive"`
+
+	// ClaimMinIdleTime is the time after which pending entries of other
+	// consumers are claimed, 0 disables claiming.
+	ClaimMinIdleTime time.Duration `config:"claim.min_idle_time" validate:"min=0"`
+	ClaimInterval    time.Duration `config:"claim.interval" validate:"positive"`
+
+	// ProcessingSuffix is appended to the list keys to build the lists
+	// holding the items being processed in list mode.
+	ProcessingSuffix string `config:"processing_suffix"`
+}
+
+func defaultConfig() config {
+	return config{
+		Network:          "tcp",
+		Timeout:          30 * time.Second,
+		Mode:             modeStream,
+		MessageField:     "message",
+		Group:            "filebeat",
+		CreateGroup:      true,
+		BatchSize:        100,
+		BlockTimeout:     5 * time.Second,
+		ClaimMinIdleTime: 5 * time.Minute,
+		ClaimInterval:    time.Minute,
+		ProcessingSuffix: ":processing",
+	}
+}
+
+func (c *config) Validate() error {
+	c.Mode = strings.ToLower(c.Mode)
+	switch c.Mode {
+	case modeStream, modeList, modePubSub:
+	default:
+		return fmt.Errorf("invalid mode '%s', must be one of stream, list or pubsub", c.Mode)
+	}
+
+	if c.Mode == modeStream && c.Group == "" {
+		return fmt.Errorf("group is required in stream mode")
+	}
+	if c.Mode == modeList && c.ProcessingSuffix == "" {
+		return fmt.Errorf("processing_suffix is required in list mode")
+	}
+	// Blocking commands must return before the connection read timeout.
+	if c.Mode != modePubSub && c.BlockTimeout >= c.Timeout {
+		return fmt.Errorf("block_timeout must be lower than timeout")
+	}
+	return nil
+}
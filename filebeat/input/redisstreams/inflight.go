@@ -0,0 +1,69 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package redisstreams
+
+import "sync"
+
+// inFlight keeps the stream entries and list items that have been published
+// and are waiting for the acknowledgement of the outputs. They are still
+// pending in Redis, so they must not be published again when the pending
+// entries are read after reconnecting, or when they are claimed.
+type inFlight struct {
+	mu    sync.Mutex
+	items map[inFlightKey]int
+}
+
+// inFlightKey identifies a stream entry by its key and ID, or a list item by
+// its processing list and value. The same value can be pushed more than once
+// to a list, so items are counted.
+type inFlightKey struct {
+	key string
+	id  string
+}
+
+func newInFlight() *inFlight {
+	return &inFlight{items: map[inFlightKey]int{}}
+}
+
+// locked runs fn with the in-flight items locked. Reading the pending items
+// from Redis and checking which of them are in flight must be done in fn, so
+// that acknowledgements, that are also done with the items locked, don't
+// happen in between.
+func (f *inFlight) locked(fn func() error) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return fn()
+}
+
+// The following methods must be called from a function run by locked.
+
+func (f *inFlight) count(k inFlightKey) int {
+	return f.items[k]
+}
+
+func (f *inFlight) add(k inFlightKey) {
+	f.items[k]++
+}
+
+func (f *inFlight) remove(k inFlightKey) {
+	if f.items[k] <= 1 {
+		delete(f.items, k)
+		return
+	}
+	f.items[k]--
+}
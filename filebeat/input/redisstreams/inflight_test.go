@@ -0,0 +1,206 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package redisstreams
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	rd "github.com/garyburd/redigo/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+// fakeConn is a Redis connection keeping the entries pending in the consumer
+// group of a stream, and the processing lists.
+type fakeConn struct {
+	mu      sync.Mutex
+	key     string
+	pending []streamEntry
+	lists   map[string][]string
+}
+
+func (c *fakeConn) Close() error                          { return nil }
+func (c *fakeConn) Err() error                            { return nil }
+func (c *fakeConn) Send(_ string, _ ...interface{}) error { return nil }
+func (c *fakeConn) Flush() error                          { return nil }
+func (c *fakeConn) Receive() (interface{}, error)         { return nil, nil }
+
+func (c *fakeConn) Do(cmd string, args ...interface{}) (interface{}, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	switch cmd {
+	case "XREADGROUP":
+		// GROUP group consumer COUNT n STREAMS key id
+		after := args[len(args)-1].(string)
+		var entries []streamEntry
+		for _, e := range c.pending {
+			if e.id > after {
+				entries = append(entries, e)
+			}
+		}
+		return []interface{}{[]interface{}{[]byte(c.key), c.entriesReply(entries)}}, nil
+	case "XAUTOCLAIM":
+		return []interface{}{[]byte("0-0"), c.entriesReply(c.pending)}, nil
+	case "XACK":
+		for _, id := range args[2:] {
+			for i, e := range c.pending {
+				if e.id == id {
+					c.pending = append(c.pending[:i], c.pending[i+1:]...)
+					break
+				}
+			}
+		}
+		return nil, nil
+	case "LRANGE":
+		var reply []interface{}
+		for _, v := range c.lists[args[0].(string)] {
+			reply = append(reply, []byte(v))
+		}
+		return reply, nil
+	case "LREM":
+		list := c.lists[args[0].(string)]
+		for i, v := range list {
+			if v == string(args[2].([]byte)) {
+				c.lists[args[0].(string)] = append(list[:i], list[i+1:]...)
+				break
+			}
+		}
+		return nil, nil
+	}
+	return nil, nil
+}
+
+func (c *fakeConn) entriesReply(entries []streamEntry) []interface{} {
+	reply := []interface{}{}
+	for _, e := range entries {
+		reply = append(reply, entryReply(e.id, "message", e.fields["message"]))
+	}
+	return reply
+}
+
+// fakeClient publishes the events to an ACKer, as the pipeline does.
+type fakeClient struct {
+	acker  beat.ACKer
+	events []beat.Event
+}
+
+func (c *fakeClient) Publish(event beat.Event) {
+	c.events = append(c.events, event)
+	c.acker.AddEvent(event, true)
+}
+
+func (c *fakeClient) PublishAll(events []beat.Event) {
+	for _, event := range events {
+		c.Publish(event)
+	}
+}
+
+func (c *fakeClient) Close() error { return nil }
+
+func (c *fakeClient) messages() []interface{} {
+	var messages []interface{}
+	for _, e := range c.events {
+		messages = append(messages, e.Fields["message"])
+	}
+	return messages
+}
+
+func newFakeInput(conn *fakeConn) (*redisInput, *rd.Pool, *inFlight, *fakeClient) {
+	in := &redisInput{config: defaultConfig()}
+	in.Keys = []string{"logs"}
+	in.Consumer = "test"
+	pool := &rd.Pool{Dial: func() (rd.Conn, error) { return conn, nil }}
+	inFlight := newInFlight()
+	client := &fakeClient{acker: newACKHandler(logp.NewLogger("test"), pool, in.Group, inFlight)}
+	return in, pool, inFlight, client
+}
+
+func TestStreamReconnectWithUnackedEntries(t *testing.T) {
+	conn := &fakeConn{key: "logs"}
+	for i := 1; i <= 3; i++ {
+		conn.pending = append(conn.pending, streamEntry{
+			key:    "logs",
+			id:     fmt.Sprintf("160000000000%d-0", i),
+			fields: map[string]string{"message": fmt.Sprint("entry ", i)},
+		})
+	}
+
+	in, pool, inFlight, client := newFakeInput(conn)
+	s := &streamConsumer{redisInput: in, log: logp.NewLogger("test"), pool: pool, client: client, inFlight: inFlight}
+
+	require.NoError(t, s.readPending(conn, "logs"))
+	assert.Equal(t, []interface{}{"entry 1", "entry 2", "entry 3"}, client.messages())
+
+	// Only the first entry is acknowledged before reconnecting, the others
+	// are still pending but they are in flight.
+	client.acker.ACKEvents(1)
+	require.Len(t, conn.pending, 2)
+
+	require.NoError(t, s.readPending(conn, "logs"))
+	require.NoError(t, s.claim(conn, "logs"))
+	assert.Len(t, client.events, 3)
+
+	// Entries pending but not in flight are published again, as the ones
+	// delivered to a connection that was lost before replying.
+	conn.pending = append(conn.pending, streamEntry{
+		key:    "logs",
+		id:     "1600000000004-0",
+		fields: map[string]string{"message": "entry 4"},
+	})
+	require.NoError(t, s.readPending(conn, "logs"))
+	assert.Equal(t, []interface{}{"entry 1", "entry 2", "entry 3", "entry 4"}, client.messages())
+
+	// Once acknowledged, entries are not in flight anymore.
+	client.acker.ACKEvents(3)
+	assert.Empty(t, conn.pending)
+	assert.Empty(t, inFlight.items)
+}
+
+func TestListReconnectWithUnackedItems(t *testing.T) {
+	conn := &fakeConn{lists: map[string][]string{
+		// Items are pushed to the head of the processing list.
+		"logs:processing:test": {"b", "a", "a"},
+	}}
+
+	in, _, inFlight, client := newFakeInput(conn)
+
+	require.NoError(t, in.publishProcessing(conn, "logs", client, inFlight))
+	assert.Equal(t, []interface{}{"a", "a", "b"}, client.messages())
+
+	// One of the duplicated items is acknowledged before reconnecting.
+	client.acker.ACKEvents(1)
+	require.Equal(t, []string{"b", "a"}, conn.lists["logs:processing:test"])
+
+	require.NoError(t, in.publishProcessing(conn, "logs", client, inFlight))
+	assert.Len(t, client.events, 3)
+
+	// A new item moved to the processing list is published.
+	conn.lists["logs:processing:test"] = append([]string{"c"}, conn.lists["logs:processing:test"]...)
+	require.NoError(t, in.publishProcessing(conn, "logs", client, inFlight))
+	assert.Equal(t, []interface{}{"a", "a", "b", "c"}, client.messages())
+
+	client.acker.ACKEvents(3)
+	assert.Empty(t, conn.lists["logs:processing:test"])
+	assert.Empty(t, inFlight.items)
+}
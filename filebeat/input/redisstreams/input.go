@@ -0,0 +1,203 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package redisstreams
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"os"
+	"time"
+
+	rd "github.com/garyburd/redigo/redis"
+
+	input "github.com/elastic/beats/v7/filebeat/input/v2"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/acker"
+	"github.com/elastic/beats/v7/libbeat/common/transport/tlscommon"
+	"github.com/elastic/beats/v7/libbeat/feature"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+const inputName = "redis_streams"
+
+type redisInput struct {
+	config
+	tlsConfig *tlscommon.TLSConfig
+}
+
+// Plugin creates the redis_streams input plugin.
+func Plugin() input.Plugin {
+	return input.Plugin{
+		Name:       inputName,
+		Stability:  feature.Experimental,
+		Deprecated: false,
+		Info:       "Redis streams, lists and pub/sub consumer",
+		Manager:    input.ConfigureWith(configure),
+	}
+}
+
+func configure(cfg *common.Config) (input.Input, error) {
+	config := defaultConfig()
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, err
+	}
+
+	if config.Consumer == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve consumer name: %v", err)
+		}
+		config.Consumer = hostname
+	}
+
+	tlsConfig, err := tlscommon.LoadTLSConfig(config.TLS)
+	if err != nil {
+		return nil, err
+	}
+
+	return &redisInput{config: config, tlsConfig: tlsConfig}, nil
+}
+
+func (in *redisInput) Name() string { return inputName }
+
+func (in *redisInput) Test(_ input.TestContext) error {
+	conn, err := in.dial()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	_, err = conn.Do("PING")
+	return err
+}
+
+func (in *redisInput) Run(ctx input.Context, pipeline beat.PipelineConnector) error {
+	log := ctx.Logger.Named("input.redis_streams").With("host", in.Host, "mode", in.Mode)
+
+	log.Info("Starting Redis input")
+	defer log.Info("Redis input stopped")
+
+	// Acknowledgements are sent using connections distinct from the ones
+	// blocked waiting for new data.
+	pool := &rd.Pool{
+		MaxIdle:     2,
+		IdleTimeout: in.Timeout,
+		Dial:        in.dial,
+	}
+	defer pool.Close()
+
+	inFlight := newInFlight()
+	clientConfig := beat.ClientConfig{CloseRef: ctx.Cancelation}
+	if in.Mode != modePubSub {
+		clientConfig.ACKHandler = newACKHandler(log, pool, in.Group, inFlight)
+	}
+	client, err := pipeline.ConnectWith(clientConfig)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	switch in.Mode {
+	case modeStream:
+		err = in.runStream(ctx, log, pool, client, inFlight)
+	case modeList:
+		err = in.runList(ctx, log, client, inFlight)
+	case modePubSub:
+		err = in.runPubSub(ctx, log, client)
+	}
+
+	// ignore errors in case shutdown was signaled.
+	if ctxerr := ctx.Cancelation.Err(); ctxerr != nil {
+		err = ctxerr
+	}
+	return err
+}
+
+func (in *redisInput) dial() (rd.Conn, error) {
+	opts := []rd.DialOption{
+		rd.DialConnectTimeout(in.Timeout),
+		rd.DialReadTimeout(in.Timeout),
+		rd.DialWriteTimeout(in.Timeout),
+		rd.DialDatabase(in.DB),
+		rd.DialPassword(in.Password),
+	}
+	if in.tlsConfig != nil {
+		tlsConfig := in.tlsConfig.BuildModuleConfig(in.Host)
+		opts = append(opts, rd.DialNetDial(func(network, addr string) (net.Conn, error) {
+			dialer := &net.Dialer{Timeout: in.Timeout}
+			return tls.DialWithDialer(dialer, network, addr, tlsConfig)
+		}))
+	}
+	return rd.Dial(in.Network, in.Host, opts...)
+}
+
+// waitRetry waits before retrying after an error, it returns false if the
+// input is stopped while waiting.
+func waitRetry(ctx input.Context, d time.Duration) bool {
+	select {
+	case <-ctx.Cancelation.Done():
+		return false
+	case <-time.After(d):
+		return true
+	}
+}
+
+// listItem is an item moved to the processing list, it is removed from it once
+// it has been acknowledged by the outputs.
+type listItem struct {
+	processing string
+	value      []byte
+}
+
+// newACKHandler acknowledges the stream entries and removes the list items
+// from the processing lists once they have been acknowledged by the outputs.
+// They are not in flight anymore after that, even if it fails, so they can be
+// published again.
+func newACKHandler(log *logp.Logger, pool *rd.Pool, group string, inFlight *inFlight) beat.ACKer {
+	return acker.ConnectionOnly(
+		acker.EventPrivateReporter(func(_ int, privates []interface{}) {
+			conn := pool.Get()
+			defer conn.Close()
+
+			inFlight.locked(func() error {
+				ids := map[string][]interface{}{}
+				for _, private := range privates {
+					switch p := private.(type) {
+					case *streamEntry:
+						ids[p.key] = append(ids[p.key], p.id)
+						inFlight.remove(inFlightKey{key: p.key, id: p.id})
+					case *listItem:
+						if _, err := conn.Do("LREM", p.processing, 1, p.value); err != nil {
+							log.Errorw("Failed to remove acknowledged item from processing list", "key", p.processing, "error", err)
+						}
+						inFlight.remove(inFlightKey{key: p.processing, id: string(p.value)})
+					}
+				}
+
+				for key, keyIDs := range ids {
+					args := append([]interface{}{key, group}, keyIDs...)
+					if _, err := conn.Do("XACK", args...); err != nil {
+						log.Errorw("Failed to acknowledge stream entries", "key", key, "error", err)
+					}
+				}
+				return nil
+			})
+		}),
+	)
+}
@@ -0,0 +1,144 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package redisstreams
+
+import (
+	"sync"
+	"time"
+
+	rd "github.com/garyburd/redigo/redis"
+
+	input "github.com/elastic/beats/v7/filebeat/input/v2"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+// runList consumes the lists with at-least-once guarantees. Items are moved
+// atomically to a processing list and are removed from it once they have been
+// acknowledged by the outputs.
+func (in *redisInput) runList(ctx input.Context, log *logp.Logger, client beat.Client, inFlight *inFlight) error {
+	var wg sync.WaitGroup
+	for _, key := range in.Keys {
+		key := key
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for ctx.Cancelation.Err() == nil {
+				err := in.consumeList(ctx, key, client, inFlight)
+				if err == nil || ctx.Cancelation.Err() != nil {
+					return
+				}
+				log.Errorw("Error consuming Redis list, retrying", "key", key, "error", err)
+				if !waitRetry(ctx, in.Timeout) {
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	return nil
+}
+
+func (in *redisInput) processingKey(key string) string {
+	return key + in.ProcessingSuffix + ":" + in.Consumer
+}
+
+func (in *redisInput) consumeList(ctx input.Context, key string, client beat.Client, inFlight *inFlight) error {
+	conn, err := in.dial()
+	if err != nil {
+		return err
+	}
+	defer closeOnCancel(ctx, conn)()
+
+	processing := in.processingKey(key)
+
+	// Items left in the processing list were not acknowledged before a
+	// restart or a reconnection and are published again.
+	if err := in.publishProcessing(conn, key, client, inFlight); err != nil {
+		return err
+	}
+
+	timeout := int(in.BlockTimeout / time.Second)
+	if timeout < 1 {
+		timeout = 1
+	}
+	for ctx.Cancelation.Err() == nil {
+		value, err := rd.Bytes(conn.Do("BRPOPLPUSH", key, processing, timeout))
+		if err == rd.ErrNil {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		inFlight.locked(func() error {
+			inFlight.add(inFlightKey{key: processing, id: string(value)})
+			return nil
+		})
+		in.publishListItem(client, key, processing, value)
+	}
+	return nil
+}
+
+// publishProcessing publishes the items of the processing list of a key,
+// skipping the ones already published by this process and waiting for
+// acknowledgement.
+func (in *redisInput) publishProcessing(conn rd.Conn, key string, client beat.Client, inFlight *inFlight) error {
+	processing := in.processingKey(key)
+
+	var pending [][]byte
+	err := inFlight.locked(func() error {
+		values, err := rd.ByteSlices(conn.Do("LRANGE", processing, 0, -1))
+		if err != nil {
+			return err
+		}
+		skipped := map[string]int{}
+		for i := len(values) - 1; i >= 0; i-- {
+			k := inFlightKey{key: processing, id: string(values[i])}
+			if skipped[k.id] < inFlight.count(k) {
+				skipped[k.id]++
+				continue
+			}
+			pending = append(pending, values[i])
+		}
+		for _, value := range pending {
+			inFlight.add(inFlightKey{key: processing, id: string(value)})
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, value := range pending {
+		in.publishListItem(client, key, processing, value)
+	}
+	return nil
+}
+
+func (in *redisInput) publishListItem(client beat.Client, key, processing string, value []byte) {
+	client.Publish(beat.Event{
+		Timestamp: time.Now(),
+		Fields: common.MapStr{
+			"message": string(value),
+			"redis": common.MapStr{
+				"key": key,
+			},
+		},
+		Private: &listItem{processing: processing, value: value},
+	})
+}
@@ -0,0 +1,103 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package redisstreams
+
+import (
+	"time"
+
+	rd "github.com/garyburd/redigo/redis"
+
+	input "github.com/elastic/beats/v7/filebeat/input/v2"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+// runPubSub subscribes to the channels, messages published while the input
+// is not connected are lost.
+func (in *redisInput) runPubSub(ctx input.Context, log *logp.Logger, client beat.Client) error {
+	for ctx.Cancelation.Err() == nil {
+		err := in.subscribe(ctx, log, client)
+		if err == nil || ctx.Cancelation.Err() != nil {
+			break
+		}
+		log.Errorw("Error reading Redis channels, retrying", "error", err)
+		if !waitRetry(ctx, in.Timeout) {
+			break
+		}
+	}
+	return nil
+}
+
+func (in *redisInput) subscribe(ctx input.Context, log *logp.Logger, client beat.Client) error {
+	conn, err := in.dial()
+	if err != nil {
+		return err
+	}
+	defer closeOnCancel(ctx, conn)()
+
+	psc := rd.PubSubConn{Conn: conn}
+	channels := make([]interface{}, len(in.Keys))
+	for i, key := range in.Keys {
+		channels[i] = key
+	}
+	// Patterns without wildcards match a single channel, so PSUBSCRIBE
+	// supports both plain channel names and patterns.
+	if err := psc.PSubscribe(channels...); err != nil {
+		return err
+	}
+
+	// Ping the server regularly so that the read timeout is not reached
+	// when no messages are published.
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		ticker := time.NewTicker(in.Timeout / 2)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if err := psc.Ping(""); err != nil {
+					log.Debugw("Failed to ping Redis", "error", err)
+				}
+			}
+		}
+	}()
+
+	for ctx.Cancelation.Err() == nil {
+		switch msg := psc.Receive().(type) {
+		case rd.PMessage:
+			client.Publish(beat.Event{
+				Timestamp: time.Now(),
+				Fields: common.MapStr{
+					"message": string(msg.Data),
+					"redis": common.MapStr{
+						"key": msg.Channel,
+					},
+				},
+			})
+		case rd.Subscription:
+			log.Debugw("Subscription updated", "kind", msg.Kind, "channel", msg.Channel, "count", msg.Count)
+		case error:
+			return msg
+		}
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package redisstreams
+
+import (
+	"fmt"
+
+	rd "github.com/garyburd/redigo/redis"
+)
+
+// streamEntry is an entry read from a stream. Fields is nil for entries that
+// have been deleted while pending.
+type streamEntry struct {
+	key    string
+	id     string
+	fields map[string]string
+}
+
+// parseReadReply parses the reply of XREADGROUP, a nil reply is returned when
+// the command timed out.
+func parseReadReply(reply interface{}) ([]streamEntry, error) {
+	if reply == nil {
+		return nil, nil
+	}
+
+	streams, err := rd.Values(reply, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []streamEntry
+	for _, s := range streams {
+		stream, err := rd.Values(s, nil)
+		if err != nil {
+			return nil, err
+		}
+		if len(stream) != 2 {
+			return nil, fmt.Errorf("invalid stream reply with %d elements", len(stream))
+		}
+		key, err := rd.String(stream[0], nil)
+		if err != nil {
+			return nil, err
+		}
+		streamEntries, err := parseEntries(key, stream[1])
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, streamEntries...)
+	}
+	return entries, nil
+}
+
+// parseAutoClaimReply parses the reply of XAUTOCLAIM and returns the claimed
+// entries and the cursor to use in the next call.
+func parseAutoClaimReply(key string, reply interface{}) ([]streamEntry, string, error) {
+	values, err := rd.Values(reply, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(values) < 2 {
+		return nil, "", fmt.Errorf("invalid XAUTOCLAIM reply with %d elements", len(values))
+	}
+	cursor, err := rd.String(values[0], nil)
+	if err != nil {
+		return nil, "", err
+	}
+	entries, err := parseEntries(key, values[1])
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Redis >= 7.0 returns the IDs of the claimed entries that no longer
+	// exist, they are still pending and must be acknowledged.
+	if len(values) > 2 && values[2] != nil {
+		deleted, err := rd.Strings(values[2], nil)
+		if err != nil {
+			return nil, "", err
+		}
+		for _, id := range deleted {
+			entries = append(entries, streamEntry{key: key, id: id})
+		}
+	}
+	return entries, cursor, nil
+}
+
+func parseEntries(key string, reply interface{}) ([]streamEntry, error) {
+	values, err := rd.Values(reply, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]streamEntry, 0, len(values))
+	for _, v := range values {
+		// Redis 6.2 returns nil for claimed entries that no longer exist.
+		if v == nil {
+			continue
+		}
+		entry, err := rd.Values(v, nil)
+		if err != nil {
+			return nil, err
+		}
+		if len(entry) != 2 {
+			return nil, fmt.Errorf("invalid stream entry with %d elements", len(entry))
+		}
+		id, err := rd.String(entry[0], nil)
+		if err != nil {
+			return nil, err
+		}
+
+		e := streamEntry{key: key, id: id}
+		if entry[1] != nil {
+			fields, err := rd.StringMap(entry[1], nil)
+			if err != nil {
+				return nil, err
+			}
+			e.fields = fields
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
@@ -0,0 +1,163 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package redisstreams
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+func entryReply(id string, fields ...string) interface{} {
+	if fields == nil {
+		return []interface{}{[]byte(id), nil}
+	}
+	values := make([]interface{}, len(fields))
+	for i, f := range fields {
+		values[i] = []byte(f)
+	}
+	return []interface{}{[]byte(id), values}
+}
+
+func TestParseReadReply(t *testing.T) {
+	t.Run("timeout", func(t *testing.T) {
+		entries, err := parseReadReply(nil)
+		require.NoError(t, err)
+		assert.Empty(t, entries)
+	})
+
+	t.Run("multiple streams", func(t *testing.T) {
+		reply := []interface{}{
+			[]interface{}{[]byte("logs"), []interface{}{
+				entryReply("1600000000000-0", "message", "hello", "level", "info"),
+				entryReply("1600000000000-1"),
+			}},
+			[]interface{}{[]byte("audit"), []interface{}{
+				entryReply("1600000000001-0", "message", "login"),
+			}},
+		}
+
+		entries, err := parseReadReply(reply)
+		require.NoError(t, err)
+		assert.Equal(t, []streamEntry{
+			{key: "logs", id: "1600000000000-0", fields: map[string]string{"message": "hello", "level": "info"}},
+			{key: "logs", id: "1600000000000-1"},
+			{key: "audit", id: "1600000000001-0", fields: map[string]string{"message": "login"}},
+		}, entries)
+	})
+
+	t.Run("invalid reply", func(t *testing.T) {
+		_, err := parseReadReply([]interface{}{[]interface{}{[]byte("logs")}})
+		assert.Error(t, err)
+	})
+}
+
+func TestParseAutoClaimReply(t *testing.T) {
+	t.Run("redis 6.2", func(t *testing.T) {
+		reply := []interface{}{
+			[]byte("1600000000005-0"),
+			[]interface{}{
+				entryReply("1600000000000-0", "message", "hello"),
+				nil,
+			},
+		}
+		entries, cursor, err := parseAutoClaimReply("logs", reply)
+		require.NoError(t, err)
+		assert.Equal(t, "1600000000005-0", cursor)
+		assert.Equal(t, []streamEntry{
+			{key: "logs", id: "1600000000000-0", fields: map[string]string{"message": "hello"}},
+		}, entries)
+	})
+
+	t.Run("redis 7.0 with deleted entries", func(t *testing.T) {
+		reply := []interface{}{
+			[]byte("0-0"),
+			[]interface{}{entryReply("1600000000000-0", "message", "hello")},
+			[]interface{}{[]byte("1600000000001-0")},
+		}
+		entries, cursor, err := parseAutoClaimReply("logs", reply)
+		require.NoError(t, err)
+		assert.Equal(t, "0-0", cursor)
+		assert.Equal(t, []streamEntry{
+			{key: "logs", id: "1600000000000-0", fields: map[string]string{"message": "hello"}},
+			{key: "logs", id: "1600000000001-0"},
+		}, entries)
+	})
+}
+
+func TestCreateStreamEvent(t *testing.T) {
+	entry := &streamEntry{
+		key:    "logs",
+		id:     "1600000000123-4",
+		fields: map[string]string{"message": "hello", "level": "info"},
+	}
+	event := createStreamEvent(entry, "message")
+	assert.Equal(t, time.Unix(1600000000, 123000000).UTC(), event.Timestamp)
+	assert.Equal(t, common.MapStr{
+		"message": "hello",
+		"redis": common.MapStr{
+			"key": "logs",
+			"stream": common.MapStr{
+				"id":     "1600000000123-4",
+				"fields": common.MapStr{"level": "info"},
+			},
+		},
+	}, event.Fields)
+}
+
+func TestConfigValidate(t *testing.T) {
+	tests := map[string]struct {
+		settings map[string]interface{}
+		hasError bool
+	}{
+		"default stream mode": {
+			settings: map[string]interface{}{"host": "localhost:6379", "keys": []string{"logs"}},
+		},
+		"list mode": {
+			settings: map[string]interface{}{"host": "localhost:6379", "keys": []string{"logs"}, "mode": "list"},
+		},
+		"invalid mode": {
+			settings: map[string]interface{}{"host": "localhost:6379", "keys": []string{"logs"}, "mode": "queue"},
+			hasError: true,
+		},
+		"block timeout bigger than timeout": {
+			settings: map[string]interface{}{"host": "localhost:6379", "keys": []string{"logs"}, "block_timeout": "1m"},
+			hasError: true,
+		},
+		"missing keys": {
+			settings: map[string]interface{}{"host": "localhost:6379"},
+			hasError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			config := defaultConfig()
+			err := common.MustNewConfigFrom(test.settings).Unpack(&config)
+			if test.hasError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
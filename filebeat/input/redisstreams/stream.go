@@ -0,0 +1,287 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package redisstreams
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	rd "github.com/garyburd/redigo/redis"
+
+	input "github.com/elastic/beats/v7/filebeat/input/v2"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+// runStream consumes the streams using a consumer group. Entries are
+// acknowledged with XACK once they have been acknowledged by the outputs.
+func (in *redisInput) runStream(ctx input.Context, log *logp.Logger, pool *rd.Pool, client beat.Client, inFlight *inFlight) error {
+	s := &streamConsumer{redisInput: in, log: log, pool: pool, client: client, inFlight: inFlight}
+
+	for ctx.Cancelation.Err() == nil {
+		err := s.consume(ctx)
+		if err == nil || ctx.Cancelation.Err() != nil {
+			break
+		}
+		log.Errorw("Error consuming Redis streams, retrying", "error", err)
+		if !waitRetry(ctx, in.Timeout) {
+			break
+		}
+	}
+	return nil
+}
+
+type streamConsumer struct {
+	*redisInput
+	log      *logp.Logger
+	pool     *rd.Pool
+	client   beat.Client
+	inFlight *inFlight
+}
+
+func (s *streamConsumer) consume(ctx input.Context) error {
+	conn, err := s.dial()
+	if err != nil {
+		return err
+	}
+	defer closeOnCancel(ctx, conn)()
+
+	if s.CreateGroup {
+		if err := s.createGroups(conn); err != nil {
+			return err
+		}
+	}
+
+	// Entries delivered to this consumer but not acknowledged before a
+	// restart or a reconnection are published again.
+	for _, key := range s.Keys {
+		if err := s.readPending(conn, key); err != nil {
+			return err
+		}
+	}
+
+	var lastClaim time.Time
+	args := []interface{}{"GROUP", s.Group, s.Consumer, "COUNT", s.BatchSize, "BLOCK", s.BlockTimeout.Milliseconds(), "STREAMS"}
+	for _, key := range s.Keys {
+		args = append(args, key)
+	}
+	for range s.Keys {
+		args = append(args, ">")
+	}
+
+	for ctx.Cancelation.Err() == nil {
+		if s.ClaimMinIdleTime > 0 && time.Since(lastClaim) >= s.ClaimInterval {
+			for _, key := range s.Keys {
+				if err := s.claim(conn, key); err != nil {
+					return err
+				}
+			}
+			lastClaim = time.Now()
+		}
+
+		reply, err := conn.Do("XREADGROUP", args...)
+		if err != nil {
+			return err
+		}
+		entries, err := parseReadReply(reply)
+		if err != nil {
+			return err
+		}
+		s.inFlight.locked(func() error {
+			entries = s.acquire(entries)
+			return nil
+		})
+		s.publish(entries)
+	}
+	return nil
+}
+
+func (s *streamConsumer) createGroups(conn rd.Conn) error {
+	for _, key := range s.Keys {
+		_, err := conn.Do("XGROUP", "CREATE", key, s.Group, "$", "MKSTREAM")
+		if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+			return err
+		}
+	}
+	return nil
+}
+
+// readPending publishes the entries pending for this consumer, skipping the
+// ones already published by this process and waiting for acknowledgement.
+func (s *streamConsumer) readPending(conn rd.Conn, key string) error {
+	id := "0"
+	for {
+		var entries, pending []streamEntry
+		err := s.inFlight.locked(func() error {
+			reply, err := conn.Do("XREADGROUP", "GROUP", s.Group, s.Consumer, "COUNT", s.BatchSize, "STREAMS", key, id)
+			if err != nil {
+				return err
+			}
+			entries, err = parseReadReply(reply)
+			if err != nil {
+				return err
+			}
+			pending = s.acquire(entries)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if len(entries) == 0 {
+			return nil
+		}
+		if len(pending) > 0 {
+			s.log.Debugw("Publishing pending entries", "key", key, "count", len(pending))
+			s.publish(pending)
+		}
+		id = entries[len(entries)-1].id
+	}
+}
+
+// claim takes ownership of the entries pending for longer than the
+// configured idle time, typically because their consumer died. The entries
+// of this consumer that are waiting for acknowledgement are also claimed, as
+// they can be idle for long under backpressure, but they are not published
+// again.
+func (s *streamConsumer) claim(conn rd.Conn, key string) error {
+	cursor := "0-0"
+	minIdle := s.ClaimMinIdleTime.Milliseconds()
+	for {
+		var entries []streamEntry
+		var next string
+		err := s.inFlight.locked(func() error {
+			reply, err := conn.Do("XAUTOCLAIM", key, s.Group, s.Consumer, minIdle, cursor, "COUNT", s.BatchSize)
+			if err != nil {
+				return err
+			}
+			entries, next, err = parseAutoClaimReply(key, reply)
+			if err != nil {
+				return err
+			}
+			entries = s.acquire(entries)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if len(entries) > 0 {
+			s.log.Infow("Claimed pending entries", "key", key, "count", len(entries))
+			s.publish(entries)
+		}
+		if next == "0-0" || next == cursor {
+			return nil
+		}
+		cursor = next
+	}
+}
+
+// acquire returns the entries that are not in flight, and marks them as in
+// flight. It must be called from a function run by s.inFlight.locked.
+func (s *streamConsumer) acquire(entries []streamEntry) []streamEntry {
+	var acquired []streamEntry
+	for _, entry := range entries {
+		k := inFlightKey{key: entry.key, id: entry.id}
+		if s.inFlight.count(k) > 0 {
+			continue
+		}
+		if entry.fields != nil {
+			// Deleted entries are acknowledged without being published.
+			s.inFlight.add(k)
+		}
+		acquired = append(acquired, entry)
+	}
+	return acquired
+}
+
+func (s *streamConsumer) publish(entries []streamEntry) {
+	for i := range entries {
+		entry := &entries[i]
+		if entry.fields == nil {
+			// The entry was deleted while pending, there is nothing to
+			// publish but it must be acknowledged.
+			s.ackDeleted(entry)
+			continue
+		}
+		event := createStreamEvent(entry, s.MessageField)
+		event.Private = entry
+		s.client.Publish(event)
+	}
+}
+
+func (s *streamConsumer) ackDeleted(entry *streamEntry) {
+	conn := s.pool.Get()
+	defer conn.Close()
+	if _, err := conn.Do("XACK", entry.key, s.Group, entry.id); err != nil {
+		s.log.Errorw("Failed to acknowledge deleted entry", "key", entry.key, "id", entry.id, "error", err)
+	}
+}
+
+func createStreamEvent(entry *streamEntry, messageField string) beat.Event {
+	fields := common.MapStr{}
+	rest := common.MapStr{}
+	for k, v := range entry.fields {
+		if k == messageField {
+			fields["message"] = v
+			continue
+		}
+		rest[k] = v
+	}
+
+	redis := common.MapStr{
+		"key": entry.key,
+		"stream": common.MapStr{
+			"id": entry.id,
+		},
+	}
+	if len(rest) > 0 {
+		redis.Put("stream.fields", rest)
+	}
+	fields["redis"] = redis
+
+	return beat.Event{
+		Timestamp: streamIDTime(entry.id),
+		Fields:    fields,
+	}
+}
+
+// streamIDTime returns the time at which an entry was added to the stream,
+// the first part of a stream ID is the Unix time in milliseconds.
+func streamIDTime(id string) time.Time {
+	ms, err := strconv.ParseInt(strings.SplitN(id, "-", 2)[0], 10, 64)
+	if err != nil {
+		return time.Now()
+	}
+	return time.Unix(0, ms*int64(time.Millisecond)).UTC()
+}
+
+// closeOnCancel closes the connection when the input is stopped, unblocking
+// any pending blocking command. The returned function must be called once the
+// connection is not used anymore.
+func closeOnCancel(ctx input.Context, conn rd.Conn) func() {
+	done := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Cancelation.Done():
+		case <-done:
+		}
+		conn.Close()
+	}()
+	return func() { close(done) }
+}
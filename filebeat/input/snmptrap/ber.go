@@ -0,0 +1,296 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package snmptrap
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// BER tags used by SNMP.
+const (
+	tagInteger     = 0x02
+	tagOctetString = 0x04
+	tagNull        = 0x05
+	tagOID         = 0x06
+	tagSequence    = 0x30
+
+	tagIPAddress = 0x40
+	tagCounter32 = 0x41
+	tagGauge32   = 0x42
+	tagTimeTicks = 0x43
+	tagOpaque    = 0x44
+	tagCounter64 = 0x46
+
+	tagNoSuchObject   = 0x80
+	tagNoSuchInstance = 0x81
+	tagEndOfMIBView   = 0x82
+)
+
+var errTruncated = errors.New("truncated BER encoding")
+
+// readTLV reads the tag, length and value at the start of b. It returns the
+// value and the bytes following it.
+func readTLV(b []byte) (tag byte, value, rest []byte, err error) {
+	if len(b) < 2 {
+		return 0, nil, nil, errTruncated
+	}
+	tag = b[0]
+	if tag&0x1f == 0x1f {
+		return 0, nil, nil, fmt.Errorf("unsupported multi-byte tag 0x%x", tag)
+	}
+
+	length, n := int(b[1]), 2
+	if length&0x80 != 0 {
+		size := length & 0x7f
+		if size == 0 || size > 4 {
+			return 0, nil, nil, fmt.Errorf("unsupported length encoding 0x%x", b[1])
+		}
+		if len(b) < 2+size {
+			return 0, nil, nil, errTruncated
+		}
+		length = 0
+		for _, c := range b[2 : 2+size] {
+			length = length<<8 | int(c)
+		}
+		n += size
+	}
+	if length < 0 || len(b)-n < length {
+		return 0, nil, nil, errTruncated
+	}
+	return tag, b[n : n+length], b[n+length:], nil
+}
+
+// expectTLV reads a TLV and checks that it has the expected tag.
+func expectTLV(b []byte, expected byte) (value, rest []byte, err error) {
+	tag, value, rest, err := readTLV(b)
+	if err != nil {
+		return nil, nil, err
+	}
+	if tag != expected {
+		return nil, nil, fmt.Errorf("unexpected tag 0x%x, expected 0x%x", tag, expected)
+	}
+	return value, rest, nil
+}
+
+func readInt(b []byte) (int64, []byte, error) {
+	value, rest, err := expectTLV(b, tagInteger)
+	if err != nil {
+		return 0, nil, err
+	}
+	n, err := parseInt(value)
+	return n, rest, err
+}
+
+func readOctetString(b []byte) ([]byte, []byte, error) {
+	return expectTLV(b, tagOctetString)
+}
+
+func readOID(b []byte) (oid, []byte, error) {
+	value, rest, err := expectTLV(b, tagOID)
+	if err != nil {
+		return nil, nil, err
+	}
+	o, err := parseOID(value)
+	return o, rest, err
+}
+
+// parseInt decodes a two's complement integer.
+func parseInt(b []byte) (int64, error) {
+	if len(b) == 0 || len(b) > 8 {
+		return 0, fmt.Errorf("invalid integer length %d", len(b))
+	}
+	n := int64(int8(b[0]))
+	for _, c := range b[1:] {
+		n = n<<8 | int64(c)
+	}
+	return n, nil
+}
+
+// parseUint decodes an unsigned integer, as used by counters, gauges and
+// time ticks. A leading zero byte is allowed to keep the encoding positive.
+func parseUint(b []byte, bits int) (uint64, error) {
+	if len(b) > 0 && b[0] == 0 {
+		b = b[1:]
+	}
+	if len(b)*8 > bits {
+		return 0, fmt.Errorf("invalid %d bits unsigned integer length %d", bits, len(b))
+	}
+	var n uint64
+	for _, c := range b {
+		n = n<<8 | uint64(c)
+	}
+	return n, nil
+}
+
+// oid is an object identifier.
+type oid []uint32
+
+func parseOID(b []byte) (oid, error) {
+	if len(b) == 0 {
+		return nil, errors.New("empty object identifier")
+	}
+	var o oid
+	var v uint64
+	for i, c := range b {
+		v = v<<7 | uint64(c&0x7f)
+		if v > 0xffffffff {
+			return nil, errors.New("object identifier component overflow")
+		}
+		if c&0x80 != 0 {
+			if i == len(b)-1 {
+				return nil, errTruncated
+			}
+			continue
+		}
+		if len(o) == 0 {
+			// The first byte encodes the first two components.
+			first := v / 40
+			if first > 2 {
+				first = 2
+			}
+			o = append(o, uint32(first), uint32(v-40*first))
+		} else {
+			o = append(o, uint32(v))
+		}
+		v = 0
+	}
+	return o, nil
+}
+
+func parseOIDString(s string) (oid, error) {
+	s = strings.TrimPrefix(s, ".")
+	parts := strings.Split(s, ".")
+	o := make(oid, 0, len(parts))
+	for _, p := range parts {
+		n, err := strconv.ParseUint(p, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid object identifier %q", s)
+		}
+		o = append(o, uint32(n))
+	}
+	return o, nil
+}
+
+func mustParseOID(s string) oid {
+	o, err := parseOIDString(s)
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+func (o oid) String() string {
+	var sb strings.Builder
+	for i, n := range o {
+		if i > 0 {
+			sb.WriteByte('.')
+		}
+		sb.WriteString(strconv.FormatUint(uint64(n), 10))
+	}
+	return sb.String()
+}
+
+// hasPrefix returns true if the OID starts with prefix.
+func (o oid) hasPrefix(prefix oid) bool {
+	if len(prefix) > len(o) {
+		return false
+	}
+	for i, n := range prefix {
+		if o[i] != n {
+			return false
+		}
+	}
+	return true
+}
+
+func (o oid) equal(other oid) bool {
+	return len(o) == len(other) && o.hasPrefix(other)
+}
+
+// appendTLV appends the encoding of a value.
+func appendTLV(b []byte, tag byte, value []byte) []byte {
+	b = append(b, tag)
+	b = appendLength(b, len(value))
+	return append(b, value...)
+}
+
+func appendLength(b []byte, n int) []byte {
+	switch {
+	case n < 0x80:
+		return append(b, byte(n))
+	case n <= 0xff:
+		return append(b, 0x81, byte(n))
+	case n <= 0xffff:
+		return append(b, 0x82, byte(n>>8), byte(n))
+	default:
+		return append(b, 0x84, byte(n>>24), byte(n>>16), byte(n>>8), byte(n))
+	}
+}
+
+func appendInt(b []byte, tag byte, n int64) []byte {
+	size := 1
+	for size < 8 && (n < -(1<<(8*size-1)) || n >= 1<<(8*size-1)) {
+		size++
+	}
+	value := make([]byte, size)
+	for i := size - 1; i >= 0; i-- {
+		value[i] = byte(n)
+		n >>= 8
+	}
+	return appendTLV(b, tag, value)
+}
+
+func appendUint(b []byte, tag byte, n uint64) []byte {
+	var value []byte
+	for {
+		value = append([]byte{byte(n)}, value...)
+		n >>= 8
+		if n == 0 {
+			break
+		}
+	}
+	if value[0]&0x80 != 0 {
+		value = append([]byte{0}, value...)
+	}
+	return appendTLV(b, tag, value)
+}
+
+func appendOID(b []byte, o oid) []byte {
+	var value []byte
+	if len(o) >= 2 {
+		value = appendBase128(value, 40*o[0]+o[1])
+		for _, n := range o[2:] {
+			value = appendBase128(value, n)
+		}
+	}
+	return appendTLV(b, tagOID, value)
+}
+
+func appendBase128(b []byte, n uint32) []byte {
+	var tmp [5]byte
+	i := len(tmp) - 1
+	tmp[i] = byte(n & 0x7f)
+	for n >>= 7; n > 0; n >>= 7 {
+		i--
+		tmp[i] = byte(n&0x7f) | 0x80
+	}
+	return append(b, tmp[i:]...)
+}
@@ -0,0 +1,126 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package snmptrap
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dustin/go-humanize"
+
+	"github.com/elastic/beats/v7/filebeat/inputsource/udp"
+)
+
+type config struct {
+	udp.Config `config:",inline"`
+
+	// MIBPaths are the directories the MIB files are loaded from.
+	MIBPaths []string `config:"mib_paths"`
+	// Communities are the accepted SNMPv1 and SNMPv2c communities, traps
+	// from any community are accepted if empty.
+	Communities []string `config:"communities"`
+	// EngineID is the engine ID of the input, in hexadecimal, it is needed
+	// to receive SNMPv3 informs.
+	EngineID string `config:"engine_id"`
+	// Users are the SNMPv3 USM users.
+	Users []userConfig `config:"users"`
+}
+
+type userConfig struct {
+	Name           string `config:"name" validate:"required"`
+	AuthProtocol   string `config:"auth_protocol"`
+	AuthPassphrase string `config:"auth_passphrase"`
+	PrivProtocol   string `config:"priv_protocol"`
+	PrivPassphrase string `config:"priv_passphrase"`
+}
+
+// minPassphraseLength is the minimum length of passphrases, as required by
+// RFC 3414.
+const minPassphraseLength = 8
+
+func defaultConfig() config {
+	return config{
+		Config: udp.Config{
+			Host:           "localhost:162",
+			MaxMessageSize: 64 * humanize.KiByte,
+			Timeout:        time.Minute * 5,
+		},
+	}
+}
+
+func (c *config) Validate() error {
+	if _, err := c.engineID(); err != nil {
+		return err
+	}
+
+	names := map[string]bool{}
+	for _, u := range c.Users {
+		if names[u.Name] {
+			return fmt.Errorf("user %q is defined more than once", u.Name)
+		}
+		names[u.Name] = true
+	}
+	return nil
+}
+
+// engineID decodes the configured engine ID.
+func (c *config) engineID() ([]byte, error) {
+	if c.EngineID == "" {
+		return nil, nil
+	}
+	id, err := hex.DecodeString(strings.TrimPrefix(c.EngineID, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid engine_id: %w", err)
+	}
+	if len(id) < 5 || len(id) > 32 {
+		return nil, errors.New("invalid engine_id: it must be between 5 and 32 bytes long")
+	}
+	return id, nil
+}
+
+func (c *userConfig) Validate() error {
+	c.AuthProtocol = strings.ToLower(c.AuthProtocol)
+	c.PrivProtocol = strings.ToLower(c.PrivProtocol)
+
+	if c.AuthProtocol != "" {
+		if _, found := authProtocols[c.AuthProtocol]; !found {
+			return fmt.Errorf("unsupported auth_protocol %q for user %q", c.AuthProtocol, c.Name)
+		}
+		if len(c.AuthPassphrase) < minPassphraseLength {
+			return fmt.Errorf("auth_passphrase of user %q must be at least %d characters long", c.Name, minPassphraseLength)
+		}
+	}
+
+	switch c.PrivProtocol {
+	case "":
+		return nil
+	case privDES, privAES:
+	default:
+		return fmt.Errorf("unsupported priv_protocol %q for user %q", c.PrivProtocol, c.Name)
+	}
+	if c.AuthProtocol == "" {
+		return fmt.Errorf("user %q needs an auth_protocol to use a priv_protocol", c.Name)
+	}
+	if len(c.PrivPassphrase) < minPassphraseLength {
+		return fmt.Errorf("priv_passphrase of user %q must be at least %d characters long", c.Name, minPassphraseLength)
+	}
+	return nil
+}
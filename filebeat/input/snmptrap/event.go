@@ -0,0 +1,176 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package snmptrap
+
+import (
+	"encoding/hex"
+	"net"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/elastic/beats/v7/filebeat/inputsource"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+var (
+	oidSysUpTime   = mustParseOID("1.3.6.1.2.1.1.3.0")
+	oidSnmpTrapOID = mustParseOID("1.3.6.1.6.3.1.1.4.1.0")
+	oidSnmpTraps   = mustParseOID("1.3.6.1.6.3.1.1.5")
+)
+
+// genericTrapEnterpriseSpecific is the generic trap number of the SNMPv1
+// traps defined by enterprises.
+const genericTrapEnterpriseSpecific = 6
+
+var versionNames = map[int64]string{
+	version1:  "1",
+	version2c: "2c",
+	version3:  "3",
+}
+
+func (r *receiver) createEvent(msg *message, metadata inputsource.NetworkMetadata) beat.Event {
+	p := msg.pdu
+	trap := common.MapStr{
+		"version":  versionNames[msg.version],
+		"pdu_type": pduNames[p.typ],
+	}
+
+	var trapOID oid
+	if p.typ == pduTrapV1 {
+		trapOID = v1TrapOID(p)
+		trap["enterprise"] = p.enterprise.String()
+		trap["agent_address"] = p.agentAddress.String()
+		trap["generic_trap"] = p.genericTrap
+		trap["specific_trap"] = p.specificTrap
+		trap["uptime"] = p.timestamp
+	} else {
+		trap["request_id"] = p.requestID
+		for _, vb := range p.varbinds {
+			switch {
+			case vb.oid.equal(oidSysUpTime):
+				if ticks, ok := vb.value.(uint64); ok {
+					trap["uptime"] = ticks
+				}
+			case vb.oid.equal(oidSnmpTrapOID):
+				if o, ok := vb.value.(oid); ok {
+					trapOID = o
+				}
+			}
+		}
+	}
+
+	if msg.version == version3 {
+		trap["user"] = msg.userName
+		trap["security_level"] = msg.securityLevel.String()
+		trap["engine_id"] = hex.EncodeToString(msg.engineID)
+		trap["context_engine_id"] = hex.EncodeToString(msg.contextEngineID)
+		if msg.contextName != "" {
+			trap["context_name"] = msg.contextName
+		}
+	}
+
+	varbinds := make([]common.MapStr, 0, len(p.varbinds))
+	for _, vb := range p.varbinds {
+		v := common.MapStr{
+			"oid":  vb.oid.String(),
+			"type": typeNames[vb.typ],
+		}
+		if name := r.mibs.resolve(vb.oid); name != "" {
+			v["name"] = name
+		}
+		if value := varbindValue(vb); value != nil {
+			v["value"] = value
+		}
+		varbinds = append(varbinds, v)
+	}
+	trap["varbinds"] = varbinds
+
+	event := beat.Event{
+		Timestamp: time.Now(),
+		Fields: common.MapStr{
+			"snmp_trap": trap,
+		},
+	}
+	if trapOID != nil {
+		trap["trap_oid"] = trapOID.String()
+		message := trapOID.String()
+		if name := r.mibs.resolve(trapOID); name != "" {
+			trap["trap_name"] = name
+			message = name
+		}
+		event.Fields["message"] = message
+	}
+	if metadata.RemoteAddr != nil {
+		event.Fields.Put("log.source.address", metadata.RemoteAddr.String())
+	}
+	return event
+}
+
+// v1TrapOID converts the identification of an SNMPv1 trap to the OID of the
+// equivalent SNMPv2 notification, as defined in RFC 3584.
+func v1TrapOID(p *pdu) oid {
+	var o oid
+	if p.genericTrap == genericTrapEnterpriseSpecific {
+		o = append(o, p.enterprise...)
+		return append(o, 0, uint32(p.specificTrap))
+	}
+	o = append(o, oidSnmpTraps...)
+	return append(o, uint32(p.genericTrap+1))
+}
+
+// varbindValue converts the value of a variable binding for the event.
+// Octet strings are kept as strings if they are printable, or converted to
+// colon separated hex otherwise.
+func varbindValue(vb varbind) interface{} {
+	switch v := vb.value.(type) {
+	case []byte:
+		if vb.typ == tagOctetString && isPrintable(v) {
+			return string(v)
+		}
+		return hexString(v)
+	case oid:
+		return v.String()
+	case net.IP:
+		return v.String()
+	default:
+		return v
+	}
+}
+
+func isPrintable(b []byte) bool {
+	if !utf8.Valid(b) {
+		return false
+	}
+	for _, r := range string(b) {
+		if !unicode.IsPrint(r) && !unicode.IsSpace(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func hexString(b []byte) string {
+	parts := make([]string, len(b))
+	for i, c := range b {
+		parts[i] = hex.EncodeToString([]byte{c})
+	}
+	return strings.Join(parts, ":")
+}
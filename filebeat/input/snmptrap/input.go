@@ -0,0 +1,188 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package snmptrap
+
+import (
+	"errors"
+	"fmt"
+	"net"
+
+	input "github.com/elastic/beats/v7/filebeat/input/v2"
+	stateless "github.com/elastic/beats/v7/filebeat/input/v2/input-stateless"
+	"github.com/elastic/beats/v7/filebeat/inputsource"
+	"github.com/elastic/beats/v7/filebeat/inputsource/udp"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/feature"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+const inputName = "snmp_trap"
+
+type server struct {
+	config
+	receiver *receiver
+}
+
+// Plugin creates the snmp_trap input plugin.
+func Plugin() input.Plugin {
+	return input.Plugin{
+		Name:       inputName,
+		Stability:  feature.Experimental,
+		Deprecated: false,
+		Info:       "SNMP trap receiver",
+		Manager:    stateless.NewInputManager(configure),
+	}
+}
+
+func configure(cfg *common.Config) (stateless.Input, error) {
+	config := defaultConfig()
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, err
+	}
+
+	mibs, err := loadMIBs(config.MIBPaths)
+	if err != nil {
+		return nil, err
+	}
+	r, err := newReceiver(config, mibs)
+	if err != nil {
+		return nil, err
+	}
+	return &server{config: config, receiver: r}, nil
+}
+
+func (s *server) Name() string { return inputName }
+
+func (s *server) Test(_ input.TestContext) error {
+	addr, err := net.ResolveUDPAddr("udp", s.Host)
+	if err != nil {
+		return err
+	}
+	conn, err := net.ListenUDP("udp", addr)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+func (s *server) Run(ctx input.Context, publisher stateless.Publisher) error {
+	log := ctx.Logger.Named("input.snmp_trap").With("address", s.Host)
+
+	log.Info("Starting SNMP trap input")
+	defer log.Info("SNMP trap input stopped")
+
+	var server *udp.Server
+	server = udp.New(&s.Config, func(data []byte, metadata inputsource.NetworkMetadata) {
+		msg, reply, err := s.receiver.handle(data)
+		if reply != nil && metadata.RemoteAddr != nil {
+			if _, err := server.Listener.WriteTo(reply, metadata.RemoteAddr); err != nil {
+				log.Warnw("Failed to reply to SNMP message", "error", err, "remote_address", metadata.RemoteAddr)
+			}
+		}
+		if err != nil {
+			logHandleError(log, err, metadata)
+			return
+		}
+		publisher.Publish(s.receiver.createEvent(msg, metadata))
+	})
+
+	if err := server.Start(); err != nil {
+		return err
+	}
+	<-ctx.Cancelation.Done()
+	server.Stop()
+	return nil
+}
+
+func logHandleError(log *logp.Logger, err error, metadata inputsource.NetworkMetadata) {
+	switch {
+	case errors.Is(err, errEngineDiscovery), errors.Is(err, errNotInTimeWindows):
+		// Part of the normal exchanges of SNMPv3 informs.
+		log.Debugw("Sent report to SNMP message", "report", err, "remote_address", metadata.RemoteAddr)
+	default:
+		log.Warnw("Dropping SNMP message", "error", err, "remote_address", metadata.RemoteAddr)
+	}
+}
+
+// receiver decodes SNMP messages, and prepares the replies to informs.
+type receiver struct {
+	communities map[string]bool
+	usm         *usm
+	mibs        *mibTree
+}
+
+func newReceiver(config config, mibs *mibTree) (*receiver, error) {
+	engineID, err := config.engineID()
+	if err != nil {
+		return nil, err
+	}
+
+	r := &receiver{
+		usm:  newUSM(engineID, config.Users),
+		mibs: mibs,
+	}
+	if len(config.Communities) > 0 {
+		r.communities = make(map[string]bool, len(config.Communities))
+		for _, c := range config.Communities {
+			r.communities[c] = true
+		}
+	}
+	return r, nil
+}
+
+// handle decodes an SNMP message. The reply, if any, must be sent back to the
+// sender even if an error is returned.
+func (r *receiver) handle(data []byte) (msg *message, reply []byte, err error) {
+	version, err := peekVersion(data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch version {
+	case version1, version2c:
+		msg, err = decodeCommunityMessage(data)
+		if err != nil {
+			return nil, nil, err
+		}
+		if r.communities != nil && !r.communities[msg.community] {
+			return nil, nil, errors.New("unknown community")
+		}
+		if msg.pdu.typ == pduInform {
+			reply = encodeCommunityMessage(version, msg.community,
+				encodePDU(pduResponse, msg.pdu.requestID, msg.pdu.rawVarbinds))
+		}
+		return msg, reply, nil
+	case version3:
+		return r.usm.handle(data)
+	default:
+		return nil, nil, fmt.Errorf("unsupported SNMP version %d", version)
+	}
+}
+
+// peekVersion returns the version of the SNMP message.
+func peekVersion(data []byte) (int64, error) {
+	content, _, err := expectTLV(data, tagSequence)
+	if err != nil {
+		return 0, fmt.Errorf("invalid SNMP message: %w", err)
+	}
+	version, _, err := readInt(content)
+	if err != nil {
+		return 0, fmt.Errorf("invalid SNMP version: %w", err)
+	}
+	return version, nil
+}
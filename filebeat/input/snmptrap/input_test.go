@@ -0,0 +1,202 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package snmptrap
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/filebeat/inputsource"
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+// encodeVarbinds encodes a list of variable bindings, the values are
+// encoded TLVs.
+func encodeVarbinds(varbinds ...[2][]byte) []byte {
+	var list []byte
+	for _, vb := range varbinds {
+		list = appendTLV(list, tagSequence, append(append([]byte(nil), vb[0]...), vb[1]...))
+	}
+	return appendTLV(nil, tagSequence, list)
+}
+
+func vb(name string, value []byte) [2][]byte {
+	return [2][]byte{appendOID(nil, mustParseOID(name)), value}
+}
+
+func testVarbinds() []byte {
+	return encodeVarbinds(
+		vb("1.3.6.1.2.1.1.3.0", appendUint(nil, tagTimeTicks, 123456)),
+		vb("1.3.6.1.6.3.1.1.4.1.0", appendOID(nil, mustParseOID("1.3.6.1.4.1.99999.2.1"))),
+		vb("1.3.6.1.4.1.99999.1.1.1.2.4", appendOID(nil, mustParseOID("1.3.6.1.4.1.99999.3"))),
+		vb("1.3.6.1.4.1.99999.1.1.1.3.4", appendInt(nil, tagInteger, -40)),
+		vb("1.3.6.1.6.3.18.1.3.0", appendTLV(nil, tagIPAddress, []byte{10, 0, 0, 1})),
+		vb("1.3.6.1.2.1.1.5.0", appendTLV(nil, tagOctetString, []byte("switch-1"))),
+		vb("1.3.6.1.2.1.2.2.1.6.4", appendTLV(nil, tagOctetString, []byte{0x00, 0x1b, 0x2c, 0xff})),
+		vb("1.3.6.1.2.1.31.1.1.1.6.4", appendUint(nil, tagCounter64, 1<<40)),
+	)
+}
+
+func newTestReceiver(t *testing.T, settings map[string]interface{}) *receiver {
+	config := defaultConfig()
+	require.NoError(t, common.MustNewConfigFrom(settings).Unpack(&config))
+	mibs, err := loadMIBs([]string{"testdata"})
+	require.NoError(t, err)
+	r, err := newReceiver(config, mibs)
+	require.NoError(t, err)
+	return r
+}
+
+var testMetadata = inputsource.NetworkMetadata{
+	RemoteAddr: &net.UDPAddr{IP: net.IPv4(192, 0, 2, 1), Port: 40000},
+}
+
+func TestV2cTrap(t *testing.T) {
+	r := newTestReceiver(t, nil)
+
+	data := encodeCommunityMessage(version2c, "public", encodePDU(pduTrapV2, 42, testVarbinds()))
+	msg, reply, err := r.handle(data)
+	require.NoError(t, err)
+	assert.Nil(t, reply)
+
+	event := r.createEvent(msg, testMetadata)
+	assert.Equal(t, common.MapStr{
+		"message": "ACME-MIB::acmeSensorAlarm",
+		"log":     common.MapStr{"source": common.MapStr{"address": "192.0.2.1:40000"}},
+		"snmp_trap": common.MapStr{
+			"version":    "2c",
+			"pdu_type":   "trap",
+			"request_id": int64(42),
+			"uptime":     uint64(123456),
+			"trap_oid":   "1.3.6.1.4.1.99999.2.1",
+			"trap_name":  "ACME-MIB::acmeSensorAlarm",
+			"varbinds": []common.MapStr{
+				{"oid": "1.3.6.1.2.1.1.3.0", "name": "SNMPv2-MIB::sysUpTime.0", "type": "timeticks", "value": uint64(123456)},
+				{"oid": "1.3.6.1.6.3.1.1.4.1.0", "name": "SNMPv2-MIB::snmpTrapOID.0", "type": "object_identifier", "value": "1.3.6.1.4.1.99999.2.1"},
+				{"oid": "1.3.6.1.4.1.99999.1.1.1.2.4", "name": "ACME-MIB::acmeSensorType.4", "type": "object_identifier", "value": "1.3.6.1.4.1.99999.3"},
+				{"oid": "1.3.6.1.4.1.99999.1.1.1.3.4", "name": "ACME-MIB::acmeSensorValue.4", "type": "integer", "value": int64(-40)},
+				{"oid": "1.3.6.1.6.3.18.1.3.0", "name": "SNMP-COMMUNITY-MIB::snmpTrapAddress.0", "type": "ip_address", "value": "10.0.0.1"},
+				{"oid": "1.3.6.1.2.1.1.5.0", "name": "SNMPv2-MIB::sysName.0", "type": "octet_string", "value": "switch-1"},
+				{"oid": "1.3.6.1.2.1.2.2.1.6.4", "name": "SNMPv2-SMI::mib-2.2.2.1.6.4", "type": "octet_string", "value": "00:1b:2c:ff"},
+				{"oid": "1.3.6.1.2.1.31.1.1.1.6.4", "name": "SNMPv2-SMI::mib-2.31.1.1.1.6.4", "type": "counter64", "value": uint64(1 << 40)},
+			},
+		},
+	}, event.Fields)
+}
+
+func TestV2cInform(t *testing.T) {
+	r := newTestReceiver(t, nil)
+
+	varbinds := testVarbinds()
+	data := encodeCommunityMessage(version2c, "public", encodePDU(pduInform, 7, varbinds))
+	msg, reply, err := r.handle(data)
+	require.NoError(t, err)
+	assert.Equal(t, "inform", pduNames[msg.pdu.typ])
+	assert.Equal(t, encodeCommunityMessage(version2c, "public", encodePDU(pduResponse, 7, varbinds)), reply)
+}
+
+func TestV1Trap(t *testing.T) {
+	r := newTestReceiver(t, nil)
+
+	trap := func(enterprise string, generic, specific int64) []byte {
+		var content []byte
+		content = appendOID(content, mustParseOID(enterprise))
+		content = appendTLV(content, tagIPAddress, []byte{10, 0, 0, 2})
+		content = appendInt(content, tagInteger, generic)
+		content = appendInt(content, tagInteger, specific)
+		content = appendUint(content, tagTimeTicks, 500)
+		content = append(content, encodeVarbinds(
+			vb("1.3.6.1.4.1.99999.1.1.1.3.1", appendInt(nil, tagInteger, 300)),
+		)...)
+		return encodeCommunityMessage(version1, "public", appendTLV(nil, pduTrapV1, content))
+	}
+
+	msg, reply, err := r.handle(trap("1.3.6.1.4.1.88888", 6, 7))
+	require.NoError(t, err)
+	assert.Nil(t, reply)
+	event := r.createEvent(msg, testMetadata)
+	assert.Equal(t, common.MapStr{
+		"version":       "1",
+		"pdu_type":      "trap",
+		"enterprise":    "1.3.6.1.4.1.88888",
+		"agent_address": "10.0.0.2",
+		"generic_trap":  int64(6),
+		"specific_trap": int64(7),
+		"uptime":        uint64(500),
+		"trap_oid":      "1.3.6.1.4.1.88888.0.7",
+		"trap_name":     "ACME-MIB::acmeOldAlarm",
+		"varbinds": []common.MapStr{
+			{"oid": "1.3.6.1.4.1.99999.1.1.1.3.1", "name": "ACME-MIB::acmeSensorValue.1", "type": "integer", "value": int64(300)},
+		},
+	}, event.Fields["snmp_trap"])
+
+	msg, _, err = r.handle(trap("1.3.6.1.4.1.88888", 2, 0))
+	require.NoError(t, err)
+	event = r.createEvent(msg, testMetadata)
+	assert.Equal(t, "IF-MIB::linkDown", event.Fields["message"])
+}
+
+func TestCommunities(t *testing.T) {
+	r := newTestReceiver(t, map[string]interface{}{"communities": []string{"private"}})
+
+	_, _, err := r.handle(encodeCommunityMessage(version2c, "public", encodePDU(pduTrapV2, 1, testVarbinds())))
+	assert.Error(t, err)
+
+	_, _, err = r.handle(encodeCommunityMessage(version2c, "private", encodePDU(pduTrapV2, 1, testVarbinds())))
+	assert.NoError(t, err)
+}
+
+func TestInvalidMessages(t *testing.T) {
+	r := newTestReceiver(t, nil)
+
+	valid := encodeCommunityMessage(version2c, "public", encodePDU(pduTrapV2, 1, testVarbinds()))
+	tests := map[string][]byte{
+		"empty":       nil,
+		"truncated":   valid[:len(valid)-3],
+		"not a trap":  encodeCommunityMessage(version2c, "public", encodePDU(0xa0, 1, testVarbinds())),
+		"v1 with v2":  encodeCommunityMessage(version1, "public", encodePDU(pduTrapV2, 1, testVarbinds())),
+		"bad version": encodeCommunityMessage(2, "public", encodePDU(pduTrapV2, 1, testVarbinds())),
+		"bad value":   encodeCommunityMessage(version2c, "public", encodePDU(pduTrapV2, 1, encodeVarbinds(vb("1.3.6.1", appendTLV(nil, 0x47, nil))))),
+	}
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, _, err := r.handle(data)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestBERIntegers(t *testing.T) {
+	for _, n := range []int64{0, 1, -1, 127, 128, -128, -129, 255, 256, 1<<31 - 1, -1 << 31, 1<<63 - 1, -1 << 63} {
+		value, rest, err := readInt(appendInt(nil, tagInteger, n))
+		require.NoError(t, err)
+		assert.Empty(t, rest)
+		assert.Equal(t, n, value)
+	}
+
+	for _, n := range []uint64{0, 127, 128, 1<<32 - 1, 1<<64 - 1} {
+		_, value, _, err := readTLV(appendUint(nil, tagCounter64, n))
+		require.NoError(t, err)
+		decoded, err := parseUint(value, 64)
+		require.NoError(t, err)
+		assert.Equal(t, n, decoded)
+	}
+}
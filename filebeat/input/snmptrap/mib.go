@@ -0,0 +1,385 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package snmptrap
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// mibNode is a named node of the OID tree.
+type mibNode struct {
+	module string
+	name   string
+}
+
+// mibTree resolves OIDs to the names defined in MIB modules.
+type mibTree struct {
+	nodes map[string]mibNode
+}
+
+// mibDefinition is the definition of an OID in a MIB module, relative to
+// the OID of its parent.
+type mibDefinition struct {
+	module string
+	name   string
+	// parent is the name of the parent node, it is empty for definitions
+	// with absolute OIDs.
+	parent string
+	subIDs []uint32
+	// implicit is set for the nodes defined by the named components of the
+	// OIDs of other definitions, like org(3) in { iso org(3) }.
+	implicit bool
+}
+
+// builtinDefinitions define the nodes of SNMPv2-SMI and the objects used in
+// traps, so they can be resolved without loading any MIB file.
+var builtinDefinitions = []struct {
+	module, name, oid string
+}{
+	{"SNMPv2-SMI", "iso", "1"},
+	{"SNMPv2-SMI", "org", "1.3"},
+	{"SNMPv2-SMI", "dod", "1.3.6"},
+	{"SNMPv2-SMI", "internet", "1.3.6.1"},
+	{"SNMPv2-SMI", "directory", "1.3.6.1.1"},
+	{"SNMPv2-SMI", "mgmt", "1.3.6.1.2"},
+	{"SNMPv2-SMI", "mib-2", "1.3.6.1.2.1"},
+	{"SNMPv2-SMI", "transmission", "1.3.6.1.2.1.10"},
+	{"SNMPv2-SMI", "experimental", "1.3.6.1.3"},
+	{"SNMPv2-SMI", "private", "1.3.6.1.4"},
+	{"SNMPv2-SMI", "enterprises", "1.3.6.1.4.1"},
+	{"SNMPv2-SMI", "security", "1.3.6.1.5"},
+	{"SNMPv2-SMI", "snmpV2", "1.3.6.1.6"},
+	{"SNMPv2-SMI", "snmpDomains", "1.3.6.1.6.1"},
+	{"SNMPv2-SMI", "snmpProxys", "1.3.6.1.6.2"},
+	{"SNMPv2-SMI", "snmpModules", "1.3.6.1.6.3"},
+	{"SNMPv2-MIB", "system", "1.3.6.1.2.1.1"},
+	{"SNMPv2-MIB", "sysDescr", "1.3.6.1.2.1.1.1"},
+	{"SNMPv2-MIB", "sysObjectID", "1.3.6.1.2.1.1.2"},
+	{"SNMPv2-MIB", "sysUpTime", "1.3.6.1.2.1.1.3"},
+	{"SNMPv2-MIB", "sysContact", "1.3.6.1.2.1.1.4"},
+	{"SNMPv2-MIB", "sysName", "1.3.6.1.2.1.1.5"},
+	{"SNMPv2-MIB", "sysLocation", "1.3.6.1.2.1.1.6"},
+	{"SNMPv2-MIB", "snmpMIB", "1.3.6.1.6.3.1"},
+	{"SNMPv2-MIB", "snmpMIBObjects", "1.3.6.1.6.3.1.1"},
+	{"SNMPv2-MIB", "snmpTrap", "1.3.6.1.6.3.1.1.4"},
+	{"SNMPv2-MIB", "snmpTrapOID", "1.3.6.1.6.3.1.1.4.1"},
+	{"SNMPv2-MIB", "snmpTrapEnterprise", "1.3.6.1.6.3.1.1.4.3"},
+	{"SNMPv2-MIB", "snmpTraps", "1.3.6.1.6.3.1.1.5"},
+	{"SNMPv2-MIB", "coldStart", "1.3.6.1.6.3.1.1.5.1"},
+	{"SNMPv2-MIB", "warmStart", "1.3.6.1.6.3.1.1.5.2"},
+	{"IF-MIB", "linkDown", "1.3.6.1.6.3.1.1.5.3"},
+	{"IF-MIB", "linkUp", "1.3.6.1.6.3.1.1.5.4"},
+	{"SNMPv2-MIB", "authenticationFailure", "1.3.6.1.6.3.1.1.5.5"},
+	{"SNMP-COMMUNITY-MIB", "snmpTrapAddress", "1.3.6.1.6.3.18.1.3"},
+	{"SNMP-COMMUNITY-MIB", "snmpTrapCommunity", "1.3.6.1.6.3.18.1.4"},
+}
+
+// rootNodes are the nodes at the top of the OID tree.
+var rootNodes = map[string]uint32{
+	"ccitt":           0,
+	"itu-t":           0,
+	"iso":             1,
+	"joint-iso-ccitt": 2,
+	"joint-iso-itu-t": 2,
+}
+
+// macros are the macros that assign an OID to the defined name.
+var macros = map[string]bool{
+	"OBJECT-TYPE":        true,
+	"OBJECT-IDENTITY":    true,
+	"MODULE-IDENTITY":    true,
+	"NOTIFICATION-TYPE":  true,
+	"TRAP-TYPE":          true,
+	"OBJECT-GROUP":       true,
+	"NOTIFICATION-GROUP": true,
+	"MODULE-COMPLIANCE":  true,
+	"AGENT-CAPABILITIES": true,
+}
+
+// loadMIBs loads the MIB files found in the given directories. The built-in
+// definitions are always available.
+func loadMIBs(dirs []string) (*mibTree, error) {
+	var defs []mibDefinition
+	for _, d := range builtinDefinitions {
+		defs = append(defs, mibDefinition{module: d.module, name: d.name, subIDs: mustParseOID(d.oid)})
+	}
+
+	for _, dir := range dirs {
+		files, err := ioutil.ReadDir(dir)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read MIB directory: %w", err)
+		}
+		for _, f := range files {
+			if !f.Mode().IsRegular() {
+				continue
+			}
+			data, err := ioutil.ReadFile(filepath.Join(dir, f.Name()))
+			if err != nil {
+				return nil, fmt.Errorf("failed to read MIB file: %w", err)
+			}
+			defs = append(defs, parseMIB(data)...)
+		}
+	}
+
+	return newMIBTree(defs), nil
+}
+
+// newMIBTree resolves the OIDs of the definitions. Definitions whose parent
+// is unknown are ignored.
+func newMIBTree(defs []mibDefinition) *mibTree {
+	byName := make(map[string]*mibDefinition, len(defs))
+	for i := range defs {
+		// Later definitions override previous ones, so MIB files take
+		// precedence over the built-in definitions. Implicit definitions
+		// never override explicit ones.
+		if prev, found := byName[defs[i].name]; found && defs[i].implicit && !prev.implicit {
+			continue
+		}
+		byName[defs[i].name] = &defs[i]
+	}
+
+	resolved := map[string]oid{}
+	var resolve func(name string, depth int) (oid, bool)
+	resolve = func(name string, depth int) (oid, bool) {
+		if o, found := resolved[name]; found {
+			return o, true
+		}
+		def, found := byName[name]
+		if !found {
+			if n, isRoot := rootNodes[name]; isRoot {
+				return oid{n}, true
+			}
+			return nil, false
+		}
+		// Guard against loops in invalid definitions.
+		if depth > 128 {
+			return nil, false
+		}
+
+		var o oid
+		if def.parent != "" {
+			parent, ok := resolve(def.parent, depth+1)
+			if !ok {
+				return nil, false
+			}
+			o = append(o, parent...)
+		}
+		o = append(o, def.subIDs...)
+		resolved[name] = o
+		return o, true
+	}
+
+	// When several names share an OID, explicit assignments are preferred
+	// over the names of OID components, and then the first declared wins.
+	t := &mibTree{nodes: make(map[string]mibNode, len(byName))}
+	implicit := map[string]bool{}
+	for i := range defs {
+		def := &defs[i]
+		if byName[def.name] != def {
+			continue
+		}
+		o, ok := resolve(def.name, 0)
+		if !ok || len(o) == 0 {
+			continue
+		}
+		key := o.String()
+		if _, found := t.nodes[key]; found && (def.implicit || !implicit[key]) {
+			continue
+		}
+		t.nodes[key] = mibNode{module: def.module, name: def.name}
+		implicit[key] = def.implicit
+	}
+	return t
+}
+
+// resolve returns the name of the OID, in the MODULE::name.index format, or
+// an empty string if no parent of the OID is known.
+func (t *mibTree) resolve(o oid) string {
+	for n := len(o); n > 0; n-- {
+		node, found := t.nodes[o[:n].String()]
+		if !found {
+			continue
+		}
+		name := node.module + "::" + node.name
+		if n < len(o) {
+			name += "." + o[n:].String()
+		}
+		return name
+	}
+	return ""
+}
+
+// parseMIB extracts the OID definitions of the MIB modules in data. Only the
+// assignments needed to resolve OIDs are parsed, the rest of the module is
+// skipped.
+func parseMIB(data []byte) []mibDefinition {
+	tokens := tokenizeMIB(string(data))
+
+	var defs []mibDefinition
+	var module string
+	for i := 0; i < len(tokens); i++ {
+		tok := tokens[i]
+		if tok == "DEFINITIONS" && i > 0 {
+			module = tokens[i-1]
+			continue
+		}
+		if !isValueName(tok) || i+1 >= len(tokens) {
+			continue
+		}
+
+		next := tokens[i+1]
+		var enterprise string
+		var value int
+		switch {
+		case next == "OBJECT" && i+3 < len(tokens) && tokens[i+2] == "IDENTIFIER" && tokens[i+3] == "::=":
+			value = i + 4
+		case macros[next]:
+			j := i + 2
+			for ; j < len(tokens) && tokens[j] != "::="; j++ {
+				if tokens[j] == "ENTERPRISE" && j+1 < len(tokens) {
+					enterprise = tokens[j+1]
+				}
+			}
+			value = j + 1
+		default:
+			continue
+		}
+		if value >= len(tokens) {
+			break
+		}
+
+		if next == "TRAP-TYPE" {
+			// SNMPv1 traps are identified by the enterprise and the
+			// specific trap number, their SNMPv2 OID is enterprise.0.n.
+			n, err := strconv.ParseUint(tokens[value], 10, 32)
+			if err == nil && enterprise != "" {
+				defs = append(defs, mibDefinition{module: module, name: tok, parent: enterprise, subIDs: []uint32{0, uint32(n)}})
+			}
+			i = value
+			continue
+		}
+
+		var end int
+		defs, end = parseOIDValue(defs, module, tok, tokens, value)
+		i = end
+	}
+	return defs
+}
+
+// parseOIDValue parses an OID value like { parent name(1) 2 } starting at
+// tokens[i]. Named components define intermediate nodes.
+func parseOIDValue(defs []mibDefinition, module, name string, tokens []string, i int) ([]mibDefinition, int) {
+	if tokens[i] != "{" {
+		return defs, i
+	}
+
+	var parent string
+	var subIDs []uint32
+	for i++; i < len(tokens) && tokens[i] != "}"; i++ {
+		tok := tokens[i]
+		if n, err := strconv.ParseUint(tok, 10, 32); err == nil {
+			subIDs = append(subIDs, uint32(n))
+			continue
+		}
+		if i+3 < len(tokens) && tokens[i+1] == "(" && tokens[i+3] == ")" {
+			// name(number)
+			n, err := strconv.ParseUint(tokens[i+2], 10, 32)
+			if err != nil {
+				return defs, i
+			}
+			subIDs = append(subIDs, uint32(n))
+			defs = append(defs, mibDefinition{
+				module:   module,
+				name:     tok,
+				parent:   parent,
+				subIDs:   append([]uint32(nil), subIDs...),
+				implicit: true,
+			})
+			i += 3
+			continue
+		}
+		if parent != "" || len(subIDs) > 0 {
+			// Only the first component can be a reference to another node.
+			return defs, i
+		}
+		parent = tok
+	}
+	if len(subIDs) == 0 && parent == "" {
+		return defs, i
+	}
+	return append(defs, mibDefinition{module: module, name: name, parent: parent, subIDs: subIDs}), i
+}
+
+// isValueName returns true if tok is a valid name for a value, value names
+// start with a lowercase letter.
+func isValueName(tok string) bool {
+	return len(tok) > 0 && tok[0] >= 'a' && tok[0] <= 'z'
+}
+
+// tokenizeMIB splits a MIB module into tokens, skipping comments. Quoted
+// strings are replaced by a single `"` token.
+func tokenizeMIB(s string) []string {
+	var tokens []string
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case unicode.IsSpace(rune(c)):
+			i++
+		case strings.HasPrefix(s[i:], "--"):
+			// Comments end at the end of the line, or at the next --.
+			end := i + 2
+			for end < len(s) && s[end] != '\n' && !strings.HasPrefix(s[end:], "--") {
+				end++
+			}
+			if strings.HasPrefix(s[end:], "--") {
+				end += 2
+			}
+			i = end
+		case c == '"':
+			end := strings.IndexByte(s[i+1:], '"')
+			if end < 0 {
+				return tokens
+			}
+			tokens = append(tokens, `"`)
+			i += end + 2
+		case strings.HasPrefix(s[i:], "::="):
+			tokens = append(tokens, "::=")
+			i += 3
+		case isIdentChar(c):
+			end := i
+			for end < len(s) && isIdentChar(s[end]) && !strings.HasPrefix(s[end:], "--") {
+				end++
+			}
+			tokens = append(tokens, s[i:end])
+			i = end
+		default:
+			tokens = append(tokens, string(c))
+			i++
+		}
+	}
+	return tokens
+}
+
+func isIdentChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_'
+}
@@ -0,0 +1,80 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package snmptrap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadMIBs(t *testing.T) {
+	mibs, err := loadMIBs([]string{"testdata"})
+	require.NoError(t, err)
+
+	tests := map[string]string{
+		"1.3.6.1.4.1.99999":           "ACME-MIB::acme",
+		"1.3.6.1.4.1.99999.1.1.1.3.4": "ACME-MIB::acmeSensorValue.4",
+		"1.3.6.1.4.1.99999.1.1.1.2.4": "ACME-MIB::acmeSensorType.4",
+		"1.3.6.1.4.1.99999.2.1":       "ACME-MIB::acmeSensorAlarm",
+		"1.3.6.1.4.1.88888":           "ACME-MIB::acmeLegacy",
+		"1.3.6.1.4.1.88888.0.7":       "ACME-MIB::acmeOldAlarm",
+		"1.3.6.1.4.1.12345.1":         "SNMPv2-SMI::enterprises.12345.1",
+		"1.3.6.1.2.1.1.3.0":           "SNMPv2-MIB::sysUpTime.0",
+		"1.3.6.1.6.3.1.1.5.3":         "IF-MIB::linkDown",
+		"2.5":                         "",
+	}
+	for o, expected := range tests {
+		assert.Equal(t, expected, mibs.resolve(mustParseOID(o)), o)
+	}
+}
+
+func TestLoadMIBsMissingDirectory(t *testing.T) {
+	_, err := loadMIBs([]string{"testdata/missing"})
+	assert.Error(t, err)
+}
+
+func TestParseMIBIgnoresUnknownParents(t *testing.T) {
+	mibs := newMIBTree(parseMIB([]byte(`
+TEST-MIB DEFINITIONS ::= BEGIN
+orphan OBJECT IDENTIFIER ::= { unknownParent 1 }
+loopA  OBJECT IDENTIFIER ::= { loopB 1 }
+loopB  OBJECT IDENTIFIER ::= { loopA 1 }
+END`)))
+	for _, node := range mibs.nodes {
+		assert.NotEqual(t, "TEST-MIB", node.module)
+	}
+}
+
+func TestParseMIBSharedOIDs(t *testing.T) {
+	defs := parseMIB([]byte(`
+TEST-MIB DEFINITIONS ::= BEGIN
+implied  OBJECT IDENTIFIER ::= { iso node(1) 1 }
+explicit OBJECT IDENTIFIER ::= { iso 1 }
+first    OBJECT IDENTIFIER ::= { iso 2 }
+second   OBJECT IDENTIFIER ::= { iso 2 }
+END`))
+	// The names are chosen while iterating the definitions, build the tree
+	// a few times to make sure the result doesn't depend on map order.
+	for i := 0; i < 10; i++ {
+		mibs := newMIBTree(defs)
+		assert.Equal(t, "TEST-MIB::explicit", mibs.resolve(mustParseOID("1.1")))
+		assert.Equal(t, "TEST-MIB::first", mibs.resolve(mustParseOID("1.2")))
+	}
+}
@@ -0,0 +1,282 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package snmptrap
+
+import (
+	"errors"
+	"fmt"
+	"net"
+)
+
+// SNMP versions, as encoded in messages.
+const (
+	version1  = 0
+	version2c = 1
+	version3  = 3
+)
+
+// PDU types.
+const (
+	pduResponse = 0xa2
+	pduTrapV1   = 0xa4
+	pduInform   = 0xa6
+	pduTrapV2   = 0xa7
+	pduReport   = 0xa8
+)
+
+var pduNames = map[byte]string{
+	pduTrapV1: "trap",
+	pduInform: "inform",
+	pduTrapV2: "trap",
+}
+
+var errUnsupportedPDU = errors.New("unsupported PDU type")
+
+// message is a decoded SNMP message.
+type message struct {
+	version   int64
+	community string
+	pdu       *pdu
+
+	// SNMPv3 fields.
+	msgID           int64
+	securityLevel   securityLevel
+	engineID        []byte
+	userName        string
+	contextEngineID []byte
+	contextName     string
+}
+
+// pdu is a trap or inform PDU.
+type pdu struct {
+	typ       byte
+	requestID int64
+	varbinds  []varbind
+	// rawVarbinds is the encoded list of variable bindings, echoed in the
+	// responses to informs.
+	rawVarbinds []byte
+
+	// Fields of SNMPv1 traps.
+	enterprise   oid
+	agentAddress net.IP
+	genericTrap  int64
+	specificTrap int64
+	timestamp    uint64
+}
+
+// varbind is a variable binding.
+type varbind struct {
+	oid   oid
+	typ   byte
+	value interface{}
+}
+
+// decodeCommunityMessage decodes the SNMPv1 or SNMPv2c message in b.
+func decodeCommunityMessage(b []byte) (*message, error) {
+	content, _, err := expectTLV(b, tagSequence)
+	if err != nil {
+		return nil, err
+	}
+	version, content, err := readInt(content)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version: %w", err)
+	}
+	community, content, err := readOctetString(content)
+	if err != nil {
+		return nil, fmt.Errorf("invalid community: %w", err)
+	}
+	p, err := decodePDU(content)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case version == version1 && p.typ != pduTrapV1,
+		version == version2c && p.typ == pduTrapV1:
+		return nil, fmt.Errorf("%w 0x%x for version %d", errUnsupportedPDU, p.typ, version)
+	}
+	return &message{version: version, community: string(community), pdu: p}, nil
+}
+
+// decodePDU decodes the PDU at the start of b.
+func decodePDU(b []byte) (*pdu, error) {
+	tag, content, _, err := readTLV(b)
+	if err != nil {
+		return nil, fmt.Errorf("invalid PDU: %w", err)
+	}
+
+	p := &pdu{typ: tag}
+	switch tag {
+	case pduTrapV1:
+		err = p.decodeTrapV1(content)
+	case pduTrapV2, pduInform:
+		err = p.decode(content)
+	default:
+		return nil, fmt.Errorf("%w 0x%x", errUnsupportedPDU, tag)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid PDU: %w", err)
+	}
+	return p, nil
+}
+
+func (p *pdu) decode(b []byte) error {
+	var err error
+	if p.requestID, b, err = readInt(b); err != nil {
+		return err
+	}
+	// error-status and error-index are always 0 in notifications.
+	if _, b, err = readInt(b); err != nil {
+		return err
+	}
+	if _, b, err = readInt(b); err != nil {
+		return err
+	}
+	return p.decodeVarbinds(b)
+}
+
+func (p *pdu) decodeTrapV1(b []byte) error {
+	var err error
+	if p.enterprise, b, err = readOID(b); err != nil {
+		return fmt.Errorf("invalid enterprise: %w", err)
+	}
+
+	addr, b, err := expectTLV(b, tagIPAddress)
+	if err != nil {
+		return fmt.Errorf("invalid agent address: %w", err)
+	}
+	if len(addr) != net.IPv4len {
+		return fmt.Errorf("invalid agent address length %d", len(addr))
+	}
+	p.agentAddress = net.IP(append([]byte(nil), addr...))
+
+	if p.genericTrap, b, err = readInt(b); err != nil {
+		return fmt.Errorf("invalid generic trap: %w", err)
+	}
+	if p.specificTrap, b, err = readInt(b); err != nil {
+		return fmt.Errorf("invalid specific trap: %w", err)
+	}
+
+	ticks, b, err := expectTLV(b, tagTimeTicks)
+	if err != nil {
+		return fmt.Errorf("invalid time stamp: %w", err)
+	}
+	if p.timestamp, err = parseUint(ticks, 32); err != nil {
+		return fmt.Errorf("invalid time stamp: %w", err)
+	}
+	return p.decodeVarbinds(b)
+}
+
+func (p *pdu) decodeVarbinds(b []byte) error {
+	list, rest, err := expectTLV(b, tagSequence)
+	if err != nil {
+		return fmt.Errorf("invalid variable bindings: %w", err)
+	}
+	p.rawVarbinds = b[:len(b)-len(rest)]
+
+	for len(list) > 0 {
+		var vb []byte
+		if vb, list, err = expectTLV(list, tagSequence); err != nil {
+			return fmt.Errorf("invalid variable binding: %w", err)
+		}
+
+		var v varbind
+		if v.oid, vb, err = readOID(vb); err != nil {
+			return fmt.Errorf("invalid variable binding name: %w", err)
+		}
+		var raw []byte
+		if v.typ, raw, _, err = readTLV(vb); err != nil {
+			return fmt.Errorf("invalid value of %v: %w", v.oid, err)
+		}
+		if v.value, err = decodeValue(v.typ, raw); err != nil {
+			return fmt.Errorf("invalid value of %v: %w", v.oid, err)
+		}
+		p.varbinds = append(p.varbinds, v)
+	}
+	return nil
+}
+
+// decodeValue decodes the value of a variable binding.
+func decodeValue(tag byte, b []byte) (interface{}, error) {
+	switch tag {
+	case tagInteger:
+		return parseInt(b)
+	case tagOctetString, tagOpaque:
+		return append([]byte(nil), b...), nil
+	case tagNull, tagNoSuchObject, tagNoSuchInstance, tagEndOfMIBView:
+		return nil, nil
+	case tagOID:
+		return parseOID(b)
+	case tagIPAddress:
+		if len(b) != net.IPv4len {
+			return nil, fmt.Errorf("invalid IP address length %d", len(b))
+		}
+		return net.IP(append([]byte(nil), b...)), nil
+	case tagCounter32, tagGauge32, tagTimeTicks:
+		return parseUint(b, 32)
+	case tagCounter64:
+		return parseUint(b, 64)
+	default:
+		return nil, fmt.Errorf("unsupported type 0x%x", tag)
+	}
+}
+
+// typeNames are the names of the types of variable bindings.
+var typeNames = map[byte]string{
+	tagInteger:        "integer",
+	tagOctetString:    "octet_string",
+	tagNull:           "null",
+	tagOID:            "object_identifier",
+	tagIPAddress:      "ip_address",
+	tagCounter32:      "counter32",
+	tagGauge32:        "gauge32",
+	tagTimeTicks:      "timeticks",
+	tagOpaque:         "opaque",
+	tagCounter64:      "counter64",
+	tagNoSuchObject:   "no_such_object",
+	tagNoSuchInstance: "no_such_instance",
+	tagEndOfMIBView:   "end_of_mib_view",
+}
+
+// encodePDU encodes a PDU with the given encoded variable bindings.
+func encodePDU(typ byte, requestID int64, varbinds []byte) []byte {
+	var content []byte
+	content = appendInt(content, tagInteger, requestID)
+	content = appendInt(content, tagInteger, 0) // error-status
+	content = appendInt(content, tagInteger, 0) // error-index
+	content = append(content, varbinds...)
+	return appendTLV(nil, typ, content)
+}
+
+// encodeCommunityMessage encodes an SNMPv1 or SNMPv2c message.
+func encodeCommunityMessage(version int64, community string, pdu []byte) []byte {
+	var content []byte
+	content = appendInt(content, tagInteger, version)
+	content = appendTLV(content, tagOctetString, []byte(community))
+	content = append(content, pdu...)
+	return appendTLV(nil, tagSequence, content)
+}
+
+// encodeCounterVarbinds encodes a list with a single Counter32 variable
+// binding, as sent in reports.
+func encodeCounterVarbinds(name oid, value uint64) []byte {
+	var vb []byte
+	vb = appendOID(vb, name)
+	vb = appendUint(vb, tagCounter32, value)
+	return appendTLV(nil, tagSequence, appendTLV(nil, tagSequence, vb))
+}
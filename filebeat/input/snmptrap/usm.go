@@ -0,0 +1,660 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package snmptrap
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/des"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"sync"
+	"time"
+)
+
+// securityLevel is the security level of an SNMPv3 message.
+type securityLevel int
+
+const (
+	noAuthNoPriv securityLevel = iota
+	authNoPriv
+	authPriv
+)
+
+func (l securityLevel) String() string {
+	switch l {
+	case noAuthNoPriv:
+		return "noAuthNoPriv"
+	case authNoPriv:
+		return "authNoPriv"
+	case authPriv:
+		return "authPriv"
+	}
+	return "unknown"
+}
+
+// Bits of msgFlags.
+const (
+	flagAuth       = 0x01
+	flagPriv       = 0x02
+	flagReportable = 0x04
+)
+
+const securityModelUSM = 3
+
+// timeWindow is the maximum difference, in seconds, between the time of the
+// local engine and the time in the informs it receives.
+const timeWindow = 150
+
+// maxMessageSize is the maximum message size advertised in replies.
+const maxMessageSize = 65507
+
+// Counters of the USM statistics sent in reports.
+var (
+	usmStatsNotInTimeWindows = mustParseOID("1.3.6.1.6.3.15.1.1.2.0")
+	usmStatsUnknownEngineIDs = mustParseOID("1.3.6.1.6.3.15.1.1.4.0")
+)
+
+var (
+	errUnknownUser      = errors.New("unknown user")
+	errWrongDigest      = errors.New("wrong digest")
+	errSecurityLevel    = errors.New("unsupported security level")
+	errNoLocalEngineID  = errors.New("received a confirmed SNMPv3 message, but engine_id is not configured")
+	errDecryption       = errors.New("decryption error")
+	errSecurityModel    = errors.New("unsupported security model")
+	errEngineDiscovery  = errors.New("engine ID discovery")
+	errNotInTimeWindows = errors.New("message not in time window")
+)
+
+type authProtocol struct {
+	hash func() hash.Hash
+	// macLen is the length of the truncated HMAC sent in messages.
+	macLen int
+}
+
+// authProtocols are the supported authentication protocols, as defined in
+// RFC 3414 and RFC 7860.
+var authProtocols = map[string]authProtocol{
+	"md5":    {hash: md5.New, macLen: 12},
+	"sha":    {hash: sha1.New, macLen: 12},
+	"sha224": {hash: sha256.New224, macLen: 16},
+	"sha256": {hash: sha256.New, macLen: 24},
+	"sha384": {hash: sha512.New384, macLen: 32},
+	"sha512": {hash: sha512.New, macLen: 48},
+}
+
+// Supported privacy protocols, CBC-DES (RFC 3414) and CFB128-AES-128
+// (RFC 3826).
+const (
+	privDES = "des"
+	privAES = "aes"
+)
+
+// usmUser is a USM user. Its keys are derived from the passphrases, and
+// localized for each engine ID.
+type usmUser struct {
+	name    string
+	auth    *authProtocol
+	priv    string
+	authKey []byte
+	privKey []byte
+}
+
+func newUSMUser(c userConfig) *usmUser {
+	user := &usmUser{name: c.Name, priv: c.PrivProtocol}
+	if c.AuthProtocol != "" {
+		auth := authProtocols[c.AuthProtocol]
+		user.auth = &auth
+		user.authKey = passwordToKey(auth.hash, []byte(c.AuthPassphrase))
+		if c.PrivProtocol != "" {
+			user.privKey = passwordToKey(auth.hash, []byte(c.PrivPassphrase))
+		}
+	}
+	return user
+}
+
+func (u *usmUser) securityLevel() securityLevel {
+	switch {
+	case u.priv != "":
+		return authPriv
+	case u.auth != nil:
+		return authNoPriv
+	}
+	return noAuthNoPriv
+}
+
+type localizedKeys struct {
+	auth []byte
+	priv []byte
+}
+
+// usm implements the User-based Security Model of SNMPv3 (RFC 3414).
+//
+// The sender of traps is the authoritative engine, so their keys are
+// localized with the engine ID of the sender. The receiver of informs is the
+// authoritative engine, so informs use the keys localized with the local
+// engine ID, that senders discover with a report.
+type usm struct {
+	engineID []byte
+	boots    int64
+	start    time.Time
+	users    map[string]*usmUser
+
+	mu   sync.Mutex
+	keys map[string]localizedKeys
+	salt uint64
+}
+
+func newUSM(engineID []byte, users []userConfig) *usm {
+	u := &usm{
+		engineID: engineID,
+		boots:    1,
+		start:    time.Now(),
+		users:    make(map[string]*usmUser, len(users)),
+		keys:     map[string]localizedKeys{},
+	}
+	for _, c := range users {
+		u.users[c.Name] = newUSMUser(c)
+	}
+	var salt [8]byte
+	rand.Read(salt[:])
+	u.salt = binary.BigEndian.Uint64(salt[:])
+	return u
+}
+
+// engineTime returns the number of seconds since the local engine started.
+func (u *usm) engineTime() int64 {
+	return int64(time.Since(u.start) / time.Second)
+}
+
+func (u *usm) localizedKeys(user *usmUser, engineID []byte) localizedKeys {
+	if user.auth == nil {
+		return localizedKeys{}
+	}
+
+	id := user.name + "\x00" + string(engineID)
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	keys, found := u.keys[id]
+	if !found {
+		keys.auth = localizeKey(user.auth.hash, user.authKey, engineID)
+		if user.privKey != nil {
+			keys.priv = localizeKey(user.auth.hash, user.privKey, engineID)
+		}
+		u.keys[id] = keys
+	}
+	return keys
+}
+
+func (u *usm) nextSalt() uint64 {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.salt++
+	return u.salt
+}
+
+// passwordToKey derives a key from a passphrase, as defined in RFC 3414
+// A.2.
+func passwordToKey(newHash func() hash.Hash, password []byte) []byte {
+	h := newHash()
+	buf := make([]byte, 64)
+	var idx int
+	for count := 0; count < 1048576; count += len(buf) {
+		for i := range buf {
+			buf[i] = password[idx%len(password)]
+			idx++
+		}
+		h.Write(buf)
+	}
+	return h.Sum(nil)
+}
+
+// localizeKey localizes a key to an engine ID.
+func localizeKey(newHash func() hash.Hash, key, engineID []byte) []byte {
+	h := newHash()
+	h.Write(key)
+	h.Write(engineID)
+	h.Write(key)
+	return h.Sum(nil)
+}
+
+// v3Message is an undecoded SNMPv3 message.
+type v3Message struct {
+	raw      []byte
+	msgID    int64
+	flags    byte
+	engineID []byte
+	boots    int64
+	time     int64
+	userName string
+	// authParams is a slice of raw.
+	authParams []byte
+	privParams []byte
+	// data is the plaintext scoped PDU, or the encrypted one.
+	data []byte
+}
+
+func (m *v3Message) securityLevel() (securityLevel, error) {
+	switch m.flags & (flagAuth | flagPriv) {
+	case 0:
+		return noAuthNoPriv, nil
+	case flagAuth:
+		return authNoPriv, nil
+	case flagAuth | flagPriv:
+		return authPriv, nil
+	}
+	return 0, fmt.Errorf("invalid msgFlags 0x%x", m.flags)
+}
+
+func decodeV3Message(raw []byte) (*v3Message, error) {
+	m := &v3Message{raw: raw}
+
+	content, _, err := expectTLV(raw, tagSequence)
+	if err != nil {
+		return nil, err
+	}
+	if _, content, err = readInt(content); err != nil {
+		return nil, fmt.Errorf("invalid version: %w", err)
+	}
+
+	global, content, err := expectTLV(content, tagSequence)
+	if err != nil {
+		return nil, fmt.Errorf("invalid header: %w", err)
+	}
+	if m.msgID, global, err = readInt(global); err != nil {
+		return nil, fmt.Errorf("invalid msgID: %w", err)
+	}
+	if _, global, err = readInt(global); err != nil {
+		return nil, fmt.Errorf("invalid msgMaxSize: %w", err)
+	}
+	flags, global, err := readOctetString(global)
+	if err != nil || len(flags) != 1 {
+		return nil, fmt.Errorf("invalid msgFlags")
+	}
+	m.flags = flags[0]
+	model, _, err := readInt(global)
+	if err != nil {
+		return nil, fmt.Errorf("invalid msgSecurityModel: %w", err)
+	}
+	if model != securityModelUSM {
+		return nil, fmt.Errorf("%w %d", errSecurityModel, model)
+	}
+
+	params, content, err := readOctetString(content)
+	if err != nil {
+		return nil, fmt.Errorf("invalid msgSecurityParameters: %w", err)
+	}
+	if err := m.decodeSecurityParameters(params); err != nil {
+		return nil, fmt.Errorf("invalid msgSecurityParameters: %w", err)
+	}
+
+	m.data = content
+	return m, nil
+}
+
+func (m *v3Message) decodeSecurityParameters(b []byte) error {
+	params, _, err := expectTLV(b, tagSequence)
+	if err != nil {
+		return err
+	}
+	var userName []byte
+	if m.engineID, params, err = readOctetString(params); err != nil {
+		return err
+	}
+	if m.boots, params, err = readInt(params); err != nil {
+		return err
+	}
+	if m.time, params, err = readInt(params); err != nil {
+		return err
+	}
+	if userName, params, err = readOctetString(params); err != nil {
+		return err
+	}
+	m.userName = string(userName)
+	if m.authParams, params, err = readOctetString(params); err != nil {
+		return err
+	}
+	m.privParams, _, err = readOctetString(params)
+	return err
+}
+
+// handle authenticates and decodes an SNMPv3 message. The reply is the
+// message to send back to the sender, if any, it is the response to an inform
+// or a report.
+func (u *usm) handle(raw []byte) (msg *message, reply []byte, err error) {
+	m, err := decodeV3Message(raw)
+	if err != nil {
+		return nil, nil, err
+	}
+	level, err := m.securityLevel()
+	if err != nil {
+		return nil, nil, err
+	}
+	reportable := m.flags&flagReportable != 0
+
+	// The local engine is authoritative for informs.
+	if reportable && !bytes.Equal(m.engineID, u.engineID) {
+		if len(u.engineID) == 0 {
+			return nil, nil, errNoLocalEngineID
+		}
+		var requestID int64
+		if level != authPriv {
+			requestID = peekRequestID(m.data)
+		}
+		reply, err := u.encodeReport(m, nil, requestID, noAuthNoPriv, usmStatsUnknownEngineIDs)
+		if err != nil {
+			return nil, nil, err
+		}
+		return nil, reply, errEngineDiscovery
+	}
+
+	user, found := u.users[m.userName]
+	if !found {
+		return nil, nil, fmt.Errorf("%w %q", errUnknownUser, m.userName)
+	}
+	if level != user.securityLevel() {
+		return nil, nil, fmt.Errorf("%w %v for user %q", errSecurityLevel, level, user.name)
+	}
+
+	keys := u.localizedKeys(user, m.engineID)
+	if level >= authNoPriv && !m.authenticate(user.auth, keys.auth) {
+		return nil, nil, errWrongDigest
+	}
+
+	scopedPDU := m.data
+	if level == authPriv {
+		encrypted, _, err := readOctetString(m.data)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid encrypted PDU: %w", err)
+		}
+		scopedPDU, err = decrypt(user.priv, keys.priv, m.privParams, m.boots, m.time, encrypted)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if reportable && (m.boots != u.boots || abs(m.time-u.engineTime()) > timeWindow) {
+		reply, err := u.encodeReport(m, user, peekRequestID(scopedPDU), authNoPriv, usmStatsNotInTimeWindows)
+		if err != nil {
+			return nil, nil, err
+		}
+		return nil, reply, errNotInTimeWindows
+	}
+
+	content, _, err := expectTLV(scopedPDU, tagSequence)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid scoped PDU: %w", err)
+	}
+	contextEngineID, content, err := readOctetString(content)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid context engine ID: %w", err)
+	}
+	contextName, content, err := readOctetString(content)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid context name: %w", err)
+	}
+	p, err := decodePDU(content)
+	if err != nil {
+		return nil, nil, err
+	}
+	if p.typ == pduTrapV1 {
+		return nil, nil, fmt.Errorf("%w 0x%x for version 3", errUnsupportedPDU, p.typ)
+	}
+
+	msg = &message{
+		version:         version3,
+		pdu:             p,
+		msgID:           m.msgID,
+		securityLevel:   level,
+		engineID:        m.engineID,
+		userName:        m.userName,
+		contextEngineID: contextEngineID,
+		contextName:     string(contextName),
+	}
+	if p.typ == pduInform {
+		reply, err = u.encodeMessage(m.msgID, level, false, user, keys, m.userName, contextName,
+			encodePDU(pduResponse, p.requestID, p.rawVarbinds))
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return msg, reply, nil
+}
+
+// authenticate checks the HMAC of the message.
+func (m *v3Message) authenticate(auth *authProtocol, key []byte) bool {
+	if len(m.authParams) != auth.macLen {
+		return false
+	}
+	received := append([]byte(nil), m.authParams...)
+	for i := range m.authParams {
+		m.authParams[i] = 0
+	}
+	mac := hmac.New(auth.hash, key)
+	mac.Write(m.raw)
+	copy(m.authParams, received)
+	return hmac.Equal(mac.Sum(nil)[:auth.macLen], received)
+}
+
+// peekRequestID returns the request ID of a plaintext scoped PDU of any type,
+// or 0 if it can't be decoded.
+func peekRequestID(scopedPDU []byte) int64 {
+	content, _, err := expectTLV(scopedPDU, tagSequence)
+	if err != nil {
+		return 0
+	}
+	if _, content, err = readOctetString(content); err != nil {
+		return 0
+	}
+	if _, content, err = readOctetString(content); err != nil {
+		return 0
+	}
+	_, content, _, err = readTLV(content)
+	if err != nil {
+		return 0
+	}
+	requestID, _, _ := readInt(content)
+	return requestID
+}
+
+// encodeReport encodes a report about the message m.
+func (u *usm) encodeReport(m *v3Message, user *usmUser, requestID int64, level securityLevel, counter oid) ([]byte, error) {
+	var keys localizedKeys
+	if user != nil {
+		keys = u.localizedKeys(user, u.engineID)
+	}
+	pdu := encodePDU(pduReport, requestID, encodeCounterVarbinds(counter, 1))
+	return u.encodeMessage(m.msgID, level, false, user, keys, m.userName, nil, pdu)
+}
+
+// encodeMessage encodes an SNMPv3 message from the local engine, it is the
+// authoritative engine of the message.
+func (u *usm) encodeMessage(
+	msgID int64,
+	level securityLevel,
+	reportable bool,
+	user *usmUser,
+	keys localizedKeys,
+	userName string,
+	contextName []byte,
+	pdu []byte,
+) ([]byte, error) {
+	boots, engineTime := u.boots, u.engineTime()
+
+	var scoped []byte
+	scoped = appendTLV(scoped, tagOctetString, u.engineID)
+	scoped = appendTLV(scoped, tagOctetString, contextName)
+	scoped = append(scoped, pdu...)
+	data := appendTLV(nil, tagSequence, scoped)
+
+	var flags byte
+	if reportable {
+		flags |= flagReportable
+	}
+	var privParams []byte
+	if level == authPriv {
+		var encrypted []byte
+		var err error
+		encrypted, privParams, err = encrypt(user.priv, keys.priv, u.nextSalt(), boots, engineTime, data)
+		if err != nil {
+			return nil, err
+		}
+		data = appendTLV(nil, tagOctetString, encrypted)
+		flags |= flagPriv
+	}
+
+	var macLen int
+	if level >= authNoPriv {
+		macLen = user.auth.macLen
+		flags |= flagAuth
+	}
+
+	var global []byte
+	global = appendInt(global, tagInteger, msgID)
+	global = appendInt(global, tagInteger, maxMessageSize)
+	global = appendTLV(global, tagOctetString, []byte{flags})
+	global = appendInt(global, tagInteger, securityModelUSM)
+
+	// authOffset tracks the position of the authentication parameters in
+	// the message, they are set once the whole message is encoded.
+	var params []byte
+	params = appendTLV(params, tagOctetString, u.engineID)
+	params = appendInt(params, tagInteger, boots)
+	params = appendInt(params, tagInteger, engineTime)
+	params = appendTLV(params, tagOctetString, []byte(userName))
+	params = appendTLV(params, tagOctetString, make([]byte, macLen))
+	authOffset := len(params) - macLen
+	params = appendTLV(params, tagOctetString, privParams)
+
+	encodedParams := appendTLV(nil, tagSequence, params)
+	authOffset += len(encodedParams) - len(params)
+	securityParams := appendTLV(nil, tagOctetString, encodedParams)
+	authOffset += len(securityParams) - len(encodedParams)
+
+	var content []byte
+	content = appendInt(content, tagInteger, version3)
+	content = appendTLV(content, tagSequence, global)
+	authOffset += len(content)
+	content = append(content, securityParams...)
+	content = append(content, data...)
+
+	msg := appendTLV(nil, tagSequence, content)
+	authOffset += len(msg) - len(content)
+
+	if macLen > 0 {
+		mac := hmac.New(user.auth.hash, keys.auth)
+		mac.Write(msg)
+		copy(msg[authOffset:authOffset+macLen], mac.Sum(nil))
+	}
+	return msg, nil
+}
+
+func decrypt(protocol string, key, salt []byte, boots, engineTime int64, data []byte) ([]byte, error) {
+	if len(salt) != 8 {
+		return nil, fmt.Errorf("%w: invalid salt length %d", errDecryption, len(salt))
+	}
+
+	switch protocol {
+	case privDES:
+		if len(data)%des.BlockSize != 0 {
+			return nil, fmt.Errorf("%w: invalid length %d", errDecryption, len(data))
+		}
+		block, err := des.NewCipher(key[:8])
+		if err != nil {
+			return nil, err
+		}
+		iv := make([]byte, des.BlockSize)
+		for i := range iv {
+			iv[i] = key[8+i] ^ salt[i]
+		}
+		plain := make([]byte, len(data))
+		cipher.NewCBCDecrypter(block, iv).CryptBlocks(plain, data)
+		return plain, nil
+
+	case privAES:
+		block, err := aes.NewCipher(key[:16])
+		if err != nil {
+			return nil, err
+		}
+		plain := make([]byte, len(data))
+		cipher.NewCFBDecrypter(block, aesIV(boots, engineTime, salt)).XORKeyStream(plain, data)
+		return plain, nil
+	}
+	return nil, fmt.Errorf("%w: unsupported privacy protocol %q", errDecryption, protocol)
+}
+
+func encrypt(protocol string, key []byte, salt uint64, boots, engineTime int64, data []byte) (encrypted, privParams []byte, err error) {
+	privParams = make([]byte, 8)
+
+	switch protocol {
+	case privDES:
+		binary.BigEndian.PutUint32(privParams, uint32(boots))
+		binary.BigEndian.PutUint32(privParams[4:], uint32(salt))
+		block, err := des.NewCipher(key[:8])
+		if err != nil {
+			return nil, nil, err
+		}
+		iv := make([]byte, des.BlockSize)
+		for i := range iv {
+			iv[i] = key[8+i] ^ privParams[i]
+		}
+		if pad := len(data) % des.BlockSize; pad != 0 {
+			data = append(data, make([]byte, des.BlockSize-pad)...)
+		}
+		encrypted = make([]byte, len(data))
+		cipher.NewCBCEncrypter(block, iv).CryptBlocks(encrypted, data)
+		return encrypted, privParams, nil
+
+	case privAES:
+		binary.BigEndian.PutUint64(privParams, salt)
+		block, err := aes.NewCipher(key[:16])
+		if err != nil {
+			return nil, nil, err
+		}
+		encrypted = make([]byte, len(data))
+		cipher.NewCFBEncrypter(block, aesIV(boots, engineTime, privParams)).XORKeyStream(encrypted, data)
+		return encrypted, privParams, nil
+	}
+	return nil, nil, fmt.Errorf("unsupported privacy protocol %q", protocol)
+}
+
+// aesIV builds the initialization vector of CFB128-AES-128 from the
+// authoritative engine boots and time, and the salt.
+func aesIV(boots, engineTime int64, salt []byte) []byte {
+	iv := make([]byte, aes.BlockSize)
+	binary.BigEndian.PutUint32(iv, uint32(boots))
+	binary.BigEndian.PutUint32(iv[4:], uint32(engineTime))
+	copy(iv[8:], salt)
+	return iv
+}
+
+func abs(n int64) int64 {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,199 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package snmptrap
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalizeKey(t *testing.T) {
+	// Test vectors from RFC 3414 A.3.
+	engineID, _ := hex.DecodeString("000000000000000000000002")
+	tests := map[string]string{
+		"md5": "526f5eed9fcce26f8964c2930787d82b",
+		"sha": "6695febc9288e36282235fc7151f128497b38f3f",
+	}
+	for protocol, expected := range tests {
+		t.Run(protocol, func(t *testing.T) {
+			auth := authProtocols[protocol]
+			key := localizeKey(auth.hash, passwordToKey(auth.hash, []byte("maplesyrup")), engineID)
+			assert.Equal(t, expected, hex.EncodeToString(key))
+		})
+	}
+}
+
+var (
+	testUsers = []userConfig{
+		{Name: "noauth"},
+		{Name: "authonly", AuthProtocol: "sha256", AuthPassphrase: "authpassphrase"},
+		{Name: "des", AuthProtocol: "md5", AuthPassphrase: "authpassphrase", PrivProtocol: privDES, PrivPassphrase: "privpassphrase"},
+		{Name: "aes", AuthProtocol: "sha", AuthPassphrase: "authpassphrase", PrivProtocol: privAES, PrivPassphrase: "privpassphrase"},
+	}
+	receiverEngineID = []byte{0x80, 0x00, 0x1f, 0x88, 0x04, 'b', 'e', 'a', 't', 's'}
+	senderEngineID   = []byte{0x80, 0x00, 0x1f, 0x88, 0x04, 'a', 'g', 'e', 'n', 't'}
+)
+
+// encodeV3 encodes a message sent by the engine of the usm.
+func encodeV3(t *testing.T, u *usm, userName string, reportable bool, pdu []byte) []byte {
+	user := u.users[userName]
+	data, err := u.encodeMessage(1234, user.securityLevel(), reportable, user, u.localizedKeys(user, u.engineID), userName, []byte("ctx"), pdu)
+	require.NoError(t, err)
+	return data
+}
+
+// decodeReply authenticates and decrypts a reply from the receiver, according
+// to its flags, and returns its PDU.
+func decodeReply(t *testing.T, u *usm, userName string, reply []byte) *pdu {
+	m, err := decodeV3Message(reply)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1234), m.msgID)
+	assert.Zero(t, m.flags&flagReportable)
+
+	data := m.data
+	if user := u.users[userName]; user != nil {
+		keys := u.localizedKeys(user, m.engineID)
+		if m.flags&flagAuth != 0 {
+			require.True(t, m.authenticate(user.auth, keys.auth))
+		}
+		if m.flags&flagPriv != 0 {
+			encrypted, _, err := readOctetString(m.data)
+			require.NoError(t, err)
+			data, err = decrypt(user.priv, keys.priv, m.privParams, m.boots, m.time, encrypted)
+			require.NoError(t, err)
+		}
+	}
+
+	content, _, err := expectTLV(data, tagSequence)
+	require.NoError(t, err)
+	_, content, err = readOctetString(content)
+	require.NoError(t, err)
+	_, content, err = readOctetString(content)
+	require.NoError(t, err)
+	tag, content, _, err := readTLV(content)
+	require.NoError(t, err)
+	p := &pdu{typ: tag}
+	require.NoError(t, p.decode(content))
+	return p
+}
+
+func TestV3Traps(t *testing.T) {
+	receiver := newUSM(receiverEngineID, testUsers)
+	sender := newUSM(senderEngineID, testUsers)
+
+	for _, user := range testUsers {
+		t.Run(user.Name, func(t *testing.T) {
+			data := encodeV3(t, sender, user.Name, false, encodePDU(pduTrapV2, 99, testVarbinds()))
+			msg, reply, err := receiver.handle(data)
+			require.NoError(t, err)
+			assert.Nil(t, reply)
+
+			assert.Equal(t, int64(version3), msg.version)
+			assert.Equal(t, user.Name, msg.userName)
+			assert.Equal(t, sender.users[user.Name].securityLevel(), msg.securityLevel)
+			assert.Equal(t, senderEngineID, msg.engineID)
+			assert.Equal(t, senderEngineID, msg.contextEngineID)
+			assert.Equal(t, "ctx", msg.contextName)
+			assert.Equal(t, int64(99), msg.pdu.requestID)
+			assert.Len(t, msg.pdu.varbinds, 8)
+		})
+	}
+}
+
+func TestV3TrapErrors(t *testing.T) {
+	receiver := newUSM(receiverEngineID, testUsers)
+	sender := newUSM(senderEngineID, testUsers)
+	other := newUSM(senderEngineID, []userConfig{
+		{Name: "authonly", AuthProtocol: "sha256", AuthPassphrase: "otherpassphrase"},
+		{Name: "aes", AuthProtocol: "sha", AuthPassphrase: "authpassphrase"},
+		{Name: "unknown"},
+	})
+	pdu := encodePDU(pduTrapV2, 1, testVarbinds())
+
+	_, _, err := receiver.handle(encodeV3(t, other, "authonly", false, pdu))
+	assert.True(t, errors.Is(err, errWrongDigest), err)
+
+	_, _, err = receiver.handle(encodeV3(t, other, "aes", false, pdu))
+	assert.True(t, errors.Is(err, errSecurityLevel), err)
+
+	_, _, err = receiver.handle(encodeV3(t, other, "unknown", false, pdu))
+	assert.True(t, errors.Is(err, errUnknownUser), err)
+
+	// Tamper with the encrypted data.
+	data := encodeV3(t, sender, "aes", false, pdu)
+	data[len(data)-1] ^= 0xff
+	_, _, err = receiver.handle(data)
+	assert.True(t, errors.Is(err, errWrongDigest), err)
+}
+
+func TestV3Informs(t *testing.T) {
+	receiver := newUSM(receiverEngineID, testUsers)
+
+	// The sender discovers the engine ID of the receiver with an
+	// unauthenticated request.
+	discovery := newUSM(nil, nil)
+	discovery.users["noauth"] = &usmUser{name: "noauth"}
+	msg, reply, err := receiver.handle(encodeV3(t, discovery, "noauth", true, encodePDU(pduInform, 5, nil)))
+	assert.True(t, errors.Is(err, errEngineDiscovery), err)
+	assert.Nil(t, msg)
+	report := decodeReply(t, discovery, "", reply)
+	assert.Equal(t, byte(pduReport), report.typ)
+	assert.Equal(t, int64(5), report.requestID)
+	require.Len(t, report.varbinds, 1)
+	assert.Equal(t, usmStatsUnknownEngineIDs.String(), report.varbinds[0].oid.String())
+	m, err := decodeV3Message(reply)
+	require.NoError(t, err)
+	assert.Equal(t, receiverEngineID, m.engineID)
+
+	// Then it sends informs with the keys localized with the engine ID of
+	// the receiver.
+	sender := newUSM(receiverEngineID, testUsers)
+	for _, user := range testUsers {
+		t.Run(user.Name, func(t *testing.T) {
+			varbinds := testVarbinds()
+			msg, reply, err := receiver.handle(encodeV3(t, sender, user.Name, true, encodePDU(pduInform, 77, varbinds)))
+			require.NoError(t, err)
+			assert.Equal(t, byte(pduInform), msg.pdu.typ)
+
+			response := decodeReply(t, sender, user.Name, reply)
+			assert.Equal(t, byte(pduResponse), response.typ)
+			assert.Equal(t, int64(77), response.requestID)
+			assert.Equal(t, varbinds, response.rawVarbinds)
+		})
+	}
+}
+
+func TestV3InformNotInTimeWindow(t *testing.T) {
+	receiver := newUSM(receiverEngineID, testUsers)
+	sender := newUSM(receiverEngineID, testUsers)
+	sender.boots = 2
+
+	msg, reply, err := receiver.handle(encodeV3(t, sender, "aes", true, encodePDU(pduInform, 8, testVarbinds())))
+	assert.True(t, errors.Is(err, errNotInTimeWindows), err)
+	assert.Nil(t, msg)
+
+	report := decodeReply(t, sender, "aes", reply)
+	assert.Equal(t, byte(pduReport), report.typ)
+	assert.Equal(t, int64(8), report.requestID)
+	require.Len(t, report.varbinds, 1)
+	assert.Equal(t, usmStatsNotInTimeWindows.String(), report.varbinds[0].oid.String())
+}
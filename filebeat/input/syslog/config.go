@@ -89,9 +89,9 @@ func factory(
 			return nil, err
 		}
 
-		splitFunc := netcommon.SplitFunc([]byte(config.LineDelimiter))
-		if splitFunc == nil {
-			return nil, fmt.Errorf("error creating splitFunc from delimiter %s", config.LineDelimiter)
+		splitFunc, err := netcommon.FramingSplitFunc(config.Framing, []byte(config.LineDelimiter), uint64(config.MaxMessageSize))
+		if err != nil {
+			return nil, fmt.Errorf("error creating splitFunc from framing %s: %v", config.Framing, err)
 		}
 
 		logger := logp.NewLogger("input.syslog.tcp").With("address", config.Config.Host)
@@ -106,9 +106,9 @@ func factory(
 			return nil, err
 		}
 
-		splitFunc := netcommon.SplitFunc([]byte(config.LineDelimiter))
-		if splitFunc == nil {
-			return nil, fmt.Errorf("error creating splitFunc from delimiter %s", config.LineDelimiter)
+		splitFunc, err := netcommon.FramingSplitFunc(config.Framing, []byte(config.LineDelimiter), uint64(config.MaxMessageSize))
+		if err != nil {
+			return nil, fmt.Errorf("error creating splitFunc from framing %s: %v", config.Framing, err)
 		}
 
 		logger := logp.NewLogger("input.syslog.unix").With("path", config.Config.Path)
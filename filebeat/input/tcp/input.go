@@ -75,9 +75,9 @@ func NewInput(
 		forwarder.Send(event)
 	}
 
-	splitFunc := netcommon.SplitFunc([]byte(config.LineDelimiter))
-	if splitFunc == nil {
-		return nil, fmt.Errorf("unable to create splitFunc for delimiter %s", config.LineDelimiter)
+	splitFunc, err := netcommon.FramingSplitFunc(config.Framing, []byte(config.LineDelimiter), uint64(config.MaxMessageSize))
+	if err != nil {
+		return nil, fmt.Errorf("unable to create splitFunc for framing %s: %v", config.Framing, err)
 	}
 
 	logger := logp.NewLogger("input.tcp").With("address", config.Config.Host)
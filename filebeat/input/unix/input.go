@@ -59,9 +59,9 @@ func configure(cfg *common.Config) (stateless.Input, error) {
 }
 
 func newServer(config config) (*server, error) {
-	splitFunc := netcommon.SplitFunc([]byte(config.LineDelimiter))
-	if splitFunc == nil {
-		return nil, fmt.Errorf("unable to create splitFunc for delimiter %s", config.LineDelimiter)
+	splitFunc, err := netcommon.FramingSplitFunc(config.Framing, []byte(config.LineDelimiter), uint64(config.MaxMessageSize))
+	if err != nil {
+		return nil, fmt.Errorf("unable to create splitFunc for framing %s: %v", config.Framing, err)
 	}
 
 	return &server{config: config, splitFunc: splitFunc}, nil
@@ -28,4 +28,7 @@ type ListenerConfig struct {
 	Timeout        time.Duration
 	MaxMessageSize cfgtype.ByteSize
 	MaxConnections int
+
+	// FrameHeaderSize is the size of the framing header read along each message.
+	FrameHeaderSize uint64
 }
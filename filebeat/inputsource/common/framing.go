@@ -0,0 +1,184 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package common
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// FramingType defines how a stream of bytes is split into messages.
+type FramingType uint8
+
+// List of supported framing types.
+const (
+	// FramingDelimiter splits the stream using the configured line delimiter.
+	FramingDelimiter FramingType = iota
+	// FramingRFC6587 splits the stream using RFC 6587 octet counting, messages that
+	// do not start with a length fall back to the configured line delimiter.
+	FramingRFC6587
+	// FramingLengthPrefixed splits the stream using a 4-byte big-endian length prefix.
+	FramingLengthPrefixed
+	// FramingNullTerminated splits the stream on NUL bytes, as used by GELF over TCP.
+	FramingNullTerminated
+)
+
+var framingTypeNames = map[FramingType]string{
+	FramingDelimiter:      "delimiter",
+	FramingRFC6587:        "rfc6587",
+	FramingLengthPrefixed: "length_prefixed",
+	FramingNullTerminated: "null_terminated",
+}
+
+// ErrFrameTooLarge is returned when a frame announces a size bigger than the configured
+// max_message_size.
+var ErrFrameTooLarge = errors.New("frame size exceeds max_message_size")
+
+func (f FramingType) String() string {
+	return framingTypeNames[f]
+}
+
+// Unpack unpacks the framing type from its string representation.
+func (f *FramingType) Unpack(s string) error {
+	s = strings.ToLower(s)
+	for typ, name := range framingTypeNames {
+		if s == name {
+			*f = typ
+			return nil
+		}
+	}
+	return errors.Errorf("invalid framing type: %v", s)
+}
+
+// FramingSplitFunc returns the split function used to extract messages for the given framing
+// type. The line delimiter is only used by the delimiter and rfc6587 framing types. Frames that
+// announce a size bigger than maxMessageSize are rejected without being read.
+func FramingSplitFunc(framing FramingType, lineDelimiter []byte, maxMessageSize uint64) (bufio.SplitFunc, error) {
+	switch framing {
+	case FramingDelimiter:
+		return SplitFunc(lineDelimiter), nil
+	case FramingRFC6587:
+		return factoryRFC6587(SplitFunc(lineDelimiter), maxMessageSize), nil
+	case FramingLengthPrefixed:
+		return factoryLengthPrefixed(maxMessageSize), nil
+	case FramingNullTerminated:
+		return FactoryDelimiter([]byte{0}), nil
+	default:
+		return nil, fmt.Errorf("unknown framing type %v", framing)
+	}
+}
+
+// FramingHeaderSize returns the maximum size of the header preceding each message for the given
+// framing type. Readers need room for it in addition to maxMessageSize, so messages of exactly
+// maxMessageSize bytes can be read.
+func FramingHeaderSize(framing FramingType, maxMessageSize uint64) uint64 {
+	switch framing {
+	case FramingRFC6587:
+		// Length in ASCII followed by a space.
+		return uint64(len(strconv.FormatUint(maxMessageSize, 10))) + 1
+	case FramingLengthPrefixed:
+		return lengthPrefixSize
+	default:
+		return 0
+	}
+}
+
+// factoryRFC6587 returns a split function implementing the octet counting method described in
+// RFC 6587 section 3.4.1, where each message is prefixed by its length in ASCII and a space.
+// Messages not starting with a digit use the non-transparent framing handled by fallback.
+func factoryRFC6587(fallback bufio.SplitFunc, maxMessageSize uint64) bufio.SplitFunc {
+	return func(data []byte, eof bool) (int, []byte, error) {
+		if eof && len(data) == 0 {
+			return 0, nil, nil
+		}
+
+		if len(data) == 0 || data[0] < '1' || data[0] > '9' {
+			return fallback(data, eof)
+		}
+
+		sp := bytes.IndexByte(data, ' ')
+		if sp < 0 {
+			// The length of a frame is bounded by max_message_size, any longer
+			// sequence of digits is not a valid frame.
+			if len(data) > len(strconv.FormatUint(maxMessageSize, 10)) {
+				return 0, nil, ErrFrameTooLarge
+			}
+			if eof {
+				return 0, nil, fmt.Errorf("incomplete octet counting frame")
+			}
+			return 0, nil, nil
+		}
+
+		size, err := strconv.ParseUint(string(data[:sp]), 10, 64)
+		if err != nil {
+			return 0, nil, errors.Wrap(err, "invalid octet counting frame length")
+		}
+		if size > maxMessageSize {
+			return 0, nil, ErrFrameTooLarge
+		}
+
+		end := sp + 1 + int(size)
+		if len(data) < end {
+			if eof {
+				return 0, nil, fmt.Errorf("incomplete octet counting frame, expected %d bytes", size)
+			}
+			return 0, nil, nil
+		}
+		return end, data[sp+1 : end], nil
+	}
+}
+
+// lengthPrefixSize is the size in bytes of the length prefix used by FramingLengthPrefixed.
+const lengthPrefixSize = 4
+
+// factoryLengthPrefixed returns a split function for frames prefixed by their length
+// encoded as a 4-byte big-endian unsigned integer.
+func factoryLengthPrefixed(maxMessageSize uint64) bufio.SplitFunc {
+	return func(data []byte, eof bool) (int, []byte, error) {
+		if eof && len(data) == 0 {
+			return 0, nil, nil
+		}
+
+		if len(data) < lengthPrefixSize {
+			if eof {
+				return 0, nil, fmt.Errorf("incomplete length prefix")
+			}
+			return 0, nil, nil
+		}
+
+		size := uint64(binary.BigEndian.Uint32(data))
+		if size > maxMessageSize {
+			return 0, nil, ErrFrameTooLarge
+		}
+
+		end := lengthPrefixSize + int(size)
+		if len(data) < end {
+			if eof {
+				return 0, nil, fmt.Errorf("incomplete frame, expected %d bytes", size)
+			}
+			return 0, nil, nil
+		}
+		return end, data[lengthPrefixSize:end], nil
+	}
+}
@@ -0,0 +1,115 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package common
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFramingSplitFunc(t *testing.T) {
+	tests := []struct {
+		name     string
+		framing  FramingType
+		text     string
+		maxSize  uint64
+		expected []string
+		err      error
+	}{
+		{
+			name:     "Delimiter",
+			framing:  FramingDelimiter,
+			text:     "hello\nbonjour\nhola",
+			maxSize:  100,
+			expected: []string{"hello", "bonjour", "hola"},
+		},
+		{
+			name:     "Null terminated",
+			framing:  FramingNullTerminated,
+			text:     "{\"short_message\":\"a\"}\x00{\"short_message\":\"b\"}\x00",
+			maxSize:  100,
+			expected: []string{"{\"short_message\":\"a\"}", "{\"short_message\":\"b\"}"},
+		},
+		{
+			name:     "RFC6587 octet counting",
+			framing:  FramingRFC6587,
+			text:     "5 hello11 hello\nworld4 hola",
+			maxSize:  100,
+			expected: []string{"hello", "hello\nworld", "hola"},
+		},
+		{
+			name:     "RFC6587 non transparent fallback",
+			framing:  FramingRFC6587,
+			text:     "<13>hello\n5 hello<14>world\n",
+			maxSize:  100,
+			expected: []string{"<13>hello", "hello", "<14>world"},
+		},
+		{
+			name:     "RFC6587 frame too large",
+			framing:  FramingRFC6587,
+			text:     "12 hello world",
+			maxSize:  10,
+			expected: []string(nil),
+			err:      ErrFrameTooLarge,
+		},
+		{
+			name:     "Length prefixed",
+			framing:  FramingLengthPrefixed,
+			text:     "\x00\x00\x00\x05hello\x00\x00\x00\x00\x00\x00\x00\x04hola",
+			maxSize:  100,
+			expected: []string{"hello", "", "hola"},
+		},
+		{
+			name:     "Length prefixed frame too large",
+			framing:  FramingLengthPrefixed,
+			text:     "\x00\x00\x00\x02hi\x00\x00\x01\x00hello",
+			maxSize:  100,
+			expected: []string{"hi"},
+			err:      ErrFrameTooLarge,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			splitFunc, err := FramingSplitFunc(test.framing, []byte("\n"), test.maxSize)
+			require.NoError(t, err)
+
+			scanner := bufio.NewScanner(strings.NewReader(test.text))
+			scanner.Split(splitFunc)
+			var elements []string
+			for scanner.Scan() {
+				elements = append(elements, scanner.Text())
+			}
+			assert.EqualValues(t, test.expected, elements)
+			assert.Equal(t, test.err, scanner.Err())
+		})
+	}
+}
+
+func TestFramingTypeUnpack(t *testing.T) {
+	var f FramingType
+	require.NoError(t, f.Unpack("length_prefixed"))
+	assert.Equal(t, FramingLengthPrefixed, f)
+	require.NoError(t, f.Unpack("RFC6587"))
+	assert.Equal(t, FramingRFC6587, f)
+	assert.Error(t, f.Unpack("unknown"))
+}
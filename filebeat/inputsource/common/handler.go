@@ -52,13 +52,15 @@ func SplitHandlerFactory(family Family, logger *logp.Logger, metadataCallback Me
 				log = logger.With("handler", "split_client", "remote_addr", conn.RemoteAddr().String())
 			}
 
-			r := NewResetableLimitedReader(NewDeadlineReader(conn, config.Timeout), maxMessageSize)
+			// The framing header is read along with the message, leave room for it.
+			maxFrameSize := maxMessageSize + config.FrameHeaderSize
+			r := NewResetableLimitedReader(NewDeadlineReader(conn, config.Timeout), maxFrameSize)
 			buf := bufio.NewReader(r)
 			scanner := bufio.NewScanner(buf)
 			scanner.Split(splitFunc)
 			//16 is ratio of MaxScanTokenSize/startBufSize
-			buffer := make([]byte, maxMessageSize/16)
-			scanner.Buffer(buffer, int(maxMessageSize))
+			buffer := make([]byte, maxFrameSize/16)
+			scanner.Buffer(buffer, int(maxFrameSize))
 			for {
 				select {
 				case <-ctx.Done():
@@ -85,7 +87,12 @@ func SplitHandlerFactory(family Family, logger *logp.Logger, metadataCallback Me
 			// We are out of the scanner, either we reached EOF or another fatal error occurred.
 			// like we failed to complete the TLS handshake or we are missing the splitHandler certificate when
 			// mutual auth is on, which is the default.
-			return scanner.Err()
+			err := scanner.Err()
+			if err == ErrFrameTooLarge {
+				// This is a user defined limit and we should notify the user.
+				log.Errorw("split_client error", "error", err)
+			}
+			return err
 		})
 	}
 }
@@ -55,9 +55,10 @@ func New(
 		tlsConfig: tlsConfig,
 	}
 	server.Listener = common.NewListener(common.FamilyTCP, config.Host, factory, server.createServer, &common.ListenerConfig{
-		Timeout:        config.Timeout,
-		MaxMessageSize: config.MaxMessageSize,
-		MaxConnections: config.MaxConnections,
+		Timeout:         config.Timeout,
+		MaxMessageSize:  config.MaxMessageSize,
+		MaxConnections:  config.MaxConnections,
+		FrameHeaderSize: common.FramingHeaderSize(config.Framing, uint64(config.MaxMessageSize)),
 	})
 
 	return server, nil
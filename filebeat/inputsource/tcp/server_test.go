@@ -257,6 +257,62 @@ func TestReceiveNewEventsConcurrently(t *testing.T) {
 	}
 }
 
+func TestReceiveFramesOfMaxMessageSize(t *testing.T) {
+	const maxMessageSize = 1000
+	message := randomString(maxMessageSize)
+
+	tests := []struct {
+		framing string
+		header  string
+	}{
+		{framing: "rfc6587", header: "1000 "},
+		{framing: "length_prefixed", header: "\x00\x00\x03\xe8"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.framing, func(t *testing.T) {
+			ch := make(chan string, 1)
+			to := func(message []byte, mt inputsource.NetworkMetadata) {
+				ch <- string(message)
+			}
+			cfg, err := common.NewConfigFrom(map[string]interface{}{
+				"host":             "127.0.0.1:0",
+				"framing":          test.framing,
+				"max_message_size": maxMessageSize,
+			})
+			require.NoError(t, err)
+			config := defaultConfig
+			require.NoError(t, cfg.Unpack(&config))
+
+			splitFunc, err := netcommon.FramingSplitFunc(config.Framing, nil, uint64(config.MaxMessageSize))
+			require.NoError(t, err)
+			factory := netcommon.SplitHandlerFactory(netcommon.FamilyTCP, logp.NewLogger("test"), MetadataCallback, to, splitFunc)
+			server, err := New(&config, factory)
+			require.NoError(t, err)
+			require.NoError(t, server.Start())
+			defer server.Stop()
+
+			conn, err := net.Dial("tcp", server.Listener.Listener.Addr().String())
+			require.NoError(t, err)
+			defer conn.Close()
+
+			// Send the frame in parts, so the header and the message are read separately.
+			frame := test.header + message
+			for _, part := range []string{frame[:len(test.header)], frame[len(test.header):maxMessageSize], frame[maxMessageSize:]} {
+				fmt.Fprint(conn, part)
+				time.Sleep(10 * time.Millisecond)
+			}
+
+			select {
+			case received := <-ch:
+				assert.Equal(t, message, received)
+			case <-time.After(5 * time.Second):
+				t.Fatal("message of max_message_size not received")
+			}
+		})
+	}
+}
+
 func randomString(l int) string {
 	charsets := []byte("abcdefghijklmnopqrstuvwzyzABCDEFGHIJKLMNOPQRSTUVWZYZ0123456789")
 	message := make([]byte, l)
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/elastic/beats/v7/filebeat/inputsource/common"
 	"github.com/elastic/beats/v7/libbeat/common/cfgtype"
 )
 
@@ -29,12 +30,13 @@ const Name = "unix"
 
 // Config exposes the unix configuration.
 type Config struct {
-	Path           string           `config:"path"`
-	Group          *string          `config:"group"`
-	Mode           *string          `config:"mode"`
-	Timeout        time.Duration    `config:"timeout" validate:"nonzero,positive"`
-	MaxMessageSize cfgtype.ByteSize `config:"max_message_size" validate:"nonzero,positive"`
-	MaxConnections int              `config:"max_connections"`
+	Path           string             `config:"path"`
+	Group          *string            `config:"group"`
+	Mode           *string            `config:"mode"`
+	Timeout        time.Duration      `config:"timeout" validate:"nonzero,positive"`
+	MaxMessageSize cfgtype.ByteSize   `config:"max_message_size" validate:"nonzero,positive"`
+	MaxConnections int                `config:"max_connections"`
+	Framing        common.FramingType `config:"framing"`
 }
 
 // Validate validates the Config option for the unix input.
@@ -52,9 +52,10 @@ func New(
 		config: config,
 	}
 	server.Listener = common.NewListener(common.FamilyUnix, config.Path, factory, server.createServer, &common.ListenerConfig{
-		Timeout:        config.Timeout,
-		MaxMessageSize: config.MaxMessageSize,
-		MaxConnections: config.MaxConnections,
+		Timeout:         config.Timeout,
+		MaxMessageSize:  config.MaxMessageSize,
+		MaxConnections:  config.MaxConnections,
+		FrameHeaderSize: common.FramingHeaderSize(config.Framing, uint64(config.MaxMessageSize)),
 	})
 
 	return server, nil
@@ -231,6 +231,8 @@ def clean_keys(obj):
     # ECS versions change for any ECS release, large or small
     ecs_key = ["ecs.version"]
     # datasets for which @timestamp is removed due to date missing
+    # keep in sync with removeTimestampDatasets and keepTimestampFiles in
+    # filebeat/fileset/replay.go, used by `filebeat test module`
     remove_timestamp = {
         "activemq.audit",
         "barracuda.spamfirewall",
@@ -0,0 +1,284 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package journalfield
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+// IncludeMatches is a boolean expression of journal matches. All the
+// conditions set in an expression must be true for an entry to be included:
+// the matches listed in `match`, every expression in `and`, at least one
+// expression in `or`, and the `units`, `syslog_identifiers` and `priority`
+// shorthands.
+//
+// For backwards compatibility a plain list of matches is accepted too, in
+// which case an entry is included if any of the matches is true.
+type IncludeMatches struct {
+	Matches           []Matcher        `config:"match"`
+	AND               []IncludeMatches `config:"and"`
+	OR                []IncludeMatches `config:"or"`
+	Units             []string         `config:"units"`
+	SyslogIdentifiers []string         `config:"syslog_identifiers"`
+	Priority          *PriorityRange   `config:"priority"`
+}
+
+type includeMatchesConfig IncludeMatches
+
+// term is a conjunction of matches. Journald ORs the matches of the same
+// field, so a term never contains two matches for the same field.
+type term []Matcher
+
+// dnf is a disjunction of terms. A nil dnf never matches, while a dnf with an
+// empty term always matches.
+type dnf []term
+
+var errNeverMatches = errors.New("include_matches can never match an entry")
+
+// Unpack reads either an expression or a list of matches.
+func (m *IncludeMatches) Unpack(value interface{}) error {
+	switch v := value.(type) {
+	case []interface{}:
+		*m = IncludeMatches{}
+		for _, elem := range v {
+			str, ok := elem.(string)
+			if !ok {
+				return fmt.Errorf("invalid match %v, expected a string", elem)
+			}
+			matcher, err := BuildMatcher(str)
+			if err != nil {
+				return err
+			}
+			m.OR = append(m.OR, IncludeMatches{Matches: []Matcher{matcher}})
+		}
+		return nil
+	case map[string]interface{}:
+		cfg, err := common.NewConfigFrom(v)
+		if err != nil {
+			return err
+		}
+		var tmp includeMatchesConfig
+		if err := cfg.Unpack(&tmp); err != nil {
+			return err
+		}
+		*m = IncludeMatches(tmp)
+		return nil
+	default:
+		return fmt.Errorf("invalid include_matches %v, expected a list of matches or an expression", value)
+	}
+}
+
+// IsEmpty returns true if the expression has no conditions.
+func (m IncludeMatches) IsEmpty() bool {
+	return len(m.Matches) == 0 && len(m.AND) == 0 && len(m.OR) == 0 &&
+		len(m.Units) == 0 && len(m.SyslogIdentifiers) == 0 && m.Priority == nil
+}
+
+// ApplyIncludeMatches adds the expression to the journal matches.
+//
+// Journald evaluates its matches as a conjunction of disjunctions of terms,
+// so the expression is split in its top level conditions, and each of them
+// is converted to a disjunction of terms.
+func ApplyIncludeMatches(j journal, m IncludeMatches) error {
+	conjunctions, err := m.conjunctions()
+	if err != nil {
+		return err
+	}
+
+	for i, conj := range conjunctions {
+		if i > 0 {
+			if err := j.AddConjunction(); err != nil {
+				return fmt.Errorf("error adding conjunction to journal: %v", err)
+			}
+		}
+		for k, t := range conj {
+			if k > 0 {
+				if err := j.AddDisjunction(); err != nil {
+					return fmt.Errorf("error adding disjunction to journal: %v", err)
+				}
+			}
+			for _, matcher := range t {
+				if err := matcher.Apply(j); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// conjunctions returns the top level conditions of the expression. The
+// conditions that always match are left out.
+func (m IncludeMatches) conjunctions() ([]dnf, error) {
+	var result []dnf
+	add := func(d dnf) error {
+		switch {
+		case len(d) == 0:
+			return errNeverMatches
+		case len(d) == 1 && len(d[0]) == 0:
+			return nil
+		}
+		result = append(result, d)
+		return nil
+	}
+
+	if len(m.Matches) > 0 {
+		if err := add(matchesDNF(m.Matches)); err != nil {
+			return nil, err
+		}
+	}
+	for _, child := range m.AND {
+		conjs, err := child.conjunctions()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, conjs...)
+	}
+	if len(m.OR) > 0 {
+		var d dnf
+		for _, child := range m.OR {
+			childDNF, err := child.dnf()
+			if err != nil && err != errNeverMatches {
+				return nil, err
+			}
+			d = append(d, childDNF...)
+		}
+		if err := add(d); err != nil {
+			return nil, err
+		}
+	}
+	if len(m.Units) > 0 {
+		d, err := unitsDNF(m.Units)
+		if err != nil {
+			return nil, err
+		}
+		if err := add(d); err != nil {
+			return nil, err
+		}
+	}
+	if len(m.SyslogIdentifiers) > 0 {
+		var d dnf
+		for _, id := range m.SyslogIdentifiers {
+			d = append(d, term{Matcher{"SYSLOG_IDENTIFIER=" + id}})
+		}
+		if err := add(d); err != nil {
+			return nil, err
+		}
+	}
+	if m.Priority != nil {
+		var d dnf
+		for p := m.Priority.From; p <= m.Priority.To; p++ {
+			d = append(d, term{Matcher{"PRIORITY=" + strconv.Itoa(p)}})
+		}
+		if err := add(d); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// dnf converts the whole expression to a disjunction of terms.
+func (m IncludeMatches) dnf() (dnf, error) {
+	conjunctions, err := m.conjunctions()
+	if err != nil {
+		return nil, err
+	}
+	result := dnf{term{}}
+	for _, d := range conjunctions {
+		result = result.and(d)
+	}
+	if len(result) == 0 {
+		return nil, errNeverMatches
+	}
+	return result, nil
+}
+
+// and returns the disjunction of the pairwise conjunctions of the terms,
+// leaving out the terms that require different values for the same field.
+func (d dnf) and(other dnf) dnf {
+	var result dnf
+	for _, a := range d {
+		for _, b := range other {
+			if t, ok := a.and(b); ok {
+				result = append(result, t)
+			}
+		}
+	}
+	return result
+}
+
+func (t term) and(other term) (term, bool) {
+	result := append(term{}, t...)
+	for _, m := range other {
+		merged := false
+		for _, existing := range result {
+			if existing.field() == m.field() {
+				if existing.str != m.str {
+					return nil, false
+				}
+				merged = true
+				break
+			}
+		}
+		if !merged {
+			result = append(result, m)
+		}
+	}
+	return result, true
+}
+
+func matchesDNF(matchers []Matcher) dnf {
+	t, ok := term{}.and(term(matchers))
+	if !ok {
+		return nil
+	}
+	return dnf{t}
+}
+
+// unitsDNF matches the entries of the units, and the entries about the
+// units logged by systemd and the kernel, as journalctl does.
+func unitsDNF(units []string) (dnf, error) {
+	var d dnf
+	for _, unit := range units {
+		if unit == "" {
+			return nil, errors.New("unit names can not be empty")
+		}
+		if !strings.Contains(unit, ".") {
+			unit += ".service"
+		}
+		d = append(d,
+			term{Matcher{"_SYSTEMD_UNIT=" + unit}},
+			term{Matcher{"COREDUMP_UNIT=" + unit}, Matcher{"_UID=0"}},
+			term{Matcher{"UNIT=" + unit}, Matcher{"_PID=1"}},
+			term{Matcher{"OBJECT_SYSTEMD_UNIT=" + unit}, Matcher{"_UID=0"}},
+		)
+	}
+	return d, nil
+}
+
+func (m Matcher) field() string {
+	if idx := strings.IndexByte(m.str, '='); idx >= 0 {
+		return m.str[:idx]
+	}
+	return m.str
+}
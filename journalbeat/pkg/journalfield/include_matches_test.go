@@ -0,0 +1,215 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package journalfield
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+// recordingJournal records the calls made to the journal as a string with
+// matches separated by spaces, and disjunctions and conjunctions as OR and AND.
+type recordingJournal struct {
+	calls []string
+}
+
+func (j *recordingJournal) AddMatch(m string) error {
+	j.calls = append(j.calls, m)
+	return nil
+}
+
+func (j *recordingJournal) AddDisjunction() error {
+	j.calls = append(j.calls, "OR")
+	return nil
+}
+
+func (j *recordingJournal) AddConjunction() error {
+	j.calls = append(j.calls, "AND")
+	return nil
+}
+
+func (j *recordingJournal) String() string {
+	return strings.Join(j.calls, " ")
+}
+
+func TestApplyIncludeMatches(t *testing.T) {
+	cases := map[string]struct {
+		config  map[string]interface{}
+		want    string
+		wantErr error
+	}{
+		"list of matches": {
+			config: map[string]interface{}{
+				"include_matches": []string{"_SYSTEMD_UNIT=a.service", "_TRANSPORT=kernel"},
+			},
+			want: "_SYSTEMD_UNIT=a.service OR _TRANSPORT=kernel",
+		},
+		"match": {
+			config: map[string]interface{}{
+				"include_matches.match": []string{"_SYSTEMD_UNIT=a.service", "_TRANSPORT=kernel"},
+			},
+			want: "_SYSTEMD_UNIT=a.service _TRANSPORT=kernel",
+		},
+		"or with nested and": {
+			config: map[string]interface{}{
+				"include_matches.or": []interface{}{
+					map[string]interface{}{"match": []string{"_SYSTEMD_UNIT=a.service"}},
+					map[string]interface{}{
+						"priority": "..err",
+						"match":    []string{"_TRANSPORT=kernel"},
+					},
+				},
+			},
+			want: "_SYSTEMD_UNIT=a.service" +
+				" OR _TRANSPORT=kernel PRIORITY=0" +
+				" OR _TRANSPORT=kernel PRIORITY=1" +
+				" OR _TRANSPORT=kernel PRIORITY=2" +
+				" OR _TRANSPORT=kernel PRIORITY=3",
+		},
+		"and of or": {
+			config: map[string]interface{}{
+				"include_matches.and": []interface{}{
+					map[string]interface{}{"or": []interface{}{
+						[]string{"_TRANSPORT=kernel"},
+						[]string{"_TRANSPORT=syslog"},
+					}},
+					map[string]interface{}{"syslog_identifiers": []string{"sshd", "sudo"}},
+				},
+			},
+			want: "_TRANSPORT=kernel OR _TRANSPORT=syslog AND SYSLOG_IDENTIFIER=sshd OR SYSLOG_IDENTIFIER=sudo",
+		},
+		"units": {
+			config: map[string]interface{}{
+				"include_matches.units": []string{"nginx", "docker.socket"},
+			},
+			want: "_SYSTEMD_UNIT=nginx.service" +
+				" OR COREDUMP_UNIT=nginx.service _UID=0" +
+				" OR UNIT=nginx.service _PID=1" +
+				" OR OBJECT_SYSTEMD_UNIT=nginx.service _UID=0" +
+				" OR _SYSTEMD_UNIT=docker.socket" +
+				" OR COREDUMP_UNIT=docker.socket _UID=0" +
+				" OR UNIT=docker.socket _PID=1" +
+				" OR OBJECT_SYSTEMD_UNIT=docker.socket _UID=0",
+		},
+		"contradicting terms are removed": {
+			config: map[string]interface{}{
+				"include_matches.or": []interface{}{
+					map[string]interface{}{"match": []string{"_TRANSPORT=kernel", "_TRANSPORT=syslog"}},
+					map[string]interface{}{"match": []string{"_TRANSPORT=kernel", "_TRANSPORT=kernel"}},
+				},
+			},
+			want: "_TRANSPORT=kernel",
+		},
+		"never matches": {
+			config: map[string]interface{}{
+				"include_matches.match": []string{"_TRANSPORT=kernel", "_TRANSPORT=syslog"},
+			},
+			wantErr: errNeverMatches,
+		},
+		"empty": {
+			config: map[string]interface{}{},
+			want:   "",
+		},
+	}
+
+	for name, test := range cases {
+		t.Run(name, func(t *testing.T) {
+			var config struct {
+				Matches IncludeMatches `config:"include_matches"`
+			}
+			require.NoError(t, common.MustNewConfigFrom(test.config).Unpack(&config))
+
+			var j recordingJournal
+			err := ApplyIncludeMatches(&j, config.Matches)
+			if test.wantErr != nil {
+				assert.True(t, errors.Is(err, test.wantErr), err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.want, j.String())
+		})
+	}
+}
+
+func TestIncludeMatchesInvalid(t *testing.T) {
+	var config struct {
+		Matches IncludeMatches `config:"include_matches"`
+	}
+	err := common.MustNewConfigFrom(map[string]interface{}{
+		"include_matches": []interface{}{"_TRANSPORT~kernel"},
+	}).Unpack(&config)
+	assert.Error(t, err)
+
+	err = common.MustNewConfigFrom(map[string]interface{}{
+		"include_matches.priority": "loud",
+	}).Unpack(&config)
+	assert.Error(t, err)
+}
+
+func TestPriorityRange(t *testing.T) {
+	cases := map[string]struct {
+		value   interface{}
+		want    PriorityRange
+		wantErr bool
+	}{
+		"number":         {value: 3, want: PriorityRange{From: 0, To: 3}},
+		"name":           {value: "warning", want: PriorityRange{From: 0, To: 4}},
+		"alias":          {value: "Error", want: PriorityRange{From: 0, To: 3}},
+		"range":          {value: "2..5", want: PriorityRange{From: 2, To: 5}},
+		"reversed range": {value: "notice..crit", want: PriorityRange{From: 2, To: 5}},
+		"open range":     {value: "..info", want: PriorityRange{From: 0, To: 6}},
+		"out of range":   {value: 8, wantErr: true},
+		"unknown":        {value: "loud", wantErr: true},
+	}
+
+	for name, test := range cases {
+		t.Run(name, func(t *testing.T) {
+			var config struct {
+				Priority PriorityRange `config:"priority"`
+			}
+			err := common.MustNewConfigFrom(map[string]interface{}{"priority": test.value}).Unpack(&config)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.want, config.Priority)
+		})
+	}
+}
+
+func TestSyslogNames(t *testing.T) {
+	name, ok := PriorityName(3)
+	assert.True(t, ok)
+	assert.Equal(t, "err", name)
+
+	name, ok = FacilityName(23)
+	assert.True(t, ok)
+	assert.Equal(t, "local7", name)
+
+	_, ok = FacilityName(24)
+	assert.False(t, ok)
+	_, ok = PriorityName(-1)
+	assert.False(t, ok)
+}
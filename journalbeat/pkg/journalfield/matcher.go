@@ -39,6 +39,7 @@ type MatcherBuilder struct {
 type journal interface {
 	AddMatch(string) error
 	AddDisjunction() error
+	AddConjunction() error
 }
 
 var defaultBuilder = MatcherBuilder{Conversions: journaldEventFields}
@@ -0,0 +1,170 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package journalfield
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// priorityNames are the syslog priority keywords, indexed by priority.
+var priorityNames = []string{
+	"emerg",
+	"alert",
+	"crit",
+	"err",
+	"warning",
+	"notice",
+	"info",
+	"debug",
+}
+
+// priorityAliases are the alternative priority names accepted in the
+// configuration.
+var priorityAliases = map[string]int{
+	"emergency":     0,
+	"panic":         0,
+	"critical":      2,
+	"error":         3,
+	"warn":          4,
+	"informational": 6,
+}
+
+// facilityNames are the syslog facility keywords, indexed by facility.
+var facilityNames = []string{
+	"kern",
+	"user",
+	"mail",
+	"daemon",
+	"auth",
+	"syslog",
+	"lpr",
+	"news",
+	"uucp",
+	"cron",
+	"authpriv",
+	"ftp",
+	"ntp",
+	"security",
+	"console",
+	"solaris-cron",
+	"local0",
+	"local1",
+	"local2",
+	"local3",
+	"local4",
+	"local5",
+	"local6",
+	"local7",
+}
+
+// PriorityName returns the keyword of a syslog priority.
+func PriorityName(priority int) (string, bool) {
+	if priority < 0 || priority >= len(priorityNames) {
+		return "", false
+	}
+	return priorityNames[priority], true
+}
+
+// FacilityName returns the keyword of a syslog facility.
+func FacilityName(facility int) (string, bool) {
+	if facility < 0 || facility >= len(facilityNames) {
+		return "", false
+	}
+	return facilityNames[facility], true
+}
+
+// PriorityRange selects the entries with a priority between From and To,
+// both included. Lower priorities are more severe.
+//
+// Like in journalctl, it is configured either as a single priority, which
+// selects that priority and the more severe ones, or as a `FROM..TO` range
+// where any of the ends can be left out. Priorities are given by number or by
+// name.
+type PriorityRange struct {
+	From int
+	To   int
+}
+
+// Unpack reads a priority or a range of priorities.
+func (r *PriorityRange) Unpack(value interface{}) error {
+	switch v := value.(type) {
+	case int64:
+		p, err := parsePriority(strconv.FormatInt(v, 10))
+		if err != nil {
+			return err
+		}
+		*r = PriorityRange{From: 0, To: p}
+	case uint64:
+		p, err := parsePriority(strconv.FormatUint(v, 10))
+		if err != nil {
+			return err
+		}
+		*r = PriorityRange{From: 0, To: p}
+	case string:
+		idx := strings.Index(v, "..")
+		if idx < 0 {
+			p, err := parsePriority(v)
+			if err != nil {
+				return err
+			}
+			*r = PriorityRange{From: 0, To: p}
+			return nil
+		}
+
+		from, to := 0, len(priorityNames)-1
+		var err error
+		if s := v[:idx]; s != "" {
+			if from, err = parsePriority(s); err != nil {
+				return err
+			}
+		}
+		if s := v[idx+2:]; s != "" {
+			if to, err = parsePriority(s); err != nil {
+				return err
+			}
+		}
+		if from > to {
+			from, to = to, from
+		}
+		*r = PriorityRange{From: from, To: to}
+	default:
+		return fmt.Errorf("invalid priority %v", value)
+	}
+	return nil
+}
+
+func parsePriority(s string) (int, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if p, err := strconv.Atoi(s); err == nil {
+		if _, ok := PriorityName(p); !ok {
+			return 0, fmt.Errorf("invalid priority %d, must be between 0 and %d", p, len(priorityNames)-1)
+		}
+		return p, nil
+	}
+	for p, name := range priorityNames {
+		if name == s {
+			return p, nil
+		}
+	}
+	if p, ok := priorityAliases[s]; ok {
+		return p, nil
+	}
+	return 0, fmt.Errorf("unknown priority '%s'", s)
+}
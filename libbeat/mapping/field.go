@@ -55,6 +55,7 @@ type Field struct {
 	AliasPath      string      `config:"path"`
 	MigrationAlias bool        `config:"migration"`
 	Dimension      *bool       `config:"dimension"`
+	MetricType     string      `config:"metric_type"`
 
 	ObjectType            string          `config:"object_type"`
 	ObjectTypeMappingType string          `config:"object_type_mapping_type"`
@@ -119,9 +120,29 @@ func (f *Field) Validate() error {
 			return errors.New("mixing top level objectType configuration with array of object type configurations is forbidden")
 		}
 	}
+	if err := f.validateMetricType(); err != nil {
+		return errors.Wrapf(err, "incorrect metric type for field '%s'", f.Name)
+	}
 	return nil
 }
 
+// validateMetricType checks that only numeric fields are declared as
+// counters or gauges.
+func (f *Field) validateMetricType() error {
+	switch f.MetricType {
+	case "":
+		return nil
+	case "counter", "gauge":
+	default:
+		return fmt.Errorf("unexpected metric type '%s', expected counter or gauge", f.MetricType)
+	}
+	switch strings.ToLower(f.Type) {
+	case "long", "integer", "short", "byte", "double", "float", "half_float", "scaled_float":
+		return nil
+	}
+	return fmt.Errorf("metric type '%s' is only allowed for numeric fields, found type '%s'", f.MetricType, f.Type)
+}
+
 func (f *Field) validateType() error {
 	switch strings.ToLower(f.Type) {
 	case "text", "keyword", "wildcard":
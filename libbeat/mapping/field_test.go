@@ -332,6 +332,19 @@ func TestFieldValidate(t *testing.T) {
 				"object_type_params": []common.MapStr{{"object_type": "scaled_float", "object_type_mapping_type": "float"}}},
 			err: true,
 		},
+		"counter": {
+			cfg:   common.MapStr{"type": "long", "metric_type": "counter"},
+			field: Field{Type: "long", MetricType: "counter"},
+			err:   false,
+		},
+		"invalid metric type": {
+			cfg: common.MapStr{"type": "long", "metric_type": "histogram"},
+			err: true,
+		},
+		"invalid counter of non numeric field": {
+			cfg: common.MapStr{"type": "keyword", "metric_type": "counter"},
+			err: true,
+		},
 	}
 
 	for name, test := range tests {
@@ -0,0 +1,397 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package process
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// ErrCgroupV2Missing is returned when the cgroup v2 unified hierarchy is not
+// mounted on the host.
+var ErrCgroupV2Missing = errors.New("cgroup v2 unified hierarchy not found")
+
+// CgroupV2Reader reads the metrics of the cgroup of a process in the cgroup v2
+// unified hierarchy.
+type CgroupV2Reader struct {
+	rootfsMountpoint  string
+	mountpoint        string // Mountpoint of the unified hierarchy, including the rootfs.
+	ignoreRootCgroups bool
+}
+
+// CgroupV2Stats contains the metrics of a cgroup v2. Each controller is nil
+// when its interface files are not available in the cgroup.
+type CgroupV2Stats struct {
+	ID     string // Name of the cgroup, the last element of its path.
+	Path   string // Path of the cgroup relative to the hierarchy mountpoint.
+	CPU    *CgroupV2CPU
+	Memory *CgroupV2Memory
+	IO     *CgroupV2IO
+	Pids   *CgroupV2Pids
+}
+
+// CgroupV2CPU contains the metrics of cpu.stat. The throttling metrics are
+// only reported when the cpu controller is enabled in the cgroup.
+type CgroupV2CPU struct {
+	UsageNanos         uint64
+	UserNanos          uint64
+	SystemNanos        uint64
+	Periods            uint64
+	ThrottledPeriods   uint64
+	ThrottledTimeNanos uint64
+}
+
+// CgroupV2Memory contains the metrics of the memory controller.
+type CgroupV2Memory struct {
+	Usage     uint64            // memory.current
+	Limit     uint64            // memory.max, 0 when there is no limit.
+	SwapUsage uint64            // memory.swap.current
+	Stats     map[string]uint64 // memory.stat
+	Events    CgroupV2MemoryEvents
+}
+
+// CgroupV2MemoryEvents contains the counters of memory.events.
+type CgroupV2MemoryEvents struct {
+	Low     uint64
+	High    uint64
+	Max     uint64
+	OOM     uint64
+	OOMKill uint64
+}
+
+// CgroupV2IO contains the metrics of io.stat.
+type CgroupV2IO struct {
+	Devices []CgroupV2IODevice
+}
+
+// CgroupV2IODevice contains the io.stat counters of a block device.
+type CgroupV2IODevice struct {
+	Major        uint64
+	Minor        uint64
+	ReadBytes    uint64
+	WriteBytes   uint64
+	ReadIOs      uint64
+	WriteIOs     uint64
+	DiscardBytes uint64
+	DiscardIOs   uint64
+}
+
+// CgroupV2Pids contains the metrics of the pids controller.
+type CgroupV2Pids struct {
+	Current uint64
+	Limit   uint64 // pids.max, 0 when there is no limit.
+}
+
+// TotalBytes returns the bytes read and written in all the devices.
+func (io *CgroupV2IO) TotalBytes() uint64 {
+	var total uint64
+	for _, dev := range io.Devices {
+		total += dev.ReadBytes + dev.WriteBytes
+	}
+	return total
+}
+
+// TotalIOs returns the read and write operations in all the devices.
+func (io *CgroupV2IO) TotalIOs() uint64 {
+	var total uint64
+	for _, dev := range io.Devices {
+		total += dev.ReadIOs + dev.WriteIOs
+	}
+	return total
+}
+
+// NewCgroupV2Reader returns a reader for the unified hierarchy mounted in the
+// host. rootfsMountpoint is the path where the host filesystem is mounted, or
+// empty when running in the host. If ignoreRootCgroups is true, no stats are
+// returned for processes in the root cgroup.
+func NewCgroupV2Reader(rootfsMountpoint string, ignoreRootCgroups bool) (*CgroupV2Reader, error) {
+	if rootfsMountpoint == "" {
+		rootfsMountpoint = "/"
+	}
+
+	mountpoint, err := cgroupV2Mountpoint(rootfsMountpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CgroupV2Reader{
+		rootfsMountpoint:  rootfsMountpoint,
+		mountpoint:        filepath.Join(rootfsMountpoint, mountpoint),
+		ignoreRootCgroups: ignoreRootCgroups,
+	}, nil
+}
+
+// Mountpoint returns the path where the unified hierarchy is mounted,
+// including the rootfs mountpoint.
+func (r *CgroupV2Reader) Mountpoint() string {
+	return r.mountpoint
+}
+
+// cgroupV2Mountpoint finds the mountpoint of the cgroup2 filesystem in the
+// mountinfo of the host.
+func cgroupV2Mountpoint(rootfsMountpoint string) (string, error) {
+	mountinfo, err := ioutil.ReadFile(filepath.Join(rootfsMountpoint, "proc", "self", "mountinfo"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", ErrCgroupV2Missing
+		}
+		return "", err
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(mountinfo))
+	for scanner.Scan() {
+		// 35 24 0:30 / /sys/fs/cgroup rw,nosuid,nodev shared:9 - cgroup2 cgroup2 rw,nsdelegate
+		fields := strings.Fields(scanner.Text())
+		for i, field := range fields {
+			if field == "-" && i+1 < len(fields) && len(fields) > 4 {
+				if fields[i+1] == "cgroup2" {
+					return fields[4], nil
+				}
+				break
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	return "", ErrCgroupV2Missing
+}
+
+// ProcessCgroupV2Path returns the path of the cgroup v2 of the process,
+// relative to the mountpoint of the unified hierarchy.
+func (r *CgroupV2Reader) ProcessCgroupV2Path(pid int) (string, error) {
+	data, err := ioutil.ReadFile(filepath.Join(r.rootfsMountpoint, "proc", strconv.Itoa(pid), "cgroup"))
+	if err != nil {
+		return "", err
+	}
+
+	for _, line := range strings.Split(string(data), "\n") {
+		// The unified hierarchy is reported as 0::/path.
+		if strings.HasPrefix(line, "0::") {
+			return strings.TrimSpace(line[3:]), nil
+		}
+	}
+	return "", fmt.Errorf("process %d is not in a cgroup v2", pid)
+}
+
+// GetStatsForProcess returns the metrics of the cgroup v2 of the process. Nil
+// is returned for processes in the root cgroup when root cgroups are ignored.
+func (r *CgroupV2Reader) GetStatsForProcess(pid int) (*CgroupV2Stats, error) {
+	path, err := r.ProcessCgroupV2Path(pid)
+	if err != nil {
+		return nil, err
+	}
+	if r.ignoreRootCgroups && path == "/" {
+		return nil, nil
+	}
+	return r.GetStats(path)
+}
+
+// GetStats returns the metrics of the cgroup in the given path, relative to
+// the mountpoint of the unified hierarchy.
+func (r *CgroupV2Reader) GetStats(path string) (*CgroupV2Stats, error) {
+	dir := filepath.Join(r.mountpoint, path)
+	if _, err := os.Stat(dir); err != nil {
+		return nil, err
+	}
+
+	stats := &CgroupV2Stats{
+		ID:   filepath.Base(path),
+		Path: path,
+	}
+
+	var err error
+	if stats.CPU, err = readCgroupV2CPU(dir); err != nil {
+		return nil, err
+	}
+	if stats.Memory, err = readCgroupV2Memory(dir); err != nil {
+		return nil, err
+	}
+	if stats.IO, err = readCgroupV2IO(dir); err != nil {
+		return nil, err
+	}
+	if stats.Pids, err = readCgroupV2Pids(dir); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+func readCgroupV2CPU(dir string) (*CgroupV2CPU, error) {
+	values, err := readCgroupV2KeyValues(filepath.Join(dir, "cpu.stat"))
+	if err != nil || values == nil {
+		return nil, err
+	}
+
+	return &CgroupV2CPU{
+		UsageNanos:         values["usage_usec"] * 1000,
+		UserNanos:          values["user_usec"] * 1000,
+		SystemNanos:        values["system_usec"] * 1000,
+		Periods:            values["nr_periods"],
+		ThrottledPeriods:   values["nr_throttled"],
+		ThrottledTimeNanos: values["throttled_usec"] * 1000,
+	}, nil
+}
+
+func readCgroupV2Memory(dir string) (*CgroupV2Memory, error) {
+	usage, found, err := readCgroupV2Value(filepath.Join(dir, "memory.current"))
+	if err != nil || !found {
+		return nil, err
+	}
+
+	memory := &CgroupV2Memory{Usage: usage}
+	if memory.Limit, _, err = readCgroupV2Value(filepath.Join(dir, "memory.max")); err != nil {
+		return nil, err
+	}
+	if memory.SwapUsage, _, err = readCgroupV2Value(filepath.Join(dir, "memory.swap.current")); err != nil {
+		return nil, err
+	}
+	if memory.Stats, err = readCgroupV2KeyValues(filepath.Join(dir, "memory.stat")); err != nil {
+		return nil, err
+	}
+
+	events, err := readCgroupV2KeyValues(filepath.Join(dir, "memory.events"))
+	if err != nil {
+		return nil, err
+	}
+	memory.Events = CgroupV2MemoryEvents{
+		Low:     events["low"],
+		High:    events["high"],
+		Max:     events["max"],
+		OOM:     events["oom"],
+		OOMKill: events["oom_kill"],
+	}
+	return memory, nil
+}
+
+func readCgroupV2IO(dir string) (*CgroupV2IO, error) {
+	data, err := ioutil.ReadFile(filepath.Join(dir, "io.stat"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	io := &CgroupV2IO{}
+	for _, line := range strings.Split(string(data), "\n") {
+		// 8:0 rbytes=90430464 wbytes=299008000 rios=8950 wios=5252 dbytes=0 dios=0
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		var dev CgroupV2IODevice
+		if _, err := fmt.Sscanf(fields[0], "%d:%d", &dev.Major, &dev.Minor); err != nil {
+			return nil, fmt.Errorf("invalid device '%s' in io.stat: %v", fields[0], err)
+		}
+		for _, field := range fields[1:] {
+			kv := strings.SplitN(field, "=", 2)
+			if len(kv) != 2 {
+				continue
+			}
+			value, err := strconv.ParseUint(kv[1], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for %s in io.stat: %v", kv[0], err)
+			}
+			switch kv[0] {
+			case "rbytes":
+				dev.ReadBytes = value
+			case "wbytes":
+				dev.WriteBytes = value
+			case "rios":
+				dev.ReadIOs = value
+			case "wios":
+				dev.WriteIOs = value
+			case "dbytes":
+				dev.DiscardBytes = value
+			case "dios":
+				dev.DiscardIOs = value
+			}
+		}
+		io.Devices = append(io.Devices, dev)
+	}
+	return io, nil
+}
+
+func readCgroupV2Pids(dir string) (*CgroupV2Pids, error) {
+	current, found, err := readCgroupV2Value(filepath.Join(dir, "pids.current"))
+	if err != nil || !found {
+		return nil, err
+	}
+
+	pids := &CgroupV2Pids{Current: current}
+	if pids.Limit, _, err = readCgroupV2Value(filepath.Join(dir, "pids.max")); err != nil {
+		return nil, err
+	}
+	return pids, nil
+}
+
+// readCgroupV2Value reads an interface file containing a single value. The
+// value "max", used for no limit, is returned as 0.
+func readCgroupV2Value(path string) (value uint64, found bool, err error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, false, nil
+		}
+		return 0, false, err
+	}
+
+	str := strings.TrimSpace(string(data))
+	if str == "max" {
+		return 0, true, nil
+	}
+	value, err = strconv.ParseUint(str, 10, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid value in %s: %v", path, err)
+	}
+	return value, true, nil
+}
+
+// readCgroupV2KeyValues reads an interface file with a key and a value in
+// each line. Nil is returned if the file doesn't exist.
+func readCgroupV2KeyValues(path string) (map[string]uint64, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	values := map[string]uint64{}
+	for _, line := range strings.Split(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+		value, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for %s in %s: %v", fields[0], path, err)
+		}
+		values[fields[0]] = value
+	}
+	return values, nil
+}
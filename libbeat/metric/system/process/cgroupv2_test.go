@@ -0,0 +1,109 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package process
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCgroupV2Reader(t *testing.T) {
+	reader, err := NewCgroupV2Reader("testdata/cgroupv2", true)
+	require.NoError(t, err)
+
+	stats, err := reader.GetStatsForProcess(1001)
+	require.NoError(t, err)
+	require.NotNil(t, stats)
+
+	assert.Equal(t, "nginx.service", stats.ID)
+	assert.Equal(t, "/system.slice/nginx.service", stats.Path)
+
+	assert.Equal(t, &CgroupV2CPU{
+		UsageNanos:         1535000000,
+		UserNanos:          1020000000,
+		SystemNanos:        515000000,
+		Periods:            120,
+		ThrottledPeriods:   7,
+		ThrottledTimeNanos: 42000000,
+	}, stats.CPU)
+
+	require.NotNil(t, stats.Memory)
+	assert.Equal(t, uint64(25165824), stats.Memory.Usage)
+	assert.Equal(t, uint64(536870912), stats.Memory.Limit)
+	assert.Equal(t, uint64(4096), stats.Memory.SwapUsage)
+	assert.Equal(t, uint64(14155776), stats.Memory.Stats["anon"])
+	assert.Equal(t, uint64(66), stats.Memory.Stats["pgmajfault"])
+	assert.Equal(t, CgroupV2MemoryEvents{High: 3, Max: 1, OOM: 1, OOMKill: 1}, stats.Memory.Events)
+
+	require.NotNil(t, stats.IO)
+	assert.Equal(t, []CgroupV2IODevice{
+		{Major: 8, Minor: 0, ReadBytes: 90430464, WriteBytes: 299008000, ReadIOs: 8950, WriteIOs: 5252},
+		{Major: 253, Minor: 0, ReadBytes: 1024, WriteBytes: 2048, ReadIOs: 1, WriteIOs: 2, DiscardBytes: 512, DiscardIOs: 1},
+	}, stats.IO.Devices)
+	assert.Equal(t, uint64(90430464+299008000+1024+2048), stats.IO.TotalBytes())
+	assert.Equal(t, uint64(8950+5252+1+2), stats.IO.TotalIOs())
+
+	assert.Equal(t, &CgroupV2Pids{Current: 3, Limit: 4915}, stats.Pids)
+}
+
+func TestCgroupV2ReaderPartialControllers(t *testing.T) {
+	reader, err := NewCgroupV2Reader("testdata/cgroupv2", true)
+	require.NoError(t, err)
+
+	stats, err := reader.GetStatsForProcess(1002)
+	require.NoError(t, err)
+	require.NotNil(t, stats)
+
+	assert.Equal(t, "session-2.scope", stats.ID)
+	assert.Equal(t, &CgroupV2CPU{UsageNanos: 48000000, UserNanos: 30000000, SystemNanos: 18000000}, stats.CPU)
+	require.NotNil(t, stats.Memory)
+	assert.Equal(t, uint64(0), stats.Memory.Limit, "max is reported as no limit")
+	assert.Nil(t, stats.IO)
+	assert.Equal(t, &CgroupV2Pids{Current: 2}, stats.Pids)
+}
+
+func TestCgroupV2ReaderRootCgroup(t *testing.T) {
+	reader, err := NewCgroupV2Reader("testdata/cgroupv2", true)
+	require.NoError(t, err)
+
+	stats, err := reader.GetStatsForProcess(1003)
+	require.NoError(t, err)
+	assert.Nil(t, stats)
+
+	reader, err = NewCgroupV2Reader("testdata/cgroupv2", false)
+	require.NoError(t, err)
+
+	stats, err = reader.GetStatsForProcess(1003)
+	require.NoError(t, err)
+	require.NotNil(t, stats)
+	assert.Equal(t, "/", stats.Path)
+}
+
+func TestCgroupV2ReaderMissing(t *testing.T) {
+	_, err := NewCgroupV2Reader("testdata/notexisting", true)
+	assert.Equal(t, ErrCgroupV2Missing, err)
+
+	reader, err := NewCgroupV2Reader("testdata/cgroupv2", true)
+	require.NoError(t, err)
+	_, err = reader.GetStatsForProcess(9999)
+	assert.Error(t, err)
+}
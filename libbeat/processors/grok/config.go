@@ -0,0 +1,31 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package grok
+
+type config struct {
+	Field              string            `config:"field"               validate:"required"` // Source field containing the text to match.
+	Patterns           []string          `config:"patterns"            validate:"required"` // Patterns tried in order, the first match is used.
+	PatternDefinitions map[string]string `config:"pattern_definitions"`                     // Custom patterns, they override the built-in ones.
+	IgnoreMissing      bool              `config:"ignore_missing"`                          // Ignore errors when the source field is missing.
+	IgnoreFailure      bool              `config:"ignore_failure"`                          // Ignore all errors produced by the processor.
+	ID                 string            `config:"id"`                                      // An identifier for this processor. Useful for debugging.
+}
+
+func defaultConfig() config {
+	return config{}
+}
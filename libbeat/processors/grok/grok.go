@@ -0,0 +1,278 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package grok
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/processors"
+	jsprocessor "github.com/elastic/beats/v7/libbeat/processors/script/javascript/module/processor"
+)
+
+const (
+	procName = "grok"
+	logName  = "processor." + procName
+)
+
+func init() {
+	processors.RegisterPlugin(procName, New)
+	jsprocessor.RegisterPlugin("Grok", New)
+}
+
+type processor struct {
+	config
+	log      *logp.Logger
+	matchers []*matcher
+}
+
+// New constructs a new grok processor.
+func New(cfg *common.Config) (processors.Processor, error) {
+	c := defaultConfig()
+	if err := cfg.Unpack(&c); err != nil {
+		return nil, errors.Wrap(err, "fail to unpack the "+procName+" processor configuration")
+	}
+
+	return newGrok(c)
+}
+
+func newGrok(c config) (*processor, error) {
+	log := logp.NewLogger(logName)
+	if c.ID != "" {
+		log = log.With("instance_id", c.ID)
+	}
+
+	definitions := make(map[string]string, len(defaultPatterns)+len(c.PatternDefinitions))
+	for name, pattern := range defaultPatterns {
+		definitions[name] = pattern
+	}
+	for name, pattern := range c.PatternDefinitions {
+		definitions[name] = pattern
+	}
+
+	p := &processor{config: c, log: log}
+	for _, pattern := range c.Patterns {
+		m, err := compile(pattern, definitions)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to compile grok pattern %q", pattern)
+		}
+		p.matchers = append(p.matchers, m)
+	}
+	return p, nil
+}
+
+func (p *processor) String() string {
+	json, _ := json.Marshal(p.config)
+	return procName + "=" + string(json)
+}
+
+func (p *processor) Run(event *beat.Event) (*beat.Event, error) {
+	err := p.run(event)
+	if err != nil && !p.IgnoreFailure {
+		event.PutValue("error.message", err.Error())
+		return event, err
+	}
+	return event, nil
+}
+
+func (p *processor) run(event *beat.Event) error {
+	v, err := event.GetValue(p.Field)
+	if err != nil {
+		if p.IgnoreMissing && errors.Cause(err) == common.ErrKeyNotFound {
+			return nil
+		}
+		return errors.Wrapf(err, "grok source field [%v] not found", p.Field)
+	}
+	text, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("grok source field [%v] is not a string, found %T", p.Field, v)
+	}
+
+	for _, m := range p.matchers {
+		values, matched, err := m.match(text)
+		if err != nil {
+			return err
+		}
+		if !matched {
+			continue
+		}
+		for _, v := range values {
+			if _, err := event.PutValue(v.field, v.value); err != nil {
+				return errors.Wrapf(err, "failed to write grok capture to field [%v]", v.field)
+			}
+		}
+		return nil
+	}
+	return fmt.Errorf("provided grok expressions do not match field value [%v]", p.Field)
+}
+
+// capture is a named capture of a pattern, its value is converted to typ.
+type capture struct {
+	field string
+	typ   string
+}
+
+type matcher struct {
+	re *regexp.Regexp
+	// captures are the named captures, indexed by the number of their
+	// regexp groups.
+	captures map[int]capture
+}
+
+type captureValue struct {
+	field string
+	value interface{}
+}
+
+// match matches the text, and returns the values of the named captures that
+// participate in the match.
+func (m *matcher) match(text string) (values []captureValue, matched bool, err error) {
+	indexes := m.re.FindStringSubmatchIndex(text)
+	if indexes == nil {
+		return nil, false, nil
+	}
+
+	seen := map[string]bool{}
+	for group := 1; group < len(indexes)/2; group++ {
+		c, found := m.captures[group]
+		start, end := indexes[2*group], indexes[2*group+1]
+		if !found || start < 0 || seen[c.field] {
+			continue
+		}
+		seen[c.field] = true
+		value, err := convert(text[start:end], c.typ)
+		if err != nil {
+			return nil, true, errors.Wrapf(err, "failed to convert grok capture [%v]", c.field)
+		}
+		values = append(values, captureValue{field: c.field, value: value})
+	}
+	return values, true, nil
+}
+
+// referenceRE matches the references to patterns, like %{NAME:field:type},
+// and the Oniguruma named groups, like (?<field>...) or (?'field'...).
+var referenceRE = regexp.MustCompile(`%\{(\w+)(?::([^:}]+))?(?::(\w+))?\}|\(\?<([^>!=][^>]*)>|\(\?'([^']+)'`)
+
+// compile expands the pattern references, and compiles the resulting regular
+// expression.
+func compile(pattern string, definitions map[string]string) (*matcher, error) {
+	c := &compiler{definitions: definitions, captures: map[int]capture{}}
+	expanded, err := c.expand(pattern, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	re, err := regexp.Compile(expanded)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map the named groups to their index in the compiled regexp.
+	captures := map[int]capture{}
+	for i, name := range re.SubexpNames() {
+		if !strings.HasPrefix(name, capturePrefix) {
+			continue
+		}
+		var id int
+		fmt.Sscanf(name[len(capturePrefix):], "%d", &id)
+		captures[i] = c.captures[id]
+	}
+	return &matcher{re: re, captures: captures}, nil
+}
+
+// capturePrefix is the prefix of the names of the regexp groups of named
+// captures, field names can't be used as group names.
+const capturePrefix = "grok"
+
+// maxDepth limits the nesting of pattern references.
+const maxDepth = 32
+
+type compiler struct {
+	definitions map[string]string
+	captures    map[int]capture
+}
+
+func (c *compiler) expand(pattern string, stack []string) (string, error) {
+	if len(stack) > maxDepth {
+		return "", fmt.Errorf("pattern references nested too deeply: %v", strings.Join(stack, " -> "))
+	}
+
+	var sb strings.Builder
+	last := 0
+	for _, m := range referenceRE.FindAllStringSubmatchIndex(pattern, -1) {
+		sb.WriteString(pattern[last:m[0]])
+		last = m[1]
+
+		if m[8] >= 0 {
+			// (?<field>
+			sb.WriteString(c.group(capture{field: pattern[m[8]:m[9]]}))
+			continue
+		}
+		if m[10] >= 0 {
+			// (?'field'
+			sb.WriteString(c.group(capture{field: pattern[m[10]:m[11]]}))
+			continue
+		}
+
+		name := pattern[m[2]:m[3]]
+		for _, s := range stack {
+			if s == name {
+				return "", fmt.Errorf("circular pattern reference: %v -> %v", strings.Join(stack, " -> "), name)
+			}
+		}
+		definition, found := c.definitions[name]
+		if !found {
+			return "", fmt.Errorf("unknown pattern %v", name)
+		}
+		expanded, err := c.expand(definition, append(stack, name))
+		if err != nil {
+			return "", err
+		}
+
+		if m[4] < 0 {
+			sb.WriteString("(?:")
+		} else {
+			cpt := capture{field: pattern[m[4]:m[5]]}
+			if m[6] >= 0 {
+				cpt.typ = pattern[m[6]:m[7]]
+				if !validType(cpt.typ) {
+					return "", fmt.Errorf("unsupported type %v for field %v", cpt.typ, cpt.field)
+				}
+			}
+			sb.WriteString(c.group(cpt))
+		}
+		sb.WriteString(expanded)
+		sb.WriteString(")")
+	}
+	sb.WriteString(pattern[last:])
+	return sb.String(), nil
+}
+
+// group registers a capture, and returns the opening of its regexp group.
+func (c *compiler) group(cpt capture) string {
+	id := len(c.captures)
+	c.captures[id] = cpt
+	return fmt.Sprintf("(?P<%s%d>", capturePrefix, id)
+}
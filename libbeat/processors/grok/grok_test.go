@@ -0,0 +1,156 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package grok
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+func TestDefaultPatternsCompile(t *testing.T) {
+	for name := range defaultPatterns {
+		_, err := compile("%{"+name+"}", defaultPatterns)
+		assert.NoError(t, err, name)
+	}
+}
+
+func TestGrok(t *testing.T) {
+	p, err := New(common.MustNewConfigFrom(map[string]interface{}{
+		"field": "message",
+		"patterns": []string{
+			`^%{IPORHOST:source.address} - (-|%{DATA:user.name}) \[%{HTTPDATE:nginx.access.time}\] "%{WORD:http.request.method} %{DATA:url.original} HTTP/%{NUMBER:http.version}" %{NUMBER:http.response.status_code:long} %{NUMBER:http.response.body.bytes:long} %{LATENCY}$`,
+			`^%{WORD:http.request.method} (?<url.original>\S+)$`,
+			`^%{JAVACLASS:error.type}: (?'error.message'.*)$`,
+		},
+		"pattern_definitions": map[string]string{
+			"LATENCY": `%{NUMBER:event.duration:double}ms`,
+		},
+	}))
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		message  string
+		expected common.MapStr
+	}{
+		"first pattern": {
+			message: `10.0.0.1 - - [25/Oct/2020:12:34:56 +0200] "GET /index.html HTTP/1.1" 200 612 1.5ms`,
+			expected: common.MapStr{
+				"source": common.MapStr{"address": "10.0.0.1"},
+				"nginx":  common.MapStr{"access": common.MapStr{"time": "25/Oct/2020:12:34:56 +0200"}},
+				"http": common.MapStr{
+					"request":  common.MapStr{"method": "GET"},
+					"version":  "1.1",
+					"response": common.MapStr{"status_code": int64(200), "body": common.MapStr{"bytes": int64(612)}},
+				},
+				"url":   common.MapStr{"original": "/index.html"},
+				"event": common.MapStr{"duration": 1.5},
+			},
+		},
+		"optional capture": {
+			message: `example.com - alice [25/Oct/2020:12:34:56 +0200] "POST /login HTTP/2.0" 302 0 12ms`,
+			expected: common.MapStr{
+				"source": common.MapStr{"address": "example.com"},
+				"user":   common.MapStr{"name": "alice"},
+				"nginx":  common.MapStr{"access": common.MapStr{"time": "25/Oct/2020:12:34:56 +0200"}},
+				"http": common.MapStr{
+					"request":  common.MapStr{"method": "POST"},
+					"version":  "2.0",
+					"response": common.MapStr{"status_code": int64(302), "body": common.MapStr{"bytes": int64(0)}},
+				},
+				"url":   common.MapStr{"original": "/login"},
+				"event": common.MapStr{"duration": float64(12)},
+			},
+		},
+		"second pattern": {
+			message: `DELETE /items/1`,
+			expected: common.MapStr{
+				"http": common.MapStr{"request": common.MapStr{"method": "DELETE"}},
+				"url":  common.MapStr{"original": "/items/1"},
+			},
+		},
+		"quoted named group": {
+			message: `java.io.IOException: Broken pipe`,
+			expected: common.MapStr{
+				"error": common.MapStr{"type": "java.io.IOException", "message": "Broken pipe"},
+			},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			event, err := p.Run(&beat.Event{Fields: common.MapStr{"message": test.message}})
+			require.NoError(t, err)
+			test.expected["message"] = test.message
+			assert.Equal(t, test.expected, event.Fields)
+		})
+	}
+}
+
+func TestGrokFailures(t *testing.T) {
+	newGrok := func(settings map[string]interface{}) *beat.Event {
+		settings["field"] = "message"
+		settings["patterns"] = []string{`^%{INT:count:int}$`}
+		p, err := New(common.MustNewConfigFrom(settings))
+		require.NoError(t, err)
+		event, _ := p.Run(&beat.Event{Fields: common.MapStr{"message": "not a number"}})
+		return event
+	}
+
+	event := newGrok(map[string]interface{}{})
+	msg, _ := event.GetValue("error.message")
+	assert.Equal(t, "provided grok expressions do not match field value [message]", msg)
+
+	event = newGrok(map[string]interface{}{"ignore_failure": true})
+	assert.Equal(t, common.MapStr{"message": "not a number"}, event.Fields)
+
+	p, err := New(common.MustNewConfigFrom(map[string]interface{}{
+		"field":          "message",
+		"patterns":       []string{`%{GREEDYDATA:copy}`},
+		"ignore_missing": true,
+	}))
+	require.NoError(t, err)
+	event, err = p.Run(&beat.Event{Fields: common.MapStr{}})
+	assert.NoError(t, err)
+	assert.Equal(t, common.MapStr{}, event.Fields)
+}
+
+func TestGrokInvalidPatterns(t *testing.T) {
+	tests := map[string]struct {
+		pattern     string
+		definitions map[string]string
+	}{
+		"unknown pattern": {pattern: `%{UNKNOWN:field}`},
+		"unknown type":    {pattern: `%{INT:field:integer}`},
+		"circular":        {pattern: `%{A}`, definitions: map[string]string{"A": `a%{B}`, "B": `b%{A}`}},
+		"lookbehind":      {pattern: `(?<!a)b`},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := New(common.MustNewConfigFrom(map[string]interface{}{
+				"field":               "message",
+				"patterns":            []string{test.pattern},
+				"pattern_definitions": test.definitions,
+			}))
+			assert.Error(t, err)
+		})
+	}
+}
@@ -123,11 +123,25 @@ func (p *Processor) Process(fields mapping.Fields, state *fieldState, output com
 
 		if len(indexMapping) > 0 {
 			output.Put(mapping.GenerateKey(field.Name), indexMapping)
+			if field.MetricType == "counter" {
+				p.counter(&field, indexMapping, output)
+			}
 		}
 	}
 	return nil
 }
 
+// counter adds the mappings of the rate and delta computed for a counter. For
+// a counter `a.b` they are `a.rate.b` and `a.delta.b`.
+func (p *Processor) counter(f *mapping.Field, indexMapping common.MapStr, output common.MapStr) {
+	parent, leaf := "", f.Name
+	if i := strings.LastIndex(f.Name, "."); i >= 0 {
+		parent, leaf = f.Name[:i+1], f.Name[i+1:]
+	}
+	output.Put(mapping.GenerateKey(parent+"rate."+leaf), common.MapStr{"type": "double"})
+	output.Put(mapping.GenerateKey(parent+"delta."+leaf), indexMapping.Clone())
+}
+
 func addToDefaultFields(f *mapping.Field) {
 	fullName := f.Name
 	if f.Path != "" {
@@ -334,7 +348,7 @@ func (p *Processor) object(f *mapping.Field) common.MapStr {
 				dynProperties["index"] = "analyzed"
 			}
 			matchingType = matchType("string", otp.ObjectTypeMappingType)
-		case "keyword":
+		case "keyword", "date":
 			dynProperties["type"] = otp.ObjectType
 			matchingType = matchType("string", otp.ObjectTypeMappingType)
 		case "byte", "double", "float", "long", "short", "boolean":
@@ -337,6 +337,21 @@ func TestDynamicTemplates(t *testing.T) {
 				},
 			},
 		},
+		{
+			field: mapping.Field{
+				Type: "object", ObjectType: "date",
+				Name: "times",
+			},
+			expected: []common.MapStr{
+				common.MapStr{
+					"times": common.MapStr{
+						"mapping":            common.MapStr{"type": "date"},
+						"match_mapping_type": "string",
+						"path_match":         "times.*",
+					},
+				},
+			},
+		},
 		{
 			field: mapping.Field{
 				Type: "object", ObjectType: "long", ObjectTypeMappingType: "futuretype",
@@ -549,6 +564,80 @@ func TestPropertiesCombine(t *testing.T) {
 	assert.Equal(t, v2, common.MapStr{"type": "text", "norms": false})
 }
 
+func TestProcessCounters(t *testing.T) {
+	fields := mapping.Fields{
+		mapping.Field{
+			Name: "test",
+			Type: "group",
+			Fields: mapping.Fields{
+				mapping.Field{
+					Name:       "in.bytes",
+					Type:       "long",
+					MetricType: "counter",
+				},
+				mapping.Field{
+					Name:       "time",
+					Type:       "scaled_float",
+					MetricType: "counter",
+				},
+				mapping.Field{
+					Name:       "connections",
+					Type:       "long",
+					MetricType: "gauge",
+				},
+			},
+		},
+	}
+
+	output := common.MapStr{}
+	version, err := common.NewVersion("7.0.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := Processor{EsVersion: *version}
+	err = p.Process(fields, nil, output)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedOutput := common.MapStr{
+		"test": common.MapStr{
+			"properties": common.MapStr{
+				"in": common.MapStr{
+					"properties": common.MapStr{
+						"bytes": common.MapStr{"type": "long"},
+						"rate": common.MapStr{
+							"properties": common.MapStr{
+								"bytes": common.MapStr{"type": "double"},
+							},
+						},
+						"delta": common.MapStr{
+							"properties": common.MapStr{
+								"bytes": common.MapStr{"type": "long"},
+							},
+						},
+					},
+				},
+				"time": common.MapStr{"type": "scaled_float", "scaling_factor": 1000},
+				"rate": common.MapStr{
+					"properties": common.MapStr{
+						"time": common.MapStr{"type": "double"},
+					},
+				},
+				"delta": common.MapStr{
+					"properties": common.MapStr{
+						"time": common.MapStr{"type": "scaled_float", "scaling_factor": 1000},
+					},
+				},
+				"connections": common.MapStr{"type": "long"},
+			},
+		},
+	}
+
+	assert.Equal(t, expectedOutput, output)
+}
+
 func TestProcessNoName(t *testing.T) {
 	// Test common fields are combined even if they come from different objects
 	fields := mapping.Fields{
@@ -156,7 +156,10 @@ func newMetricbeat(b *beat.Beat, c *common.Config, options ...Option) (*Metricbe
 	}
 
 	moduleOptions := append(
-		[]module.Option{module.WithMaxStartDelay(config.MaxStartDelay)},
+		[]module.Option{
+			module.WithMaxStartDelay(config.MaxStartDelay),
+			module.WithCounters(b.Info.Beat),
+		},
 		metricbeat.moduleOptions...)
 
 	factory := module.NewFactory(b.Info, moduleOptions...)
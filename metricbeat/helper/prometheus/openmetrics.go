@@ -0,0 +1,679 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package prometheus
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	dto "github.com/prometheus/client_model/go"
+)
+
+// OpenMetricsType is the media type of the OpenMetrics text format.
+const OpenMetricsType = "application/openmetrics-text"
+
+// OpenMetrics metric types.
+const (
+	OpenMetricsCounter        = "counter"
+	OpenMetricsGauge          = "gauge"
+	OpenMetricsHistogram      = "histogram"
+	OpenMetricsGaugeHistogram = "gaugehistogram"
+	OpenMetricsSummary        = "summary"
+	OpenMetricsInfo           = "info"
+	OpenMetricsStateset       = "stateset"
+	OpenMetricsUnknown        = "unknown"
+)
+
+// MetricFamily is a Prometheus metric family, with the information of the
+// OpenMetrics format that is not supported by the Prometheus data model.
+type MetricFamily struct {
+	*dto.MetricFamily
+
+	// Type is the OpenMetrics type of the family, empty for other formats.
+	Type string
+
+	// Unit of the metrics of the family, from their UNIT metadata.
+	Unit string
+
+	// Extras contains the additional information of the metrics of the
+	// family, in the same order. It is nil if there is none.
+	Extras []*MetricExtras
+}
+
+// MetricExtras contains the information of a metric only available in the
+// OpenMetrics format.
+type MetricExtras struct {
+	// Created is the time the metric was created or reset, from its _created
+	// sample. It is zero if not exposed.
+	Created time.Time
+
+	// Exemplar of a counter.
+	Exemplar *Exemplar
+
+	// BucketExemplars are the exemplars of the buckets of a histogram, by
+	// their upper bound.
+	BucketExemplars map[float64]*Exemplar
+}
+
+// Exemplar is a reference to data outside of the metrics, like a trace.
+type Exemplar struct {
+	Labels    map[string]string
+	Value     float64
+	Timestamp time.Time // Zero if not exposed.
+}
+
+// GetExtras returns the extra information of the i-th metric of the family,
+// or nil if there is none.
+func (f *MetricFamily) GetExtras(i int) *MetricExtras {
+	if i < len(f.Extras) {
+		return f.Extras[i]
+	}
+	return nil
+}
+
+// openMetricsFamily accumulates the samples of a metric family while parsing.
+type openMetricsFamily struct {
+	name    string
+	typ     string
+	help    *string
+	unit    string
+	metrics []*openMetric
+	byKey   map[string]*openMetric
+}
+
+// openMetric accumulates the samples of a metric with the same labels.
+type openMetric struct {
+	labels    []*dto.LabelPair
+	timestamp *int64
+
+	value     *float64
+	count     *float64
+	sum       *float64
+	created   *float64
+	exemplar  *Exemplar
+	buckets   []*openMetricsBucket
+	quantiles []*dto.Quantile
+}
+
+type openMetricsBucket struct {
+	upperBound float64
+	count      float64
+	exemplar   *Exemplar
+}
+
+// openMetricsSample is a parsed sample line.
+type openMetricsSample struct {
+	name      string
+	labels    []*dto.LabelPair
+	value     float64
+	timestamp *float64
+	exemplar  *Exemplar
+}
+
+// ParseOpenMetrics parses metric families in the OpenMetrics text format.
+func ParseOpenMetrics(r io.Reader) ([]*MetricFamily, error) {
+	var (
+		families []*MetricFamily
+		current  *openMetricsFamily
+		seen     = map[string]bool{}
+		eof      bool
+	)
+
+	finish := func() error {
+		if current == nil {
+			return nil
+		}
+		family, err := current.build()
+		if err != nil {
+			return err
+		}
+		families = append(families, family)
+		current = nil
+		return nil
+	}
+
+	start := func(name string) error {
+		if err := finish(); err != nil {
+			return err
+		}
+		if seen[name] {
+			return fmt.Errorf("metric family '%s' is not contiguous", name)
+		}
+		seen[name] = true
+		current = &openMetricsFamily{name: name, typ: OpenMetricsUnknown, byKey: map[string]*openMetric{}}
+		return nil
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := scanner.Text()
+		if eof {
+			if text == "" {
+				continue
+			}
+			return nil, fmt.Errorf("line %d: unexpected content after # EOF", line)
+		}
+
+		switch {
+		case text == "":
+			continue
+		case text == "# EOF":
+			eof = true
+			continue
+		case strings.HasPrefix(text, "# "):
+			fields := strings.SplitN(text[2:], " ", 3)
+			if len(fields) < 2 {
+				// Comments are not part of the format, but ignore them
+				// as the Prometheus text parser does.
+				continue
+			}
+			keyword, name, value := fields[0], fields[1], ""
+			if len(fields) == 3 {
+				value = fields[2]
+			}
+			switch keyword {
+			case "TYPE", "HELP", "UNIT":
+			default:
+				continue
+			}
+			if current == nil || current.name != name {
+				if err := start(name); err != nil {
+					return nil, errors.Wrapf(err, "line %d", line)
+				}
+			}
+			if len(current.metrics) > 0 {
+				return nil, fmt.Errorf("line %d: metadata of '%s' after its samples", line, name)
+			}
+			switch keyword {
+			case "TYPE":
+				if !isOpenMetricsType(value) {
+					return nil, fmt.Errorf("line %d: unknown type '%s' of '%s'", line, value, name)
+				}
+				current.typ = value
+			case "HELP":
+				help := unescapeOpenMetrics(value, false)
+				current.help = &help
+			case "UNIT":
+				current.unit = value
+			}
+		case strings.HasPrefix(text, "#"):
+			continue
+		default:
+			sample, err := parseOpenMetricsSample(text)
+			if err != nil {
+				return nil, errors.Wrapf(err, "line %d", line)
+			}
+			if current == nil || !current.accepts(sample.name) {
+				if err := start(sample.name); err != nil {
+					return nil, errors.Wrapf(err, "line %d", line)
+				}
+			}
+			if err := current.add(sample); err != nil {
+				return nil, errors.Wrapf(err, "line %d", line)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if err := finish(); err != nil {
+		return nil, err
+	}
+	return families, nil
+}
+
+func isOpenMetricsType(t string) bool {
+	switch t {
+	case OpenMetricsCounter, OpenMetricsGauge, OpenMetricsHistogram, OpenMetricsGaugeHistogram,
+		OpenMetricsSummary, OpenMetricsInfo, OpenMetricsStateset, OpenMetricsUnknown:
+		return true
+	}
+	return false
+}
+
+// suffixes returns the suffixes of the names of the samples of the family.
+func (f *openMetricsFamily) suffixes() []string {
+	switch f.typ {
+	case OpenMetricsCounter:
+		// Accept also the name of the family, as exposed by many Prometheus
+		// exporters.
+		return []string{"_total", "_created", ""}
+	case OpenMetricsHistogram:
+		return []string{"_bucket", "_count", "_sum", "_created"}
+	case OpenMetricsGaugeHistogram:
+		return []string{"_bucket", "_gcount", "_gsum"}
+	case OpenMetricsSummary:
+		return []string{"", "_count", "_sum", "_created"}
+	case OpenMetricsInfo:
+		return []string{"_info"}
+	default:
+		return []string{""}
+	}
+}
+
+// accepts returns true if a sample with the given name belongs to the family.
+func (f *openMetricsFamily) accepts(name string) bool {
+	_, ok := f.suffix(name)
+	return ok
+}
+
+func (f *openMetricsFamily) suffix(name string) (string, bool) {
+	if !strings.HasPrefix(name, f.name) {
+		return "", false
+	}
+	suffix := name[len(f.name):]
+	for _, s := range f.suffixes() {
+		if s == suffix {
+			return suffix, true
+		}
+	}
+	return "", false
+}
+
+func (f *openMetricsFamily) add(sample *openMetricsSample) error {
+	suffix, _ := f.suffix(sample.name)
+
+	labels := sample.labels
+	var le, quantile *float64
+	switch {
+	case suffix == "_bucket":
+		value, rest, err := extractFloatLabel(labels, "le")
+		if err != nil {
+			return err
+		}
+		le, labels = &value, rest
+	case f.typ == OpenMetricsSummary && suffix == "":
+		value, rest, err := extractFloatLabel(labels, "quantile")
+		if err != nil {
+			return err
+		}
+		quantile, labels = &value, rest
+	}
+
+	key := labelsKey(labels)
+	metric, found := f.byKey[key]
+	if !found {
+		metric = &openMetric{labels: labels}
+		f.byKey[key] = metric
+		f.metrics = append(f.metrics, metric)
+	}
+	if sample.timestamp != nil {
+		ts := int64(*sample.timestamp * 1000)
+		metric.timestamp = &ts
+	}
+	if sample.exemplar != nil && suffix != "_bucket" && (f.typ != OpenMetricsCounter || suffix == "_created") {
+		return fmt.Errorf("exemplars are only allowed in counters and histogram buckets, found in '%s'", sample.name)
+	}
+
+	value := sample.value
+	switch {
+	case le != nil:
+		metric.buckets = append(metric.buckets, &openMetricsBucket{upperBound: *le, count: value, exemplar: sample.exemplar})
+	case quantile != nil:
+		metric.quantiles = append(metric.quantiles, &dto.Quantile{Quantile: quantile, Value: &value})
+	case suffix == "_count" || suffix == "_gcount":
+		metric.count = &value
+	case suffix == "_sum" || suffix == "_gsum":
+		metric.sum = &value
+	case suffix == "_created":
+		metric.created = &value
+	default:
+		metric.value = &value
+		metric.exemplar = sample.exemplar
+	}
+	return nil
+}
+
+// build converts the family to the Prometheus data model.
+func (f *openMetricsFamily) build() (*MetricFamily, error) {
+	name := f.name
+	var typ dto.MetricType
+	switch f.typ {
+	case OpenMetricsCounter:
+		// Counters keep the name of their samples, as in the Prometheus
+		// text format.
+		if !strings.HasSuffix(name, "_total") {
+			name += "_total"
+		}
+		typ = dto.MetricType_COUNTER
+	case OpenMetricsGauge, OpenMetricsStateset:
+		typ = dto.MetricType_GAUGE
+	case OpenMetricsInfo:
+		name += "_info"
+		typ = dto.MetricType_GAUGE
+	case OpenMetricsHistogram, OpenMetricsGaugeHistogram:
+		typ = dto.MetricType_HISTOGRAM
+	case OpenMetricsSummary:
+		typ = dto.MetricType_SUMMARY
+	default:
+		typ = dto.MetricType_UNTYPED
+	}
+
+	family := &MetricFamily{
+		MetricFamily: &dto.MetricFamily{
+			Name: &name,
+			Help: f.help,
+			Type: &typ,
+		},
+		Type: f.typ,
+		Unit: f.unit,
+	}
+
+	hasExtras := false
+	for _, m := range f.metrics {
+		metric := &dto.Metric{Label: m.labels, TimestampMs: m.timestamp}
+		extras := &MetricExtras{Exemplar: m.exemplar}
+		if m.created != nil {
+			extras.Created = floatToTime(*m.created)
+		}
+
+		switch typ {
+		case dto.MetricType_COUNTER:
+			if m.value == nil {
+				return nil, fmt.Errorf("counter '%s' without _total sample", f.name)
+			}
+			metric.Counter = &dto.Counter{Value: m.value}
+		case dto.MetricType_GAUGE:
+			if m.value == nil {
+				return nil, fmt.Errorf("metric '%s' without value", f.name)
+			}
+			metric.Gauge = &dto.Gauge{Value: m.value}
+		case dto.MetricType_UNTYPED:
+			metric.Untyped = &dto.Untyped{Value: m.value}
+		case dto.MetricType_HISTOGRAM:
+			histogram, bucketExemplars, err := m.histogram()
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid histogram '%s'", f.name)
+			}
+			metric.Histogram = histogram
+			extras.BucketExemplars = bucketExemplars
+		case dto.MetricType_SUMMARY:
+			metric.Summary = &dto.Summary{
+				SampleCount: floatToUint64(m.count),
+				SampleSum:   m.sum,
+				Quantile:    m.quantiles,
+			}
+		}
+
+		family.Metric = append(family.Metric, metric)
+		family.Extras = append(family.Extras, extras)
+		if !extras.Created.IsZero() || extras.Exemplar != nil || len(extras.BucketExemplars) > 0 {
+			hasExtras = true
+		}
+	}
+	if !hasExtras {
+		family.Extras = nil
+	}
+	return family, nil
+}
+
+func (m *openMetric) histogram() (*dto.Histogram, map[float64]*Exemplar, error) {
+	sort.SliceStable(m.buckets, func(i, j int) bool {
+		return m.buckets[i].upperBound < m.buckets[j].upperBound
+	})
+	if len(m.buckets) == 0 || !math.IsInf(m.buckets[len(m.buckets)-1].upperBound, 1) {
+		return nil, nil, errors.New("missing +Inf bucket")
+	}
+
+	histogram := &dto.Histogram{
+		SampleCount: floatToUint64(m.count),
+		SampleSum:   m.sum,
+	}
+	var exemplars map[float64]*Exemplar
+	for _, b := range m.buckets {
+		upperBound := b.upperBound
+		histogram.Bucket = append(histogram.Bucket, &dto.Bucket{
+			UpperBound:      &upperBound,
+			CumulativeCount: floatToUint64(&b.count),
+		})
+		if b.exemplar != nil {
+			if exemplars == nil {
+				exemplars = map[float64]*Exemplar{}
+			}
+			exemplars[upperBound] = b.exemplar
+		}
+	}
+	return histogram, exemplars, nil
+}
+
+func floatToUint64(f *float64) *uint64 {
+	if f == nil {
+		return nil
+	}
+	var u uint64
+	if *f > 0 && !math.IsNaN(*f) && !math.IsInf(*f, 0) {
+		u = uint64(*f)
+	}
+	return &u
+}
+
+func floatToTime(seconds float64) time.Time {
+	sec, frac := math.Modf(seconds)
+	return time.Unix(int64(sec), int64(frac*1e9)).UTC()
+}
+
+// extractFloatLabel returns the value of the given label as a float, and the
+// rest of labels.
+func extractFloatLabel(labels []*dto.LabelPair, name string) (float64, []*dto.LabelPair, error) {
+	rest := make([]*dto.LabelPair, 0, len(labels))
+	var value *float64
+	for _, l := range labels {
+		if l.GetName() != name {
+			rest = append(rest, l)
+			continue
+		}
+		f, err := parseOpenMetricsFloat(l.GetValue())
+		if err != nil {
+			return 0, nil, errors.Wrapf(err, "invalid value of label '%s'", name)
+		}
+		value = &f
+	}
+	if value == nil {
+		return 0, nil, fmt.Errorf("missing label '%s'", name)
+	}
+	return *value, rest, nil
+}
+
+// labelsKey returns a string that identifies a set of labels.
+func labelsKey(labels []*dto.LabelPair) string {
+	pairs := make([]string, len(labels))
+	for i, l := range labels {
+		pairs[i] = l.GetName() + "\xff" + l.GetValue()
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, "\xfe")
+}
+
+// parseOpenMetricsSample parses a line with a sample and its optional
+// timestamp and exemplar:
+//
+//	name{label="value",...} value [timestamp] [# {label="value",...} value [timestamp]]
+func parseOpenMetricsSample(text string) (*openMetricsSample, error) {
+	sample := &openMetricsSample{}
+
+	i := strings.IndexAny(text, "{ ")
+	if i <= 0 {
+		return nil, errors.New("invalid sample")
+	}
+	sample.name = text[:i]
+	rest := text[i:]
+
+	if rest[0] == '{' {
+		labels, r, err := parseOpenMetricsLabels(rest)
+		if err != nil {
+			return nil, err
+		}
+		sample.labels, rest = labels, r
+	}
+
+	var exemplar string
+	if i := strings.Index(rest, " # "); i >= 0 {
+		rest, exemplar = rest[:i], rest[i+3:]
+	}
+
+	fields := strings.Fields(rest)
+	if len(fields) < 1 || len(fields) > 2 {
+		return nil, fmt.Errorf("invalid sample of '%s'", sample.name)
+	}
+	value, err := parseOpenMetricsFloat(fields[0])
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid value of '%s'", sample.name)
+	}
+	sample.value = value
+	if len(fields) == 2 {
+		ts, err := parseOpenMetricsFloat(fields[1])
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid timestamp of '%s'", sample.name)
+		}
+		sample.timestamp = &ts
+	}
+
+	if exemplar != "" {
+		sample.exemplar, err = parseOpenMetricsExemplar(exemplar)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid exemplar of '%s'", sample.name)
+		}
+	}
+	return sample, nil
+}
+
+func parseOpenMetricsExemplar(text string) (*Exemplar, error) {
+	if !strings.HasPrefix(text, "{") {
+		return nil, errors.New("missing labels")
+	}
+	labels, rest, err := parseOpenMetricsLabels(text)
+	if err != nil {
+		return nil, err
+	}
+	fields := strings.Fields(rest)
+	if len(fields) < 1 || len(fields) > 2 {
+		return nil, errors.New("invalid format")
+	}
+
+	exemplar := &Exemplar{Labels: make(map[string]string, len(labels))}
+	for _, l := range labels {
+		exemplar.Labels[l.GetName()] = l.GetValue()
+	}
+	exemplar.Value, err = parseOpenMetricsFloat(fields[0])
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 2 {
+		ts, err := parseOpenMetricsFloat(fields[1])
+		if err != nil {
+			return nil, err
+		}
+		exemplar.Timestamp = floatToTime(ts)
+	}
+	return exemplar, nil
+}
+
+// parseOpenMetricsLabels parses a set of labels at the start of the text, and
+// returns the rest of the text.
+func parseOpenMetricsLabels(text string) ([]*dto.LabelPair, string, error) {
+	labels := []*dto.LabelPair{}
+	i := 1 // Skip the opening brace.
+	for {
+		if i >= len(text) {
+			return nil, "", errors.New("unterminated labels")
+		}
+		if text[i] == '}' {
+			return labels, text[i+1:], nil
+		}
+
+		eq := strings.IndexByte(text[i:], '=')
+		if eq <= 0 {
+			return nil, "", errors.New("invalid label name")
+		}
+		name := text[i : i+eq]
+		i += eq + 1
+		if i >= len(text) || text[i] != '"' {
+			return nil, "", fmt.Errorf("missing quotes in value of label '%s'", name)
+		}
+		i++
+
+		// Find the closing quote, skipping escaped characters.
+		end := i
+		for end < len(text) && text[end] != '"' {
+			if text[end] == '\\' {
+				end++
+			}
+			end++
+		}
+		if end >= len(text) {
+			return nil, "", fmt.Errorf("unterminated value of label '%s'", name)
+		}
+		value := unescapeOpenMetrics(text[i:end], true)
+		labels = append(labels, &dto.LabelPair{Name: &name, Value: &value})
+
+		i = end + 1
+		if i < len(text) && text[i] == ',' {
+			i++
+		}
+	}
+}
+
+// unescapeOpenMetrics replaces the escape sequences of help texts and label
+// values.
+func unescapeOpenMetrics(s string, quotes bool) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i+1 == len(s) {
+			b.WriteByte(s[i])
+			continue
+		}
+		i++
+		switch {
+		case s[i] == 'n':
+			b.WriteByte('\n')
+		case s[i] == '\\':
+			b.WriteByte('\\')
+		case s[i] == '"' && quotes:
+			b.WriteByte('"')
+		default:
+			b.WriteByte('\\')
+			b.WriteByte(s[i])
+		}
+	}
+	return b.String()
+}
+
+func parseOpenMetricsFloat(s string) (float64, error) {
+	switch s {
+	case "+Inf", "Inf":
+		return math.Inf(1), nil
+	case "-Inf":
+		return math.Inf(-1), nil
+	case "NaN":
+		return math.NaN(), nil
+	}
+	return strconv.ParseFloat(s, 64)
+}
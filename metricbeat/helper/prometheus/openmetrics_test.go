@@ -0,0 +1,171 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package prometheus
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+const openMetrics = `# TYPE http_requests counter
+# HELP http_requests Requests served, with "quotes" and \\ backslash.
+http_requests_total{method="get",path="/a\"b"} 1027 1395066363.000
+http_requests_created{method="get",path="/a\"b"} 1395066363.5
+http_requests_total{method="post",path="/"} 3 # {trace_id="KOO5S4vxi0o"} 0.67 1395066364.5
+# TYPE request_duration_seconds histogram
+# UNIT request_duration_seconds seconds
+request_duration_seconds_bucket{le="0.1"} 2 # {trace_id="oHg5SJYRHA0"} 0.05
+request_duration_seconds_bucket{le="1"} 5
+request_duration_seconds_bucket{le="+Inf"} 6
+request_duration_seconds_count 6
+request_duration_seconds_sum 4.5
+request_duration_seconds_created 1395066000
+# TYPE queue_size gaugehistogram
+queue_size_bucket{le="10"} 3
+queue_size_bucket{le="+Inf"} 4
+queue_size_gcount 4
+queue_size_gsum 25
+# TYPE rpc_duration_seconds summary
+rpc_duration_seconds{quantile="0.5"} 0.05
+rpc_duration_seconds{quantile="0.99"} 0.2
+rpc_duration_seconds_count 10
+rpc_duration_seconds_sum 1.5
+# TYPE build info
+build_info{version="1.0"} 1
+# TYPE feature stateset
+feature{feature="a"} 1
+feature{feature="b"} 0
+# TYPE temperature gauge
+temperature NaN
+untyped_metric{label=""} -Inf
+# EOF
+`
+
+func TestParseOpenMetrics(t *testing.T) {
+	families, err := ParseOpenMetrics(strings.NewReader(openMetrics))
+	require.NoError(t, err)
+	require.Len(t, families, 8)
+
+	counter := families[0]
+	assert.Equal(t, "http_requests_total", counter.GetName())
+	assert.Equal(t, OpenMetricsCounter, counter.Type)
+	assert.Equal(t, `Requests served, with "quotes" and \ backslash.`, counter.GetHelp())
+	require.Len(t, counter.Metric, 2)
+	assert.Equal(t, float64(1027), counter.Metric[0].GetCounter().GetValue())
+	assert.Equal(t, int64(1395066363000), counter.Metric[0].GetTimestampMs())
+	assert.Equal(t, "path", counter.Metric[0].Label[1].GetName())
+	assert.Equal(t, `/a"b`, counter.Metric[0].Label[1].GetValue())
+	assert.Equal(t, time.Unix(1395066363, 5e8).UTC(), counter.GetExtras(0).Created)
+	assert.Nil(t, counter.GetExtras(0).Exemplar)
+	assert.Equal(t, float64(3), counter.Metric[1].GetCounter().GetValue())
+	assert.True(t, counter.GetExtras(1).Created.IsZero())
+	assert.Equal(t, &Exemplar{
+		Labels:    map[string]string{"trace_id": "KOO5S4vxi0o"},
+		Value:     0.67,
+		Timestamp: time.Unix(1395066364, 5e8).UTC(),
+	}, counter.GetExtras(1).Exemplar)
+
+	histogram := families[1]
+	assert.Equal(t, "request_duration_seconds", histogram.GetName())
+	assert.Equal(t, "seconds", histogram.Unit)
+	require.Len(t, histogram.Metric, 1)
+	h := histogram.Metric[0].GetHistogram()
+	assert.Equal(t, uint64(6), h.GetSampleCount())
+	assert.Equal(t, 4.5, h.GetSampleSum())
+	require.Len(t, h.Bucket, 3)
+	assert.Equal(t, 0.1, h.Bucket[0].GetUpperBound())
+	assert.Equal(t, uint64(2), h.Bucket[0].GetCumulativeCount())
+	assert.True(t, math.IsInf(h.Bucket[2].GetUpperBound(), 1))
+	assert.Equal(t, uint64(6), h.Bucket[2].GetCumulativeCount())
+	assert.Empty(t, histogram.Metric[0].Label)
+	assert.Equal(t, time.Unix(1395066000, 0).UTC(), histogram.GetExtras(0).Created)
+	assert.Equal(t, map[float64]*Exemplar{
+		0.1: {Labels: map[string]string{"trace_id": "oHg5SJYRHA0"}, Value: 0.05},
+	}, histogram.GetExtras(0).BucketExemplars)
+
+	gaugeHistogram := families[2]
+	assert.Equal(t, OpenMetricsGaugeHistogram, gaugeHistogram.Type)
+	assert.Equal(t, uint64(4), gaugeHistogram.Metric[0].GetHistogram().GetSampleCount())
+	assert.Equal(t, float64(25), gaugeHistogram.Metric[0].GetHistogram().GetSampleSum())
+	assert.Nil(t, gaugeHistogram.Extras)
+
+	summary := families[3]
+	require.Len(t, summary.Metric, 1)
+	s := summary.Metric[0].GetSummary()
+	assert.Equal(t, uint64(10), s.GetSampleCount())
+	require.Len(t, s.Quantile, 2)
+	assert.Equal(t, 0.99, s.Quantile[1].GetQuantile())
+	assert.Equal(t, 0.2, s.Quantile[1].GetValue())
+
+	info := families[4]
+	assert.Equal(t, "build_info", info.GetName())
+	assert.Equal(t, float64(1), info.Metric[0].GetGauge().GetValue())
+
+	stateset := families[5]
+	assert.Equal(t, "feature", stateset.GetName())
+	require.Len(t, stateset.Metric, 2)
+	assert.Equal(t, "b", stateset.Metric[1].Label[0].GetValue())
+	assert.Equal(t, float64(0), stateset.Metric[1].GetGauge().GetValue())
+
+	assert.True(t, math.IsNaN(families[6].Metric[0].GetGauge().GetValue()))
+
+	untyped := families[7]
+	assert.Equal(t, "untyped_metric", untyped.GetName())
+	assert.Equal(t, OpenMetricsUnknown, untyped.Type)
+	assert.True(t, math.IsInf(untyped.Metric[0].GetUntyped().GetValue(), -1))
+}
+
+func TestParseOpenMetricsErrors(t *testing.T) {
+	cases := map[string]string{
+		"content after EOF":     "a 1\n# EOF\nb 1\n",
+		"unknown type":          "# TYPE a foo\na 1\n",
+		"metadata after sample": "# TYPE a gauge\na 1\n# HELP a help\n",
+		"not contiguous":        "a 1\nb 1\na 2\n",
+		"invalid value":         "a foo\n",
+		"unterminated labels":   "a{b=\"c\" 1\n",
+		"unquoted label":        "a{b=c} 1\n",
+		"missing le":            "# TYPE a histogram\na_bucket 1\n",
+		"missing +Inf bucket":   "# TYPE a histogram\na_bucket{le=\"1\"} 1\n",
+		"exemplar in gauge":     "# TYPE a gauge\na 1 # {b=\"c\"} 1\n",
+		"invalid exemplar":      "# TYPE a counter\na_total 1 # 1\n",
+	}
+
+	for name, text := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseOpenMetrics(strings.NewReader(text))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestGetFamiliesOpenMetrics(t *testing.T) {
+	p := &prometheus{mockFetcher{response: openMetrics, contentType: OpenMetricsType + "; version=1.0.0; charset=utf-8"}, logp.NewLogger("test")}
+
+	families, err := p.GetFamilies()
+	require.NoError(t, err)
+	require.Len(t, families, 8)
+	assert.Equal(t, OpenMetricsCounter, families[0].Type)
+	assert.NotNil(t, families[0].GetExtras(1).Exemplar)
+}
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"mime"
 	"net/http"
 	"regexp"
 
@@ -40,7 +41,7 @@ const acceptHeader = `application/openmetrics-text; version=0.0.1,text/plain;ver
 // Prometheus helper retrieves prometheus formatted metrics
 type Prometheus interface {
 	// GetFamilies requests metric families from prometheus endpoint and returns them
-	GetFamilies() ([]*dto.MetricFamily, error)
+	GetFamilies() ([]*MetricFamily, error)
 
 	GetProcessedMetrics(mapping *MetricsMapping) ([]common.MapStr, error)
 
@@ -69,7 +70,7 @@ func NewPrometheusClient(base mb.BaseMetricSet) (Prometheus, error) {
 }
 
 // GetFamilies requests metric families from prometheus endpoint and returns them
-func (p *prometheus) GetFamilies() ([]*dto.MetricFamily, error) {
+func (p *prometheus) GetFamilies() ([]*MetricFamily, error) {
 	var reader io.Reader
 
 	resp, err := p.FetchResponse()
@@ -97,6 +98,14 @@ func (p *prometheus) GetFamilies() ([]*dto.MetricFamily, error) {
 		return nil, fmt.Errorf("unexpected status code %d from server", resp.StatusCode)
 	}
 
+	if isOpenMetrics(resp.Header) {
+		families, err := ParseOpenMetrics(reader)
+		if err != nil {
+			return nil, errors.Wrap(err, "decoding of OpenMetrics failed")
+		}
+		return families, nil
+	}
+
 	format := expfmt.ResponseFormat(resp.Header)
 	if format == "" {
 		return nil, fmt.Errorf("Invalid format for response of response")
@@ -107,7 +116,7 @@ func (p *prometheus) GetFamilies() ([]*dto.MetricFamily, error) {
 		return nil, fmt.Errorf("Unable to create decoder to decode response")
 	}
 
-	families := []*dto.MetricFamily{}
+	families := []*MetricFamily{}
 	for {
 		mf := &dto.MetricFamily{}
 		err = decoder.Decode(mf)
@@ -117,13 +126,19 @@ func (p *prometheus) GetFamilies() ([]*dto.MetricFamily, error) {
 			}
 			return nil, errors.Wrap(err, "decoding of metric family failed")
 		} else {
-			families = append(families, mf)
+			families = append(families, &MetricFamily{MetricFamily: mf})
 		}
 	}
 
 	return families, nil
 }
 
+// isOpenMetrics returns true if the response is in the OpenMetrics text format.
+func isOpenMetrics(h http.Header) bool {
+	mediatype, _, err := mime.ParseMediaType(h.Get("Content-Type"))
+	return err == nil && mediatype == OpenMetricsType
+}
+
 // MetricsMapping defines mapping settings for Prometheus metrics, to be used with `GetProcessedMetrics`
 type MetricsMapping struct {
 	// Metrics translates from prometheus metric name to Metricbeat fields
@@ -178,7 +178,8 @@ metrics_that_use_labels{label1="I am 1"} 20
 )
 
 type mockFetcher struct {
-	response string
+	response    string
+	contentType string
 }
 
 var _ = httpfetcher(&mockFetcher{})
@@ -195,6 +196,7 @@ func (m mockFetcher) FetchResponse() (*http.Response, error) {
 		StatusCode: 200,
 		Header: http.Header{
 			"Content-Encoding": []string{"gzip"},
+			"Content-Type":     []string{m.contentType},
 		},
 		Body: ioutil.NopCloser(body),
 	}, nil
@@ -57,6 +57,12 @@ func NewUdpServer(base mb.BaseMetricSet) (server.Server, error) {
 		return nil, err
 	}
 
+	return NewUdpServerWithConfig(config)
+}
+
+// NewUdpServerWithConfig creates a UDP server with the given configuration,
+// for metricsets that don't read it from the root of the module settings.
+func NewUdpServerWithConfig(config UdpConfig) (server.Server, error) {
 	addr, err := net.ResolveUDPAddr("udp", fmt.Sprintf("%s:%d", config.Host, config.Port))
 
 	if err != nil {
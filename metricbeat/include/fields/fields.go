@@ -32,5 +32,5 @@ func init() {
 // AssetLibbeatFieldsYml returns asset data.
 // This is the base64 encoded gzipped contents of ../libbeat/fields.yml.
 func AssetLibbeatFieldsYml() string {
-	return "eJzsvXtTHLmSOPr/fApdNuKHOdsUD4ONuXcjfgwwM8TamDH4zJ5Zb9DqKnW3DlVSjaQC92zsd7+hTEmlegCNTfkxy5zdGbq7SkqlUql857+Q3w7enZ6c/vz/kCNJhDSEZdwQM+eaTHnOSMYVS02+GBFuyA3VZMYEU9SwjEwWxMwZOT48J6WS/2SpGf3wL2RCNcuIFPD9NVOaS0G2kt1kM/nhX8hZzqhm5JprbsjcmFLvb2zMuJlXkySVxQbLqTY83WCpJkYSXc1mTBuSzqmYMfjKDjvlLM908sMP6+SKLfYJS/UPhBhucrZvH/iBkIzpVPHScCngK/KTe4e4t/d/IGSdCFqwfbL6fw0vmDa0KFd/IISQnF2zfJ+kUjH4rNgfFVcs2ydGVfiVWZRsn2TU4MfGfKtH1LANOya5mTMBaGLXTBgiFZ9xYdGX/ADvEXJhcc01PJSF99hHo2hq0TxVsqhHGNmJeUrzfEEUKxXTTBguZjCRG7GernfDtKxUysL8J9PoBfyNzKkmQnpocxLQM0LSuKZ5xQDoAEwpyyq307hh3WRTrrSB91tgKZYyfl1DVfKS5VzUcL1zOMf9IlOpCM1zHEEnuE/sIy1Ku+mr25tbL9Y3d9e3n19s7u1v7u4/30n2dp//vhptc04nLNe9G4y7KSeWiuEL/PMSv79iixupsp6NPqy0kYV9YANxUlKudFjDIRVkwkhlj4SRhGYZKZihhIupVAW1g9jv3ZrI+VxWeQbHMJXCUC6IYNpuHYID5Gv/Ochz3ANNqGJEG2kRRbWHNABw7BE0zmR6xdSYUJGR8dWeHjt0dDD53yu0LHOeAnQr+2RlKuX6hKqVEVlh4tp+UyqZVSn8/j8xggumNZ2xOzBs2EfTg8afpCK5nDlEAD24sdzuO3TgT/ZJ9/OIyNLwgv8Z6M7SyTVnN/ZMcEEoPG2/YCpgxU6njapSU1m85XKmyQ03c1kZQkVN9g0YRkSaOVOOfZAUtzaVIqWGiYjyjbRAFISSeVVQsa4YzegkZ0RXRUHVgsjoxMXHsKhyw8s8rF0T9pFre+TnbFFPWEy4YBnhwkgiRXi6vZG/sDyX5Dep8izaIkNnd52AmNL5TEjFLulEXrN9srW5vdPduddcG7se954OpG7ojDCazv0qmzT2nzEJIV1tr/xXTEp0xgRSimPrB+GLmZJVuU+2e+joYs7wzbBL7hg55koJndhNRjY4NTf29FgGauwFN3VbQcXC4pzaU5jn9tyNSMYM/iEVkRPN1LXdHiRXaclsLu1OSUUMvWKaFIzqSrHCPuCGDY+1T6cmXKR5lTHyI6OWD8BaNSnogtBcS6IqYd928yqdwI0GC03+5pbqhtRzyyQnrObHQNkWfspz7WkPkaQqIew5kYggC1u0PuWGvJkzFXPvOS1LZinQLhZOalgqcHaLAOGocSqlEdLYPfeL3ScnOF1qJQE5xUXDubUHcVTDl1hSIE4SmTBqkuj8Hpy9AZnE3ZzNBbkdp2W5YZfCU5aQmjZi7ptJ5lEHbBcEDcKnSC1cE3u/EjNXsprNyR8Vq+z4eqENKzTJ+RUj/06nV3RE3rGMI32USqZMay5mflPc47pK55ZLv5YzbaieE1wHOQd0O5ThQQQiRxQGcaU+Haycs4Ipml9yz3XceWYfDRNZzYs6p/rWc90+S8d+DsIze0SmnCkkH64dIp/xKXAgYFN6LdC1F2rsVaYKEA+8BEdTJbW9/bWhyp6nSWXIGLebZ2PYD7sTDhkR09ijO9Pdzc1pAxHt5Qd29llLfy/4H1a+efi6w31rSRQJG967gYt9wgiQMc9uXV7WWJ799xALdGILnK+YI3R2UBOKTyE7xCtoxq8ZyC1UuNfwaffznOXltMrtIbKH2q0wDGxuJPnJHWjChTZUpE6OafEjbScGpmSJxF2npL5OWUkVnOIwNtdEMJahAnIz5+m8O1U42aks7GRWvo7WfTK1kq/nPLBUZEn+Kzk1TJCcTQ1hRWkW3a2cStnYRbtRQ+zixaK8Y/s8t7MTEG3oQhOa39j/BNxaWVDPPWnitjpxHN+1t3lSo0YEnh2wWj+LJO6mmLD6EbjC+LSx8fWOtQmgsfkFTedWJ+iiOB7H49lpmwOg+u9Oj20iuwXTi2Qz2VxX6XYsxuiGDFMZKWQhK03O4Uq4R545EITWr+AtQp4dnK/hwXTSiQMslUIw0BhPhGFKMEPOlDQylbmD9NnJ2RpRsgJ9sVRsyj8yTSqRMbzIrbCkZG4Hs9xNKlJIxYhg5kaqKyJLq0dKZQUer+SxOc2n9gVK7H2XM0KzgguujT2Z1164smNlskBJjBri9FZcRFFIMSJpzqjKFwH7UxByA7Qy5+kCBMs5s6IvLDBZ+sIUVTEJAs1dV2Uuw63d2Ap3JeA4VhGVKQhXDqLONjl5I3wdCN7tohvo2cH56RqpYPB8Ud84GoXngHo8EyeNdUekt7W79eJVY8FSzajgfwJ7TLrXyOeICaCmXMZYjlid1+9IV+UjIGOpQu+TKc11fSNkbEqr3OCQzR8be/A2WhPM18HDz1JaGnz9+jA6g2nOW7rEYf3NHcrEgXvTHjZPj1Q7AuSG27OApO+3yR1BC95UempzSoJiM6oyEB6tbCiFHkXPo+A44Whu49Jqn9Nc3hDFUqtXNVTXi8MzNyreTDWYHdjsF/bxCDI4gJqJoDLYZ87/cUpKml4x80yvJTALarulYyGdqdCsZEW7xqRe11FgM2PawuGkcY8lo6jQFIBJyLksWJCPK416hmGqICveVibVSq1ZKzb13MqBIloL1Hj03M9OD8SdnbCgB4EeGCHAHUsLlpj5ba6niOFHjdYRkZ/A3l6VrixC3Ki1AsaFBe+flcANAH0MNSxvyewZrMavkKYzpBWscL/W4UR7E1IwPOF4G36eYCqEw4OiGs0yollBheEp8H720Tipjn1EeX2EQpTnCDrIdkaSa26Xy/9ktXJtF8oUKNyam4q67TiZkoWsVJhjSvPcE5+/ESw3nUm1GNlHvVCiDc9zwoRVLx3don3SCi4Z08aSh0WpRdiU53lgaLQslSwVp4bliwcoVjTLFNN6KJ0KqB21aEdbbkIn/wQ2U0z4rJKVzhdIzfBOYJg3Fi1aFgzssiTnGuxWJ2cjQv09KxWh9mL5SLS0dJIQ8o8as05MA8Nhza/njCh642HydD9O3BdjRFlTyhRWCa+FyKxC2yFejeOEl2MLyjhBsMYjkrGSicyJ+SijS1EDASq927Faikr+113gVCdPd3gE1WRhmL5HtI/2Hi08zdcagPxof0DrTvCwuDPpSAJZZ3er9nYagCFhD6B0OB6O4yeNOWdMJik3i8uBDASHVmbv3Z03VkdgNO+CI4XhggkzFEynkbEiTNaB71QqMycHBVM8pT1AVsKoxSXX8jKV2SCowynIyflbYqfoQHh4cCtYQ+2mA6l3Qw+poFkXU8Ae71emZ0xelpKHu6npHJBixk2V4X2dUwMfOhCs/jdZycHVtP7yefJia2fv+eaIrOTUrOyTnd1kd3P31dYe+Z/VDpCPyxNbNkDN1Lq/j6OfUOL36BkRZwNBKUxOyUxRUeVUcbOIL9YFSe0FD2JndIEe+nszWJiQwrlCiSpl9sZwwvc0l1K5i2cEFpU5r0Xb+oZC8HJSzhea2z+8hyP1x1pHIJxKE7lxwX/D0e5QwAU5Y9KvtmuHmUhtpFjP0s7eKDbjUgx50t7BDHcdtPVfD2+Da6Cj5mDqPWm/VmzCmoji5T0whAeaxHlyFoQ0zxHhsogpC42x3pDjXYsnZ9c79ouTs+sXtfDZkrcKmg6AmzcHh7dBTRo2b5O08dJ7rG/BzYVVL1FLOjmzEzmdAQNTTg8uggJOnrFkljhrEs1jQwFBbdMbmhqujXBWIp3TKrVgfhQzkkuakQnNqUjh6E65YjdW5QEdX8nKnugWxu2iS6nMwwRcL+Roo3i/1Btjw47/veADddsHyHuNVZ/h258k3W034ejsyTJC5+37ceb24Dbit9xJG6ZYdtknVz7e9WaVmzmfzZk20aQeRzj3CBZSlizzIOtq4sXRsP8/1T4evKai4ZwuOpUKwkiSGcj2SSqLFcI1WYk+t11PGE7jXEoZM0wVcBWXiqVcW10L7CgUtV9wxEIYUTXJeUp0NZ3yj2FEeObZ3Jhyf2MDH8EnrI61lpALtbCUaiQaDj5ye/Xh9TpZEM2LMl8QQ6/qXUVtOafagF8DY2lQMRfSEFD6bliew9ovXh/Vzt+VVCbV1Ur3Lq2R0SAJI8tL2P4vQBFsOrUH+JrZWZ1M4/bwGbt4fbQ2Qm/OlZA3wlvJGmARh/qRN0cCikpak70bD67ILvG05w3DWjzWGALq+b7JBkjmNoqpN2I52oHvG2RTaaaSYSkm1sjQcC0VmoPt5OijKhiYSeT0No5BBXl9dHAGoRC44qMwVEwqq93VsYLyfKDFWfGfwAReZkm6AEyrPO+RJL9Lw4xd8KomdkkwHSgY9JrynE7yrjB7kE+YMuSYC22YI7EGbsDO+tUIEGYfngJxkYPF4HTjUKYu5grX513lYJHcKHNqrATSQ6gI54DqcrwTOFkXiDnV88G0dcQU8B07j+XJqVSKWdG3EfA1RcM4MChBqJBiEYePohAXkcp7zVwwyxhWwTM0aMMHu7pxCDJMpZjiXtG8MScVmb2SakcO8VHBfUQ1SExTh5SCDgZzdqF4PAX5q7G087mVttGqAsGFXHQXHfE0Cjyt4TmWFS4vOI79F7f7jTHRgCDpBf8CDEXAGTpVNAQf12GV6ADCmCSvTkBkErk1jHJK3jCjeIrhTToOn6KCHB9uY/CUpb4pM+mcaTAqRaMTbrSLXK2BtJTbDLhuRM5yHcJymiC4cVUlXEisYoU0IYiHyMponrFopjZkCBMlLmbTL8gTmKhfdQaxZmw4DloPBMGpbnKv8tlhua5BdQh7iIswBXPtcFx/9aJGEM4FQbmx44RnIdDanegFyfh0ylSssIPZj0N4sb0H7TFcN0xQYQgT11xJUTRtRjVtHfx2Hibn2cg7ZYD+ydt3P5OTDEOhIUigajOXroD64sWLly9f7u3tvXrV8nOhiMFzbhaXf9aewMfG6kE0D7HzWKyg+xFoGo5KfYg6zKHS64xqs77VsuC5+LXhyOHExy2eHHnuBbD6Q9gGlK9vbT/f2X3xcu/VJp2kGZtu9kM8oDgQYI4jTLtQR/ZG+LIbKPloEL3xfCCKmbwTjWY7KVjGq6YyXip5zbOlHNGf7eOCs+YnTPzhjPN+6I0eEfpnpdiIzNJyFA6yVCTjM25oLlNGRfemu9GNZaFRfKBFOZv4Jx63+DqWGbvUfCaovTob97LMGDlv/HL7BX0xZ5q1E0Qa4hrcdBMuqFrApCRMqpcPOcTg8HtEqImUOaOiD20/4k8gydIShAWOcZYOFos+F9XT9akZVbHVMOwt8pIHVRtqqsGCXg6yjLuQti6WgdKZstdGakV1BKUnDr1COdyliczstZ2qRWnkTNFyzlPClJIK87g6o17TnGexR86qUarSxs9HXjN6zUgloqgtPIb+1foVfz7r8cOwN1STSqRzll6xnhj/43fv3r67fH968e79+cXx0eW7t28vlt6jCjMSB3JcnePwDYYdSD/wuzoMgKdKajk15FCqUjbC8O9dCqCRLXNf3nE8Vs+NVAzl03gre7aHpPOmyfrvdk8pRPrVr9/2HqRhYeKdD20ageRq+VitNYIo6uKgpMgXzRysyYIYKXONUWwUzAyQFcPSK5RNkQ47JPOwgwzE+pl47ec7aGKBK6XJga6ZsiJfRujMCuGRNjdnNQ8Vpilp9h432kD+PWdpGcTUFwcweUfG4c6Iv7wjDjg82Iz1dFGYnXzeKMOwZKldjQMyQIFE4Ozjzhsnp/EgUXJ4dFfNWV5GVg1QdNCLF4bWToUSC3uzGh7MVsvcWEMaHurF86wp/PGCzgYVRmOhCiYLIUQIkCW0ScVzY/XAHtAMnQ0EWU1ZDi46a5mZo5T1u6ePUtfvSF5vi+kwq8sDb8w74HbUi66jJIIcijQ7lCCKo5OCCjpD5s91TQgdIQpT5iM+EoUcx5zkqPX1HbwkevTu0HRkuNHTEHaEbvGNZuZ4z5hRNPp9cejIflwc+rcYKN2I814qWjrcMq7axCNFS4dhIWr6KVr6KVr6f3e0dHwwfVCNKy3T3q8vFTIds8KnuOmnuOnHAekpbnp5nD3FTT/FTX9PcdPRJfa9BU83QCfDRFDz0s4W3/T3hA2zRrxwqfg1NYwcvfl9rS9iGE4N6CHfVNA0ROlGxhm3UjDZ1LgxkkwWgIkjBiWGHn+FQ4RBP0Bs+3Kx0LfS8tcOiM46EuVTVPRTVPRTVPRTVPRTVPRTVHSb4J6iop+iop+iop+ior9llvbZUdFZjteL9369fg0f7y7Lu0zEFcSb5HyiqOJMk2whaIFqlEe5pJmvfOyKrIJJxv38hoqFq1IXF2l1JaMkWdFzCkmOjXlWXIFcHz6Lhh4fSzepQjV8CPBgBseDWvQ0zz3qpjLP5Q0Xs30Pzd/IES5gPefiys23IM/GSZbn4zVX+M6riFKQ37jI5I2u3z9HcN9iZM6zcaJl33vvBf+4DjJbZ+0dWBpgLHI+6RuwoOnb8+Vdgc2wvOQ7intrQf4UBvfth8G1t+yvExXXWtlTkNxQQXItRD/FzN2CJysxJkW2OxBDfHO0i1M8CB49p1sDAXT+y8HWp0G0vftiOJi2d198GlS7zn47CFS7W9sPg2ogDt3Qdp1w074261KaBS21N3rHPB1aHUlBMq6vusfmiinB8ufbiZd8l1huSc1Qat1PVZ4jxHaSztpbwB/uf3CC5QesOf18+8MnLQgsjCUVi4GWdRLKzuA0nQ0a+WSYjEBrjqLkOVuHGNdHvYhLlkSADb3alov8ExZ7RuM4gvsXZ4e/7K2V/viru24WTn/gyl4kz5NXLzY3k62XO1u7D1ii7+BzCWsdNNHNLfRziPX87ODk9CI5/o/jByzRNdAZel1ums9Z30o4jR8+Hhx7NRf+fhsUVuRNK3cjIFggRKOs/tHp+X0WiJ8asbZ2wqPTc/JHxcDSYAVVKvQNi1p32d9dYrYTWBmHZNdQSrmuee/HWpBScQm2hhkzWEkah3WDPhtnQkOa4z48P15zTXQWfpJ4dLA6+1LMaC6r2xm5EXHaEDqs0VlCdWybcDCgWH3DFKv3Di2nXOM4XSjx1fHaQyKDGyt+9Jj11QNBqFJ04ZGBWHbvo5uIpnMHBtGu6rliplIiMmj6ZniuDFgkMTAC1u0rtnAoq+N1/d7gFmjm+7I1wpEnC3J8eF63zXiHJdxxrLmV4aGtQmwEKOrl4I9+ckFu7FvHh+du+HYEkt1mS34Q9YR+fOxaAr80Q8rtc57MyYEhBRe8qIqR+7K2CrhFFVbjiztoje0sYwscpP53lsF17RsZWWErDEntaCkIK9z4No5Uk1JqzSfob8igIrm9+WltKnFGQx933A8o1STFjjaNOPYWRSZpTgeLWMecfYrROWFDfG5BhhTDofERxpRgYf8Oszw57QU9qtswiIsboI24I0YstDpFusPBKBZN8HF0+GrJRKa97wWyrIFheZTEA/q1dwTtrc3E/18vFoaMW7xoOuEtxUXpyi3QSYll7nWzcRB1xhA5JYenB2+O7YGYMIss+35+zbJRzJxWVzUZo7OkZjEmyl+QwjdekkoxXUqL4mDZiwaBc5mQk8CrhDTe094e0zc3HEN7Bh8sP7Y3D4PGpJ1tubm5SW4Jw/A7Y8wyLufbApUs7iEzB2LIrsFCajk3rBcQ0LsJ3uZE03nM2NkU+FIjz4LrlKqMZQn5nSnpc+gLsNnMXSgqstAaf5MaaThFT1x7P50OWMfgYl7XMPhEFgOk2bQYMJoxdTnNfXPIIczfcGfLKdkmOTOGKeCSODOBmRuFSEpsZVQXO9gnBwcjcnE4Iu+ORuTdwYgcHI3I4dGIHL3tkKz7uE7eHdV/NuPHB3NP2x2yS8PYvdhNTTWYjeuWt0rOFC2QAkOb3oAE+wiIZZhcEw0EWWslr/NxkDnoHg1qe2trq7FuWfbEFT/64p0nSgo0l6MYhemwzhx9xQUE0KEA25BpSWhpGkcvQS9G43FXN4fBwHIcBmVkwAw4CeMxb8XRr++P3/2jgaPAGb+YxODa/LjbAvWSe4WDBgMf8l6EC7EFWnzvBXNaqyCTkGK9VFwY6NeXzim0tFaaPJuwXN6Q59uQeGchIFvbL9ZGEe1L3Xij5uVBQ8J2TEyntLRnimpGtjbhCpnBHB+Ojo7WajH8R5peEZ1TPXca3x+VhKSmMLIbKiEXdKJHJKVKcTpjTnfQKKPmPEq/mzKWxSOkUlwz5YKDP5gR+aDwrQ8C6I85n8aD7tiwzV89FvYp/vWbiX8NRBGQPyQxhElAxastC26BdQvBDol2GYUbaA4qoUusAKCBEYaZRjVqdDXZtuvcShxWgDRGDZzXEDacjF57rcdYGSGJCEmMojyH7oJMcdkv+PYj/Sn6GNnfU/Txg6KPa/r5MgqC05PuFioODg6akrHXVS8/J4fooGOiy3NycmZlOAa1wMaxaWPcsjH4H8fe1Odoh0+nPK1ysCBVmo3IhKW00sEyfU0VZ2bhlaOYUAtqtFUK7VAOrIQcfzTKt/wD+KIKAx5Qg+3PJQGraISccS2uQst3boI5C3slZOyjfbuwVBIPjSIBvgS/M6o5hKiFEevmeiipWOF2Krt1FYN20zadNL/bam8wSMJfQhHwc/WnGp6+hVigBnQDno3V+HAEA78P2chGDtFWJgX6a15e0MOwLtcTOQgglGXGr5mG7oWRa6HRzhAeSxWLQ6UyocMoU4St7SNYFooaAG/wd+6ABhCt+aGNOWChZMqt/5ks0fqaL+wQWspwrzhtDU/HWkIORAb1WlMpasXVYbV59m93VHh7vtXjHE/o8NJg+A3V9dKGC+j48D4X0Btm6HpsrPbVmZw1evnCfve1mVbsj4orlkGhs0eIcDg+PA9+VLjHAn7tYjQxMiFjlurEPTTGCH8PRs0EQTAC1lNpg/UJIdo777QPJeS3ORO4Z7CB2LU/yGtcZDxlmqyvOyOpc2BYgCw+dc5nc5P3FaWNVgPvR8G1ObMs2upvyrUppdk/Lag+TTGds4K28E8873dL6BqVk81kM6YcpWSjENhx+GLpEGZoQ++dQS7iEsh3AXaNgMf32NC2QPkBn3NuoLJkUNAlZ1gC2aLZMwIIwk+pvYVu8PYJdgzce240y6e1ok0Fjv4AN91AyeWATDT6tNwJCOCdNrhhYvpDekgPBM7QdA8YUfB9z2K9saoxsDY0vbq00sVfIQ3qAoMvU2jenLLg+wGMWmItc/ARso+tfkZfSNANuzvCk+ZK5ZpgYovDF9jHlJV1pnHEKv5Jr2mSUzFLTqs8P5Pgjjj2j8c85LrVUfz4eomG4qGRb28hQd8duT84PJdeXcGag4qnDV4QWM6BfbTVstyyh/ad7G9iaAhWMDPHcxp4U60pvJaBM8HFwUWaV66OO3htqAmuMtC0xKweI9QUtxPVi3Dj+aGoT+ewVKaML2LvStPXDdadTR0VmpDW7sb0/m/Q/eLE7RGW9+rp0j5h5saK+TS0Y3byjLoObmaczDU4Z1DDP82ltms78DtxP7qxlIQ/x1JBbS0otpOTglFdKVZgFwAImu7DbPQYBPoaesUCDcdojsmjxnHBCgkRKkxDP203XFZj2rXVvuaBZxlWgCG/Uiwh5wz3fIzl5+xFN8Zlc+MKPANT0HUL/MiTH45wHJHgILXzamP19MYlvlw1/iWq7XyyroCjBwXBOx+a9feclSPUk8FCk3FYhIjeIidQ+hNIoBZB51R4vPpO6OPadB021zKMMSBknWbZeETG7tysw7lh8NWU52wdxfxsjL4j70Fp3AYg30dBK1gfs8yBwvpq+FeaqfWSam2RuY5hSU2ZwoE+zHZgAgwcpCmZWjXIypKHOKcvkoaBXqhhg5RKDe5IbQsDZcUZtNzW2IE88GTOmaIqncdxxO29qcU/3O6VCZ+RSQX1NlYsfNGInOmmUS2SyHPDlON2rSn23c6OycJdFkFMx94izsrlHgtjQtoENwvnO0PJmmvkWfki7kviZrSbMnad/l2KkWVj9YhEVxMPVpvqw/hejXPzgg2N5rm8sRBa3TJtbpS7d9ySIlMcNVYOga0J+kaEya5qWJm5FfWiulu3y7iPZ0o4cfJlGrk5QzQdLApyxUG/hoy4CHNRdUsfslVpFi6NjOlGZw8nYGpSiajU5YgoNqMqy+PdB+4PTxMrx1T2D6mIXR7ocaBP4UUjr5mCW8Zq8UFk8pIdj7eE+aBNlHPIyVF3G3Ze7Ow1kY8c6B5ekNXGiCZ+3WnAQTrtaNgG3I83VksNvBVuxSlXUUKNYhR4m6XOGeyJVPYzWFFKXrIcej/cQtMZtzJE6orn/F+oH2poUSLboCb+ysRtUE1sJQ+3OUNro5X3fDGeEI3TvlJOBCnslay5qVAZHrmQQ3MjSZjWHbQJ61G5kfX7j2kczSJ8pjVmLOUpJBS5Sjw5hNWgYBRbm1yEgou3RBKvmUQstsC2wKuAdNyTkLGbEW4cl2hBUkjBjazj++ohVldBLfY7Zj/6Xi5GkivGSlKV6EaAl+LD1cSqVasR0iYe7dWKJy6l+Sje2dq9G+Wmx1lV25tbL9Y3d9e3n19s7u1v7u4/30n2dl/+3oxCzKihmt1XQenzKz7gNK3ANNHACLpWwBFeYClbKjDYzOlTVoWQyl83WN+Lpo17JpezkdP/cjlbG8WTh1vESCfjLOratdF5TWURld/Ddlc12LDpiqWyKIBnQy62kCZYtmB4K/c05gZVLwTJFTKr8pr0sYYHJmuj1ENJJrH9legM03PZlDSdsyTCRdjeSi1T+LGnQlbrTS7Kylz6HwUV0kXCef2vMvEDVL/hec57n0EHG9DIVi/hHLmpGzY0Ap7AMG2TkpBPIdbtmcfPzKpNijkfpKmdfo24xj5e5BkNzC4yrwrYPeWd6iJMLBO0dduVUoPauU3aFwnSm704/fderAqA27sGfIZyAupiq6r9gGU9fqF6Tp6VTM1pqe3h08Z+M+VixhSE26yB84/euJvMSLsBFP1Ske2nkEIbZZcPJgMwvFrJsU30dT+pvr8Ofjw8+mJWvZMju5pQMj1Sxlow79Gd6e7mZtaETMxYN6l6eZnkItwJQBeBq1Kl+LWPwGRQfFTR3AWUGqk6EgbIFr7eBAgD4/rCiWXxFl16cSFfEJmmlVIsSxynrG/iXMvO6A1pKp6gYBR7ovu8ZUzwsfd1VImfBAGKaHrTqwOfCKdU2tOFSr9Vw7SuCisxCEns2kDbGQVJwd293jU1V1LIXM4aRT/sVSOvfFgA1/sNXJH/r724+hu/3eOl7uzdZGtz6/els6OveJsZfWN6rg/g+iRFF4076FG0A637Udq2SUhP8WJD/LPp1OH3XBcDcKDFFtrxIkecL1IdHKK13aRXg3bxwV5rQX6HYvus4npOaM6U8YIMnIWGdawVd4CXVnO0loyKayRzeePkcYsqgKCRLRZdcGRORZZDXOGcLcBVdmNVZWGiY6qYXTMYK+svUcwAhCiZ16vmBkaBkw5NYSAASxtLDDdzBmlqIaIdW4qCo8+AW3BW5VSFUPtadVRWuOoReXLm6n4Gp0ksUw0myOIsUY4JRD3DWtqSovOKO/UBFBTkVVVZSuVMNKkUKSsh5AmHRo0ir2YgCXQtKbVbnsJJEF56Rnn4AERBuH/XRv7c4MjjVvhZQxWsXRFgBrTP3yZnNrDuef8QeH9nmTr7aILxwJKzMFyF0/fekf8dUsMtSrSV2CEWhqF0l8n0MuphmHFtJZMMDKNYDgzUWWY5E8tqorfSv4vfgShgozi79rr0+BL3pofVn7OSbL0im3v72y/2tzbR0n14/NP+5v/5l63tnf/3nKWVXQB+ImZu7xFoEcMUfreVuEe3Nt0ftRRoeYGu4JxOK3svayPLkmX+BfyvVum/bW0m9n9bJNPm37aTrWQ72dal+bet7efNOruyMlYx+qYvF6s+ferd4tY39sF4GRMQiB1zLrwxIiMr9VgGX06tM1KeW6klGFRKpnyYdbg/oIo7GmwwnZllvSLMqTQuVQHFO5/eCzWfnSsgMvRnDRMlcgvM72pdfJZX+6ItEXev764WYkbQehctdngn8tomEi0wAv3AXgUiwO8FUYqhcXAJlLLy+hp5FtaGn12SGd7PYdA6PBdFMrdG0PXrimh1cmyoSxO0b7xP7ejRfahDxBUyZnkN1TniDV5qW6/jsBK3sXHI1k+VAnqq0SJcwqzj7GA6g4RcK91qLVPn4cN9uEXkMA3uVtcWsYPXKJi23LSWMvysZh6b3vetRDFu9G6lYhFEFlBCOeQMesBIJhny1YJe1bujmdA9V4lDa4PFDNzGdvU8xKf1nTM0IsOpwuvZh9KeL7SzPHVtzq/lLLKxFigsNS7WOijOK2b+TulpFEG0nJobqthd2VfusMB1f77QhZXO5saU2Ro2v56ib8T1OHIDt4vwhRGfYdmVUV2dZN0tcd3fQesHlVWdxGzttio0jW2ESoSROeXR9/Gdn4C8f/ea5Fxc+djqu4vZeRdIWyjwo2D1RPD58jT2ITscRiOQg0iCH4XrqJHIHykt+yCuWhaqGPK9QgrwrgAzDB4a7M3VQbLdXb2/seG6Wl0zkUmVpLLAnmsb/7K5CaaPZbVExfXVpY4u79uu82kuaW+M0TuurwiMAOKq4lJxjHBuU6h2RES0zCvQv6Psp/eaOWM+rAzM6c71gEx6zlS7GV+A/dJq9kvQ2K2LWD0F0wD/k2Uw7D0LGmFMgk4peKTCIjYt2WxtbvaYUwrKXQlLV5d2ISvY9qaB2x1VLDAH6Zg6Akg3/Rl2iBtnHtHMkpOol4FYc4GRcH1hyc2WyVKzP6olT+jDelScu4F9a7VbeC1EbrUehfBQhN87AsAUrjtuyRF4ZehVM4WcfaSpIVJlzncdVN/IPxl7J8OpDuazYJjuYOuaRR2AHqXNBGYwYrBNmKB5fhri1l3+o99CrniQ4sKIcU55lK+AT3kzt3f30ihc2jMnnTifR1V6U0gUjhF2AoJ33KzcKVGpFJprEwtEjjJjywdce/YK7K3r4C7fsJ4Js2iGvobjXM4SDb8n/vcklRkbJ573+q/rpIjYuFgHy2LNFTdFW8xtOqmQq/k2KfXRPDk6X0t8NlnjjSAXObIm3OrvNyLMiJHwVh6vQ9zDuKksMQjm9uVGURNhwd1L5GWTpg1dqkXN3W4L9Inc67hwYUCx6yKiCHRh1G7yW3wX9pz+WXeZHCAL427tobEkeyBqxmF3OCwILQsuGNHB3BRHcsVotnCU5C5rT+i1/Tm6JvEAeuIg0ioQN1w3VK00ZSVmNIdJfX4R1Cmg9vhLATL5yZGbfOW4UrJkGweFNkxltFiJsp3pZKLYNSof/vHzi5U11AXIL7/sF0XNTDjN/VPrm7v7m5sray022o26/cbMB2bO1SeGYEG0UtMy0IosWtHVZB1jsVbgph8hSWFcU3R3kFpR7cR3IXkiTx8RJux+6yhgy/HVDPydMrJI4KIg97BUdktB5nTatk/ravcb+4KhVE7hX5SdxmWVGqptyGpbexAwNhSY8xKZdM0pK3uEr5k2fOZX11S9l1AsBJxbPzSmUHCxnrHSzDuj45XUbNVO0L0GQlOIdXe5YgICb0mZ05Tdqp3copXUJ/6ztJNi4fSTYuGyrK2GAnNs7G6/3MpYNlmf7k4213e2t/bW915ON9d3aLqz93KTPt+bsru1F08PU+6M/C7G/Sf/+Y4Q9wMsTNqKh4bCHR3/EISaazKxclEzWMyFbNtfIXbOBynbsd3K/f7/BJVbXR0wJ3ZFphw44GDx9Vvko8D9ZyqyDanqxZJG1MvIVaIIdsPJAqc88XZv8qb2OvznTydv/suXTNR1vLe9ZHnK9FqCL7vwf2eF6Wn8TSHVmGWIzdZ6/HGMvMLO1PSguGmMxfoMwWT1NXVeYhJq6FrRwg/da1n1Jrh6KzWGbxlF0yswqaAVsCf8gxqj+KTqdDYeoEgR4j3MF1//4UtsFIHs+ZqqhaWN0G2G/MIUhqlBFRT2cU4rDeZLSGCXU3e3NLm1ZQvM1z7y8fTueNr7kF+zEdhyIZE4G9X9fewdBY0AYpcJ+8jSyrARmfMsY2IE4ZD4bynyxchxyBG5Udz0mA5X/3PFP7syIiv49Mp/fWql9afOEE+dIZ46Qzx1hnjqDGG+784QvaH9D5MdQA6CcUAYhLrRS4oLEFGHxNZ4vykspFH42mNJN7VA4GQuihE2kAnVL+/gb6GALQzjNhAlh6oEO864sFONncrH7VlhmoxhFeNIX8Vgf8zjwNrbwapnHx1ZTTMNw3lt0sMdV/Bu4auR9/fYVxw2SHa+ad3y1gWA2kSpW/31g7AzFJShwWHIug/qDLRyd1Eqjk3FebCZ4tdRdAQUuHRmh8gU0FnhxlwWbIPmHvNhpXa4SxzmcxfbS9xHCkRRLMR5x2qbhglgzIrl7JpGlua6dVlvNF2UPlGWTFlFFy+AhvkOrs+8r1X+4bJcCVAzYFMDYFlhks5els6uFJrmD1Zh9Ezxwl4E2O7y5Ig8+/nkaO3Oo7S6tbm51TzwtX44NITt3gE9LQbbB+CL9h76Sg2GvmIXoa/YKqiOxR8uOfPEjl3biL2gitxNhL+9Kal9VrZ3Xzzfe948LQUv2OWA1SzenLw5xjhqf7v47E+AFpTCZrciRbRRjELcyWRhIlNCpaEEgzMW3tzcJJwKmkg120CfNySAbhQs43QdLMHx38nHuSny/zw5OD2oWfx0ylNOc7Qb/9fIXRm+3FmC5YJ6csms/FGC3D9x1QTDmJjeGGK/o6X7TLtlGX8xHCW9sYQUo50LIlMrtgfqor2lRFY3X+xstkjoMyXSHoE0SJIUQolBdWgeswFLA5+2G2jhZR7q/fibso73N3FH6g7KfHHP9kUqb8RgkWpoPrYTrIIFRUHa3/330+O29/pqdX2glRh0EYv0k1FrI2FvsTRoR/ht6KdZJFQ+TPjduG3vn7qOPXUde+o69tR17Gt2HYtCefifDwzk6zF62UGsGAEyW6Qxv42Va+SeUMrHRTxwTVbsx55Cw1svnu/tNAA1VM2YufyL3FIXsBq8pyCYYlGAr/+LlZqDfQMJ9RlSYcYVeKgdJGsd6gvu5BBcMWi/ESu5gCHgPRgCVB0LHJVBfHbeshKg4HO7rSBYChhmjbs4gJ/dxzvCAH5mMq6VmVKlFpjEh04tWgv+YGrCDm2hMFGwpTdjPVwzVxleib1lobw4pmJjwCNL55A3XqcYWMhOzryLVCqnbKh1XVk9JdjGlyqhyc1iKP/Sod28XmH0jRRW72tmAmDsDBOD+btOG34uN1m3nrNUZk4OsLBdC8BKGLW45Fr2lJ1+HJThFOTk/G1/tenDg16QhtpBB07vJh5SQVvWbU/V94AyY/KylLHsFauIUsy4gYqKIiM5NfChe8L/m6zkUqzsk/WXz5MXWzt7zzdHZCWnZmWf7Owmu5u7r7b2yP+sfilVcvW9PYI+ZKglnNKAmpH3d2CQnZySmaKiyqmKXdfQTjOFCCvLbKIr9jAuRhLJFly5VGmItMZKS2SaS6lcyPwInXZxlb8wKIKXk3K+0JglB/mGI2APGCPS6tlYpzFBSCIXhFZGFsD9IvbWvegnUhsp1rO0sS+KzbgUQ56sdzDDXQdr/dfDPpgGOloOnt6T9WvFJiz9oc/O7e+v8MXtN5i9VNF4HZVq7Qlnh2d0HbzTco7EYe3LFxgftqdIo1hU8HiZsGDIDimYSyq5raUPFeT10cGZvUEPMC2z9p7F3USaLGQwIej2os+4KNeXEi2+GyFK60vxtxjnAFDyQ0+pIEefv/jP95QSnmPVHyDPmiLrnBP4neYzqbiZF6GyLFcu9CyKoWR55qLZsBIxhKXOsVUWhpq/OdodgQNjDei8VMxx64QcZJkHYxpCHjEC1w0xWUDCuEqp9kalJnDIjC2AaLvGehaQI6ZZSRU1MnQUproRXf1MC3qF8bMjgnlwc/r8cndr+yFNi7+0q+nLe5m+joPpS/qWwnmSulGb+xf/+c64ZQgSbsctu+xusDRUBsuoaENFlDx1fHgO7yZ/84fg1oz4bpwvTCpFXeQ51ntCEW1QNUGhua8YNKwVnTQtC+2cquyGKjYi11yZiuakoOmcC6ZH5EimV0yFTqLKpW78ezVhSjCIdJUZe1BVZpXOuWGpqe5NfP2UjX/bSrFuzNeRCD7uvbh8sfO1bli8C+U02jtPav6ave2OrQMrUPZMY/HVDrK6qm+7fcOIUpFTZn48eXve7fL1movqY8/YNdDRTGFEuPd9BYGeeI23pxdvz98GzNxjU5sxmXxDijSA860r0wjkN6dQx2B9I0q1BembV6wtkE/K9bepXNu9+RYV7Aiur6lkN6WugSBZ/cWNHd9IjUrBdT+DkCF941P1xx6yMSg29vy6hr5eK4T72IlD9yisj7Mep62iHBDHDR/ogEdfOo3mN3ShSQWvjCBX0FUaCEaHglHBxQwKX7i620xccyUh0KfRVt3tH/SerhSoiZUv+DaeMGqAEY3bWCjvwUJ/E0gQRnlZNz5s9V6i6QDI/cVt5m2zDkWjp3fSZ9R1EikzosqIGt8L/tEXEnGMEorK/VHRHIJ7wpiRLOfb20BlB9djPTT0qDRTiasCAl16M5byDKqtWXEUSKlm7tBVs7X5UidTWvB8qAiMt+cExyfPvJNGsQzStjM24VSMyFQxNtHZiNygONz1t+GTHbir/BFTmr+a/7Oj7uCuN6N0QsyD677WL/LS1OL7jfwnvWZtbEUFpgbY5fYacLYANqjbit64Qi4dyHeSnWRzfWtrex10cp62oX9cAepb2+s4gs6h7LbN/Y82Zry180vtrJ/PnWcr90k9ItWkEqa66wxTdcM7Z3jYkKEO8MvS49ZmsrWTNPvqDlZ2w5VXbl0rVoM/zGWVBWXc2wnqindOqsHgBSihPTbbScEyXhVjKKJzXbRKGzYsAcEm1Gish9XvwMIbu+BrOSSM2CePtKpOlEuGxd4WVXOObQpqSS4UFUAze3Pbnm/vNqe39+PXcrhA2MaQ/hZYHSsoH4qtW9WSwARe3kq6ANhr+JHD4b4af7YLXtUglvlreEroNeU5nfRkthzkE6YMOeZCG9ZiboAb9Ab9dT1+0SK/aedfBOeX9gO2gBiwc4hXPIHvgAcOyu4oDL1q8HJo3ugYlCBUSLEo+J9xN2lAYfj4PhReHMMqeDa2lIIfvPaN+k8qxRT3ql3wQGSuAngYttl0qYGnL9M8OCTEw5xdKB5PnfxqLO18LpUPtYXaEbXpv150Ixtigh0BgunHmEaAxS8XF2fw+XaH20/ebR1i/uxLUfNC1zmbjCuV+2pcmmEpThNh2AKpcg+vYn9UTD8g1MK/MJHZIomzqB5YqDN+tYncONq3BSaBWdvo3dt7eTuILuHnL3CRXjjjBm78nRj5heW5JDdSubYaHcwMsG8XEmsz3LF7zyywwLTmjFrpu6vSbO0879/Mgpm5HOo+XG2gFKdqpWZH5e2wqfOExcVtjQwBG1iV7I+KqYXVg0IX4EymVeHT38LYvvfvyomvXGp1q+PD856w9RkzI1JCh+eyMr1oggLXarDsr3du+LrwWoy5zm76jMpJLmeJz1hKZbHRgl2XUmj2xXkKTrssU4mB/Otylbtwcjtb8bj50nzFQftpjMUBjZVwehxVn19zuolTVy+o11+1s9mMtxjWiANw3WYV2wIjTZ11bpia0rRR2PCk8eXdQaFhgE4Pf4gLTaXKCBczqwljf0T8szkvaYi9kOqjWCmVK3VEhS/Mq9pFkImSFWRX5pJmZEJzKlKm1sKowWjDPoZ08TAW9KGC7kg9vfATaOFm6q4hbszQKSQMU6MAgfNjaSa0VK50e0kFsStaw6IhMRyJw08PKnpCp5aX5WjO6VA12gKJ4CzopKh3rFYvRz0OaL97gZuFst7Y2RdNaxaVXGiesRGRlXF/KJIVf4YWHzXqBS36zJLuxR/u4ZqDx+PW+Do5aiOrQd41ts5P35x1zgkhJ0c93G9z2QUOnYTp94LdThHdPHczvwf+OiVkFvOp1+7jHXGMR50Qw1BE2xcFLFg6p4LrgkSVAkMzlijZCjrL1GGN0Csl7Na9oY2d6dy4oes01BDz5VfD/FG8fNP8hPXYw0RYnd6PCZ7NuGz738aNhfi34laDnTr/rRUKaWARLIvH/1so4jupDFHUGcF9sd+/gdXDKtDww/HhuUPfA4IngVCbRPs4foS3vuOHRWSI8nGb1W3oOe2p04X4cv4GDeE5YSgFclwFnYh8uf1GkT9X+Qt7QFNDZpLV7QVgEHRJxE3HM8m0WF01oY+0FFEvJl/Nv6xMvJ+Bmizdh24DULIkNPOJex2sdXrzI9Uh0Y9vqBLjERkzpex/OPyrvrVo3tMDAIptNrfV0pIaYF8vWp2NcCJ3l0D5N6zAgrd8XS60AjKPS7LEo6Q51T5KALrzeNUwzAC3ky+5TNJKG1n0u52lmiUsp9rwFPv6JRMpjTaKlsmP/q8GsjCVHooGJDlfqhUBdCIMCO5gyI7S6pUSSqhQLrwb3ZEduNBdy3I8Ne3eUNGRaa12Z/vWpQx4HbWp4JEWF5UyNI5yLGM0XZrrL+0Vtjf5J72mvYipRDpgyYsOXtx0roLjXGYdVNyzv/Y09CxkmM6c/rgC44z5t+/USdv9zEH9jZ4IGzthU0ioKXNuMJfBkKpsNAcoqWr0xD3BqCUFlYcwl23shvVGWUReHN+E1f0VhSLWdsRmCX8WA9doJdhYhl/sqLMg39UtjIkt/FyvD+iEgLWQUideU8zsRv83E6mEoBmpiGA3wBes6FbI6/gQSJJC3daqbIP8uY1OiZauj6m91iYMbGtxaNfEx3mAde6z+51CAC04xt8sgkQZ8nPgIlzi6GGJffcVfrjsI+vO2XNXbSiW2uzzxWOxAvJY7NVdcBNzpGtO3TAJOcuZVU81Y+TdT4ea7O5s79itfL71YifpWVoypSnPfQOfx7aIrEYr9C2m/IQd2artKg7rO4jbINWrsjRkl+XOSLuaJhX+ygvdpTbDkPbd7edd4th+fieOBr6ffOcd9tGsT6hVBJZGVmsdQNQv+9biG8o9+la3tvmWxnWfvsWsHpJrskf+ViPnX4OkmjR5T93QzaobyN9D/wDXUgVYsqOeQCgw89arrZ5iMs93+9Da6IP1MNzee2LaTdnuPzF9zb9czy+L45phxKpKnRnbnrjmNIClts3t5Oh8bRRrJVat6ADvTuZM9jYJuxP00LfMKznU9bBPTat1mb0N7mpd1m7itlS/sl6eEDZ8yMyUb4EYmg38wqhLEQGYWW+hgEip/YqbH0HR7bbgdNRgLENDbmxyOo2+uicd3ZuBmzm0aI8uiko4cQzLOMlrFvoa1wm7BIWyqEGPy4HVDWuOe+KTMm796D7SwA3bbhkUOgg/IOe11rKHOi4HqMnM+DUTro9WNKuzw5RKGpnK3Kn6XkFXE24UVTwiHCwG65pVG3tYNMrIBZROc02LRiCQ0lxLmGyBikD9sL5alJFJhqd/jOzNxSZSXo2IubGynPKtzOL6rlbz0NxUTkqvq5Bj190wIpSzAljqIk/2FspCUae6uyUcqY2MaUNOzrC+lR6BI0KPSDTmDVe+qu436BmnvGiQVo8jcpmeqLc6IVfRC4neR5C4wQ8OOzKR9txAZJ/dliafHbvOofDmGISIsUW21Zu5FOF7xciVkDdiRMb+sLqfUFSJ+tnrqui5kV7sNRDgOIhZXA7msVg9wIg4aKaH5mAB2ZJ+ceTkDF16jpqoJjcszx2TC+vxx69OP2zyv9oCR6GnyTqdCamNvfkMFRlVQGO++nMYdpo36+u/ZlS5isvUhMiEGTfzagIxCZZAcj6bm42AvHWerdtLpkfo25+//Vd9uvPLv775effNPzb25ifqP87+SHd+//XPzX9rbEUgjQGsHStHfnB/+3t2bRSdTnmafBDvmF0P7Dmptev9D4J8CMj5QP5GuJjISmQfBCF/I7Iy0SfuykziJ9+JED9VAgj3g/ggfpszEY9Z0LKMWj8C08HLyykzRd0JzrlgR+FCiuwc8ZiBc0GSvSaQgAzdwTi7SRCGWyb2qJGKlEzxghmmEJAG0MvBVAPSgMD+F0QeN1k8cpg0WelayADbDbqZSnVDVcayy8/JJjw583HmdZtYd1yjn5y9rFTyYzfsY+vVdrKVbCVNKy2ngl6iOjUQgzk5OD0gZ547nKLm9uzeKu2en6wjcN0vsF571MP23PERuK98tzn/lnb8h+bQ+xw4GEg8p8z8lMsb4HAa/nLBmWHcXM68Q6By0Zl9a+rW020iWixXzfuTDE5OXE1gkthxSbPMcWPXa80yWX81XedUuIdjA6DPRkejJQwJNev//vrgFKnvj3Uu1v/ALwxFf2fUgo4c5FZWiGKmESDf9ITYiROO1kL4G0tznAD0EVQtz2SlozEBEM1E5ty4lk3ijgar7t7mdrL1B2EipaW2Jx/kLSs/tmI3WsrP74xdjchvXDE9p+oqWQsovy+swC4gcasb6DgB0rvBBY1Ak87RXzpuIFrBgPrvW6fM4WJuCyO4dTkPDPYYOq8B1ZLJgkhIqpMKaMzJvbquBuGPXXs5P0O46m98yhtglzS9Yve2jbzd3gSirhvkk4Rd926PuFv/0iPw+h9rzciJvv0i73YzYs7z6wGkrNXXLz2jrKVV5DzsYwKy5IjkwMv/SVOrw4XgjKBbfns6U0hCCHGmHuohUHjuzqrf7Eh8QH0ZEr6or2dnl/jvOE98DIkXc2sM53RhxYIqK0fEpOWI8PL6xTpPi3JEmEmTtW8P8yZtIX6gNFgXnvj2/ATasuQovt7E6aqerF9bLCYWdzuIwcg+UWqWjkjJC0Dot4dOC3QDn9/zPfpXuEGDm9+NAk87++jb+Lu76gtGMY+d5uglg95KjpeMQvF2LOzRMStip8YQSJcxw1Iz8uNjVA4G19074npTxncKpr3nsKG4btZeD6nhIdzHlxXEQSn0y1fQ8B2W2mryLsWUzypV77skqhLLI4BoOTV2usSXsmmXOfT2ej0iN2wCGiBn0JjfqAoS+xFdXIqNUsF6YVxfcsXLw7Xa/IM/wVZAdsPGIEUzgn87lxo0gM7QFqsHZ28canTyQ812An1GFm2KnT5vMWi7e8PHHPMpoWLhmRxgHdepA11oH2qJtKFr4f8OfMMqvA4WusyTNy725I+KVTgwOb54DVUypQAS8savUsmUaR1ZL8IwoZ6rYuD+SCUErFnJzOMDogOPD88fYIVncWj5o+uX/rgnLqx/LlGfqyPYwSQehWmjmg/tLmkRmcktY0Sa+FOKZuqtkQSj7/h04fMHvP2LkHOMxqeqaFic6qvG2cTbul0rLt/7TDA83+rzt4TnYywMNWwmFf+TBUiWvQFwAUlASfIUpv9gza2Dw7983H5nxd9nIH9nQd+zLBcv4TsX6TqLskx4KNuIY8PA5+U0+CKCse6O1REjw4GKeTCkNNSeKaoYBNa5y8KP7Oqh+65aI3LsXB31NXT05vcR+eXdiLxmM/uEVTHbGD2rJjlPL3EYtnTPt6fCvk+FfR8OUu+GPhX2fSrs+1TY969X2Ldd17d5qde+mC+j0/m07eGVOj/T96vVudGe1DryOdnXHST+5fW67pK/d8XOr+h71uwaa/jLqHZ+VV9Qt+MilUUciPFpul2dj05x1KZel3h21dHrQJ8Lo96j1x29+X1pVH5ayFYdklVXuem/44epBf/m4PB2ABrzDymlH9aZ0V0khM2qo0LhQbDhu3DnON47vNmI7p6zvJxWeVyjt77upnUkUHBWBAcCxWxJlteFbDCFU6oZFfxPlKkbcRFCxsnekPnIWMYypwBgKifClbOpIawozaIn5vQS4vPOf25sxFO1effDt1aB/Kna/FO1+adq848M/OdUmy+VzKr0EYv2ddJ13Qy33FwtEPX25mYDPs0Up/mwMdVed3eTOc28KVoMVpV/7srqt8usgXWeGkogYgLEwamSRTNmTrkGP1En1RCrXY+0KJlO+krS+Gh6Na7FvbG/3aE+TabhPyX8B25a+EPmOYMqNmg/sH/VQQk9OYIN7bku5xclaD0mUv8OAy9HcOeLggrTMlb1nt/H6TnpNyViiHUBkFpWgnd9dFD7+3tSKONxfCQIE4qncyQoCAFpVMwOeY2pLEoqvNRkxUCwpzaIsZXkGOdU6lDP0IqSkG1KlaJiBvE8U54b5qy9UH3ZC4lQ7gJCfgU86AXNAEa9nodUwPoKleKb4i4ZTDX4eld9TFteXKtvvgbZhmvqHK6pe0j3AoIyPf34kgP9ZCpbN+Dy1R2/S63gSSVo4eh2leA71gf+KhzikZWB71gT+ObVgDg5xtf4ctz7LPrqTqZd3/m382y447WhORauwuhbP6uH78TUpbt8x/Seofxro+DNQgKLGIfmf8ajQtGBMLQDBMd0gbD1WIb7/hVpdIkvVbjh1mblj7bjbk8e3Kd8UvE8uxyWGlcPXEpk767ZUw9Q1Ns0dfmQjiwCnwlUEb6JCriGlNFUFgU35PyXA4xSEBiFziCD2g/RUxBgujN9yfZeZdmLrcnmq729ydY2Y5ubm5NXe69evNh78fLl1mZaO3jvMWinc5Ze6Woo3nTohu8gy68Q5M5rpkKVum7W7N7k+farjL7ae/WcPd/ZfPUqfZnt0Ww3nbxKX+00de1o8oFWdNSMLoH06iYXCJC/LZkIdXiUnClagBKcUzGr7NqNdCSlwRW7oVjO6SRnG2w65SmvQ85JHfDf1A8QnZc6lW3d/hGdhxlsjZiRubyJFwx16sKOuiC7SjO1DiEtIzLL5YTmHbzg130LYcvoOxk1/S0PLOODLOBe+JqYy3nKhB7M1fEah3cFkzFXvI05f9ibzaMIJTr0IXI4hZglN2KssilZkPOzo/8gfrrXXBusH1MzI6k1n+SszrDXZfYRsuvdkHpjrctnDkqazlkYeDvZHFDS670ioilqypFNwYqaoTqEnVEzjyrx+H3jHYKKoNuotNoA0t84ZHlO1cZMbmwlW9vJq3ZnFCi5lQ6Fwl9kYUFGm0WYjLx/9zq4u7wEA50SuK5FEl6XKL296mAosyItL7PEtOx9YwWbJVb9oIqEnmIazUS698j29vP72pQ+YkE3ZxDtygLgrnThSV7ejEkM6hXbmUe+qrqZ0+YjBRW0rvBMXM6yzwTbJ6osRiQrr2YjMlHsZkSE/WLGihERFXz9T6q6Z16VxbLbOKwk5je0OUvcyWQ7eRUL/025/5j8Au1iPkXy/w2VI3ImlbGkT44/srTCP5+dHa+F+q3Li9VNi+QgsT1WZHXTNGzGlpZGvtpfRqiBp3jO1q2W0NVeodyZnBpyKFUpVTPZ8h6SGF70CkvNujLYA1d6RuMw6HtWZsceWPcIS2spFw9c1ovkefLqxeZmsvVyZ2t32fX5CtOXsNCh49DsKj+HRs/PDk5OL5Lj/zhedn3DOgjDovq8hA9c3Eo4gR8+Hhx7ZgR/t23RK3evPlp76qNdPX+MvrrbD7OUYcRP0e9FSamoPSl1h1WX+dps/wT1Jv1whGcbESm6Wl+N6udgcB/76UvotDo1VucydKF9EyicinCjWT4lVITdtasqOeaO2wdRLfFlwMB6i+DWwfTLWVFmQ4X/rh4oRReuihUgiaoZVFnQI7toBfQBeLQLohMt88owrDQaRdlB6dVwr0WyyRu6IBPm3FyImVJJw6ACq9Acuh1He9aRIdzHdZSFJ1xs6NDEd52s5+FPqyaGD1ubif3f1osOIi8h2+ZhAmNLE2NiZuZBVXfEYscGx96iv4q9C9uqsJlvXOHClZmzKLCfJlV6xQyhguYLzTWRwmrJYcjC3shhk8iN1ScCN4AWrlTFZ4i8gUKG4YUCNySq8c+dOo53hK50yVMuK123jO3IdTvLMspUZuxS85mgYJdjH7m+t97QRMqcUdGH+x/xJ4ywL+2QkJ9PwgxxjbA20KtGVWz1EyHHlnyDncL77IQpUwYNWr47YE98Y0RbvkVUqhalkTNFyzlPsXOOro9zPOo1zXkWZy1B66hKGz8fec3oNSOVqOsmuBYD/tX6FZ+nV48fhr2hmlQCjISh+XRcOPndu7fvLt+fXrx7f35xfHT57u3bi0/dsgrTVAbKsDnH4RuXM3jnoPKvelRJuLUyQPJSlq07ztLquZGKaVckqd7ons0j6ZzyOFT173bHUXaoX7/tPc9yrJwC5S9Yhpk8jQ5Wrg81arGQY9Mo0TFZQElXjdG7wJlYvkBjM9ofkEo7BPVZpx4o+zPR3M+zIHiEzzi2LI24F1qurWQ3o1xo07hiJ1xQtSCuqWyzZm33bNLGXtxz8B6Kp6KgIrtcsoHU1/HPNvfhpyrPPdzYsgpICe5L15jI3Zlt97uXesJcTvppST1I1DTP69u23fyscw1/ulzUkIfIOhRFVi25Z5kkfYhlGrD28+1xQW0pH6XvZgoZMhW83lyHwTrdA4OmwBuCleF0HM1XX2RTcgMh/40K6WCIhZxcDwgGIMDhef/+5Ghk1aJCCq/dkJ/fnxzpUXw/0qiudWGPn11qvgglprE0cKjcA0657qoPpdBGVanB/rGoNOQLN1yMOchhsCQsBSmVZYIpuHwKbvgsvmTPTo6IYpVmjVLade1rXxprCt1WcHnQN8DqkCNC7VWl2yFnxGdPWuxJbXqYbbqd7uzuZq+mr149f7m7tMuwPkPfLC9ZPtbjoKUjxbTe0JHuOM8t7HDzCU2nuzGQdiAUUZq6S51MjqXTmVVEoipVvSUpo25JEytuu0stBN/Wk/nzjl0nsP5tbESw/wAX7nEabble3EsQkT2KSZHtDsTI3hzt4hTdSfWcbg006/kvB1t3TLu9+2K4ibd3X9wx9e7W9nBT725t90z9FwkGW/UXCobxNSQEy381SV1AA3r4nYahiOYFz/vcLG2OUVJlj+3XsRsNYvx5uM1nGStujaYnq9CXtAo5xH+/xqH+BTzZiL59G9EtO/fXMRX1L/DJYjSUxagf30+Go/vQ9WQ/+kvYj9x+PpmRnsxIX92M5Gnx27cmDWMwegiKnkxKy2Pri1qWHgjWl7M9PRywL2idejhwX9B+tTxw37SF6wsZsZbHVjlbSt54UOT3SX1NOo4GsVmRpYvpBoOeMDu+vRYfutllG/plGs/eEbMeoty6ObbbO9sPBa4D3WNE1UNXcIe5VVL2g7r1QFCB0S8B661ZPlYf5QVrbKsT67t2ou3NrRfrm7vr288vNvf2N3f3n+8ke7vPf3+oBmTmitFsubKGD8LyBQxMTo4egwwclANG8Dpwe1Pacfb1pYsteqC5+V5kv8BGAeaWVGRpEb4foWKAfDXUlqM6UCumaxxSgXm9E1Y34d8PQ0YV7AglEyVvNJT3MaAxcOOA8BIoNPmhM0bSStmBcug+KCITwLL7UZUW8s8QNc9ZKkXW5Luh9VFVdpO5n28vHaruYLyR6oqL2SV2LJTqEZMrhqQfSyYOdBJAbzshOorDXBZsg+Y8XbrgZ8mS/yVJJyVL/rp5JyVL/uqpJyVL/vLZJyz535iAEiHgWxT8A3BfXqwPU39toT3k5H5DInm4ar+iwN2C4VsQpwNI37Sw/AlRNd+fJO3x8/XkZA/B9yMFL08YjyAi11UWZlwbhxWX+/gu/u725MefMHnRNYW1lOHzwv0AvoAfNEsnS6YGQt44VCcYiJ+svnXCFNZAIDeKG8NcauWEavZihzCRygyKaoXN+UmqsEDVXWBdW+qcmb/TvGLHH8H7+Y7Nfq2YWrjvRk2PP6RP6hJpXNbOO2hBhQ69cV5e2u/GSQh5kb41wqQyXm6px5wwY5giiqXymik64Tk3C4CldkfUznF78t8d/3z548npwbt/4MqZa2vd48j6/dcfq4PDzYO///rjxcHBwQF8xn/+bVlhB7YYb5/7gqM+rYY+xgRgnRu7vVA9DeZzVXLrbT0LiKCaWB4JUYB9b8K+uD3yBJAAWWjoxxOGdM8HIoEpyTOL5PPfR4Ds4/84Ozg9ujz/fQ3pIXYUBRh4KNxCoGSqq/OGU7I/KiZSbFTgJgQCtqO/ef/64gTmgrH9cNAjOIx4TRXUUSI5hPnhsKKCPnOw1pqi7ZhHv719d4QEffzz5a/2UwP0iPrabYixAWHKC5oTxVy4GnrOnrFkRsYrWyvjHrfW6n+uHO5/UIZ+UCy7NKb8MOHiQ7GgZZmwj2zlv5a22gDBDVTa+dxQkVGVNfcbL1THRXyQim6vEEli2VXM+fUQCziYTBS7xkq/oBV5V6Sdr3ON/PLvr98sC/AVWwwA7y/8mmErcn7tPMxyakfq3nnnb3+6+O3g3fGHWmPzLPz04sMhyi5/R5X+w0lhBZqfeKhnYgkUm9DoDzdcWEAt3S2t0nUKLz3K8iFox44dx+TYrRrZ4eCEAu/u27gPn42QcMx7EPPhiE2qWV1z5/4CORGcQzXWhDn8Hd/tarMUxLWwVPe/D7JS/dWddSJCfLRmxl7hBaPC2OtkSlN7QVPDSMmvJca6KOj5SknJWWqX4uGDmjruA4RPwQMa+/7UEbQuBltbIRliD8WClDlNoQO+vWGOD89d1AK5iEFwQ2sGtSfFzPOCYoSlvOvbSU4hrgumQFnB3Y1cRUJNrV/i4rkgY4fFZBxWcmAZZKqYCTFKFkNxP6CRKw/ng8uhYtxcahM61quRD3iqKcK3vB2RNOdMmBHxj0I3PmzHlPjq+NklLxNyMsV65mXJXOjayZnn20bW0PNyPMJ6HVh3SjikAcao68JzckaM4tec5vliRIQkBQXRLK4+xw1MRhXLRlbcC9Hy0VT7W6+2k81kO9naHT+gysac6qFKvx3kOd4RVM+ZRjKQwiJEecJykhWGDHryh7Y/NRepNKqXENBf48+NGuqicEE0N5VrwYcV5xayWlWWFHSlGMSx1fqWA4zQfCYVN/PC0tMzDLdlik0lvGEJyrJMuPQCAGvLtzUsl0Buf68riz7HoE7OetHXVKP1YE0x/EZCrKSd7XZo7uePVd4oMvbOf76DM9pnfB2c0FQqig8Gi4aLyMNAQbGoe16EvhJ0ZgV+C4CLjvYhi4TmTBlNpCISCsUJiYXKYGG1JuALw9kpovBJN9oNSOderkUVIAIcL2K273mKByoruAZ3gRUAlcxD1Wk9Cq05JTIycnJ0vnFydl7/ENpvjcgNm/ghSwwfx54P4YFK5S5wVo8IExmojyRjhqWYUiGsfGpZsmbk2fHRuzVXTTqEbTKTPqR+T2Xm7Z4ej9cnD4p6xj0WoLlmqVmVSbEIdXIRCAg3hb8sZ5AkVYyaqNBw2CtPWYEygCs16LuTpHVuqFp/HfeCva+KAPbmG8qneFA3/0MaQPHGDYVLdDHArqUHcliPhIAVy2Vr8vCxxL3IIAfGsKK06sFJJGO8ZvRqaf1rcPfjBTa5b3seYePdhns89C/yx1ymV0RZtVobkGVK6GRPjk7PMQL4l4uLs3OyQS5en0Ngukxlrpe+K4YKIz/ANZ4cIaPi2kdHW9XbVfeCysfIO5FRRlJTbWHwDLKXcB5EMFubSwc8DVtiOFYE8luqDd/OGwJqMCbXCu00Y3dUfHX1gH0d4CWWP6jbpNF/HdcJxiqfYbPcuXj99vDfL49Ozy/tIbi8eH2+7NqGLuC7+q5RtNdIqy7cnU8Y73XY3d77IPxq0WiHT6FpNkedDbtbiEyq1VVNMplWdV5GczZQKOzJXF2t6UlIU1PRyIq/aeSdoSTn4grWQwoZ9ilHhwuiYOKl6vqac7V0Qdzp2tJ8MWImkht+xUuWcQr1re2njU/aXitrsaH89actytXMjEgpc54uRiiboEyArlx/61pFAU72g25/DOgvWN0NLjYhOfPe5Zlj+Zc/oZy1LJ6q6hvh/WB5kCoEAQQcwZWg6ztBj1qXAWd6qeugyTC718LW5ib+/9IGokGDei6iPkQbRLFrrtuiw4TZVQPtgF7vctW7S0vuWVPU59B3E3ZK0nn9zR1q0oF7zm6y7wBItfNFgKnF/iailv+pFMJtzzSI6qj0EMVmVIHhUDNQUPQoeh73f8LRtYj8dJrLG/AoqazWmX6SilwcnrlRsaOvDmAibCnj13UAChfccJqT83+cQqFuZp7pNfejG9QOWMOCbgmkxSB0tWdyDDJfdPDxQ80FPF6MokJTNzjY0JwmRGhqKswvc91HDFMFWQnjrVj+AbdaNKyHQrQA1wnQl/vZ6YmOeTPfkKa+LLzhDVv8UJfypltTxOtwVpbzxgSoQcMq3IhRFiyoof+sBBIFuGbQLube7husRq2QpjPkFFiw3cZ1OJxtpfoQh9/wS2h6f9DAQ7OMaFZQYXiKjpKPxrWvZh/TORUzNmowda5DB2sjyTW3y/W90LF5oYBkX9qwGnnLngpzTK3q7McUvoc2XiRo2nNOOW14nhOGhibMkHUt10UWmxkBYVMedeigZalkqTg1LF88RL1Gu+dQghO2CIWrz21M3ffcriEwmGLCZ5WsdL5AaoZ3ApcHj6IO2THQkJQKcnI2IpRksrAbAMbQSvCPREtLJwkh/6gxS/MbutBoWm5e2fTGw+Tpfpy4L8aIsqaMJqwUVTtRs8pn2YPRNuHl2IIyThCs8YhkrGRgnybSyQyk7vwPVlmuW8EsVCdL96e9LZ7FJf3iOITm0ICqLq9MKyOFLGSlfctDwHv9dQDQd13DgZ4dnJ+uddJs7b3NaDqvbU2ISgyGZD039O7Wi1ftNTeaXX7T6VzLR9D09rdsoOJnKWc5I69fHzbw0ROYskwwZPxas8ILhKBAaihU7474vSMJZNHdrdprNv9Cwr4Hsk/ybyM0OH7TLD1jMkm5WQxVZOSQm0X/7ryRwijW6o8E4EhhuGBisMInp42CJ26yDnynUpk5OYBgCtoDZCWMWlxyLXtSlh8HdTgFOTl/C/nFHQgPD24Fa6jddCD1bughFTTrYsr357sHnBmTl6Cc9837WooZN1WG93VODXzoxtz+N1nJpVjZJ+svnycvtnb2nm+OyEpOzco+2dlNdjd3X23tkf9Z7QA5oBFn9b1mat3fxy0DJw3tC0eEoskBpTA5JTNFRZVTFZc2MnO2IClUdrBiZ6PQgrs3TdNoxF0b55QJdC1AtHwuMVJowlSdFO9F2/qGQvByUs4Xmts/0LA4Iqk/1nEc1qk0Fk/2QZTAsWt0ZWQBF+SMydCssWPdmEhtpFjP0s7eKDbjUgx50t7BDHcdtPVfD2+Da6Cj5mDqPWm/VmzS6oPedmR2YOh3Yq7WHvrQMst1X68pCx32rY7f5OTsesd+cXJ2/aIWPlvyVkHTAXDz5uDwNqhJwzJrks9w8K5eWDXTKV6QchErChPoX3l6cBH0b1fxgTvJrD6zkpSKX1PDyNGb39cimbd5VkCbyyXNyITmVKRwWiMHoVREycoe4haS7TpLuVRqw4NSCGIE2PG/YRSgBvsAqa7Th4uZT5PhWrkunW34zDwbh/bbSBwDFpli2WWf9PiIfd4gmHA2Z9pEk3oc4dwjWEhZsiyAXE280Bm2POoRO4oCcWE4p3FOpSIrUymTGUjwSSqLFcI1WYk+t6sIohfVBRdlDGu7QKUHlnJtNSrXdwd03JxfuTQe9BDqajrlH8OI8Aw0ktzf2MBH8AmrSa0l5ALDe4xE88BHXgRz9GSBXU4XxNCreldRJ86pNsTcSJLTCcs1qt9CGkgFwFpGdu0Xr490iNxdSWVSXa10b8waGQ2SMLK8hO3/AhTBplMGJezsrE5ycXv4jF28PloboUvkSsgb4W1hDbCIQ/3ImxsBRSWtyd6NhykwHeJpzxuGtXisMQTU832TDZDMbRRTb8RytAPfN8im0kwlw1JMrHfVOS8hcily4RA5vY1jUEFeHx2c2avgAFd8FIaKSWW1uzpWUJ4PtDgr5BOYwEsm3fCvZFrl+SNn/n4184td8KomdkkwHagRd/jV8wlThhxzoQ1rNd8H3IA19asRIDrUBqdAXORgzsTbyxE6h6HzJ4LdccMHsvUQKsI5oFIc7wRO1gViwNBXX7gR+A6EmRoZde2LIw8wFhgZlCBUSLEo+J9RcBqiMHx8j6WM+ZSMYRXQrU+5D3Z149BkMJViinvVjnYQUIO7dtcQX9mxj6juzex+FFIKmhbM2YXi8dTgr8bSzkM/coKFqLnoLjriaRR4Wssz7MuXRK5h/9XdTSj92x1Ho4l/w2BJ0FHq+KeMGuqAu6GapDLPWWqijuuNVpWhTeWUiwxpLVB+LmfakXyooennhrQU9LU/wA/GyjkrmKL5gGVYj/0cMevz8W0e/Gd8CjYMLOi+1qlCngHxgC6KLkvtS4UqBkn+Guuwjt2AcLIzybQVx7oS1h7dme5ubk4byBjkqPZUoQ3xD0JghABCjIFMNTVBa9CiVFxH/ExOMdlEyIw5c2FjybWHLmSqA8GAXJqxbnn3kLPaKSEbA+MyYwt6xTThpu7nH3PmWtK2dGoJ0jdYhYMhWIdqmykb9sBY3YKnVU4VwBuGZAU3vmRyO4LsVBrnNuaYWyKY62DAWP2CxnPZAAPiwmUD7XW8ZuSgxshvvKGpIWP7nrsu7O0BHy32QX6iPQWvs+cv2S6bTNkmZS/SnVcvt7MJezXd3Hq5Q7dePH85mext77ycvmhZjgaxXTYELU9s6NePuBNgqxWmJ3pehDKr7mTCPQyJOY5eaJ7LG9z+jGuj+KSKI8fdGC4FQFWQFBFMmFDot3n1o0HCR1toQyFBFyxd9QkRwcgegX+C36ZUwwqOrdLGU5cR0zhFXgpod8ZP80qbTrt7K3v+yKjRfYOg5uguOKifXIYqAuFRu5HjWl7BLK6pPRiA7rj6dJeuWLyOdXfcmkQkMzaoA8VTEw0kAVO2+ExECeZGIi8KpGRH8C97ruilYfsbHNMooDSusAFpteDEx7SjUbQJfumBLdb+j4mvmR0GdddJgMynmPnRlqOlFkuOQOhSVAsA+yzueRRd2CRUR4OJBcFO71O1GidZMi1WV2upa06vmfempqw0uLgwG0IMKPbClQPS5StFDWeipA8JJ5qLWcX1POxafSjhSNv7glRl46p395zUFlQSS9GuzoLDi2DaW6wDS6iHb3GhJtXUDMZTzxpZR64QcOwWVVCBIWma9YgJfr71TfdPqzm0jlI6H9WTi3nCOH5rrU3pfqCcexB5fcTzg+8JeDGiGggLBh23R55tyAnhho4Ec7+SaJJjv0EnUxxEqjAGVawFXfuE3sJ6b7zkNG5w1fE9XLexHb3xtI+zI39vFsbzGxKC8hq6RXdXah5sJMmlvCLUXkmYiccMNkNp6RZRLb7A3bvYeJ5sJzuxngWxew01q/7mDi0Ln7o/ktMHB2JPA3AObTRFwuZIUcjmPcGasfvMRWx+kyGFLjjyKaTwKaTwKaTwGwkpxDPpK0zVjOQrxhUiSE9xhU9xhY8D0lNc4fI4e4orfIor/K7iCuGy+O7iCh3UZMi4Qne13xNPR3MXhFafWhlC7Xpj6qJUNmIUBWVLzL75GMNb0ZF8Jj6+wRjD5YW6Lxho2EPzXz3QMBY1nwINnwINnwINnwINnwINnwIN2wT3FGj4FGj4FGj4FGj4LbO0zw40hJ4pCIxzgF3U39zhAHP9HiwN5lRrPl34yCVs8g5lNmmaSqwsA/WrcC5i6EcpZOFNRv7itzC/4UYxcnBx8X8O/51MFS0YFOXtDT6E+hpSwTqbgLjZQTWiobYqV6GKJ+h+bsyTo/MROf35p99GUPVyzQc0hA7iHlz0lOAaEgNdxZO/ARS+erMbMS5WavUPJ+yFslRufxw2UA9d4UVJU7Oy1pyFpXMg6uRvXv2q1x5qRvv5XA1bLkCXAXGNpnMoBBUqQYINzYDb1dM5TDWCHUpTWZQ51xhlNJM09+BFVUSFPfpWt0Yf68raA/yOYUu/AI92+A1TBu/+tFJQQSgUz0SbrSefhhiL+wy/h80IMZHMqs4Q5we7RX4KU7mxeMOuTLzMHnqLQcAVlM0Ss1CClTAr4GMTCkO4mFn9FRvOS0UUM0rqEiXnPAKWzma4PF91p3Xy35xcvDt2R6upfCEpD3bDW3rmqF4jMhvU6HH3D1c821dbijlBWOQbahT/SC5wnGbx01HctSghz9jHJNS5o8bQ9Cop7JhQ5w4h0RsXB5ubO5sbYYK1NtbwgT58fSFJI8S1LI+7Gl0xN/3yuEOW1oe7oYtBXsDp9PUgK5V/pxh80Ai1vOEvjS9xpANTbOIV97n/VIf1PjpePTB642Jr59Wru861/f0WtP1FtN1GEPR3uk23ix237N3X4SxLY7chWwzEXJbH7oPGCLh2ZfK8tuBqxD6kMxz9/9m7+t62caT//30KIv1jk4Ot2I7tJPugt0js5JrnmqZP3ewe7rBwaYm22UqiS1Jxs5/+AYcvol4c29m46RYFFovGkvgyMxwOhzO/AdRsH9axYNhPWZgJe/DPMWgt4COiUpB4CjYZhUpKAEoZ3yN8xyjg7zcjspBzB9CZG2x6CF+CXuvUGuuES22o6cqvW9SmC+livrNKDCNdxYumERiRBm1Vd6nFLMq4+9mE4HokrSi816PxxWD46mL8bnQ2/u3q/avx2cVo3O6cjAfng/Ho1Vmn1//bGg3jZq4RLDza7YgKby+um7YGnZA4jZo4ZikpcI1BcL1DujdjA1e5E304A+moyiTTuJ5N8iWMM0HvQEF+qE5pHM4xTT8gQdPQeLz9EkVIXxPoHDAHGRlTUY3Tub66CoKNC4msGsmOSHxmC/j4tPY6r0THF6ifH23mEI25mheP4kEe8Gy5gKW5/ygmj00pF7IgFjYTZu4CymoqOhQ403wco+ZYzIMk6u2IP4OCgkpnhC+42hFzCObrYQ9FFI6JbIqGF+8cG4sR3pCQt8HKudRZFYIKSdLQ3CZp0F3wO+oCTw1vL3OXUjlTtGcwr6SYLRaEQxYK0Ku8RFqXx/3B8WVn0OudXw6PhycXJ+cnl93zy/PL1uD0YvAYnog5bj8bU0avztp/ea6cXhydHg1Pj9pHJycnJ8POyUmn3x90hqftXqfdHbaH7cHg4rxz9kju5DvOs/Cn0+vXc8jR0Msp+PMcylvVnHqaddM/Ob7s9/tnrV734rJ9fNY6uehcdtr9zsXZeXdwPmgNO/3eRXt4fHLcO7847p5fHg2O253B2WlneHa5cWkKM0cqRLYzk2eY52jZ4pPK3s8mH0nortb1COxfYMnV7kcGWrrCpTIBB29eXt8P9RXYO8YkGpw10M3ty6t0yrGQPAvBt/qe4KSBhoOXyb0NHBkOXto4hs0J+BEf7WofN5dCkFqch+frfk3eqTKq52ypYzQXhCthU0I2Gr0+zA1thOY4jcQcf6reiUZd0pu0T6L+pNcLj9ud487J6VGn0w5P+xPc6W4rTymTYzyVG4nUqlr6QyzJ4XuaEN9YhpK9Bs+8YBUIlDKIZyJmsUZqKftrs6b+/0+dVqfdbKn/3rdaP8N/QavV+s/GNWe9+U4g9fMrTtjYRhtPtn163HqKyWpEtycOHiiVqxMMhTiOlbpM0ejNldGqksRxAS5f343MmZCpqe9XrQxiqEcFwrrGlbm4MqeqAP2maOxpbfVmoXBLqfjxjCiyL6hJEvJj8kyaUIX4y+UyMBl7Qci2JbhWlc+pnisKOVfEjixrFXJybyt03ty+HBbq6TyVHhbZQl/ejPWRelepcO50Zbqptx0KZ3n9y5zEMVt5bllxmu/0+uN/Dq7Vaf7opFvz9sVguMH7PwVBsPliz3i5EPWunSCqx7wMC1xVQva7pnFD60JTG7EusEeQcNHp9fnGlWeIkHgSg+BvMNMJYzHBad2EzvUjNI1xYVp0ap1dKCUzJqmW9iWGuLiQCDHNYoRTL6ed41RAfSvjU0sRSUN+D5X5ZJamJN74IJuSL3Js3WtflZXOp6dL6+hxkyhAb4lmrCkm7AVJQn7h2ZuzvML6vvVjKuVJcapLWWEh6CxVmkMcylg0YSbKmldzaOp2Vz4IvsxlEr/A8SJt2jE2aSQOSucrU2s/N99jtoSbZVGVOjXKw7Wlgfw4aZElOxU4KkqOWBA40y+ET+S+rlR7utS3JSndWMwM6uw36TU0Y9vWa1id0nN5DVeNZNf72g68hj4vHsWDb9praIb73XgNLbf+yl5Dnyffh9fwObny1F7DEne+E6/hhhzyD+t/Oa+hmeNOvYajrfyDFb9gvlV4mPjP4B803X/ERzs7itY7CE2Vz6dyEB6ddrvdNp70e8e9Lul0WseTNmlPur3jyVG/2462pMdTOAjf00Qd4JJFxV9mnEPfgoPQm++fdhBuO+Gv7iA0k92tv2q0sWeqpJJrVIA6WdqVHYQs2YkK2G192zcZ4IQU8hTtTrXAXFj8MfU743RGUxyb822NBASdjZltOtm1g+ENAHvSP0ikD+Gw+zn/Argr/Wmum6JcV83fxUNxHNrkRxsT5f20Oi5qmIOM2kbqMWshjOkPYvUx1kcazrLZnGV29WCU0JAzh7DMwzmVREsmjmN1sFFH4DtKlvnJKg/4N4vAGzjyUicQJ58zok6szVxIbPXeJZnY5/b4NOUslU2SRiVsvKaazueMcLXxQPl8M48cs2GCw0/+l1vEY6nR7zDodTU4su44z6c607/o4Yp8biZBRmfk5oWHzVl5QtSugySbEWX9gWXomswz+XRelyW42ohjzTwPeFIS3jReHeJRspJS251MTzvTo97x8eSoG+E+PgrJaec0apEW6R4f9cvkdaWSn4fIrvsSqe3vNh/bJv07nBrIyUgIFhk3sA2Q4OOAnUXmXQUpC9rRF6IVzb5QIV+rNW31jzFuTfBpqzM59rRCxmNfI9y+e71GG9y+e23jHy20qLmjACc3rFMiiSlzDwvv9t1r0YAwSPOm1ViKBhNOICkbRWyZKpFgSIRzkpCGQz5YYDk33zNk/XibLLTdZrwaY9tmsfG4keeGF6/H9oo4t4IlxCDNYqBngu91sK5xkF+9VbM9VCRUdNXptPF9AySCZdKhCrpWdQb/lbn1U23rFH4Pk0Yjcc6YRd74YK72DIhgRWhqbvjcNYP1RO+KtO/nJsjW5nMK4wZTysl2XmMGmNXgyJLxuISiWmqCCo3RKQjgnFNpPJ4NxcWUSaUK+T3ET89hvRW/LzUeEwxJhAvCKYtQkgkJjUyUrgvjLCJRDcyCPiPDyxOC9hbpbC/3c6jP9wL1W5VDC7MDeklrsyQHh3lyrrxlXHpgqYoocOTR4vTigyf/ki32SsT58OKDPrQUISjsoEvZt9MsfkID7NlyG66mOotfqUBIhqSJWtImIRIKu2eC5Av23vOVABhofsahKfqg5Fm19wHuDsH3AgveAJwLxIk6HYGprw7J3J4drMFTxC31UW9qwu2LGuDnbvfoUKPz/vL5ZQGt94VkiwL37IL8Djj4022asAiQ4nM9A6IvkCAkLVC2ivjllVFIHfpowlIqmTLntQZgE9i5I7cZTIhSNUZwGhqPHAtfFDBctgJOs25DfQoZBJKk6GMGUEL5wRF0l9pHyxgtTnJclq77zDWLwdJfYuEG2ijs87XFQB4lRKq1FY8L8rXAQnhS8+T3cqb50qkiKI1B7gpC4S2W81Lfnm41BNorDWcHSGU+QlZlHN3uUUVzdLtHhUGpI9T9Lo0E6MAIscNchPHqJ+beu24Ovh29VxK2yt71C+xdcJ8X+Q4IvxfA4NcGnbNaUqa+hRXqJapp3503dlumhutYLehvkkn3VsPrTE9WmymuRQ2klCKSLGQ+Hhi6fvOD+boEIF+o+IAmRC4JKYYwyCXTtmppg35udDSlgn9Ao3070Gj60LYrIRhB66t1Iuw2e6V9V2dBfvi51u7U412xbxX9CT9A39AP0LdHgb7tMKT41jRfY6P4Iyg4d+zfa6rygeOuXDGigKHkqkbAq9q8hcxZcofd+cL4GYpVJEySrZIPKKED5ekACNsHxFW/UCLMjmqRpFDCAK0Gaxcxjewx2TqicIowxPsYgxt2a+H5h5MtIGC+W7y+54Tq+4HSV4vS970D9P0FsPmeG5bvByLfWkS+Zwfj+4HDp42KMZ5ZN6JnWqD81w0MDN2GNTPyOrQsIQYQD004W3p3iD663r1xdIk5WyKlvFK43rW3ylC+LGSJMg7dWd3cqmduqPacvIVNQFwhyq+gJUxvZZbQt3NboGm1YO5kQDnpKoMa4SnmtDCob94JXNIDnnyMC/JRnus1+4PGMT7sBS20r7nxP2jw9tZwBt2MULszbuvDzTUO1Q//PkBni0VMfiOTf1F52G/1gnbQ7rnh7f/r1fvr1w39zT9J+IkdIFOc7rDdCVromk1oTA7bvYt298SQ+7Df6po8DUd0EUxxQuNded1uRki3j/btmYiTaI5lA0VkQnHaQFNOyEREDbSkacSW4qCanAtvVsb9fVz53CwIxx5QorUN4TRi43Nd6C2HMikryjpp0blmH/EdKVPrE+Ep2ZUZX5mD7s0NW4ce4OWqFdINukGr2W53mjOSEk7D8ui/kyPACl7ba3qP06uY++8yZax1+rU4a/sz6zkkqWSigbJJlsrsoTWM+ZJW1vBuQwMrg99UHtutoF3WlLsdaqmw6AM7p9Lunn11FxvNaCyrX1+fvdnEplLvFYtzag+/Kzx/0uoE7c9I4tm+OPDrfFovChba/YUFoukMYkaUaU70P6F9LAQLdTadLuec2itBOC/AgULN2kEMe3VPdWemErJD/zLvvdE3o4Gafd0sOAkZj1RzNJ3FZrYSzwBqFq5QMwhEgORByzyvnPTnJk2bnxFJQ7wQmR6laJjjTt3IUOG205XiMk37wLjYXesKkgrGDRLxfwj51EC/UU7EHPNPB3BnCVC4Bo/XVlbmeDqlYYUSNE0JX8lV3QTSL5nJ5QwWaN+60kyr5llx/gcrJvnw9Aqg1NvO8oHpFTAJICjH3lOpk2gUUSNZdjwFWYEySJEOlzbkkHg2A11gmryZ2CwPT7it9Aa+lJtc3hr5s6+bJp1s+8dZiF93q8KEUtpDcERFyAkcussrzLQJI/DaW8UXr3yTqd3U0Cc6v8rTFkebnTlnYEJXQ20pGiBqE8fuqF/V139bsxF/hZPPzUIDNuoZwJF5mzmwTAoakYcn4rR+FqeE4wmNbYlCq/4rD1bvA2obKDS0gRMf13SNKh59m7h/5zawjXAnDZD8jvhTKKduDAKlz/2IcpiIrNAFw+2Owx63gP0m9MaaRE23vvenvg90CMcX1dfodnRxoP4BZi6O4UXXaP4BlngCOxFHl2bdHhTu3nJsgM8Zju/FLMM8CvS/g5Alh5+XZDIn8eJwysYQQRYffkrZMibRjKimDwsTHFtcViKCuUz++3/QkBtYkRj5u78f1EYH2dBEe71Svf366b97dl57v28Bv1MDPr8LINxiRy6ppEAFETKeW5YF5uSHdD+oCZKRAMEhvBPisAJaO/h1NNqUEt6Iv9lTUYWqpfqrVZLC4jN7lnBbOI5hN/R7q/t6xfII74iH/ws67HCKP4OYxy/COzKG28SxNzgxDjnBkkT/HUChDNetr1sp0XvxxZcFE0pzDH698Gf4e4W/VylKcHgzQjoNDnWCdifoN/wwniI5TKDgu7eDLbLwSZolcOjZ6QKxWtS7QfFga6h4gDXVxVHHoprVcbEpCXaMDq9nbFTD/tXwwAZOmIryizzquX6zRPoCO0BX/p2zqUFf7sA0au+nqnQt7x6biv5yjuWYirFaAjQ6MLJelnHXekXWr4a/1/Co2Wm1T5utVqu1BRzMbpHNzxAntoboKgVTsJ+NttEZJAmVdKaPP44WlhlO+qMSX8qEqedIOKPNCU3Vr+DOC2f0F/WPl46O/XZ7CzIqwRvvVPjNKZJxJEKc1otqZfJqJu1W+yTYRihU+ynhwR1JI7arDPv3xXLdlQ0ehoD0EKq44yTFk3iNue5PiHESKMtrg8lMY4Zri7H/NFLN6HAYjtOZufpqBS1lcbdbQUs7E+GfFntqTlDChESC3BHux5qfKxNTmBaZOn0qi00IIkQCd22gtRcxo9ISJSGS01CgfQ2tj+7gKj9PP9Fh3l+gUPmC0zsakxkxyVzmllgSrrPaDhqmkkreqn/nq9pw7arPZhyahTJcOmoCxnRgUr1CtiArjIAa88ua6iC6zchg8R1ULNVe0NuOxSS9o5wBPtdGV1lfidcX/rDWMR2n98glMYCUGA410GM4BBeylBPALPsGWCRJsmD8W+LOezOidYyBu58Ey0wTWpE0MpB6MItGYb+2vAqfbl1sSOHd+srhIP8GW29LQWu7o/P+m1+HB/lmr47GVGJJ73xklDvCQT5x+ommM3BR771my70G2rsmEc2SPS3Ne6/obL4HLFDHNHTXUUx16tO1CJIgyg5IDcHg+pLQVd7WUdAykbn34EOMyJSmxUQu1UL+coFHnhTBG1QgtkwBNzZCCU7xTPueLq/ejd4HN3zWQFdpGKB9+EEpT3Q7amqQlJQBKuCUekctPsOpK9eynDOlDKiwyZCSoTmJF6D3waMuSAjCqSxb0BPK+lqw1C8RQ3AiEA45E9pwXjIeRytENL2LgpQKGczYHfgsmkYVgbhWlYG+HNlMVA1LdmhdOK7XWhgQ1KqoB4rCboK2/AvPQyGQ2ksZp9IwAnEyw7r+pKcCHkfBihGvugld17VUbCqC/IwmupwmTsM54/rPZmiPzMYfea7fKVDmH9D2wOa8mHKUEyhqaK4ubFQkLKU4NtlyihnghKvzHurbMouE/AD7CmN5ZZGTDYfMnVuh5QmUrKQJ+cPG0diGcUxdmt0Cy/nPxuVZejmhM30k/xlJnpFi63ouhWaZDx+j/xivnck/cj1gKQsWF+wCs4wDOXVndfOrEK06N0Vb/70HpwWN1nKj2nAt6x5sXRFYANxGQFMhcX58XEsnABjX3yL7LaKRFeowZlmUy+9A/Wm3Ea4WKY6wxPUifW2ealsgLHwK5838GgBH0RheGNsm1ZshEUKfNayEF2YNHwQLzpRE5OGxeYK3ftL88rB8+CFa5hO1zv4JyRp6xvq4U9M5TfCM1HSNE9rEkzBqd45qtWHe+5VqAV0N3TFa08mywsjmC3SmxAReYnHkrxI7IEW4wJEEiLxGzmpfflDOvD7sAPMj9sPduAm597fuaYOlU+pr0/Xj9ZbgcE5TAgpmo87MB4H3waZ9+aeC8Qba9OGvNu3VyPimjKusr0374WSWG70P91F4tbZ9q48iFn4CWTUKaWj/rlle+hkSEsMVchxrnBzQRvqZWtdizrgc620ht4vsLq77azpltGK3dcNCNZd7xU8KSkRvTX6l9HpieQSr/6SWaCu6Uhpn+95A03kLasteS19u1unjuzOpmugFen8zvFGGzVJZ5wkGkGJBfqmMpWBloIctDbRanyOn0/UQAiu5aj/P5faV/qumkat0ynxpNduC+hxZXeMJqPq9VjzNvnExGPkRMNTGfAQkFMF9YtDjX5grXGzqmaujT/5lKdWCOYiY1ZK+mjWFfIh6aPN15J3mFIGLopzt1X6ZCCYZjatdVjnqdu+99smw3Trd22w4NyMEPfhu8/qBhCwitevgobEIyYkM55sPxvaiE6rSeyeBn7IJ4SmRcI9h5PBf/m817ebPnbFXtNzyRpEvhQ9r1fyjtZq1MOiHZa5M8QWL6tXOVovZo8CC6YIoVeaqrrIaHf7Ynt6yCN1eDasdqf+LBQ6fblJ5i9XOWFRR+X+yMxutXe3MqMu//2nF7D0eJ3ixoOnMvLv39w1XkTdis5EkeFEdMmRd6duwb27c3tjqB88JFE4RRD4ti/N2VzA6IouY3QNo1ZN2nLe7omNlCJJpFj/5lL2GV3S9xg56bMeu2bXd1ht9f75f3a7ZYIwuz3eXt+6HmnbNw3xfcYfaun0gbxtttQmQL5uanaaHgHwhYSa920xUY3qaGX9kMftEcRNnkkVUwEVFPv3/1U/R0Dy5R/57yDt5r/We1DTl78JmHK7JVV5B816gXUzFe4ktXGo2PN+EY7CpG4AXpF/fJ33Ilbyiuwsczk3OoYYRdMEhpuCbwcsgFDDdXJyvKbclJOYyWxR8mkgD1iQ6LsU5BaWBScYJkWpi3NxVAd+IBJNcwyrAD+rPhgl+gKGBhxvHABgitNP76m3DupZA3GnUgCxiuLwqDAlc3VIAZepJaGJlF5xFWSi3JyRE87m1a5pRZqKb20PdPlpcCt3+JFzeyb7X88Garr3Ahy171t9aUufT92RBIJ6lqS5cVT8OC/S6de+3714bqH11VIHujLTCSB4iepjxzStA5b3+5qAN7fyWWDgRN0dKnMk5SaWL6dQwdM7rW7q2cJF/G1xcbHlnUZi7uYohMkhYlMW1sZNF8vqXFfobHdakI4oBczsHUVm1B/h3FYWO17m6zGC9PX3TodpvHxjtis40OmphLhBs4NxPq65BMs6hBqHaU4x/AbSdRlt1sYqaTzRFCY1jKkjI0soViUkUDwxIycMdnxkEXTt17cPQqIwOj5+GxFuGpQJdJo4a31s4WAuxuNSVOomelBZz6ziJGWR9rBh56XZlxdBfMSGLfNtk8KW7mdwvbAI/IiNN+kd30ncsXkNPCB42kQh6r0Bn8RLfC4DhlQztRSzcK88b4rKjwOLFjj38xbUaDTCfWDH82zSotmKZgzmWu1NPt+kFWvMJbruxAPP/HwAA//+KB4Of"
+	return "eJzsvX1zG7mROPy/PwUepeqRdUWORFnyavU8V/VjJG9WdX6LJV/ukk2J4AxIIpoBJgBGMvfqvvuvutHAYDjUi23R3iSq2kqs4Uyj0Wj0Oxq/Y38af3h79vYP/w871Uxpx0QhHXMLadlMloIV0ojclcsBk47dcMvmQgnDnSjYdMncQrBXJ+esNvpvIneDZ79jU25FwbTC59fCWKkVG2WH2V727HfsfSm4FexaWunYwrnaHu/uzqVbNNMs19WuKLl1Mt8VuWVOM9vM58I6li+4mgt8BGBnUpSFzZ49G7IrsTxmIrfPGHPSleIYxn3GWCFsbmTtpFb4iP1E3zD6+vgZY0OmeCWO2fb/cbIS1vGq3n7GGGOluBblMcu1Efi3EX9vpBHFMXOm8Y/cshbHrODO/9kZb/uUO7ELMNnNQigkk7gWyjFt5FwqIF/2DL9j7AJoLS2+VMTvxCdneA5knhldtRAGzC1rmfOyXDIjaiOsUE6qOQ5EENvh1i6Y1Y3JRRz/bJbg539jC26Z0gHbkkXyDDxrXPOyEUzaBJla100JEyOwNNhMGuvw+2QUQMuIXMjrFqta1qKUqsXrA9HcrxebacN4WXoINvPrJD7xqoZF397fG70c7h0O919c7B0d7x0evzjIjg5f/Hk7WeaST0Vp1y6wX009BS7GF/w/L/3zK7G80aZYs9AnjXW6Ai7c9TSpuTQ2zuGEKzYVrIEt4TTjRcEq4TiTaqZNxQEI8DTNiZ0vdFMWuA1zrRyXiilhYek8Osi+AHdclgzHs4wbwazTQChuA6YRgVeBQJNC51fCTBhXBZtcHdkJkaNHyf/Z4nVdyhyx2zpmWzOth1NutgZsS6hreFIbXTQ5/v6/KYErYS2fizso7MQnt4aMP2nDSj0nQiCnECxafSKH3yXwJv08YLp2spK/Rr4DPrmW4gb2hFSMI1x4IEykCgxnnWly1wDdSj237Ea6hW4c46pl+w4OA6bdQhgSHyz3S5trlXMnVML5TgOzVoyzRVNxNTSCF3xaCmabquJmyXSy4yJOZzNWNaWTdRnnbpn4JK2DPSeW7YDVVCpRMKmcZlrFt1cX8mdRlpr9SZuySJbI8fldOyDldDlX2ohLPtXX4piN9vYP+iv3WloH86HvbGR1x+dM8HwRZtnlsb+kLOT5an/rrykr8blQnlNIrI/jg7nRTX3M9tfw0cVC+C/jKtE2IuHKGZ/CIsOfVs/cDeweEKAOFNyMloKrJdCcO5brshS5swNWCOf/oQ3TUyvMtbCBXTWw2ULDSmnDHL8SllWC28aICjY2gY2vre5Oy6TKy6YQ7PeCgxzAuVpW8SXjpdXMNAo0Ko1rbIYaDSea/RtNlUDaBQjJqWjlMXI24M9laQPv4bcAV8E+ASm0EIhbMj9DIG8WwqTSe8HrWgAHwmQXIp0qWghAAEXcONPaKe1gzcNkj9mZHy4HS0DP/KRhy8BWtYMWvwxYgZElMhWc2Mjv3/H7N2iTSLtmQrTivK53YSoyFxlreSOVvoUWYX1Q7KKhweQMNDuHsUG/Mrcwupkv2N8b0QDB7NI6UVlWyivB/oPPrviAfRCFtMgBtdG5sFaqOUEOr9smXzBu2Ws9t47bBbw8fv+GnQM7GSKZ34jI5Ph3a660u0PUC1EJw8tLGaQO7WfxyQlVtLKot6tv3dere+lVGIPJArbITArj2UdaIuRzOUMJhGLK7kS+DkYNqDJToXkQLDieG21B+1vHDeynaePYBMFlspjgeoACJGIkQuOIH8wO9/ZmHUKsTj+Ks6+a+kcl/96IL5k3MfkxsqhnbKTXDSr2qWDIxrK4dXpFZ3rwv5uYIJktAL4jEXoraBlHG5nEoVdBc3kNRq0GXelXzr9NGmohynrWlLCJYFPTDCNgd6PZT7ShmVTWcZWTHbMijywMjEIJmITUKWvVqai5wV0cYUvLlBAFyCbFbhYyX/SHijs71xUMBvZ1Mu+zGVi+QfLgVL1ICo/0zAnFSjFzTFS1W/aXcqZ1ZxWBEzexihfL+o7lo2c4ALOOLy3j5Q38X6Qt2IJ2EVgT5xrMcYSH2jwIXQZyO8jsSNX2Xc/iNMRUtK+gCpOzzsJHmD0G6Cx+xfMF+AR9EqdwAp3J29wAqf+T/NgusVdwepntZXtDk++nZozt2DCN00pXurHsHFXCPfbMWDHefuK1CHs+Pt8BPuTBOiHEcq2UQI/xTDlhlHDsvdFO57okTJ+fvd9hRjfoL9ZGzOQnYVmjCuEVORjZRpewviDdtGGVNoIp4W60uWK6BsdfGzB4COJULHg5gw84A31XCsaLSippHezM62BcgaIrdAUODQoS8lv9JKpKqwHLS8FNuSTAhZihkRux1aXMlyBzAFFJE8werDBVU02F6XLGWlVZajVfxwGkEjwccEQ1mP1FwKi3TGRvxMcEM9gChBAs5tsd1iDwctlqHOuN50h6oJuIC9tjvdHh6OWPnQlrM+dK/oriMeurka8xE9BNuUyp3A4b/bs1Lh/8B/aAPWYzXtqAEfD8jDel8yC7P3bW4F0yJ5xmjw5/0HpeCvb69UmyB/NSrvgSJ6V8gDMxpi9hswV+BPMWGVA6CXvBs35YJtqCgN5MB24jJ8GIOTcF8LIF21ArO0je94bjVPpwm9SKl2xW6htmRA5+VZTsYFdcnLwnqF4ztWj2cIMH8HqCGW5AK1R0GeCd8/9+y2qeXwn33O5kaL14b7cmEdIbyoeVwLTrDEowtcGYmYDIRLDGA5Wc4cpynGXGznUlaE+g84hvOmEqtkVuuNNmK2CqmREzYTqoqJUJWr/16GfyAz0fTUX0g9APDGAXAQUGaKl5WOZ2iBR/JH3GTjoDgPZqbAO2LkFtHTCpAL2/NQrx8/4YuCUxmLAOWEtfpV0PJBhWfr2GuKOJHyKbELzdME4MFeLm8aYaRKOsqLhyMgcEYaMCibli4pO31wfeiCKg0kbbzmmI4Ta8lL+KELmEsBbLhUGH20rXcFqOsxlb6sbEMWa8pDAcY0EjgDSda7McwKvBKLFOQsRP2QYdUB7jk2C4FMI6YA8gKRBsJssyCjRe10bXRnInyuVnOFa8KIyw9vGEZVekILfjUgXeogHJ/olipprKeaMbWy49N+M3BJKxGyCL1ZWAuCp4oRbjVmfvB4wHPQvhUlAsn5iFyJ/LGPvvlrJkpsH2bOUwrKPhNwGnwPeTjB5MPH9GJgM3Tyhwwgkq7K/Gxw59wHOSyXoCkm2SebQmEEmphSrIzEf2Ah8ygkSXPtvurorN/uUUOLfZv7gOBx3eYjVdOmHvMe2TtfcRnu5nHUR+D/B8dCdmWGhPEkt40dlfqqODDmKese/B7EukBclwDz/rjDkXOsulW172ueJxhpZuuX513oCPIHjZR0dDHkootymc3ibBijhYD7+32rgFG1fCyJyvQbJRziwvpdWXuS42geaJH4Kdnb9jMEQPw5PxrWhtajUJpbULesIVL/qUKnWehlZuQ2cu9GWtpXLrxn2t1Vw6iGuDvi65wz96GGz/D9sqtdo6ZsMfXmQvRwdHL/YGbKvkbuuYHRxmh3uHP46O2P92dQIg+bgysYP79kcrzDDo4+Qnb/EH8gwYxUCQQPDb3HDVlNxIFwxBFvI3Rvj0Q6JAT4LejBEmz+HS+DBVLsDlI+N7VmptSPFAusKHJINpG6QcI/RKVi+WFrKzMcORh23d+hOMvdUuSeNCxAcUP+jDChXkXOgw22x7de2m2jqthkXeWxsj5lKrTe60DzjCXRtt+MeT2/Da0FYjnNbutD82Yiq6hJL1PTjIet0o22fvo5EWJCIqi5SzfDA2BHJCavHs/fUBGGRn769fBhgipNMDWhXP78HrS2jzZnxyG9bp4AoC5PUDtvUttLkwXFnvJZ29h4HIZ/CFKW/HF9EBZ89FNs8omsRLwoaAYh43BJo6qY24VxKfkznDMfyo5qzUvGBTXkJY09gBm0kjbsDlQR8fIlrCrFIcJl1r4x4w7TVGjnWmTTbdSg2A/49CD+/b2i457rL3OrN+77/+Iutuv4tHb00eYnTevh7vaQ1uY36QTtYJI4rLdXblWob4kr24DU7lQs4XUF3VDhpo5Mce4ETqGtIpM0+0ZhrMUYLqk7FEPq+mEnDki0K0AspIsjnG56DSawvCVVvJ3ylHtSVGlFKC7LupMCJcG5FLK8qlj6Nw7/1iIhYGr5tpKXNmm9lMfooQ8Z3nUG92vLvrX/FvgI+1k7ELswROheAHBA4+SVB9Xr1Ol8zKqoY4F79qVxWVOoNqNcxr+Foa75hDHhmdvhtRljj3i9enbfJ3K9dZc7WVba+yXkuMDks4XV8i730DjhCzGQi0a8Gcrj3TES+w5+Li9enOwBckXCl9o0KUrIMWI9IPQjgSSVTzlu0JHvB71mee1XEjWKBjSyGAvvWPzTbIMrdxTLsQD+MdfN5hm8YKQ0GXTXFM6pH5wLU2PhwMg8MScVYJjLfo2W0Sgyv2+nT8HlTB2M/4NIJKWaWrH2CATFRclhuaHJj/DAcINktXUCMCs6Ys17i7/5CBGZjwtmUwJSQ4Ohj8mssSku09PTkup8I49gryt0KqPm0wzvrdGBBH3zwH4jDZxmpw+nUoM6q5woFDVNFHJHfrkjuwQNYwKr6+SXc5XQk/WB+JBbeLDQ2/TZSCyULx8gKM91wbI8AR6BR8AQU5CSjFuNJqmZaPeiMuYZWPVlAxywQ+wiIlCGjjH0DRSSwyzLWa+QwuLztjQvgj56pN5LBQFbyOqTZS09RjpeiD4UT6WPSZ5Uvx+G4i7XwB1jYMBHu71HOp+pNOZBpHmdbJHOum6CaOw4Pb88b+oAHzrBfzC3mpG6yYlGpmeCw+bssqfQLI1yQRYuC5ZHeUUc7YG+GMzKGgBmRdUj7F4fzFvq/oBO6bCZcvhMWgUgKdSWepcrVFEnZL4Gnbr5yVUJjqy3K6KBBc0ygqiTWi0i4W8TDdOCsLkZBjFTOPE2dUsxkmRIApHYWfUkCsWxuOvySA3KIdPLh8ModzCy2qRLDPSRHmOcRTNyf1ty9aAvmxgG/SZBBUVoZCa9rRS1bI2UyY1GGHHxykoiCe50NAQycUV44JdS2NVlU3ZtTy1vhP53FwWQxCUuYEsXr34Q/srMBohi8SaFaFS7a9urdevnz5ww8/HB0d/fjjSp7LmxiyhHTGr20m8LGpOk7GYTAORDl9+hENdtgFySbqCYfGDgW3bjhaieBR/drm2OGMRmBnp0F6Ia7E2T1E5XC0/+Lg8OUPRz/u8WleiNneeow3aA5EnNMK0z7WAaXwsF8o+WgYvQlyYFnfgVBCRrefVaKQTdcZr42+loUwG8IyNaO8NAsDZqG0OD33w2/sgPFfGyMGbJ7XAwLJYGcWci4dL3UuuOpNjt/YzrQgZKPVhiZFMfEv3G6pOtaFuLRyrrhrjOjoZV0Idt755XYFfbEQVqweEOmYa6jpplLBYR3I4bE4qM0erCd8cXiXpj0Taqp1KbhaR7bf+59Axue8hnmhR9biAuSjqp4e+bbhnOL2s3vspYCqddw1K6g+2vJvj4tCUklbn8rI6cLA8QIoASJU1tShN94Op2Mic1DbuVnWTs8NrxcyZ8IYqE3F8M4q1GteyiLNyIEbZRrrwnjsteDXgjUqqdry2zB82n6iZ6vwI1g4/tKofCHyq2jbJ6vy6sOHdx8uP769+PDx/OLV6eWHd+8uHrxGDR4B3FR2/dyDT3OQLesLszqTNxLOceiZYyfa1LpThn/vVJCMoujOYi2/3bE9ts+hdsnbp+lSrlkeOD7cCVn/J6wpx0q/9vPbvsNjWFM0zUNpE0StCpRjESRONtRBaVUuu2ewoKpe6xLQ5Q6rDK8hFomcgsMSH25/3UZGZv1Kuq6XO4AjqZSuBLoWBky+gvE5HNBsrU/4IspQ5bqW5trtxjvEv2cvPYQwgSwk5IXp6oz04e3qYju+GHQGqF40v0EY9c7ztnLN1iKH2RCSEQvPBBQfp2ycnqVAIqU6ugqKL5OoBjo6PqsZQVtyodQSnBsoD8y2H6yxZLEBwUKBh3bysugaf7Li840ao6lRhYPFEiKPEDDatJGlAz9wDWqOzzeEWctZhBefr4SZkyPrdw+fHF2/4/D6yvhnOCqdA++Mu8HlaCfdVkmEYYlnNzTyBw+dVVxxNCBAgreM0DOiCiicNYkcSUqOU0lyuvL4DlmSvHp3aTryaFrijGVHPi2+2z05vgZmUo1+Xx26Fz9Uh/5bLJROifCwammCSEcvHq1aOoLFqumnaumnaul/7WrpdGM63Wkt871KplNR+FQ3/VQ3/VQ3/VQ3/VQ3/VQ3fXvddKLE/tGKpzuob6iCWtYwWjLSfWXDorV8nGa1kdcQyzl98+eddRXDuGvQD/lNFU1jlW4SnKGZQrjLtbRxGpplvB1fsFMB6ers8We4iTLozzDbvl0t9K28/L0LolNqPVVFP1VFP1VFP1VFP1VFP1VFP1VFP1VFP1VFP1VF/wtWRRdl2cl+vX59X9brgRVXEI1gpZwabqBqtVgqXnk3inACJzF0PqYmqxiSoZ/fcLWkLnVpk1ZqGaXZll1wsL+742x5kzmWz+Isbailm4aaZyrwEHBcciYM9qKHVrtEupkuSw1Np48DNv/GTv0EhqVUVzTekj2fZEVZTnao8V1wEbVif5Kq0De2/f7co/sOU7vwodXrvvuo5Kch2my9ufdw6aCxLOV0HcCK5+/OH54K7JblZf9AdW8rmD+Vwf32y+BWl+yfpypuZWZPRXKbKpJbIfRTzVynZq6l04LbRVYVhw+gzZfsrTenh2iUZp+Fj13w0YYQOv95PPoyjPYPX24Op/3Dl1+G1eFof3NYHY72Pw+rDUnojrdLxk2yZy4WnValFa9tCHqnMh0uGADLp5D2qr9trqAKpXyxnwXL9wHTrbnblFv3UwPhMMAYBunNfQX5k+NfyLD8xfecfrH/yxdNCCOMNVfLDU3rLLad8cN0lC4s0CAchikgeQzIyFIMoagre1RFXIssQWzTs02efuFk3/O0juD+yQH4y7W90h9/djTMF87sZfYi+/Hl3l42+uFgdPgZUww3+FzCgI8ci1w/0a9h1vP347O3F9mr/3r1GVOkC3Q2PS8a5mvmtxV34y+fxq+Cm4v/fhcdVi+btu4mQJh+oTpt9U/fnt8XgfipU2sLNu3p23O4zgUiAGiocmVvRHJ1F/xOB7PJYBXSLdJWym3P+wBrCQlv8Kk0mwuH8yKwBPT5pFA2Q3bD9yc7dInOMljFKXSMOodWzIhkiJ24WOSKYNrSYetbyHCbxiYIB3/s4EYY0a4dnGDA8AbC6WPpP53sZA8PB3Rn/Og169twKYIxfBkCSZ7K9D06xth516PBLHU9N8I1RkUE4v10oQ1YfH6Bh8algm1DnggtDfgqtDb+KDpchYGjdsuRp0u4nilsA7jIDlu4e1gLOPdSQf1wGgSo2un4OxfC4FDzyx3AI/BpTAAqkGCZgf2w6smXK/hbS5ABuiXl8B6tTsbGjsFFDVVTDehhhBsmVYHHF9ACwTaBUSZAGWzq3ZuGtG1uZMAqHspLGDBmBT2M4JiLC9c4cstqba3Et4G9eQE9AZaMt6ESChqSzXYLotyy3N9o06ljX+HILC/5xirWgW0QPiiBuCBEPHDVgIJwvFxQTYlv7N8Tlmdv16Ke9G14bMyx8gHg0+Np8PgDqqubQ3DfNCHU0flPoam3DbkXwMYLrECSFCBdapBtr05+tJeF/9ZSYYOK3FOhzWsBjybHlVdQZ7Vvc5/uxjN0xjEYomfs5O34zSsI2E0FEAu+L6/h5GAinLa3LZvAYJMg/aciEe0M+qJTd3xI2thaqyKJ7CVAYPkmGTuLsgo6ilGmfRVmuNxwgtczhGL5Ceg1AVGF/rLc3NwkNSprV8a58gELc1uhEtAeTCOI7AtzjRFSkNw4XyTA2kUIMSeeL+JAkEOaoVxK5XYhbc5NIYqM/VkYHc7QVxizWVApKhAxpd+0JZofordZR0fr+XSDfQwuwu7Ssy8VMciaHbwXghfCXM7KcDnk4+O9PUadrWdsn5XCOWFQSvqRGY6c7KVXn2p/lREtFDfQcmw8YBcnA/bhdMA+jAdsfDpgJ6cDdvqux7L055B9OG3/2a0fl8WGZgorBFPztXtpmppbiAJSoBPKawxE7aEqjzsKUrQRXx8MRLPMH65JAOGptVq253G8cLB92/vl/mg06sxb12vqih998pSJ0pD+LcLdHf44LIWjr6QqQDHgDOnwFEFk8UrTtHoJ72J0gXYkxuIVPB4MqhxPGbweNYV5K43++PHVh//u0ChKxm9mMRiyEb22gMlIca9x0BHgG8IS9SIMt4oavRzvj8Z3Vi7rVVoNayOVA4MQEgV4pbWx7PlUwOVGL/bB/UEM2Gj/5U7bwMQttO180cry6CGBa22ZsDmHDrVTbgUb7aEKmYO38/yX09PTnUBDxn7P8ytmS24X5PH9vdFOpJAJVMYu+BRuZ+LGSDgg630HaLUCbexlcvxuJkSRQsi1uhaGioN/cQP2i/Ff/aJAe4FQw5zGZ+nYuMzfvRb2qf71N1P/GpkiEn+TzBAHYbITWaAJtlcI9li0LygIEFwxHw9WIAejIIwjDVrS2Ga6D5neUUZUAWpspcIixbCTZCRRlMDYGvh6D6WhR7ksYYVrYaReb/iuJ/pT9fFT9fEXVB+3/PNtHATyk+42KsbjcdcyDr7q5decIRr3QnRlyc7egw0HV4YpNgnOErhdkw7LiPjjJIT6iHfkbCbzpsQIUmPFgE1FzuHWQOLja6gcgyKVWdrpMhxGsRB7AjYktKCnmjPhyj/EL5Q1ixZR568/1wyjoglxJhF8hVe+SxfDWfC6VIX4BFhVwCUpaG8S+I/wd8Et+AdOR4jt5XrwKizdEibRYzL6c9gLnXSfdV2AYAl/C0cgjLX+qOHbd1gL1MFug3tjO90cMcAfSjaKAREabFJkzoQrwx2G5Fqn30PUq1xi0NXCS2lqoXOdIb6WG5GWShXKRigzj9tqjuChWLQI0O4J6YAOEivjQ4gJx4eQFs3/uUZ6YcacW2a1jnqFvDW/O3YyNoaoLYVqIkyianfv356oCPF8PYsBlJ4sjYHfwCUi76SAXp3clwJ6IxwfpsHq0J2JotEPb+y3NnWaFDPAxafSiOKYQbnN1zMtBP9DHhXjYJG+MBmoZ8jYROQ2o5cmaKRFNAgmzcWLHgjsY50mSGJe9q4PZexP0KsE1wwXEBJ4ib0mVSEh0TAcUpCUEhiAENDTlnK+cOW6prTJbPD7pLi2hMOK6L8ZXCLLePE3QJWiHDZfiIqHryNEkv00hR7rjOBa7pRzoECywzvxwYNLmLlKEnVUcYnsu8S4RqTjRwuxD1GB7A7vURqorgUkd6CMA1sgA5mDIDCwLHDVumU3XvvEOAa+Am2bRTkLWwzcWQ89234wF/dl/6PU47wCNFDYr6YTPIJ3xuAeBYPbj4eswYACTfegkRTfr5lsCFZ1AFvH86tLsC5WgH+NMvtuZwZAb+KMGM4o5n6QosCsdQleFuCQfStlnuryuLoDv9OoVa6LIba0fEF8ykXdnjRORMXf+DXPSq7m2dumLN9Dfw5hXoXXUxkSr+MNMiQ+uFuGkK5d10gw3I68vji81MFdQY6DnusEy8uCKHLGUBe+cmU5V63OCDo5aGLwueEm4QU8TGRT6ym81lEyYUZYqrxsqI87Zm24i6kyeAqAIozQthgHaidB8AIoHo5zQH2ywcIJsDmoNX17wTrF1L1DE4+1E8yQ/wYFxNOD23jAfu0t7VPhbsDM5+HiK072DBQFEFg/GF1wDgckDDRnh1NKbBxW4n5yg51FWob5FL9q/CWlJWRU4YZraMZuqWh6HWWT17DC3vErEXk4JXPKHi2NK1HBQVTQWjBaAIdHT3h7U0CBvQwIqhMVBvIbIzJ2LmB1BZvg4mWg6CZ+2pisj/mnUHIBTN1m8gliNADx2ANhCuNCbfeKEn+IGgPv7Q5b7MvFyzbIFw89Oggh+dDtv0dRDlJ3lN5IdzFVT9C98WcOdieyQGuCLrgKdA03oU+yXl9+FBgTJMiQF8VkwCa0b4a4bwQ+gvKzoTfzi4nPHYUMSoQI2gDt+8C2NDMIjyCHrevhDyfghjW3FmT10JcldRYjoL6Z5fAHYHAjzdgMnDGwJU/8mKFJmi/08h42WqkcYvxpHsg7KxTQoqUBQAF5tpDCcJMvlskKr65Na/4hcLY1lXM2bUA62S3YgwlEKWw3qBahzmTphCFptzLEMa3shC1JWUQz3d8tQlEuei3CBJa9lm5JuTPcM0A3lFnlMr2XhEaEPTKhm/7piBFojRYiBFcDWqtcH+EHN47GxRga9A28AdEOvmXeXSjSOzSlCBQ10AxcEqlafyN8K2yfK3njFpAZTfpu3W7jPpr1sX1G9mWepDljNR1OagDnM4Bd0dNKnauku2Uo2YIgVlAaBXBscrMHGZhwtCNpdTmA1Aw3RZmuvp6F3CkDO6aB9JU2EMjEHpLen4L9bZm+hpATFGyysQrkjJZdIiuAv6lo09s57Oy0vwwHLw+OusT3EqhL/54sKNpgRJe+tBs8kKBJKbjNndhF/XizEIlsRa04kyY5UGMEtBWCxDDjc1wTbeBvjKLUshYl3v1wC08XEmyInJrn/B8Y0jpe1V7VcZc+aluBEa4RZtTm4hNYz5AdjM14YjXOqko5U6wClWyla5DD/B3Q4E/eaBaHpY02FWtcbtiJIv4ZdToL0dRwg0zOy7zBE+GAaSFKLKvxhlEabaIKBaq3RIRbUdYxW3BZ8FMkOrQ6si6e2C2YdCQlVjCptJIuWkksAQFVTrpdMfgz3OXiNLsSomZN7RM7+FG6ubpUBbcaKLlKR1CtfsflvBykK0uBM8Kzz/nb+3ujl8O9w+H+i4u9o+O9w+MXB9nR4Q9/7lYhQkDaCnfPfvjqMzA0TDrpWVyvsJSYN8FEONohbgEFtMntKOBCaKJi6O/F846eKfV84IMO4HDsDNLBoxaBUBDaOEtSL5quYgqirhItRNgUKdoOVhlSGFWFMWk8iw1p1BDZAuZFu6czNlC7LZKrdNGULevDj+AjgmKCooEltgD211+pHpj+WvMaKsGyhBZxeZvOKZPP6JC18qVUdeMuw4+KK02VcPS7blz6ArdvZFnKte/4BBvK09FaxjmloaNrfE3VzcmwXU7Chcs81WHP+78FuE1GUA7StUm/du+49bIoCBr4GaF4VwD6r7XN6wONhSq65F2rzm9TKS2qPW2yqkg8v2nTPg9mFQFmqGuw0ZWeortYZB1UN9jW42fo5PG8FmYBp9lKPbcOnsykmguD5TY7sJ6G35Amg0Z1gmENTpJiKkSllXUGpg/7HYIdc2i/ma0yfXuf1Lp/jX9/cvrNonpnp7Dpg6vVrlgP5yN+MDvc2yu6mKm56B+qfrhNchF1AvJLlKpQKHQdKjDh6hHlDC+poBSaha85yB/2AhkXk1bhpLb4Cl8Gc6FcMp3njTEQhfCSMg6AFzSvQu9YU+kAUALr0nPLMAGvr5NO/CwaUMzym5Ts8YUzhW1J8Pye8k4/VExZ28CNhlhuwSGYINWcqgrCfGMBVb4wWmnoSJI2/WCs1PoqlAVIe9yhFfv/VyfXPgnLPXmQzj7MRnsj0tl3REYDL0H44x4++r5+bijg+iJHF2Y3oYwiABoGKKuxSTyeEsyG9OcUlaDtvdT1BTi6iXG8JBEXmlTHhGjktPUeNNUHB68FV4vM9nkj7YLxEq4pJkMG9wLFnCjS1M68jZN0oa3YqH6ObKFvyB4HUmF0kwbxzBzBTgVbcFWUsFMvFmKJqbIbyHgqFxUi2DRw2h+Dle1Db2bAhnJGl+2spUMouNPxUhgswLIOmOFmIaBiIdoydKUoyCZoqgBOY1NyE0vtI1BtoOalv1WQgh3W79hUGzNk/SjJGRPwF/xcVi1FyoqT+wBvkKxqamhaaqlvh4JAPqyUB+09irKZo1/Zj6TQekJeD3eCCtazt4fHaAqC8Wt3BmHfeMjxPAexfAQZC2UDi/n31xAdgXeoHmT/Juj+AYQ6JB9C8ADYWTlp4u77SOx/h9XQVXHRiQaLHWthIDiuoIo0v2zL+mGzgmVS4OkVf0kyWCtWgGQSRcv0YP1T/c4UCg2dkeI6+NKTS782a0T9uajZ6Ee2d3S8//J4tOcj3Sevfjre+39/N9o/+P/ORd6A2eP/Ym4BIQe8IkYY/2yU0aujPfpHROoGEt62wX0KxzWXzDoNvWHDB/7/rcn/fbQHiehsxArr/n0/G2X72b6t3b+P9l/sdxe6ceAYbWKdH025gPv0pbqF5jcJxXiFgKuNbUdy4ZtpkJUHKjPIKkSQMy5LSGbEgEotTCizjvoDu7hDjN3RcWZRtIMk+L2F24rxNTS74vFe7PlMqYAk0F90QpSIsR3gRSYRIj5EWR2atiQiv9VdK4QZ4NW7pqAIL7a1jyCTCSaoj0EVqIg/rQjGOlB+5bqqdRP8NfY8zg1HDofMUFi1AjDOjUwymuPOIFWPJOk6jXyi941TROgR6BRsEjI2vWCGQCQEfJMFftCyxpQr/EcLm57k/akxqAlbsoAoaqtdfOgMD+SCdWutzinD59fhlqB9MvdObxEA3pJgtpKmtYN2VLcIK46qEiyKSQsf+Fstw9sAx4dOIETjEWOFFhaUNdYQxtWxQtk1qoTI2hExdP7bdGXMo3mo2+exPm3dPvNBZNxVXj2HUtrzpaXIUz/mDFnoNsYKIew2ZEIl4HHQ4JgFnRLiD63qhbdn7gaCfnecvqLNgur+fGkrsM6gXXaxgyllGAlyI3THEQFebcIXIT73bVcGbXeSIU1xGHTQcNyA66TmO/119F93ltGIbjjl0dfxQxiAffzwGo6+XJFMSk5o932CNgWSLDqqngAF7S3wjbmTeZpDJhomENg4seAHUR2FieBBftpNYIkfo7k6GaBtwam3IRjvXhjGDA0Krz6RYXXt8e4u3Wp1LVShDRw38Heu7f5ubw9DHw/1Eo20V5c2Ud63qfNZqblbtwYfpL1iCAFYDvtLgDbTsx6HWmIiZnXZwMc2Of0ElWhoJPuZbds29eCFNNSZZbfgfgmefXcCa3ns1klsvwW3sZS/ioKZ+yc0gHwoZzbnmJEiiIztAduM9vZW2Qry6VxSC0vqSwslr7Ds3QA3bVXc8/44pk0Qil0/Id5RgFPBbig8YgVUqah2Gp5qVBgJSoVabmbbHSJa8ffmgTv0sy5P2D4nwOFqtZR+HfqALd19FbK1tOohEYCh8DYfS7IUck7aK5mOO/+J545pU1DuOrq+SX4yzU4G3GLUhk5+tDfjtNS6FqaNst62WT6PUheLWGwTB+iQq2tu3ZU/+lM8Kx6tuAiRzDkIqAWd09p6Icwd0r08Bo9YFE42o5xHU4dQSFKOEVfCgmFEo0pyonKtLJzSSwwi4sxgRgRDyoIK7M0LSETKN84HjmiqOdQPsUmp55nF37PwewZ56kkWLJnwuD0UkQYXoyGPPBre7Zm5HbKTVAvXpLRb8+z0fCcLp8k6X0S7iNga6mQZnIoKI/pKeLDH2xL3CDfXtS+CuX26SdVE+GGNx/lDl6chm9Fl6C9IW/iMy72JCyoDSlMXvcqQNk1+S+4C9umv7S2Tj25VXNzjPXSmBBuiFRywwgQTSlqTYkTCuRuiLCH/vyROImUdGD0CTdWk34CBOZgGB+JG2nSvjHMII0HMoh00nC/CPgUctr9WaJOfndLgW68aKIPZHVdwPLLg1VZy2plPp0Zce+cjvH5+sYXNobhiP/98XFWtMJG8DG8N9w6P9/a2grV4e9VtT4R+3/CBW0jzhSVYMLdO+RVneXd4OOs59LVYW6D5HaQ7hKK6pkR3sDZNTMADAnR3K4WXB0woWG+bFGyRXC1AuoAtG0H6SeHZw9rAkoKKCt52ONZFFx3dEjHbaCkVOfzLWtgVrmlMuakdv+o9QL0RNZgLFpmmyymhdF9dwznJeZhd1/V+gGOhcN8GY88foZBqWIjaLXrQ113Vznx6DY2mEKqlJAZ0jAGDqC55Lm71Tm7xSiL4r/NOqiX5J9WSTlmDh4Jj7B7u/zAqRDEdzg6ne8OD/dHR8OiH2d7wgOcHRz/s8RdHM3G39xL4AepI0xr3n8Lfd5S4j2GLiNV6aGzc0csPYak5tLwQaqVYjEq24Yg41s6FImWATTMP6w9IxT5gZHYloRzc4BjxDUsUqsDD31wVu9q0k41xfxSxA+pEEeOG06Uf8izEvdmbNuvwl5/O3vyV3gXLI8RXQMnCeamdzH9M5f8UhWkPxcVqf45HjSG4LcvefAhoq/RjqOmz6qYhmCqKB+z4W9PhrzlliWNXSDQtAui1kdUQgmuX0vryLSiNu4LtSEmvNeUf3Dkjp03vZuMNNCkC9JLxkqmM40PEisTzNTdL2PPxthn2szACbAlwGtVQfFrwxmL4Em9d0zPSLREuUgfEggi9j0I9PW1P0IfyWgwgpgHKz0I3sXjBFOgovAggTZmITyJvnBiwhSwKocAn44X/XziLOiAJOWA3Rro1ocPtv2yFd7cGbMu/vfXX7bvlx62d1p9uhni6GeLpZoinmyGebob4B78ZomutfZHtgHYQwgEbH5X9Q80FC5yLq979vmss5En52mNZN61BQDYXx8IUfxJqvb3jf4sNbGEeYQG95dDUgAGbVDDUhFw+iPtBbG+Cs2hjaqHY35/jAOua7imGqB68OgBPM4/ggjcZ8A67FNBYoVfn3N9jqzh/QTLlpu1Kti4itMqUduV+/WjsbArLAL89dR/dGbgZ3lGVCompGHqCWJyR14F4jBpcUtghCQX0jJLdha7ELi8D5eNMAdylB/O1k1030+1TGCA04rxjtt3ABApmI0pxzZNIc3t12dpqOqIWlNDVtTCQhvMKoBO+g92sy3VX5Z88VCohafqdOR6NPVBkxUF6a1mreQeduSw2hMh7IyvwN9APxxDjH85Od+7cStujvb1Rd8O3/uGmMUxtpLXY9TfAN7176DtdMPQdbxH6jlcFhaGl2tzhzDOA3caIg6EKvBfCza1B0d8r+4cvXxy96O6WSlbicoPdLN6cvXmFnwcjOJ7+RGzRKUz3EBgg1hnBK3g6XbZBEUgowoxDsBA6i0queKbNfNfnvKF6xu5WopB8CGN2/p19Wriq/MvZ+O04QtTQdw3yDvjGXwekMkK7s8y3C1pzlgzsjxrt/il1E4ww/fHGWPudTD2ctHuo4K82x0lvdNERXcA+OgezPXIXxfJXmWjv5cHeCgt9pUW6xiCNliQ009QFug7dbbbB1sBpsTbRBpR52G1RU7b1/p0bqXsko39kq4pU37QO9WPPAXU6DrCNERQDQz5APz3u9V7fra8PXiUGc0n9k8HKQsIzag3aM37jiNEI/iLjd/e2tX+6dezp1rGnW8eebh37nreOtQSw8teHLGlSYtCZ3jZqGwACZgTabInH/C51rr30nMCcsRUo9nTcgj/XNBoevXxxdNBpNOy4mQt3+U+ipS5wNgxmg+kNu6ygmMBm9xS9fM1kOwjgugF89hyWANNuA9ZispOtLklMJwfsmo1FAy7o4n4MBHzEQIBpa4HJjZDCsOfnK1ECKI0Tpod7jBUE3OdCp3UAfxD6vjKAPwgdktw5lkMaswS7llNSi7eGP4aaIAacNCaKsfRurQdd5qrjJ2m2LJRcCiPjqTAn8gWeG2+PGABmZ+9DihSawXjqDW0DfoooPiOHnku33FR+6QQWb60x+gZOgwpedlHB2hmhNpbvSo39OFgPt7fauAUbY61tN3ab60Y5s7yUVq9pO/04JPNDsLPzd+u7TZ+M16K0qRUkdNYu4glXfCW6Hbj6HlTmQl/WOrW9kjFfazWXDgKqEGAtucM/eqNv/w/bKrXaOmbDH15kL0cHRy/2Bmyr5G7rmB0cZod7hz+Ojtj/dv3XPp0eTYZtf4TWcqFkKPkJWI5HGTEI+Q5kG/htbriC48xp6totxBJEjvDCJlGxJyG8sHIYSBo6Ko2V1lD1DhKy1HAkuqmmEMqXVAUWDv+10RaPXsnqxdJizScIXCg1zsMWTuPicGdje4wJSxLhZHbjNNxTUKTira/op9o6rYZF3lkXuHNDq03urA84wl0ba/jHk3U4bWhrET5rd9YfGzEV+bN1ce6gv+KD2zUYKFX8NagxYKc15ez4TkhLGxHtN8KKvGpSYw/VK51bNh59q6WSPAZjEE3ECgxNzioBbM/07LYrfbhir0/H78EIGkNduUiyZx7/tINSmNnGjCBqD7Om6bOfFN1L6SO+u7FK61vJt5TmiFD2bE2rIOLPn8PfdxhYwJ/wXWDPliPbMyf4Oy/n2ki3qGJnWWmo9CwuLdZrUzUb2NdUlgrfi9D9683p4QATGDvI57URJK0zNi6KgMYsljz6ClwCMV3igXHI/YWgUhc5HBwR9LFr388CZAWzouaGOx1vFOY2jSqx51ZBOa5PK0LJJrML/uLycLQfquIfsuW+darp22eZvk+C6VvmlsKYUO7b2U/h7zv209i3hVitW6bT3Rj2a7DgSSpos5IcnoKuB/Bt9m9hE7RZjLYeByLga+p84UMobY5NngkoKozYRBtdTXRo7msGzX4GgMCsse8zQVxwU8Bx5wG7lsY1vGQVzxdwofSAner8SphwuEgYOrrxH80UjhxjpasuhP2M7YS1qk7kUO/UXfxH0f9tAMcL9M54PYvg09HLy5cH30vDel2oZ+0aR1YLavY2HdsWVnjbM0/NVwAC9cW3aN8IURv2Vrjfn70779/y9Vqq5tMa2PSinqUjRYio9ykOt6ZL9Mm7txfvzt89uyfGE5ZiLnT2G3KkEZ3fujPtkfzNOdQpWr8RpxpQCv7UPeh8P8cakOzT68m5/i0417A2v0UHO8HrezrZLUKgjzaEyfbPBDvITBgrWfYzR40Z2ubblhoTLgSbBMwmYMZV4GTQhb7BK4QXgjmUbXdmJYtNzIe8VRxXpnXDYxvpGFqn8fKGL6F2Gz4ZAFOT+9YGHSAuIdUcG19Q322hrqXRqurWidM9EnT3NLQPVY41oeHbZCq4y5BSq1So76HC+ksgYdmYrNuLD7u+QcXze8B+CXF/psW8bdRN8ejbO/kzuXXSc2bClQk3flTyE9m0QVBiU7m/N7zE4p4IM7HlwvU2gAClVdoLPSC3AUXl0DICnGpWiFzCBQPeHEVWikD9rZori69tNuOVLJddqj2aenp3zjx89jwkaYwo8Nh2IaaSqwGbGSGmtoBCIszi9vNt/s0e3k1Z/hPkP3vuDvDwapVOrHmg29fWyu03PGfvztkb/Td+LVaplTSY2sAqr87BjxbRhqgOtqz2jVx6mB9kB9necDTaH6JPLvNV7Pv7+p9prdMKOiLZbYv7X6uUCdHOx6PO3RiH8Wg/g92n7YA100a55q49zM2NVKvY02y/FfI03L38CLfqHmSjeyoQHke1XFB75RW1Ah78SambIhTFmBAnaDvekVWDo/sW2hO3n0G1b1NNsInOddWeF+5HAkhnie7FeqhxfIQ3TcG3dkiEuM4e6aqXpn5gWextVTXn/uaD1pKLTQWaur9sL/YPu8ODfvyG4aAYpgnKeaP5FhggExWXt4j1/8ve13a3ceP+vt9PwZM3arry+NmJe87/hWq7rc86iRu73d3e3GNTM5TMzWg4nRnFUe+53/1/fiDI4TzYll0rD13vnt1YIw0IgCAIgCDwp4mDayloAGdvNa0tQgCFcXsCAl+lfgbBg5LMMlbNeiLkB6lTVIjpyNsoHaN64RHCxqql3Ig3FJOO/ronfgGRX/ThX4Dn40pqA9Pec8AWEivsHOIcT4xDV3KQ9h2bwqZeNXQ5tL1kBZUJ1LNazFD3kMEKsDiswf6LL7x4iZcinVxCUuwH533TXgIXfWLnql3wIKNq+5mp16q7CtIjVCtxzTui5C/E05hdLLrC8lA8PptKO7syhUu1pdoROusSHeg0STotPHCrqkaCxU/n56d3HLj94I6tfc4fXvIl6iLXOVtczovUVeNCFSGU4qwCDmNmitThi85QqrxHqoV7YWySRRTeorpt6QWWiCs/Gb7aZG6Y7dtCU9Cobfa+fPniZhT5ws8SSH7pUnfOwQ078bdy5CeVpkZcmyJN+jmzgnk7N7jkVd42e98AWVJaV0oiX6Hr0mzubPdPJhoum2QJnJecxgbugwZL7VCBqibO1+XtbFPnsQqL21bGJ2zQ5UPx+1wVC/jlvgtwYuL5zF1/87Bd799nx65yKeITRwdnPWnrU1UNRU4dnvN51csmKnBdrOz211sGz/aCLhvCGN1Ufm2MwqD8FNeT1lu4l7lBJfZPrVPssMsqlRDJv65WuY0nN6sVx5tPrVcY24cpFkbalvHpOahaFvWbKyk3ecr1gnrPq3Y2mvkWqw3iEF48RJdTFKRxiKBdTTGRsQrtlePGw5uNFgiXB9Dp4U95obEpcOY4hSdMW4OyfzbHFQ2zl676FAqtELglZeYK8xbtIsiiMHO6XZkaNLaVKXKRiuceqg/aoJkPy5WHRX2ooI/7euGjj1yjawjD9J1CPJiaBRY5Bwv9JxAXQqXGMpeZAEXPbdGQEI+I+dPDip7UqeVtOZlqWa5IxLyI4C4wYoNlY8Zq93LYcwDtZo8Bi7qsNwmAbfJBrNRZqRM1RKMP/qMQyewP3+KjZn0mZ31hSX7xb3doTb8ckpXz6/iwzayGeNfcOnv96rSzTlDtu0f7bSxL4Ap9+ZpEDHKzRHSwV9XVHfg77FMzDfXUiZneoaEGh50UQ19E2xUFnCnUpNLljL09qhTom7F4OxHKDnaOT2uEoqtn687Uxs5wDNfpSqrdpVz5VT9+kC/fDD/Z8vN+oLEKti5KF26Ubf/2skGIe8vfOuur89+iEIfvIEIlIfxvfRFf9CMrJAfBXbHfbynqAQeavkByqGVfNFhaj9FabArto8Q2Bm9cxw+UP/dZPjxZzHTHNeEK7DNv+If0I3feUAoZgirItENqqauNP2wW+dPc7ynjSmBTo+r2AoSPPZIIm44nRpXZYOAqhSxQe7w+sHDV/PN5Fc6nlyYkSDpkBFW58s18wl4Hzzu9+a3U2d398loW2eVQXKqiwD+a/q/etWTa0wOAOmM3pxWyVKxgXs+b+VY8EO8lCNtK3GzktKegXOicxDwsyRJCiVNZuiwB6s7jXEM/Au1OfNYkRTwvKzPrTxcyxTRSqSwrHdu+ftHYmAq9h/Poe/dXg1n2Kj0VDYjQ8L3Jtl4djq2jZnCHQ4DCCWeORF9CRerMHaOz2MGqZeL5Vn9d71C0l0yL2p2tG0lZ4XbUloJHIi4oZVix5EAxtnL86IXe0l5+eqP/yA+ylzHzLO6mZ66OLzwcV3C8MkmHFS0WtEnCaughRKYrWNu+5QJQcuMQbq5Tp2z3Myf3N/gFg0UsfUIXavJUV3TkrSsxzxvNAXJZNHriHmckQgVVHrJ32S4ZrAvKWuaF+U3ovvYR5bxhHQBis4S/CtFvtBJskOGIHXYIcl3dPEzbwo97fVATI1sLKWbzmvSfSuz5t8piQy1nkH+qrtE1QMF0m5kP4SIwIkbpXDCohfKNbRuWbHQqSsN9TLGtjRXF1sLUrjFbUPTun+93iozXFKkD4tXCW5ROdK251BTc3qVnS+zzI/vhok+sO2uPt1pfLLXZ54tr4ZIipeLQtHXPdBVqpA9a8o4didNUyRLJbEq8/eGgFLs7WztYytubezvNwzS2BCcy1qlr4LMEofeKiAwCCl2LKTdgqBpraoOjYgZIHWXqNkg1VZAhkMVrpF1NU2Zuy/PdpZxbYduXbW13hWNr+1YerXh/Yk7BTFwbSzgCSzOrRQcJ9Ys+WlxDuSXIuN9Ut6b5hsZ1D59iVffC06V4Kb6tmfN3b6lGTd3D9ozdHwqr333/AG6pQiqZlYkXFBKQzf3NroRsbu/2sdUjcP9ldOeKcbDvFIK2b9Lw3rjnF1R7rTBCV6W+Gdse2MO1XGrH3NBwbBh6JXArOsjzypya3iZht6Lu+5Y5J0dyD/u47i9HCNBucFvrMqbavbRcv7JeneB+v0qb9YsQBj9gMxd6KSGAJrtJAgKn9jNOfoBFZ96P2Ed1M8+B3DDk9Dp4dEvYCfPowsDNO7QgNzaz2TxjD9SWcULPZzYdZX1hlwryODjhHdjaJg1GetCNWwfdZRow2HbLIN9B+B53Xmsve1XLZUQTJab6AzrkmZZvz3GYvDCViU3Kxbudg16MdVXIos7jF2h5jWYViTv+RLdHspFnVDqNmxYNySCVaDAOQ3qBgcMfl+8XeRCS0fHvQ+xcamzM+6GormHLFYzMtZsnFxovdTVnK72uQm677nqIqLNlcXHGcKKwCyW+qFPd3ZJW5nqCVILjU1vfqsQhc4FWTwHMa124qrpf4Mm41LOGaPUcRHa8y/scQg5sdgOBtRY3nYPTYcXYYN1QZp82wcIjPXvJnUPpzUsyIi7BbJ3RJPrnhRLvM3OdDcWlW6z8lS5b/ezL+axnR9p72WAAa5BqcbGyJMLByGbEUTM9EEnUBcSJ41NbQ4OlSZbiWqUpKzkGKfzy8yIum/qPVwJl/VbGpGtymhlExtDDJEtkQTLGCWj1Wp2kzfr6J0oWXHFZVj4zYaqrq/mYchIgIKmeXlXrnnlrOlnDJtPl9+Z3V2/+Xr7e+envr37cffXv9ZdXx8W/Tn+Pd377+Y+N/2lMhReN5jw8SrTj2aED7nZ/p66rQqIEdfQue6tAD9kf7iIcum6+y8Q7BinEO/Gt0NnYzLPkXSbEtzhPCz5pLjNpv3OdCO2neUaC+y57l6GmdQhzJvM8aP1ISsduXuzMzOpOcHwEO/QbUhDnCGF6zQUwg1LQBWQQ/0Gr68jicMPAjjWmELkq9ExVqrCINJBeDqcakQYGwIRMHh4shOwHjZ61xYl535CbiSmuZZGo5ELnd4iOzvuEgy72HZ+6PPO6TSwv1+ArjpflhfnYTfvY3N+KNqPNqBmlRYX0C+tONbF7NAWDguri1GmH1zSU+ObOKu1On6xZ5LoPbL12f0gqxBnrEQrXu25z7q2S9Y9M9TRjDUam0mtV/YAWo9BwJf3FyZkebmqm7kAAt1DB+j6aOgzfazI6W66a94MCTmyuRjSIsw5xhiOThLUx91qDkmWhjj6kMuMfM1CBr91tdBu0JJAzyOCvJ6PXVvp+X9PZ2u/2QSXteWfQgk6MUmTROUyd2egqswiBgSNto4X0N1fZxlAiwKp1Mjmv+zYKiwjudvIxLtSktWF9VPflxla0+TsaBMq8xMrHxg4KayViczc8UOv8/KbU+6H4py5UeSWL99Hz20+tW3McMXVLzPVDlhMxvZtc0Eg0aUvi5sYDKFih//uGnTkrQTelEdxIzj2TPVZIyOvaLRmjtzrueqLXILK12ZDkHV37vaRDzo+UrvpPPdENtHOJTs73MH/7TF0G8iBjl9/tMXfrb3oMXvelB+lM336Td2unSTUr1TvIfshkDU5eOL/eD0OjRkJ9jAR2pKFIaXP5j4zfD+vjdP/zL9Bn8pcQHAc91qtg4RmvVTfZgflg/WW68CVdPTss43/YccKUJOHM3JrDqVygVPM8yYeiivOh0PmHvTUdz/KhUFUcPf/yOF/F+Se5BsvpiW/OjqktSyqqRkgBxDixPgEXI/Bux3IwiE/kpYqHItczYuiXx04g3eDn17yP/hV2UEeLgxLGR9+Ez24JkI6CnMdmgJRLocvU7YtDX7wdEauesGJimym6RLpEodDe0MGnlzi57k6Ia00bnx1M7HO2oXi9I543rob7dB9XVtCiiWRkGkEwqa0m77j4N50X9bwbUcyz5Rkg0EEXw0WulE27zKGL15dDca3G2K8+apQ41Bma3GIJWnZpk63nBdGLh77kCqMQuM0M2BrIDDZEKRiRzrdTU5aiDzS4Ojp9xazhe9JgbCCfQUQbXU9vDmibSSPnGKeO2cIpOeK6pbP0clG6VEsrG6WQS/CbqGCodZd58cpmQmAfp/hLloij8xPEuXKDunmlD37lhUE39yB64cE4iw6+DY4/YkMJa4VKPD8wu9ju7xGFV2Fq+aP7l265R5zWf2XgYIYZ7BQ/D9K0yYwC6wm/ehuCYrQy8QeapYUgKiNs9h0Og3ggF/8S4kxnU/SmL2aNiJMH7GLi8va8fHdmYtPz4c/fkJ5PrjC6gaKO8B/KR+Ju15ntCYk8S6KnNP17p+l3eKiTlTPw8+btdyheoQ1R0/zoifwdgr5mWy4k4Ss36TpEQQmviB7nk2AI+HvuLMIH626hTlSGQYqGDr5SjZMpWSgJ0LxZOMhcD/2YjzuG4oiPOupt6PDVb0Px09uhOFFT/AIuZpujp0isiS8sGFUty9mnwr5PhX2fCvs+FfZ9Kuz7VNj3hsK+7bq+zU3dIdD0R25bRH/Op+NxPoFT50b6er06nbUN9Ce37t5unc7+6/y6Lsld1fJ1OXY6+/o9uwYNfxnXTmef3LfTWWxmYSLGw3w7l4DIbh0TIryWduqq49eRP+eh3uHXHb76bWlWPixlq07JqqvcNGd3tbXgX40ObkagMf4KhX5wUN+M7jKB3xBBVij9kGL4nO4c5nv7NxvZ3VcqzVF+MajR6wHrSZ0J5PZCz4wSY83oNNUXskGWFOqIT2Wm/yADKEDzeCIyE172Bs6ZUolK2AGADDm8UjWphJrl1aJrlm9e4HRmcfbjU7X5p2rzT9Xmn6rNP1Wbv1e1+bwwyTyuVoQqjqV5hBt2rhaK5dbGRgO/UhVapqvNqXa+Ow/Gnnn0SdKRwKFqkXc4Q2yiwBhlTJA5iOz6xl6vCrtxmqCTqs/VriGhkWPUV5LGZdMXvhyREJdud6f6NElJ/+T0D+209IdJU0VVbGz8AH/VSQk9dWwczAZLGxe0HpOpvxLg5QTubDGTWdUKVvWu30dBzYsaDxF2HA1tpUZ2UPv5HVcoQzguE0RlBTLuSaCglxtRpfpeI3IvZOasJpiBFE9tCGPrkqMXyPMrVbLhVpIpSbdNZVHIDJ2hCjHRaaU42kvVl52RSOUucEpK/k/hDU2PRk3PfSpgrcyN7pT35quPTdZHK3QNPt9WH8qWM9fcyKZsiK3fps5oj71DdKEI37g6Z77kQL+YmtYOuHx1x6/SK3hyCZZ2Cb5if+DJGeh1Br5iT4Dp/FSYLyuGtRvgeSzj967GF2vv0+DRrUq7VHfrbCoyVFYytYWrbPatG9Xhd1zVpbtcx/QeUO61oT/NAg1DTz3u+es/QqhUdMCDZkQsTE6ErWGhixRsFX8OvPTOEjYPX9GM85zcu0/5eK7T5IIZtCLcBiO+Etk7a1j1hEU9TRO+D8liwTBFLRV9/YP8ldHYzGa6Emc/jQBJisxmoaOqV+JBdNyQ7b3JzuSFermfJHub4439ly/Hm1tKbWxsjPdf7u/tvdx78WJzI07+dofKc4yNr1T8vpyvSjcdMPgOsxyFZHeiTIurUteRhr2X4+2t/UTuv9zfVts7G/v78YvkpUx24/F+vL/T9LWDwVdE0WH9wRHlJquN+ZtcZe4IIy/MtJAzcoJTmU3nWAWVYZEq6Sh2HYUKUC9rXeF0Q9cp56JO+G+Qy+y8KGOTqxURfJwlNDXZVFyZ65BgqlPnZ5ST7NApZw26Jx2KaWrGMu3wxT7uI0QlSxCRyEr1IXoOxUe3gHvxa3Iu1bHKSrXEcA/h2eDEgueCyfaueJtzbrEHegLNfqQofR8i5ineZIQbLhtKFZydHv5LuOFOEDih+jEeZG7KUo9TVd+wL/PkI92uZ5Dl+vOunhnlMr5SHvBWtLFCS693iwiGqCXHNLBABaWVYVFdBZV43LzpjkAF2K3Py2KdRH/9QKWpLNanZn0z2tyK9tudUajkVqxWhPxPiJPlwNcU9WDil7cnTmV5C0bjLqEua5NE1yVKgxpjLUqdKE0NdBmEadn9Bo2ElqD6XhUJncQ0mol0cN7b2tq+q03po82Ab1XatQXouJLTk9ika4gYqgfTyENXVb26ks2fzGQm6wrPgu8su5tg34kinw1Fkr+fDsW4UNdDkeHBFE0Zsjk9/o8sumu+yGfLTuNqLTE3oc1RPJ52SYXGf9PuPxI/UR+qh1j+/7TOkTg1RYWtWBx9VPHc/vnN6dFz3NmSiEEuH7BpRiQfm1cuqd0N04gZQ5aGrtpfgvRX/Eqnaq3SfUEJKndmJpU4MEVuijpeu4RIBFitmtTg6QMpPZVhGvQdlAH2in0PTxoP80Cy9qLtaH9vYyPafLGzubssfa7C9AVG60m2fXwq/4yMnp2Ojl+fR0f/OlqWPj6+WzVRPMyfIe6ZX4HvPo6OnDKiv+tYiY1FP7ud+oD22GW7Ov0YPLpZOw6WDYy4IfwW13xRZvVJSt1hlW++NuAhvlaDEzpZD0SRa301qp9TwP3SDZ9Tp9VJpTIUkFuUrgmUHUroqlQpbgf72QVVubZ3xyGI1i3hvB24pQ7dOpl+uSjKdFXpv4NRUcgFV7EiJsliSuVCyiGILiiWRnwEQXJcmnRewW6orsIsO3yp/L4W2Cav5ALZSvaYy3IGlU4UVWDNSk3djoM569gQ/HHN2sJjna2XvonvmlhzYe01REGc/bKGU338d7NZIAuMvKBLQEuw88ayNycqm1ZXbj06YQFsOthb9Fex57StuW3mG1a44DJzYAGYPZ6juI2QmUwXpS6Rhn5lrj3ImcwW9SSJa/gTXhugKBAmLVhD4hUqV9cvoKcLipa6fQcN0xJ3KR0VGudlrmNt5mXdMrZj1+3cripqjuNmxkWpp5lECDBSH3V5Z72hsTHoD9DH++/tV5CiWOYASZWLhR8hrBHWRnpQFXM1eCDmtiVfE/NPGCeMVYH+27ioiBmu5mVPfmMgW65FVFws8gpxovxKx7ZzTlkv5xDqB5nqJLy1hNPbAhWHeDxxotAMYp7VdRO4xYB7tX7FTNrwPVjEKeYZBQlV0pWso7dv37y9+OX1+dtfzs6PDi/evnlz/tApm9trKl3z41GyFs4s+MbmDAxIGFXRJuxPWcItyojJKmkS1SuNt6ylwRnSDUouklRPdM/kifhK6iyQuF8x49Z2qF+/6T2ncmCEUbkR5LPiJk+jgxX3obZeLN2xaZToQIa3MSnQlZXVTCpdCJIjGpaldPCoq54k+0+yuV9nAeVETzUqqPnxsIht5Bpm6xRHM3W8Fm+MdSaLheCmssGE9K5N2ZiLOxbeffk0m8ksuViygdTnOZ9tzsMP6HXDeFNrGitKZOSoJNzL28fvzurxY7H107J6rFCjuIzfbYMZokyzzjb8cLuoYQ+JtZTsn5bds8REopTOSms/35wX5CyUmkewvptXyKwystsbdxisr3sgaMKnIbYyXBlm83moZiKuMdO+xBKl4lMgFon53lSyCQikbX755fhwiKL/M5M570b8+MvxYVnnBKJ+UlDXeoblB1LThSOWjLugco+Z1IMFVB+YrKyKeUzqVLLTgFt2Hc4h0QzuHrDK0QUKxaoqI2a60tNwkz09PhSFwrlgWEq7rn3tSmOhoCkjZPsGwEEeComtqmynnAl3exLcM2XVo2zjrXhndzfZn+zvb7/YTZYWQr+GHk8KP1uux6jlI4WyHlAa3baeW9zRVc8l6vs5LVha6iOaY8FEMZMQq/oyOQlYpeCIBFWqWiu0sVPDlRijJC9vaj75th7MrXeCxc0/eGQPl7Rwz6HR5vaLZYUISzGaJbtLcOkhiuzV4S6t9uahHz0pr+TmikY9+2m0ecuwW7t7qxt4a3fvlqF3N7dWN/Tu5lbP0F1D/qtUEAO3oWCsYG3BQoD+xdUznAa6E372MJDCM9Np3zFLW2PkEu13os8TN1pJ8Of+MZ8lNEbApqeo0KeMCjHjv97gUD8BTzGiLz9GdMPM/XVCRf0EPkWMVhUx6uf3U+DohsCRZ9dT/OgvET/i+XwKIz2FkT57GMnJol9RjyeMq9QsjxYwug+LnkJKS4SUmFufNLJ0T7Q+Xezp/oh9wujU/ZH7hPGr5ZH7oiNcnyiItTy38qlO7qfA7s78Pq63SdZolJsVRLrYAeJPYqygINGP676TnevkDl/zXpi7CdHdawQ7Wztb90Uuf3zenhJox8eByPtR3bwnqqTol8D1xls+cGdx0yecVjbrO/gNtjY299Y2dte2ts83Xn63sfvd9k70cnf7t8E9sa6uCiWT6PG5fE6AxfHhY4gBY/m4eqkP3d4r7Xb0tY37Io2s1MdD95OoUcqkbVlFkEV6PrSOgT0c8LXlZOmlFchEqOVt7/WOVd2E32Eswgp2QopxYa7h8ZWqooNnXTESzgKlJj+4MxHPC6zblLoPZkEIYNn5mOfAfIkJCeS8waUzFZssaepd3/ponnfkZnN7a/eeOKLWpM6mF7YHsykWS6D7BcgPjGdGnZstmmLRssU77Fm/MjO1LnFZb2kuqei/5NJJrqIAsVVTGzz9FPdOchX91a+e5Cr6y98+UdF/4wWUgAFfouHvkfv0Zr0f+nMb7Q6RL8kkdzh9ToO7hcOXYE57lL5oY/kWZfDXsaQdfz6fneww+Hqs4OUF4xFMZIdnoaa6rIpFePfxbfjs5suPPxDhgpvCQjJ4J/QAXAE/1HNc+mogzq4iqk7weDPVwHvwho0pQaOI60JXuBBJ+SFjWaq9HaGy2OCwM1h0P5jCE1h0CaxrS52p6lc0Nz/6SAf8b9X0Z3Qw52fD5ok/XZ8scyvjpj68oxZU9kDvMs0v8Owy8ikvxrVGQIo42y01zLGqUICzUDFOruRYp6jtKbPwOKI+HIcP/fbox4vvj1+P3v7bUq64rXXPQdZvP38/Hx1sjH79+fvz0Wg0os/4YzT6n7/dIcaNKbb2QWuSO4bFgyb4wOYE2Do3mF4sFDseV8mtp/XUMwL11DKb2db7JrB2c+QEIKKqVSV1WfUg+fdeSGhI8Q2YfPbbUODfo3+djl4fXpz99tzKQ3hQ5HHQvnALWvQoxoOHVL/PUa+khDXHA5IAA/qrX07Oj2ksgu3AUY9gD/GDLDTO6EVKlz8t2Gw+Q8FCKt5aSzRgHv7zzdtDK9BHP178jE8N1D3chnD5nKtExXomU/S3sOlq9uQM51zi8tnms8ueY63B/3l28N27opLvCpVcVFX+bqyzd7OFzHOciD77v4N7CdyKSjufVTJLZJF4mSBYdkNlLeKSVMo2hWDs2dJ9Na70h1UQMBqPC/VB03xhffqjSIzX2UZ++sfJq2URfq8WK8D3J/1BoQicpIvWlHhiJqC8u+edvfnh/J+jt0fvao/NqfDX5+8OrO3yq40cvDueITT4g/b1TCCgtmV8+e5aZ2As5G5Z6ruFlx6FfLr0BdhhTg6maghwtEJJd7d5gYl796cZwlBFH2PeHarxfFrX3LmTQyGeq2qsSWO4Pb4jIMth7PBlU6dpK9WPbq0T4fOjS1Uhg2CmZFZhO5nIGBs00tJy/cGQvS0L6vkqRa4VmuS7clNQy94kofQp+gFtAmEGLedglzCSKfcwW4g8ldgubCnuo4MzzloQ5yEKDLpUVHsSteitLpihdIIpgt0JKTtpaocgHjv7RXNNCDJqav+ShADlJi6Zi9Glp2QEBRkXqvI5SuBQ2A9oyOXhXHI5VYxDr0Hfsb4YuoQnBhq0vB2KOEWhwCFXrh/SKuHeeJGrjp9c6DwSxxNbzzzPFaeuHZ86vV2ZGnudXw7pl0CpgrlgmUYck9yF5/hUVIX+oJG1NES+x0ySaRZWn9MVDSYL3CEeL+ps+WCo7zb3t6KNaCva3L28R5UNJAY0l9ejGdGjNMVkwxe7UqUVA5OBIYUTLLasQArZCYQhbAblorRCzGE6CU0LIeAfQ/V1UXQmSl3NaTJLrji3MPMBmhBlJbJFkcfmoTrEhEynptDV1Qzy9A0mnZJvJpBkK1BQmWBWjcDz6HZlULNX50swt7/XFdjHCur4tJd9jZGCSyGrmkgMQaPdjM3d+nGeqoZydJ9v0Yxv5yknS5V1U6kgPxi4uYw80nM4SXFrXvi+EnKKkF4xTymXQVZcWrhCE0hVVCXyNAwmX2SGcs8sYbUn4ArDYYggfZKhXZPf5OxamrciQNxuxLjPZXWKQyqZ6RJbKfRbVZjUV50uh+6nQAyKTBwfnq0fn57VX7hmGuVQXKuxA5nnqbvEEvxgXqScOFsOhcoSch9FolA9GOND9K1KLpX45ujw7XOuJu3TNtHL+x71e+bVlVmVSGL7HjZ6LOCTyEs1T0y2mLmVY5HAV/YvaAYj4kL5HdkpA5orJ1leMkgrNeTb2QX8cU2cVbJYO6kJuFMncG++xYpYM6qb/5EyZPOGQdnFwznA3NLDaljHBIYpSMvW4mEmtzBDjKoKXdlUIo4DG+NEyffLciWgYUWMQUgseOBEBDS7CXd86Cfy+9TE70UBt7qsyJbJqZO9OHx9Zi+S/3R+fnom1sX5yRmCbJWJTVouywGdrIjwEWlddPskRaVLlx0N15ure1HlY7AE1hsUZWA1MUxRK8hewbmXwGxuLJ3wxOV1V8Sc0BFIb6g2fLNuYIiCc3JhtMtE3VLxlesBuzrAS5C/0mOTRv91O4umCG7YLLcuTt4c/OPi8PXZBRbBxfnJ2bK0+Zq6KyJw8LZRtBcdL++6TxjONYMUzTl3XPDfQrGgJjBsUburcgjQtrUaDEqRmHhe38tojkYOBVbmYFDLU2aqWoqGMH/j4HRGopTLe2ggKWbGz1NqD1y4Ob6zqj1M11yMzJ1o0J5GV4xYZdG1fq9zlWhJ9a3xaf1B0wtbS1Urmtxw5YKPpaqGIjepjhdDe4BgbQJ7lOt2XfiXtLLvtfvDOZBipupucAHjXHjv4pRV/sUP1s5alk/z+Rei+3GaB565JACGyLZzWe8J5bC1GWhVLrUdeIj9qmRzc2PD/m9Z3q02qec86EO0LhADDVN7iMyxAtUkO9gA3V31LmnRHTQ5iiyHQyfprH5yi5s04t9BVl0HQNxcgniTXY9NDbEd7z7EJst4eibeVKeJQVX9qSxwviVKRQ5KOQx+b+d/rO3RotWnk9Rc04lSkdQ+E04Mzg9O2ZUi154JBJr4VKhY6Q91AorOdIXWi2f/fk21vFX1Tfmcv2SgAFjjYo8lrCx6o6s9EivIdNHhB8PEY8eXqpBZKRk4xdDYE8KF2jkiNb77CO74iGce3jPoD9rVArAOi6yFeInTOv81+4msvJVrSFNvTQzRogJMMDmybA0R0sFRlrPGANaDJioYovNZqQlfbLL/zLO4riRr42L8dh+wmrWZqTogsSbsNK7R4mw71QcW/LojoXn6g6ogGTZtUSp0Z9QxhBDH5GC0zIT6GF+hqyBH/xiotm0HUV2iMuKDLucydb3QyXMHoaqoZCNq5CJ7hR9jIlNvvxNvZb2R2NAeH8qVlU5ToWygCXs5xwYoihiEGSl+MdFBhw6Z54XJC5ytpIv7uNc27rkivTcgqaepchPjA61Eg1cws7Gezs28TBdWmukdBinsiWLpb8dQQ1KJoOdQSJGYGSYAShO70kdRGshJJMS/a87K9FoucDOhjvrzli2vHU5O7i8jfnBp5dMLGeXDZLCiGCpyxefulj1E6TLS+SV02mVk0bpEpz4EeLHKDNsMou78T1FZ7U6//ayU0dL9aW/KZ+FLvxYOwsvGY8khDZOZGUrVcstDcd54zDC9pmBA34zOXj/vXLPFvq1kfOV1hrGstMmQqmeH3t3c22/T3Gh2+bgOy5fV37LBih+NmaZKnJwcNPjRk5jSObLqSbULX2sg8j2+QN3oylbvDvQ9i4RV0d2petls/mUF+w7MHqIteE+w8Jt5oVNlolhXi1UVGTlA3krv7LxCPFW1+iMROiarNC6Vrwqn0DHxg3Xwe22K6kqMKJlC9iA5z6picaFL03Nl+XFYh+JPxUIcn72h+8UdDA9GN6K1qtlklHon9EBmMulyyvXnuwOdqTIX5Jz3jXtisqmu5ojcZInAiVQ172HI4P+JZ6nJnn0n1l5sR3ubOy+3N4biWSqrZ9+Jnd1od2N3f/Ol+P/NPQFIPq5ObOA++AWdwtx+HHwFEZS+feEQOfmQSBIhfDctZDZPZRGWNqqu1ELE2ODJ7Aw20AO3b1bNoJHmNs6xwo7BdvckNabg5un1pXhn2jotJxi9VORXi1LHMuUm00MRu2VdG4pCvDYV+IQfWgucDFbshzPaIKfKOGqjQXvuxqasTLaWNDutYm6QlGOyVa40JDua7LaFtvbzwU14rWipMU69K+3nuRqr+NaDzA4O/YeYg/qE3mlE132dfy7oAt9YtTt+i+PTDzt4cHz6Yc/BUG17aybjO/B6CG9ejQ5uwjocPJNVpPMllvUNvDmHm8mOF6ItDUcBWaaJeD069/43V3zQbJkxSEo5yAv9AeHJw1e/Pa8Ze95cK+TNpUYmYixTmcW0WoMDQvQ4M3Ms4haTQWduiup+Nu3dVwhCBgD+F8wC68GWTQ7cZtU1CEUfLlU9zIZrXqXoTsMypuXNU3DKbL9JxKGDSqq1dNFnPfbKwENW3AAuzJWeXqmyCgZ1PLJjI8Go0HmuEo/yfOyMzr4esUMO9nhw7HEiJvFsYkw0JQs+is3sGYJEz4LPAURKqbanqJxchGPRYkYbbl6oWJfwqLjvDvm4qX7P13jsCWE5n0z0Rw+RfkONJL9bX7eHiPYXiLc/j8R5QeWPEOJAeOCjnvlw9HiBiqg5AlnyfT2rtHWLVJaVqK6NSOVYpahnmKbIZhDk2lEtI9B+fnJY+szdZ7GJ5u+fRYO26NXMaIhEZfILWgCfQCLUZIJQ2QcUasrZcuE5/Eadnxw+H9qr3+8zc525WFgDLcGsH7pwI7Eol7XYMzzIe9QVnva4Hiz4WHMI0J993WJDInOTxNQTsZzs0POG2CB5iEMrq5KY0O+q77z4zKXgCEeYyU0aQ2bi5HB0CstjZCk+9KBCUWnuDxggUjOp0xURByNf0ADOMmkqakJgMk/THqf2qwy/gOBBKUASt/DVk/pEtLNPjtKxKipxhOYhSmdd3lA09bMJII2+egmkYZa77PkQAm8uR8gHhnyeSGHJdZfI1iOo9PNVOsXhTNjBukisMPXVFW4EsZT/CivPtcFrVKjkXGD6IZzZDNlr+g+PgzXiAlH5xZYy1hNxiZci6tZX8Adw9NI3GYxNNrFh3na2Q0Y1uOvjGuEqO/YJlU7usDgfRZS8p0WEdLHoCstD8fhsKu3M9yPH2k7NVGddogOdJkmntU6GddzInz0LHt1yNuzOGVHzsn3Q6Gx/+g4pXtwRvc5/QoCHkUNZ3NikqYorlfS3qvRtKica6fFZEkh+aqYli7yvoenGxlEZn7Xf4xxM5VdqpgqZrrAM65EbI1R9Lr/Nof+NnlAMwxZ0fx4sWfIfdEIXeskXtUeWpSsVWiiqHFDadj6XDJBWdmIU+opU0aAtHC/lzmR3Y2PSYMZKlmpPFVqW2mKeZTA4HcbiuPYkwRINWZnlhS4DfWYm9rJJZhLF4cIGyfUJnb+pTgIDOwCv9DCWX+mUkA2R4ZuxM/keN1yqup9/qJk9ZJJTCKRrsAoUTFbnmTuwzSsbWDDwLXSMwCrh60GqGe4QJ2Eenf/utan42FjbuyWZsgd+pVL1C6Vdlw00KC/cTEJK6yq7wQG1zfxGyj6dTl/iPdqA7e5BHyFwZD/JpCtvyfYLtavGE7Uh1V68s/9iKxmr/cnG5osdubm3/WI8frm182LSbD36eEr7ZkOLqeZz/UA7Ebca0tLMdnQv6rJemdDD9mIOywuOX6/t9Ce4uqnH8zBznGHAtZS4W0A3XHwIE1wtm1s/BuZLO8RrxOFKG+nyQPkItlVj8tg+jWVJNuYRHFkd842YxipyVkC7M36cohx+u909bM/vlazK5lLEl5ewWMcLt8FRG67cVxHwP4VmvfRQ+RbXBAsDQBrVp7typUI61ni5NYUIIfOuJD2eenfSJL1IYOE2JKcpCYiw4Cd1dBgQ3MtOK/I0kgaDJIQJpWGFDaR+JBA3vnY0DCbBke7VYn3+MXY1sz1Q3k48Zu6KmYO2nCy1VLLnfp9EtRDAb2nSwuzCpqCyDEbiGFcQkU1ir2o1VrJRZTYY1FbXFdo88mlqrHIK3ch6NIsxsdgZV4wk31dy8Zd5uMoqQytaZ9O5Lq/8rNWLkpY09gsxzxtbPe9zpgSqQdKTcHUWmC8ZSqvYoL1XCTV4M2kQ3ZQaD9FLz3Oxhi9qqh1RM5lRMhdyN7vLy423tsH/2dxrLK4yuNL5mCqa7wmjfFHV1rhNX2xFd+4pfugynu+9T9CLgdRAiZN53WfPNuwEv0MHhrmjJBiE75J9B1EiY8MUHgaOX5vYtVfoDar32llOlw2tetkVi8b3jelgC3wVM8KXxtsT4pPyruWts1Lr4MqI1Jj3ONGWfBMPectohtLyLZiahnbvcmM72op2Qj+Lcvcablb95BYvy/6q42B1MjldciBhZY+W1psmYRNSkLJ5R7JmeHzGGZtfZEohJ0c+pRQ+pRQ+pRR+ISmFdk2ySASK5DPmFVqUnvIKv5a8wv9l71mb00ay/b6/osupuo6nQAaMX7mVnbLBnvhOnOQGZ2bvbk3hRmpAEyGRbmGH/fW3zunTrRYSWHggr3VVasaA1OfZr/N8iit8iit8iit8iiv8KnGFuFl8d3GFhPVW4wrpuvFAPB2PKAiNBsWwOhNqVxpT56SysVRyvGzFo28+xnApO7y/yI9vMMaw+qHuCwYaluj8Vw80dI+aT4GGT4GGT4GGT4GGT4GGT4GGT4GGT4GGT4GGT4GG/1GBhtixJXUdYDfZNyscYNTvAXQw4kpBCBZFLoH9i8psch9KxJjzA8FiKf8MPghjMjIbPwjqOkylYGc3N//V+ZUNJZ8ISE4oDz4EVxn4AEGUeUQIOrgVwY9IDAklHf3pLkxjXnV7Nfbml8vfa1j1cs8ENNgO4gZd7SnRNHgpFGXxvZ/QnWWqN9OIbrFSSHSiw54tS0XyIW4gLmwnnEy5n+7s5aEIf4yz3vuJxnZotzWjDTyqYQuhmGC3g+Ma+GZC5VSCxIJBUOgxW5EQVA0YCOKaTCOIkQDcRwmP6Jq841QRjaFkD9yttWN6x9Tqr+J3tCLNT7utrNHEXwvSeveHM4kVhEggUC0GdNaoD42rbz9azri6WWEYAFLA1Rmi9xCSxy4tKBqLarPaEenMTrEjKBIqmxWPaIuDiq1wwEczBk9ZGI8gUQ6KqmibikhlAk5v2MVtXR/GUj4aASoJTcPCzL++unl/QVMrJxNS5a3t8DBrQlRJYmZOGw3v/o+KZ5tqS+5KQKMyds1TGX5mN3ocKz+yTjtdi8C889mzde54mnL/ozeBMeFes68xUfs3Z41Gu7FvAewtck0/UMavL3TSsHEt1XlHQ7L8avrleaeXtDLebbsYJKichYHlkL9PDq41guWx3TS+xJS2i2Ker4hfga+anzQi2zxfDTJq/6bZPj1dwVn8fQnbfpDbbi4I2hD3nYlp+bFjiey+zspSmbs0JMu4/DW5u9YYlteRyt0WXvceuCoUO8NxrJqduZS8/MF+mPgzZS7+WQ1aU/AR+g+KCMpXQ1EY6KSERSmjOeN3SYj19+uBmKZjW6AzO7DBVTlgn73DximN6gsJdgdgONTMF8qrfJj1w+lYyC0pWg/9XCyMg9DPqjJrkFrNgpm0X1MIrsPSRVnfvO71LzrdVxf9972z/u9XN6/6Zxe9frN10u+cd/q9V2etw6O/PbDCWMrReeg5vNsSF95dXNdNDzoFtXfrPAIvryu1BNtX0rSz1TXQVE5DMrCSmajKySzFP+riM0SogyMgGbLbIkl9f8zD+JapEKZ6ai3vdlCsR6BzwGzJSPDClBy9rzzPezxzNSZbYvGZaeDj8toBXoiOz3GfRmQMUVwli0fJIAt4NlLgKfk/slhMgDQMpUpdxExUJ+K1KBH6WM9Lpv44QUHSrzcJDrckn45D0xBug3IqofB4VoL5unvIghCvicmQdS/eWzHmI7wZMLnCzAHLsZ/ECjycsU/eJF10F2ilZpBZ7lk2NZwAWTAx8jTrpDibToWENBC0XS4KhDUuj486x5etzuHh+WX3uHtycXJ+ctk+vzy/bHROLzqPkYka8+ZXE0rv1Vnzu5fK6cXB6UH39KB5cHJyctJtnZy0jo46re5p87DVbHeb3Wanc3HeOnukdLId56vIp3V4VC4hGpEZSW1GQtmoWlKbmTdHJ8eXR0dHZ43D9sVl8/iscXLRumw1j1oXZ+ftznmn0W0dHV40u8cnx4fnF8ft88uDznGz1Tk7bXXPLhtrSi5Uara1I083y9EyzSfhvD8b/Cl861rXGJhPeJJzZUPjwmkRS0sXpLTIwM6bl9fzrnaBvU+SlHXOauzth5dX8VBylcqZj90xbgSf1Fi383IyN4Ej3c5LE8dQnYF/8oMtce+MnEJjnmYuEEVwKe8UDtXj5B4YOWdTIUHZQMl6vdf72UEbsvDiQI35x6JPNGiLw0HzJDgaHB76x83Wcevk9KDVavqnRwPeaq+rT3GS9vkwraRSy3rpd3kq9m/CiXAPy9iyl+qZu1MXM4AxnknQZA2EtIBwboalHfhbzXoD/t00Gi/wn9doNP65+wh6B5j6+QUJprNRZWKbp8eNTRALSVhCbjh4IMeJMziBQywv2Mpj1ntzRatqKqIoVy5f+0YgcdT09yt2BiHuQfKZ7nFFjiu6VXnsd1AqZ9UOVRY9UMvyg+ygIwFsn4aUJOTG5FGaUIH59/f3noCQq9D3/GRdhuulckvMrrQ8FxbkbCGmMdnDC/Jkbjp0vv3wspvrp7OpdVjNptp509dXarUlptnbFYEpPzvk7vKIIDQ1iJJF5tDH+rLbfOvwqP9L5xpu8wcn7ZKnLzrdCs/vep63W5mhM3kntsS9JUYQgJi1YYGvdPa75jH0hxCx6Y1YFtijhD9tHR7JZlUaoWrLAPyiIqhA6SBJIsHjMoLO9U9sGPEcWZjfgMYuFotRkoa4SmCarJr5vlAKAjR4bAAxCMKOFfa3IptaDA3G5Rw786WzOBaRV5W8WHxO+8a8VoHAzYnS2vR0ax2Ntwg89k7IrGGzynq36JX66uzNGcXgyjl7buyYsHiGPNatrMABO4qhE5faTyNVR0rgNA+TuY7H7uU/eJ/H6SR6xqNpXDc41sNA7S3cr5RW0Oz4HiX3cLDgqqh1gOV+06usdFKo2UQEFeTxWIUL1YIhFhWO4GJkOQ3JYHdFSxdQu6ClldWMqs46m0MF2r6Q1ZBwW9dqWCTpa1kNl2GyJRZv02pIpFS1GhYp/6athoTuD2M1JHq+a6uhK5Mfw2r4NaWyaavhgnR+EKthRQl911ZDonGrVsPeWvbBgl2QhmRGyxZZ9aXsgwT+T36gvqyBkLp8bspAeHDabrebfHB0eHzYFq1W43jQFM1B+/B4cHDUbgZr8mMTBkIwlamUT6buARjviGQc+hYMhA69f9lAuC7BX9xASMSS7agCpRtYGB5eCowMFuntvHkJN0szsyGVcytLQH6H3zQ73syw/1guT9HsVFMuFd348PtEhqMw5hFl+ZZogNfaXZOsbRsY3sAhBVp/BvoSjucTAxNRyZH5EIlppFYTaMhLJfdN8qOJiXK+Wh4X1c2KjJpBymvWYp/hfwuzHkOiOQSuJrPROJkZay9nkxCKQlKlNSgeF0JkOWgm5EDANSsW7C4U91k8RhbwT5PAQZw5qRNMCgjXSxWrZ0piuvfei4H53VyfhjKJ07qIg1y0HvAsTdinmZDgmZrwwNKR1WwYcP+j++Ya8VjAxC0GvZoELLt32lOGBpzlU52hPClLTGW0UYKMzsjNGg/TXXkgYNdhaTIScPrDG5UdkvSyZvK6DMNhI4608CwYCIqTdbLqUGcdqFzr7S4qeXswPG0NDw6PjwcH7YAf8QNfnLZOg4ZoiPbxQb5+pNsq+esw2YJfYLX53uRjm6R/W6cGczImgkPP3iBL8CHG1LDJiR0STtCWv5AVY/aFAvsajWHj6JjzxoCfNlqDY2dVmMnIXRE+vH/9wGrw4f1rUmpbWpR8FHD9glykaSTgngc9liWm3314/1pBF5PAPGlWLODBQArM5WcBpLGHcZow5UNt8xolfNbYlKdjej9hSVx9om0345Wc8ST2mYxqWW543j3mZsZfxVgpkCrNcuTnhM91sC4ZyKGSTBzsQ5tq4KvO547mNdQIKNhoqgraUYFeLGCL92IYGxyMUFnGVnfRlThHiam8cUuuPSoiuFvBw2f4ai3R22LtzZiCbE0+p54vEPeaAS85BtBsoDEZZFQ4rL8pDhFC/K4uVAum5jAli2cNpAg9h8SdkHMYBy65jC+8vzB4JDgWUpwKGSYBm8yg/G+SwsU3jP1oFoDHIJfvbF0H+uGBYDvTeLST2TkAhx0PvitO62k8yollKPlokhWH2bhUoGBKmLgaz/DKg59un906+p8m03w5CMFun2Ht7jjJl6AwSHu7eVpmUfQD5DZcDZESmOU6ETScgDuXEiKxsftMiWzCzh1bCRYDNaQxOLLcgj7DeLfoO4TdV5tZqMC5YlLA7Qhv+3BJlubuYA48+bqlbtUbR69cN1W2Arxotw/2dbXfnz+9pO/152dpMs1Jz0zIH0CCux/iSRLADh9k6wysB+DyFCLOcdZytKyNQmyrj06SOEwT8Mih0FkywJ07sJvBQDBuFQdlLQU3uyaqAkdnKxZ71mPAq7CaDVMRsz9hMZEiuzji2gX7aG5Suppjs3Tta3ZYjt0pwOVmEK3l9vnSZiCPUiLQ2CU/5/RrypVytGYD+pWT+Tsa3qxRtK3kM/OBm1uDn44XYDtrKzFox3ugOlYpOo+ukFXAo90+KKwc7fZBDqlPMyHnFbB6DJOwbBYCICW2NRcRX/0L+b3LaKAxGfJ0QdkKe9fPuHehPy8wN/NFKFiDXx/o7KklTtjtz7c4Q62ljJHtzsHdtKmRaNfj8A423jFP1RyS8AU6ptgR4WAI9k+IBsvwQdT1k7f0NmV2mxTzXMcHNhDpvRDZqRKAQmMJ2J7MrcyI9mtXR4Ml+Kk02rdTGk1f2ralBD0cfelatAM8U65woH2RzoK8fVF67tT4FsnDkZ6Kvj0VfdtE0bcthhR/oOEX5oTn2naUkDnjjvm83LqDSgiYGxuP2VTzNZRs1wh8VB9v4fIRiTtu7xdpUtJYjJJsfR7rFjoQ7iSgznauIC58EwpFO6qpJMUmiQTpcm0iDgNzTTaGKB4zjvE+GiN95VaOfXji7X4jxqPl5dK2Xq/va5bqe6rSV1ql70cv0Pcd1Ob72mX5nBiabfkqvveKfGGwmSJ4q3VnRTG+//A6fFiHD57q85ExIzpHC5Z9W+GAoccwx4ysDy34RvB6zdlAJveOD9Gq3c1YzMnQpSAICKqLxujeJUcZ0AV9uyZgjLd3dfKqzyyq5p68xplA2EaUeT3YyipB0BZFEr4bmwZNyxVzKwhlrCsg1eNDLsPvywico/ND7OhHP6cfi7ReJ/8Oo4jvH3oN9lxL479Z590Hkgx722PNVr+pLzfX3Icv/rHHzqbTSPwuBr+G6f5R49Brek0TVc3Y819f3Vy/rul3fhH+x2SPUXO6/WbLa7DrZBBGYr95eNFsnxC7948aba+ZZ7ryhnwSRvPNcT3Hprc9psdnz82dSIpgzNMaC8Qg5FBhSQoxUAF4K+MguVd7BQbqJwt4/xgun7dTIblTKNGcDfE2YuJzTUATesype2ZRz7TqXCd/8juxyK2P0Lgs2paUF2nQ0Cza6E6Q/H7ZDGl7ba9RbzZb9ZGIIZprEfvNLljfmqyNm96R9DLh/mORM+Z0ujnurMbYwKP57Is4TVSNzQazOJ2tmsNc3i/cYhLlEbVfCnkC96A+Nhtec3Gl3C6qC41FV+ycsLo756u7iMfuyeq312dvqpyp4DlzmuIys/DTwXbOThotr/kJ6q8+V3tun09jReFKm7/A3ReP4O6OR3Oh/8TxuVKJr3M+8ZgMlpgBxeqGMRiA8LesxLDT91QDo07ItvoXPfdGe0Y9oL6MCvBry4BxKHI1iojalI+w1CxMM+zgA8RlKZhuO+lP9TCuf4LMUz5V0KwUWg3V6LpThhnLeTttK668wQnD2bh16yoRq0RSJeJ/CvGxxn4PpVBjLj/uoc8SS+FSPV7TWVny4TD0C5wI41jIpVLVQzD9EBGXCVix58aURqPSb3n695YQuZq8XFHqdalcQV6uJgEG5Rg/FdxEgyAkzWJxia5gWygMIReGHVBoGPcmGvItKarnKjdRLz1XyymXt0T/zOM0pNVt9zqLAfvmQRNKaS7BQah8CW7z4gyjMVHiznjL5OK0b6LeTTgX8l2e1rjabM04gwRddUHXbCFqimM3XCquiZUzd7Z483mL/+eRVgoAtBYNySyFnIzVhBgy7mZRLCQfhJFpUWiW/8IPy/cB2AZyA1Uw4vMS0Kxg0TeJ+3d2A6uiUlQcdFtXkVw7dToQJDIfUY6EpAW+cHSzKc918ithQm/Mkahu5/dzp65pjXXx+gKzrfehd7EHf+AxF6rQD8tiobs85QPciSS7pHm7l/O9ZbUBPs14NFejGZeBp/8Gd9v+p3sxGItouj9M+qCAPNqHxk+RCEZiwJXYzxHYN3VZhfLG6eRf/4sDWcTyzMie/cNtIZfFlZnQRONe8XYXdX33XzuGrp0/dlervKMfZcXnN60loCT5KvfmTJbngvITmZ0sc8KhYVm+gAMmI2EFB/9Oqf1C0drOb71eVU44GG+ODRu+FRW46nxRzlKcfLRnKbuFQ0/HJM5BK3t7yfTw74RT/xfb1+8P+SdU8+iZfyf64Duc9x3kVN+H0v0i+FcHG2VYsO7aCokesBdffJ4mClaOzm8XriL9UZDvVQwtOd/2mE6DYy2v2fKOKNQHFs+FpdUECr5/11kjC1/EkA617QliVtHMCu6WrQlVnpIHJkeZiEpmx0VVFmztZAKUG4ppaXh+1d0zgRPUUX6aRT2Xb5YMWvnKuceuXJ8z9aBfBECDGv9Uka/ZoOup/v2Yp/1Q9WEKhMEe6Xru/BCKLIS0oOtX3T/+lgP8Ar6utxrN03qj0WisUQ5mu5XNoaAOtUtdusDkzs+02oDvMmCTMA1H+EPGCyMMIyoRLMhlkTHlEvFHYX0Qxvv+nQDF9fxR+DP88dLy8ajZXIONoHj9rSo/3SITyZTP43JVLRAPlDQbzRNvHaWA8WMhvTsRB4ncIkluSExOiAYFplEokHUjYnDbVycokcIbcCUqEDOMEp6WYbzbAweiAvcnkzwekeur4TXgxN1seA2wwKVj/NPUnhoLNklUyhTkprix5udwxFQ0YgI2GTixQStpBRkWVJx/GiVhapgyEakMfcWe69L67A6jR4xFiFGY92dsVD6V4V0YiZGgZC7yEqdC6qy2vRp1UslGdX2+MIYdF1L/RtCOXQ9FUROI0x6levnJNB+ftvL4ZY7qqLr1gGrx7RVOqofe4XoiFvFdKBOsz8Wjb0fWFy5aDwmdx3NmkxhQS0hCNfYYCWEcdSgFAFffgIigBmYivyXp3BBGDwkGKuawCU9neioASwMqqYfbZiYOmCVGVv7m5kVFDm/XVo4X+Tec9m73xDLPrs7P3/zW3cs2e7gah1Br09Z0hMoodwIYCUsppJSiiXrndXK/U2M71yIIZ5MdvbjsvApH4x1cEOGaxu5asLza5dOOiJqgFg2QIHcHFtg4lTPWgdegyNw52mwDMYQIWDso3QOyh3MycrQIn4Ccnnvomgx4T3jMoXvaYM4ur973bry3clRjV7Hvsef4BSye7EOvPuBwfI8TrAo4DI3KM5bIEY9tu5b7cQKLQahMMmSaQEHPKa77YFRkSvionHCyBd1L4fQ1TWJSE/iXCj6BFH2ZKKSa3ScyCpaoaHwXeDFUkRsld2izqNNShGtEcTHQzpFqqkoi2ZKW3rhSLz1hwNqB3MOFguiy7V9kFgrB2FSGiQxTEgTkInDdf9JZAh7HwUUGdgCMz6NVXKwDQ16wgcC1kcf+OJH6Y903V2ayR57rZ3Kc+TuO3TE5L9SOEl43BkjaPTDnH8Nx0SyOwkAjXJn1EEMwPFMJeYX4cri8MpWTSULkc8uNDJh50Kjw30mcH5hHoU2zg/yuF2TyXHh4Eo7ADwlrVypnIj+6poWe1MMmbvkY/aH/ICV/py8dzuKJC3eB0UzCaZWAldFXYFqRNuCt+9xKspBppdIoDlwqupWjA4MVltvwoIs1j/3KEociQlD5ACw45l0WBkap/SiZBZn+duCj2UYknFR5wFNertLX9Ks+lfu5V/G+mbkBeBD08YG+GRKAQI5mIl0Nz1GNL3hTmYBGZOGxdu7SL/XPZXRn+uGGaNErMM9+wUQdTTGgwFgJ8HDCR6IENJ+EdT7wg2broL0a+hWMwK669hqNVFlRkG4+Y2egJvhQEgXEjxxCwDjPsgTl84CelT68Us8cGAbB7Iq9GowlKAweC6nC1FmAVXX+ONAm3B+HscAFphIwesFzXqgKy70V9CuspqvfqgqVdLyq4ArzqyocSHFM4kowco+Wjm/WoyDxPwqZLUhd87lkeunfmEp5CttqFOk6Obga6d9gXisI6e3rbSE7F5ldXMOr28VoyW5r0Spz7uVfcV8jv7bbKb2cWQ7Dyl8pZdoSULDirA8N3nK3uzWhLrxZDejjwWF2mmLsGbt52337gr2CdigJm/ApLLJK/OwMW3LKeOCksWI9z9Z0jYJnNBf280xv4aBVrrVX8TBxtZW2BXidmbXGUVD4vlQ9ad+46PToK7xNhSbmwxO+8uYTqh7/jFy4nPqZw9Une3Mh1SJR6YOavlw0uXyI8tLmD7F3mHEEHUWZ2ItwE+UNZmFUBFmUqN29d5on3WbjdKcaOuDDAghueEA5ImCvKJ0Hq3BRqRSpP66OjIGiE6riudXAj7MBxKGmQmV6+Kv7Xcm42e/2sJc/uWWDZie2B1fV7KUHV9bs0Qd1bpHj0yTwKrJ7BUcdDkwT3RClKFwANQuDjUF6lwTsw1W3CAj+q6bcFxsDlY1YBJYEhSX/LwIz0dpFYLRc/vSXF2bn5/6ET6dhPKJnd37aWRtj2kgmfFpEGbOucP/79vB2cCtHXgpsnKJE7hKboV9EsBrgbNwlgg7ENErmYLzeLOBs3CWA4SAohrNo4yQ7Ay8Bne1QGwVsh30QbPmh76/D1ePSBkNreba7vLNflIxLP2b7ir3Ulu0D2djrbQLic9VjJ0HwxGfhz1LHm1l29CSK/0yi5GPI63yWJhCcCm7DjPz/0b+yLv0yZ+5z1hZSxXpSMpS7CxMedshlVkF6ztMmprxfokwlSvCCfyY8n8IxkqFFgAyGy2GGwfrgLjikSsHIVEbQBofo9m6mXoYI03HGV9s6W6VcprNpzqYJFh4IcoAveWYUBMhQ7YNPBJjvE0m+KpSbgLBIqEEIZRXwC/hYo+AHRA0t3DyCIVKlg4Ou3tWMaQnmAguDGjw6hmNaHiU0dacKOVPOQoqVncokmPnp+owEfLK5S8PAMdHStgrso9UlB3ZX2byT5w7kvQdAO4EPa0LW7xpWZ+Q7uqCYnMUxOBDCuBwPU+h1behQz2oMl08ICNXgSFsRk1VM92eyegeoDOrvtrShoQ9qzxkVpysln6VjCCygYBUqQ2eWtUW3hY38q+C4WNNnkaOdXDEi9SZJMIvEakIXA1b0Ozr6WkcUg7nHKaKybA9wfRU5wKUGhyKyzp5eFVXz7gpslwDT1VFpZE0LBhsIuRqFzkxKkADuKWRfwNUOxyM7A1Rpu6PlcRJGUaigImaglmIDdaCAYUEffBCV2hY4XAHfA1A8hBuuZkVm+XCwAQW2kABVj72lurN2MEwcuTVPeeZUgdWrdHBSYGJItYyX0oTYbJ0e7uvm+GCfhIyILZBE+fwe1TBejfwZFTo2GqpNTYAD2YRMdYBstVzoo0bh7nxuqvaaSpgoGsASsKfVyHAlSjA5ZwnmC06wJaiDMS0/vaogn4fomO8pPiegSb9gkLF6olYjBauTCRjBGeGxs+iez6HuKVYK3QkSf2cBCx0+H3imrG/fKZP54MYDHAZ2mnjbrGhLAOG0aVZzcxEc/LoOFHg+x3ADRgl5F/rib/8/AGJjDDs="
 }
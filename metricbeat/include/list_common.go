@@ -82,6 +82,8 @@ import (
 	_ "github.com/elastic/beats/v7/metricbeat/module/http"
 	_ "github.com/elastic/beats/v7/metricbeat/module/http/json"
 	_ "github.com/elastic/beats/v7/metricbeat/module/http/server"
+	_ "github.com/elastic/beats/v7/metricbeat/module/influxdb"
+	_ "github.com/elastic/beats/v7/metricbeat/module/influxdb/write"
 	_ "github.com/elastic/beats/v7/metricbeat/module/jolokia"
 	_ "github.com/elastic/beats/v7/metricbeat/module/jolokia/jmx"
 	_ "github.com/elastic/beats/v7/metricbeat/module/kafka"
@@ -99,6 +101,7 @@ import (
 	_ "github.com/elastic/beats/v7/metricbeat/module/linux/ksm"
 	_ "github.com/elastic/beats/v7/metricbeat/module/linux/memory"
 	_ "github.com/elastic/beats/v7/metricbeat/module/linux/pageinfo"
+	_ "github.com/elastic/beats/v7/metricbeat/module/linux/pressure"
 	_ "github.com/elastic/beats/v7/metricbeat/module/logstash"
 	_ "github.com/elastic/beats/v7/metricbeat/module/logstash/node"
 	_ "github.com/elastic/beats/v7/metricbeat/module/logstash/node_stats"
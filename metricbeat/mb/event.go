@@ -46,6 +46,11 @@ type Event struct {
 	Took      time.Duration // Amount of time it took to collect the event data.
 	Period    time.Duration // Period that is set to retrieve the events
 
+	// Scheduled and actual start times of the fetch that collected the event,
+	// only set for periodic fetches when it is enabled in the module.
+	ScheduledTime time.Time
+	FetchTime     time.Time
+
 	DisableTimeSeries bool // true if the event doesn't contain timeseries data
 }
 
@@ -111,9 +116,9 @@ func (e *Event) BeatEvent(module, metricSet string, modifiers ...EventModifier)
 
 // AddMetricSetInfo is an EventModifier that adds information about the
 // MetricSet that generated the event. It will always add the metricset and
-// module names. And it will add the host, period (in milliseconds), and
-// duration (round-trip time in nanoseconds) values if they are non-zero
-// values.
+// module names. And it will add the host, period (in milliseconds),
+// duration (round-trip time in nanoseconds), and scheduled and actual fetch
+// times values if they are non-zero values.
 //
 //   {
 //     "event": {
@@ -154,6 +159,12 @@ func AddMetricSetInfo(module, metricset string, event *Event) {
 	if event.Period > 0 {
 		e.Put("metricset.period", event.Period/time.Millisecond)
 	}
+	if !event.ScheduledTime.IsZero() {
+		e.Put("metricset.scheduled_time", event.ScheduledTime.UTC())
+	}
+	if !event.FetchTime.IsZero() {
+		e.Put("metricset.fetch_time", event.FetchTime.UTC())
+	}
 
 	if event.RootFields == nil {
 		event.RootFields = e
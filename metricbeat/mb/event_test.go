@@ -201,6 +201,22 @@ func TestAddMetricSetInfo(t *testing.T) {
 		}, e.RootFields)
 	})
 
+	t.Run("schedule", func(t *testing.T) {
+		scheduled := time.Date(2021, 1, 1, 10, 1, 0, 0, time.UTC)
+		e := Event{
+			ScheduledTime: scheduled,
+			FetchTime:     scheduled.Add(3 * time.Second),
+		}
+
+		AddMetricSetInfo(moduleName, metricSetName, &e)
+
+		assert.Equal(t, common.MapStr{
+			"name":           metricSetName,
+			"scheduled_time": scheduled,
+			"fetch_time":     scheduled.Add(3 * time.Second),
+		}, e.RootFields["metricset"])
+	})
+
 	t.Run("no optional fields", func(t *testing.T) {
 		e := Event{}
 
@@ -379,15 +379,47 @@ func (b *BaseMetricSet) Registration() MetricSetRegistration {
 // the metricset fetches not only the predefined fields but add alls raw data under
 // the raw namespace to the event.
 type ModuleConfig struct {
-	Hosts       []string      `config:"hosts"`
-	Period      time.Duration `config:"period"     validate:"positive"`
-	Timeout     time.Duration `config:"timeout"    validate:"positive"`
-	Module      string        `config:"module"     validate:"required"`
-	MetricSets  []string      `config:"metricsets"`
-	Enabled     bool          `config:"enabled"`
-	Raw         bool          `config:"raw"`
-	Query       QueryParams   `config:"query"`
-	ServiceName string        `config:"service.name"`
+	Hosts       []string       `config:"hosts"`
+	Period      time.Duration  `config:"period"     validate:"positive"`
+	Timeout     time.Duration  `config:"timeout"    validate:"positive"`
+	Module      string         `config:"module"     validate:"required"`
+	MetricSets  []string       `config:"metricsets"`
+	Enabled     bool           `config:"enabled"`
+	Raw         bool           `config:"raw"`
+	Query       QueryParams    `config:"query"`
+	ServiceName string         `config:"service.name"`
+	Schedule    ScheduleConfig `config:"schedule"`
+	Counters    CountersConfig `config:"counters"`
+}
+
+// ScheduleConfig contains the options to schedule the fetches of periodic
+// MetricSets. By default the first fetch is done on start, and the following
+// ones every period since then.
+type ScheduleConfig struct {
+	// Align the fetches to the boundaries of the period since the Unix epoch,
+	// so with a period of 1m they are done at the start of every minute.
+	Align bool `config:"align"`
+	// Jitter is the upper bound of a random delay added to each fetch.
+	Jitter time.Duration `config:"jitter" validate:"min=0"`
+	// Metadata adds the scheduled and actual times of the fetches to the
+	// events.
+	Metadata bool `config:"metadata"`
+}
+
+// CountersConfig contains the options to compute the rate and delta of the
+// fields declared as counters in the fields.yml of the MetricSets.
+type CountersConfig struct {
+	// Enabled adds the rate and delta of the counters to the events, by
+	// comparing them with the previous values of the same host and dimensions.
+	Enabled bool `config:"enabled"`
+}
+
+// Validate checks that the jitter of the fetches is lower than the period.
+func (c *ModuleConfig) Validate() error {
+	if c.Schedule.Jitter > 0 && c.Schedule.Jitter >= c.Period {
+		return fmt.Errorf("schedule.jitter (%v) must be lower than the period (%v)", c.Schedule.Jitter, c.Period)
+	}
+	return nil
 }
 
 func (c ModuleConfig) String() string {
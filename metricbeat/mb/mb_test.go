@@ -111,6 +111,50 @@ func TestModuleConfig(t *testing.T) {
 			},
 			err: "negative value accessing 'period'",
 		},
+		{
+			name: "schedule",
+			in: map[string]interface{}{
+				"module":          "example",
+				"metricsets":      []string{"test"},
+				"period":          "1m",
+				"schedule.align":  true,
+				"schedule.jitter": "5s",
+			},
+			out: ModuleConfig{
+				Module:     "example",
+				MetricSets: []string{"test"},
+				Enabled:    true,
+				Period:     time.Minute,
+				Schedule: ScheduleConfig{
+					Align:  true,
+					Jitter: 5 * time.Second,
+				},
+			},
+		},
+		{
+			name: "counters",
+			in: map[string]interface{}{
+				"module":           "example",
+				"metricsets":       []string{"test"},
+				"counters.enabled": true,
+			},
+			out: ModuleConfig{
+				Module:     "example",
+				MetricSets: []string{"test"},
+				Enabled:    true,
+				Period:     time.Second * 10,
+				Counters:   CountersConfig{Enabled: true},
+			},
+		},
+		{
+			name: "jitter longer than period",
+			in: map[string]interface{}{
+				"module":          "example",
+				"metricsets":      []string{"test"},
+				"schedule.jitter": "10s",
+			},
+			err: "schedule.jitter (10s) must be lower than the period (10s)",
+		},
 		{
 			name: "negative timeout",
 			in: map[string]interface{}{
@@ -0,0 +1,292 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package module
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mitchellh/hashstructure"
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/asset"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/mapping"
+)
+
+// counterTimeoutPeriods is the number of periods the last values of the
+// counters of a series are kept without receiving new events.
+const counterTimeoutPeriods = 5
+
+var (
+	beatFieldsLock = sync.Mutex{}
+	beatFields     = map[string]mapping.Fields{}
+)
+
+// getBeatFields loads the fields of the given beat, caching them for the next
+// calls.
+func getBeatFields(beatName string) (mapping.Fields, error) {
+	beatFieldsLock.Lock()
+	defer beatFieldsLock.Unlock()
+
+	if fields, found := beatFields[beatName]; found {
+		return fields, nil
+	}
+
+	raw, err := asset.GetFields(beatName)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := mapping.LoadFields(raw)
+	if err != nil {
+		return nil, err
+	}
+	beatFields[beatName] = fields
+	return fields, nil
+}
+
+// counters computes the rate and delta of the fields of a MetricSet declared
+// with `metric_type: counter`. The last values of the counters are kept per
+// host and dimensions, where the dimensions are the keyword fields of the
+// event, or the fields declared with `dimension: true`.
+type counters struct {
+	fields     []string        // Full names of the counter fields.
+	dimensions map[string]bool // Full names of the dimension fields.
+	prefixes   []string        // Prefixes of the dimension object fields.
+
+	mutex sync.Mutex
+	cache *common.Cache // Last counterSample per counterSeries.
+}
+
+// counterSeries identifies the events whose counters are compared.
+type counterSeries struct {
+	host       string
+	dimensions uint64
+}
+
+// counterSample contains the values of the counters of an event.
+type counterSample struct {
+	values    map[string]interface{}
+	timestamp time.Time
+}
+
+// newCounters returns the counters of the fields under the given namespace,
+// or nil if there are none.
+func newCounters(fields mapping.Fields, namespace string, period time.Duration) *counters {
+	c := &counters{dimensions: map[string]bool{}}
+	c.populate("", namespace+".", fields)
+	if len(c.fields) == 0 {
+		return nil
+	}
+	c.cache = common.NewCache(counterTimeoutPeriods*period, 8)
+	return c
+}
+
+func (c *counters) populate(prefix, namespace string, fields mapping.Fields) {
+	for _, f := range fields {
+		name := f.Name
+		if prefix != "" {
+			name = prefix + "." + name
+		}
+
+		if len(f.Fields) > 0 {
+			c.populate(name, namespace, f.Fields)
+			continue
+		}
+
+		if f.MetricType == "counter" && strings.HasPrefix(name, namespace) {
+			c.fields = append(c.fields, name)
+		}
+
+		if !isDimension(f) {
+			continue
+		}
+		if f.Type == "object" {
+			name = strings.TrimRight(name, "*")
+			if !strings.HasSuffix(name, ".") {
+				name += "."
+			}
+			c.prefixes = append(c.prefixes, name)
+		} else {
+			c.dimensions[name] = true
+		}
+	}
+}
+
+// isDimension returns true for keyword fields, unless they are declared with
+// `dimension: false`, and for any field declared with `dimension: true`.
+func isDimension(f mapping.Field) bool {
+	if f.Dimension == nil {
+		return f.Type == "keyword" || (f.Type == "object" && f.ObjectType == "keyword")
+	}
+	return *f.Dimension
+}
+
+func (c *counters) isDimension(field string) bool {
+	if c.dimensions[field] {
+		return true
+	}
+	for _, prefix := range c.prefixes {
+		if strings.HasPrefix(field, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// process adds the rate and delta of the counters of the event since the
+// previous event of the same series. For a counter `a.b` they are added as
+// `a.rate.b` and `a.delta.b`. If the counter is lower than its previous
+// value, it is considered to have been reset, and the delta is its value.
+func (c *counters) process(host string, event *beat.Event) error {
+	values := map[string]interface{}{}
+	for _, name := range c.fields {
+		if v, err := event.Fields.GetValue(name); err == nil {
+			values[name] = v
+		}
+	}
+	if len(values) == 0 {
+		return nil
+	}
+
+	series, err := c.series(host, event)
+	if err != nil {
+		return err
+	}
+	current := &counterSample{values: values, timestamp: event.Timestamp}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	previous, _ := c.cache.Get(series).(*counterSample)
+	if previous != nil && !current.timestamp.After(previous.timestamp) {
+		// Ignore events older than the last one of the series.
+		return nil
+	}
+	c.cache.Put(series, current)
+	if previous == nil {
+		return nil
+	}
+
+	elapsed := current.timestamp.Sub(previous.timestamp).Seconds()
+	for name, value := range values {
+		delta, ok := counterDelta(previous.values[name], value)
+		if !ok {
+			continue
+		}
+		parent, leaf := "", name
+		if i := strings.LastIndex(name, "."); i >= 0 {
+			parent, leaf = name[:i+1], name[i+1:]
+		}
+		event.Fields.Put(parent+"delta."+leaf, delta)
+		switch delta := delta.(type) {
+		case uint64:
+			event.Fields.Put(parent+"rate."+leaf, float64(delta)/elapsed)
+		case float64:
+			event.Fields.Put(parent+"rate."+leaf, delta/elapsed)
+		}
+	}
+	return nil
+}
+
+// series returns the series of the event, from the host and the values of its
+// dimensions.
+func (c *counters) series(host string, event *beat.Event) (counterSeries, error) {
+	dimensions := map[string]interface{}{}
+	for k, v := range event.Fields.Flatten() {
+		if c.isDimension(k) {
+			dimensions[k] = v
+		}
+	}
+	h, err := hashstructure.Hash(dimensions, nil)
+	if err != nil {
+		return counterSeries{}, errors.Wrap(err, "hashing the dimensions of the event")
+	}
+	return counterSeries{host: host, dimensions: h}, nil
+}
+
+// counterDelta returns the difference between two values of a counter, as an
+// uint64 if both are non-negative integers, or as a float64 otherwise.
+func counterDelta(previous, current interface{}) (interface{}, bool) {
+	p, pInt, ok := counterValue(previous)
+	if !ok {
+		return nil, false
+	}
+	c, cInt, ok := counterValue(current)
+	if !ok {
+		return nil, false
+	}
+
+	if pInt != nil && cInt != nil {
+		if *cInt < *pInt {
+			return *cInt, true
+		}
+		return *cInt - *pInt, true
+	}
+	if c < p {
+		return c, true
+	}
+	return c - p, true
+}
+
+// counterValue returns the value of a counter as a float64, and also as an
+// uint64 if it is a non-negative integer.
+func counterValue(v interface{}) (float64, *uint64, bool) {
+	var i int64
+	switch v := v.(type) {
+	case uint64:
+		return float64(v), &v, true
+	case uint:
+		u := uint64(v)
+		return float64(u), &u, true
+	case uint32:
+		u := uint64(v)
+		return float64(u), &u, true
+	case uint16:
+		u := uint64(v)
+		return float64(u), &u, true
+	case uint8:
+		u := uint64(v)
+		return float64(u), &u, true
+	case int64:
+		i = v
+	case int:
+		i = int64(v)
+	case int32:
+		i = int64(v)
+	case int16:
+		i = int64(v)
+	case int8:
+		i = int64(v)
+	case float64:
+		return v, nil, true
+	case float32:
+		return float64(v), nil, true
+	case common.Float:
+		return float64(v), nil, true
+	default:
+		return 0, nil, false
+	}
+	if i < 0 {
+		return float64(i), nil, true
+	}
+	u := uint64(i)
+	return float64(i), &u, true
+}
@@ -0,0 +1,171 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package module
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/mapping"
+)
+
+const countersFields = `
+- key: fake
+  title: Fake
+  fields:
+    - name: fake
+      type: group
+      fields:
+        - name: network
+          type: group
+          fields:
+            - name: name
+              type: keyword
+            - name: in.bytes
+              type: long
+              metric_type: counter
+            - name: in.time
+              type: double
+              metric_type: counter
+            - name: connections
+              type: long
+              metric_type: gauge
+            - name: version
+              type: keyword
+              dimension: false
+        - name: other
+          type: group
+          fields:
+            - name: bytes
+              type: long
+              metric_type: counter
+`
+
+func TestCounters(t *testing.T) {
+	fields, err := mapping.LoadFields([]byte(countersFields))
+	require.NoError(t, err)
+
+	c := newCounters(fields, "fake.network", 10*time.Second)
+	require.NotNil(t, c)
+	assert.ElementsMatch(t, []string{"fake.network.in.bytes", "fake.network.in.time"}, c.fields)
+
+	start := time.Date(2021, 1, 1, 10, 0, 0, 0, time.UTC)
+	newEvent := func(elapsed time.Duration, name, version string, bytes uint64, t float64) *beat.Event {
+		return &beat.Event{
+			Timestamp: start.Add(elapsed),
+			Fields: common.MapStr{
+				"fake": common.MapStr{
+					"network": common.MapStr{
+						"name":        name,
+						"version":     version,
+						"connections": 3,
+						"in": common.MapStr{
+							"bytes": bytes,
+							"time":  t,
+						},
+					},
+				},
+			},
+		}
+	}
+
+	// First events of each series don't have rates.
+	event := newEvent(0, "eth0", "1", 100, 1.5)
+	require.NoError(t, c.process("host", event))
+	assert.False(t, hasKey(event, "fake.network.in.rate"))
+	event = newEvent(0, "eth1", "1", 1000, 1)
+	require.NoError(t, c.process("host", event))
+	assert.False(t, hasKey(event, "fake.network.in.rate"))
+	event = newEvent(0, "eth0", "1", 10, 1)
+	require.NoError(t, c.process("otherhost", event))
+	assert.False(t, hasKey(event, "fake.network.in.rate"))
+
+	// Changes in fields that are not dimensions keep the series.
+	event = newEvent(10*time.Second, "eth0", "2", 300, 2)
+	require.NoError(t, c.process("host", event))
+	assertValue(t, event, "fake.network.in.delta.bytes", uint64(200))
+	assertValue(t, event, "fake.network.in.rate.bytes", float64(20))
+	assertValue(t, event, "fake.network.in.delta.time", 0.5)
+	assertValue(t, event, "fake.network.in.rate.time", 0.05)
+	assert.False(t, hasKey(event, "fake.network.rate.connections"))
+
+	// Counters reset.
+	event = newEvent(20*time.Second, "eth0", "2", 50, 2)
+	require.NoError(t, c.process("host", event))
+	assertValue(t, event, "fake.network.in.delta.bytes", uint64(50))
+	assertValue(t, event, "fake.network.in.rate.bytes", float64(5))
+	assertValue(t, event, "fake.network.in.delta.time", float64(0))
+
+	// Old events are ignored.
+	event = newEvent(15*time.Second, "eth0", "2", 100, 2)
+	require.NoError(t, c.process("host", event))
+	assert.False(t, hasKey(event, "fake.network.in.rate"))
+
+	event = newEvent(5*time.Second, "eth1", "1", 1500, 2)
+	require.NoError(t, c.process("host", event))
+	assertValue(t, event, "fake.network.in.delta.bytes", uint64(500))
+	assertValue(t, event, "fake.network.in.rate.bytes", float64(100))
+}
+
+func TestCountersNotDeclared(t *testing.T) {
+	fields, err := mapping.LoadFields([]byte(countersFields))
+	require.NoError(t, err)
+
+	assert.Nil(t, newCounters(fields, "fake.unknown", 10*time.Second))
+}
+
+func TestCounterDelta(t *testing.T) {
+	cases := []struct {
+		previous, current interface{}
+		delta             interface{}
+		ok                bool
+	}{
+		{previous: int64(10), current: int64(15), delta: uint64(5), ok: true},
+		{previous: 10, current: uint64(15), delta: uint64(5), ok: true},
+		{previous: uint32(10), current: uint32(5), delta: uint64(5), ok: true},
+		{previous: 10, current: 12.5, delta: 2.5, ok: true},
+		{previous: common.Float(1.5), current: common.Float(1), delta: float64(1), ok: true},
+		{previous: -5, current: 5, delta: float64(10), ok: true},
+		{previous: nil, current: 5, ok: false},
+		{previous: "10", current: 15, ok: false},
+	}
+
+	for _, c := range cases {
+		delta, ok := counterDelta(c.previous, c.current)
+		assert.Equal(t, c.ok, ok, "%v -> %v", c.previous, c.current)
+		assert.Equal(t, c.delta, delta, "%v -> %v", c.previous, c.current)
+	}
+}
+
+func hasKey(event *beat.Event, key string) bool {
+	found, _ := event.Fields.HasKey(key)
+	return found
+}
+
+func assertValue(t *testing.T, event *beat.Event, key string, expected interface{}) {
+	t.Helper()
+	value, err := event.Fields.GetValue(key)
+	if assert.NoError(t, err, "field %s not found in %v", key, event.Fields) {
+		assert.Equal(t, expected, value, "field %s", key)
+	}
+}
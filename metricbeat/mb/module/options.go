@@ -86,3 +86,12 @@ func WithServiceName() Option {
 		w.eventModifiers = append(w.eventModifiers, modifier)
 	}
 }
+
+// WithCounters enables the computation of the rate and delta of the counters
+// declared in the fields of the given beat, for the modules configured with
+// `counters.enabled: true`.
+func WithCounters(beatName string) Option {
+	return func(w *Wrapper) {
+		w.countersBeat = beatName
+	}
+}
@@ -0,0 +1,79 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package module
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/elastic/beats/v7/metricbeat/mb"
+)
+
+// schedule calculates the times of the periodic fetches of a MetricSet.
+type schedule struct {
+	period time.Duration
+	jitter time.Duration
+	next   time.Time // Scheduled time of the last fetch.
+	random func(n int64) int64
+}
+
+// newSchedule creates the schedule of a MetricSet started now. If fetches are
+// aligned, they are scheduled on the boundaries of the period since the Unix
+// epoch, otherwise the first one is scheduled now.
+func newSchedule(period time.Duration, config mb.ScheduleConfig, now time.Time) *schedule {
+	s := &schedule{
+		period: period,
+		jitter: config.Jitter,
+		next:   now,
+		random: rand.Int63n,
+	}
+	if config.Align {
+		s.next = time.Unix(0, now.UnixNano()-now.UnixNano()%int64(period))
+	}
+	return s
+}
+
+// First returns the scheduled time of the first fetch, and how long to wait
+// for it from now, including the random jitter. Aligned fetches wait for the
+// next boundary of the period.
+func (s *schedule) First(now time.Time) (scheduled time.Time, wait time.Duration) {
+	if s.next.Before(now) {
+		s.next = s.next.Add(s.period)
+	}
+	return s.next, s.next.Sub(now) + s.randomJitter()
+}
+
+// Next returns the scheduled time of the next fetch, and how long to wait
+// for it from now, including the random jitter. Fetches that should have
+// been done already are skipped, as a time.Ticker does.
+func (s *schedule) Next(now time.Time) (scheduled time.Time, wait time.Duration) {
+	s.next = s.next.Add(s.period)
+	if !s.next.After(now) {
+		missed := now.Sub(s.next)/s.period + 1
+		s.next = s.next.Add(missed * s.period)
+	}
+
+	return s.next, s.next.Sub(now) + s.randomJitter()
+}
+
+func (s *schedule) randomJitter() time.Duration {
+	if s.jitter <= 0 {
+		return 0
+	}
+	return time.Duration(s.random(int64(s.jitter)))
+}
@@ -0,0 +1,120 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package module
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/v7/metricbeat/mb"
+)
+
+func TestSchedule(t *testing.T) {
+	start := time.Date(2021, 1, 1, 10, 0, 25, 0, time.UTC)
+
+	t.Run("from start", func(t *testing.T) {
+		s := newSchedule(time.Minute, mb.ScheduleConfig{}, start)
+
+		scheduled, wait := s.Next(start.Add(2 * time.Second))
+		assert.Equal(t, start.Add(time.Minute), scheduled)
+		assert.Equal(t, 58*time.Second, wait)
+	})
+
+	t.Run("aligned", func(t *testing.T) {
+		s := newSchedule(time.Minute, mb.ScheduleConfig{Align: true}, start)
+
+		scheduled, wait := s.Next(start.Add(2 * time.Second))
+		assert.Equal(t, time.Date(2021, 1, 1, 10, 1, 0, 0, time.UTC), scheduled.UTC())
+		assert.Equal(t, 33*time.Second, wait)
+
+		scheduled, wait = s.Next(scheduled.Add(time.Second))
+		assert.Equal(t, time.Date(2021, 1, 1, 10, 2, 0, 0, time.UTC), scheduled.UTC())
+		assert.Equal(t, 59*time.Second, wait)
+	})
+
+	t.Run("aligned to epoch", func(t *testing.T) {
+		s := newSchedule(7*time.Minute, mb.ScheduleConfig{Align: true}, start)
+
+		scheduled, _ := s.Next(start)
+		assert.Zero(t, scheduled.Unix()%(7*60))
+		assert.True(t, scheduled.After(start))
+		assert.True(t, scheduled.Sub(start) <= 7*time.Minute)
+	})
+
+	t.Run("first fetch", func(t *testing.T) {
+		s := newSchedule(time.Minute, mb.ScheduleConfig{}, start)
+		scheduled, wait := s.First(start)
+		assert.Equal(t, start, scheduled)
+		assert.Zero(t, wait)
+
+		s = newSchedule(time.Minute, mb.ScheduleConfig{Align: true}, start)
+		scheduled, wait = s.First(start)
+		assert.Equal(t, time.Date(2021, 1, 1, 10, 1, 0, 0, time.UTC), scheduled.UTC())
+		assert.Equal(t, 35*time.Second, wait)
+
+		scheduled, _ = s.Next(scheduled)
+		assert.Equal(t, time.Date(2021, 1, 1, 10, 2, 0, 0, time.UTC), scheduled.UTC())
+
+		// Starting on a boundary fetches immediately.
+		boundary := time.Date(2021, 1, 1, 10, 0, 0, 0, time.UTC)
+		s = newSchedule(time.Minute, mb.ScheduleConfig{Align: true}, boundary)
+		scheduled, wait = s.First(boundary)
+		assert.Equal(t, boundary, scheduled.UTC())
+		assert.Zero(t, wait)
+
+		s = newSchedule(time.Minute, mb.ScheduleConfig{Jitter: 10 * time.Second}, start)
+		s.random = func(n int64) int64 { return int64(4 * time.Second) }
+		scheduled, wait = s.First(start)
+		assert.Equal(t, start, scheduled)
+		assert.Equal(t, 4*time.Second, wait)
+	})
+
+	t.Run("skip missed fetches", func(t *testing.T) {
+		s := newSchedule(time.Minute, mb.ScheduleConfig{Align: true}, start)
+
+		// The previous fetch took longer than two periods.
+		scheduled, wait := s.Next(time.Date(2021, 1, 1, 10, 2, 10, 0, time.UTC))
+		assert.Equal(t, time.Date(2021, 1, 1, 10, 3, 0, 0, time.UTC), scheduled.UTC())
+		assert.Equal(t, 50*time.Second, wait)
+
+		// A fetch exactly on the boundary schedules the next one.
+		scheduled, wait = s.Next(scheduled)
+		assert.Equal(t, time.Date(2021, 1, 1, 10, 4, 0, 0, time.UTC), scheduled.UTC())
+		assert.Equal(t, time.Minute, wait)
+	})
+
+	t.Run("jitter", func(t *testing.T) {
+		s := newSchedule(time.Minute, mb.ScheduleConfig{Align: true, Jitter: 10 * time.Second}, start)
+		s.random = func(n int64) int64 {
+			assert.Equal(t, int64(10*time.Second), n)
+			return int64(4 * time.Second)
+		}
+
+		scheduled, wait := s.Next(start)
+		assert.Equal(t, time.Date(2021, 1, 1, 10, 1, 0, 0, time.UTC), scheduled.UTC())
+		assert.Equal(t, 39*time.Second, wait)
+
+		// The jitter doesn't move the schedule, the fetch started at 10:01:04
+		// and took one second.
+		scheduled, wait = s.Next(scheduled.Add(5 * time.Second))
+		assert.Equal(t, time.Date(2021, 1, 1, 10, 2, 0, 0, time.UTC), scheduled.UTC())
+		assert.Equal(t, 59*time.Second, wait)
+	})
+}
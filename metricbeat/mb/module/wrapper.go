@@ -24,9 +24,12 @@ import (
 	"sync"
 	"time"
 
+	"github.com/pkg/errors"
+
 	"github.com/elastic/beats/v7/libbeat/beat"
 	"github.com/elastic/beats/v7/libbeat/common"
 	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/mapping"
 	"github.com/elastic/beats/v7/libbeat/monitoring"
 	"github.com/elastic/beats/v7/libbeat/testing"
 	"github.com/elastic/beats/v7/metricbeat/mb"
@@ -57,14 +60,16 @@ type Wrapper struct {
 	// Options
 	maxStartDelay  time.Duration
 	eventModifiers []mb.EventModifier
+	countersBeat   string // Beat whose fields declare the counters.
 }
 
 // metricSetWrapper contains the MetricSet and the private data associated with
 // running the MetricSet. It contains a pointer to the parent Module.
 type metricSetWrapper struct {
 	mb.MetricSet
-	module *Wrapper // Parent Module.
-	stats  *stats   // stats for this MetricSet.
+	module   *Wrapper  // Parent Module.
+	stats    *stats    // stats for this MetricSet.
+	counters *counters // Rate and delta of the counters, nil if disabled.
 
 	periodic bool // Set to true if this metricset is a periodic fetcher
 }
@@ -102,12 +107,28 @@ func createWrapper(module mb.Module, metricSets []mb.MetricSet, options ...Optio
 		applyOption(wrapper)
 	}
 
+	var fields mapping.Fields
+	if wrapper.countersBeat != "" && module.Config().Counters.Enabled {
+		var err error
+		fields, err = getBeatFields(wrapper.countersBeat)
+		if err != nil {
+			return nil, errors.Wrap(err, "loading fields to compute counters")
+		}
+	}
+
 	for i, metricSet := range metricSets {
 		wrapper.metricSets[i] = &metricSetWrapper{
 			MetricSet: metricSet,
 			module:    wrapper,
 			stats:     getMetricSetStats(wrapper.Name(), metricSet.Name()),
 		}
+		if fields != nil {
+			namespace := metricSet.Registration().Namespace
+			if namespace == "" {
+				namespace = wrapper.Name() + "." + metricSet.Name()
+			}
+			wrapper.metricSets[i].counters = newCounters(fields, namespace, module.Config().Period)
+		}
 	}
 	return wrapper, nil
 }
@@ -141,6 +162,11 @@ func (mw *Wrapper) Start(done <-chan struct{}) <-chan beat.Event {
 			defer wg.Done()
 			defer msw.close()
 
+			if msw.counters != nil {
+				msw.counters.cache.StartJanitor(msw.Module().Config().Period)
+				defer msw.counters.cache.StopJanitor()
+			}
+
 			registry.Add(metricsPath, msw.Metrics(), monitoring.Full)
 			monitoring.NewString(msw.Metrics(), "starttime").Set(common.Time(time.Now()).String())
 
@@ -213,26 +239,33 @@ func (msw *metricSetWrapper) run(done <-chan struct{}, out chan<- beat.Event) {
 	}
 }
 
-// startPeriodicFetching performs an immediate fetch for the MetricSet then it
-// begins a continuous timer scheduled loop to fetch data. To stop the loop the
-// done channel should be closed.
+// startPeriodicFetching begins a continuous timer scheduled loop to fetch data
+// for the MetricSet. The first fetch is immediate, unless the schedule aligns
+// fetches to the period or adds a jitter. To stop the loop the done channel
+// should be closed.
 func (msw *metricSetWrapper) startPeriodicFetching(ctx context.Context, reporter reporter) {
 	// Indicate that it has been started as periodic fetcher
 	msw.periodic = true
 
-	// Fetch immediately.
-	msw.fetch(ctx, reporter)
+	config := msw.Module().Config()
+	now := time.Now()
+	schedule := newSchedule(config.Period, config.Schedule, now)
 
-	// Start timer for future fetches.
-	t := time.NewTicker(msw.Module().Config().Period)
-	defer t.Stop()
+	scheduled, wait := schedule.First(now)
 	for {
-		select {
-		case <-reporter.V2().Done():
-			return
-		case <-t.C:
-			msw.fetch(ctx, reporter)
+		if wait > 0 {
+			t := time.NewTimer(wait)
+			select {
+			case <-reporter.V2().Done():
+				t.Stop()
+				return
+			case <-t.C:
+			}
 		}
+		reporter.SetScheduledTime(scheduled)
+		msw.fetch(ctx, reporter)
+
+		scheduled, wait = schedule.Next(time.Now())
 	}
 }
 
@@ -313,6 +346,7 @@ func (msw *metricSetWrapper) Test(d testing.Driver) {
 
 type reporter interface {
 	StartFetchTimer()
+	SetScheduledTime(scheduled time.Time)
 	V1() mb.PushReporter
 	V2() mb.PushReporterV2
 }
@@ -321,15 +355,20 @@ type reporter interface {
 // used by MetricSet implementations to report an event(s), an error, or an error
 // with some additional metadata.
 type eventReporter struct {
-	msw   *metricSetWrapper
-	done  <-chan struct{}
-	out   chan<- beat.Event
-	start time.Time // Start time of the current fetch (or zero for push sources).
+	msw       *metricSetWrapper
+	done      <-chan struct{}
+	out       chan<- beat.Event
+	start     time.Time // Start time of the current fetch (or zero for push sources).
+	scheduled time.Time // Scheduled time of the current fetch (or zero for push sources).
 }
 
 // startFetchTimer demarcates the start of a new fetch. The elapsed time of a
 // fetch is computed based on the time of this call.
 func (r *eventReporter) StartFetchTimer() { r.start = time.Now() }
+
+// SetScheduledTime sets the time the next fetch was scheduled for.
+func (r *eventReporter) SetScheduledTime(scheduled time.Time) { r.scheduled = scheduled }
+
 func (r *eventReporter) V1() mb.PushReporter {
 	return reporterV1{v2: r.V2(), module: r.msw.module.Name()}
 }
@@ -382,6 +421,10 @@ func (r reporterV2) Event(event mb.Event) bool {
 	if r.msw.periodic {
 		event.Period = r.msw.Module().Config().Period
 	}
+	if r.msw.Module().Config().Schedule.Metadata && !r.scheduled.IsZero() {
+		event.ScheduledTime = r.scheduled
+		event.FetchTime = r.start
+	}
 
 	if event.Timestamp.IsZero() {
 		if !r.start.IsZero() {
@@ -405,6 +448,11 @@ func (r reporterV2) Event(event mb.Event) bool {
 		event.Namespace = r.msw.Registration().Namespace
 	}
 	beatEvent := event.BeatEvent(r.msw.module.Name(), r.msw.MetricSet.Name(), r.msw.module.eventModifiers...)
+	if r.msw.counters != nil && event.Error == nil {
+		if err := r.msw.counters.process(event.Host, &beatEvent); err != nil {
+			debugf("Failed to compute counters of %s: %v", r.msw, err)
+		}
+	}
 	if !writeEvent(r.done, r.out, beatEvent) {
 		return false
 	}
@@ -258,6 +258,36 @@ func TestPeriodIsAddedToEvent(t *testing.T) {
 	}
 }
 
+func TestScheduleIsAddedToEvent(t *testing.T) {
+	for _, metadata := range []bool{true, false} {
+		config := newConfig(t, map[string]interface{}{
+			"module":            moduleName,
+			"metricsets":        []string{eventFetcherName},
+			"hosts":             []string{"alpha"},
+			"schedule.align":    true,
+			"schedule.metadata": metadata,
+		})
+
+		m, err := module.NewWrapper(config, newTestRegistry(t), module.WithMetricSetInfo())
+		require.NoError(t, err)
+
+		done := make(chan struct{})
+		output := m.Start(done)
+		event := <-output
+		close(done)
+
+		scheduled, err := event.Fields.GetValue("metricset.scheduled_time")
+		if !metadata {
+			assert.Error(t, err, "unexpected metricset.scheduled_time in event %+v", event)
+			continue
+		}
+		require.NoError(t, err)
+		fetched, err := event.Fields.GetValue("metricset.fetch_time")
+		require.NoError(t, err)
+		assert.False(t, fetched.(time.Time).Before(scheduled.(time.Time)))
+	}
+}
+
 func TestNewWrapperForMetricSet(t *testing.T) {
 	hosts := []string{"alpha"}
 	c := newConfig(t, map[string]interface{}{
@@ -0,0 +1,36 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Code generated by beats/dev-tools/cmd/asset/asset.go - DO NOT EDIT.
+
+package influxdb
+
+import (
+	"github.com/elastic/beats/v7/libbeat/asset"
+)
+
+func init() {
+	if err := asset.SetFields("metricbeat", "influxdb", asset.ModuleFieldsPri, AssetInfluxdb); err != nil {
+		panic(err)
+	}
+}
+
+// AssetInfluxdb returns asset data.
+// This is the base64 encoded gzipped contents of module/influxdb.
+func AssetInfluxdb() string {
+	return "eJysVMGu0zAQvOcrRuGGXvsBOXBACInDExy4PznxpF2eY1vr7Sv9e9SmeSQE0YJwctrsTGa8mt3gmacGEvtw+O7bCjCxwAb1p0vpw/u6AjxLp5JNUmzwrgKAR5pKV6DsKC/0kAjbExMMQSKRNVnqUthWgDLQFTZoaa4CemHwpbmwbRDdwIWOc9lOmQ12mg75WpmD5sCBrhyUA6O9fpvwzzwdk/pZ/Td+pufxJxFSf7GUk0Qrk0G+MNr2FfMGn9VTIQUy5KTmomFP5QOCaxkKjhICBmfdHr1osZX2sW/7diU8td/Yzf2Mhad/sPXV7crSz3alYxhH+pdCnrJTN8xGcn43844GPh3awEXHkmNwOUvcXdvrkOKu/p+Eo4A/Uq6v8wZnMZUbMtuUAl28n/MKqO8b6sdLGJZjfRjjQo/2NE/FetxHFZtf4TpsN37/ZczFmccYYem6FFo6u70OpvPrWphOLwy+NNWPAQCc9lgO"
+}
@@ -0,0 +1,56 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package write
+
+import (
+	"github.com/elastic/beats/v7/metricbeat/helper/server/udp"
+)
+
+// The HTTP server settings (host, port and ssl) are read by the HTTP server
+// helper from the root of the module settings.
+type config struct {
+	UDP udpConfig `config:"udp"`
+}
+
+// udpConfig contains the settings of the optional UDP listener. Points
+// received by UDP have no request to set their precision, so it is
+// configured here.
+type udpConfig struct {
+	udp.UdpConfig `config:",inline"`
+	Enabled       bool   `config:"enabled"`
+	Precision     string `config:"precision"`
+}
+
+func defaultConfig() config {
+	return config{
+		UDP: udpConfig{
+			UdpConfig: udp.UdpConfig{
+				Host:              "localhost",
+				Port:              8089,
+				ReceiveBufferSize: 65536,
+			},
+			Precision: "ns",
+		},
+	}
+}
+
+// Validate checks that the precision of the UDP listener is valid.
+func (c *udpConfig) Validate() error {
+	_, err := parsePrecision(c.Precision)
+	return err
+}
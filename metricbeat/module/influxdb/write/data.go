@@ -0,0 +1,74 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package write
+
+import (
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/metricbeat/mb"
+)
+
+// pointsToEvents groups the fields of the points with the same measurement,
+// tags and timestamp in a single event. Points without timestamp are reported
+// with the time they were received.
+func pointsToEvents(points []point, received time.Time) []mb.Event {
+	var events []mb.Event
+	index := map[string]int{}
+
+	for _, p := range points {
+		timestamp := p.timestamp
+		if timestamp.IsZero() {
+			timestamp = received
+		}
+
+		labels := common.MapStr{}
+		for k, v := range p.tags {
+			labels[k] = v
+		}
+
+		// join points with same measurement, tags and timestamp in a single event
+		key := p.measurement + labels.String() + timestamp.String()
+		i, found := index[key]
+		if !found {
+			event := mb.Event{
+				ModuleFields: common.MapStr{
+					"measurement": p.measurement,
+					"metrics": common.MapStr{
+						p.measurement: common.MapStr{},
+					},
+				},
+				Timestamp: timestamp,
+			}
+			if len(labels) > 0 {
+				event.ModuleFields["labels"] = labels
+			}
+
+			i = len(events)
+			index[key] = i
+			events = append(events, event)
+		}
+
+		metrics := events[i].ModuleFields["metrics"].(common.MapStr)[p.measurement].(common.MapStr)
+		for k, v := range p.fields {
+			metrics[k] = v
+		}
+	}
+
+	return events
+}
@@ -0,0 +1,222 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package write
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// point is a point of the InfluxDB line protocol:
+//
+//	<measurement>[,<tag key>=<tag value>...] <field key>=<field value>[,<field key>=<field value>...] [timestamp]
+type point struct {
+	measurement string
+	tags        map[string]string
+	fields      map[string]interface{}
+	timestamp   time.Time // Zero if the point has no timestamp.
+}
+
+// precisions are the durations of a unit of the timestamps for each precision.
+var precisions = map[string]time.Duration{
+	"":   time.Nanosecond,
+	"n":  time.Nanosecond,
+	"ns": time.Nanosecond,
+	"u":  time.Microsecond,
+	"us": time.Microsecond,
+	"µ":  time.Microsecond,
+	"ms": time.Millisecond,
+	"s":  time.Second,
+	"m":  time.Minute,
+	"h":  time.Hour,
+}
+
+// parsePrecision returns the duration of a unit of the timestamps with the
+// given precision.
+func parsePrecision(precision string) (time.Duration, error) {
+	unit, found := precisions[precision]
+	if !found {
+		return 0, errors.Errorf("invalid precision '%s'", precision)
+	}
+	return unit, nil
+}
+
+// parsePoints parses the points in the lines of data. Empty lines and comments
+// are skipped. The points of valid lines are returned even if other lines are
+// invalid, in that case the error of the first invalid line is also returned.
+func parsePoints(data []byte, unit time.Duration) ([]point, error) {
+	var points []point
+	var firstErr error
+	for i, line := range bytes.Split(data, []byte("\n")) {
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 || line[0] == '#' {
+			continue
+		}
+
+		p, err := parsePoint(string(line), unit)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = errors.Wrapf(err, "unable to parse line %d '%s'", i+1, line)
+			}
+			continue
+		}
+		points = append(points, p)
+	}
+	return points, firstErr
+}
+
+func parsePoint(line string, unit time.Duration) (point, error) {
+	p := point{}
+
+	keyEnd := indexUnescaped(line, ' ', false)
+	if keyEnd < 0 {
+		return p, errors.New("missing fields")
+	}
+	key := line[:keyEnd]
+	rest := strings.TrimLeft(line[keyEnd:], " ")
+
+	fieldsEnd := indexUnescaped(rest, ' ', true)
+	if fieldsEnd < 0 {
+		fieldsEnd = len(rest)
+	}
+	fields := rest[:fieldsEnd]
+	timestamp := strings.TrimSpace(rest[fieldsEnd:])
+
+	keyParts := splitUnescaped(key, ',', false)
+	p.measurement = unescape(keyParts[0], ", ")
+	if p.measurement == "" {
+		return p, errors.New("missing measurement")
+	}
+	if len(keyParts) > 1 {
+		p.tags = make(map[string]string, len(keyParts)-1)
+		for _, tag := range keyParts[1:] {
+			i := indexUnescaped(tag, '=', false)
+			if i <= 0 || i == len(tag)-1 {
+				return p, errors.Errorf("invalid tag '%s'", tag)
+			}
+			p.tags[unescape(tag[:i], ",= ")] = unescape(tag[i+1:], ",= ")
+		}
+	}
+
+	if fields == "" {
+		return p, errors.New("missing fields")
+	}
+	p.fields = map[string]interface{}{}
+	for _, field := range splitUnescaped(fields, ',', true) {
+		i := indexUnescaped(field, '=', false)
+		if i <= 0 {
+			return p, errors.Errorf("invalid field '%s'", field)
+		}
+		value, err := parseFieldValue(field[i+1:])
+		if err != nil {
+			return p, errors.Wrapf(err, "invalid value of field '%s'", field[:i])
+		}
+		p.fields[unescape(field[:i], ",= ")] = value
+	}
+
+	if timestamp != "" {
+		ts, err := strconv.ParseInt(timestamp, 10, 64)
+		if err != nil {
+			return p, errors.Errorf("invalid timestamp '%s'", timestamp)
+		}
+		p.timestamp = time.Unix(0, ts*int64(unit))
+	}
+
+	return p, nil
+}
+
+// parseFieldValue parses a field value, that can be a float, a signed (123i)
+// or unsigned (123u) integer, a quoted string or a boolean.
+func parseFieldValue(value string) (interface{}, error) {
+	if value == "" {
+		return nil, errors.New("missing value")
+	}
+
+	switch value {
+	case "t", "T", "true", "True", "TRUE":
+		return true, nil
+	case "f", "F", "false", "False", "FALSE":
+		return false, nil
+	}
+
+	switch {
+	case value[0] == '"':
+		if len(value) < 2 || value[len(value)-1] != '"' {
+			return nil, errors.New("unterminated string")
+		}
+		return unescape(value[1:len(value)-1], `"\`), nil
+	case strings.HasSuffix(value, "i"):
+		return strconv.ParseInt(value[:len(value)-1], 10, 64)
+	case strings.HasSuffix(value, "u"):
+		return strconv.ParseUint(value[:len(value)-1], 10, 64)
+	default:
+		return strconv.ParseFloat(value, 64)
+	}
+}
+
+// indexUnescaped returns the index of the first occurrence of c that is not
+// escaped with a backslash, or -1 if there is none. If quoted is true,
+// occurrences between double quotes are also ignored.
+func indexUnescaped(s string, c byte, quoted bool) int {
+	inQuotes := false
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\\':
+			i++
+		case quoted && s[i] == '"':
+			inQuotes = !inQuotes
+		case s[i] == c && !inQuotes:
+			return i
+		}
+	}
+	return -1
+}
+
+// splitUnescaped splits s in the occurrences of sep that are not escaped.
+func splitUnescaped(s string, sep byte, quoted bool) []string {
+	var parts []string
+	for {
+		i := indexUnescaped(s, sep, quoted)
+		if i < 0 {
+			return append(parts, s)
+		}
+		parts = append(parts, s[:i])
+		s = s[i+1:]
+	}
+}
+
+// unescape removes the backslashes that escape any of the given characters.
+// Other backslashes are kept, as the line protocol does.
+func unescape(s string, escaped string) string {
+	if strings.IndexByte(s, '\\') < 0 {
+		return s
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) && strings.IndexByte(escaped, s[i+1]) >= 0 {
+			i++
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
@@ -0,0 +1,168 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package write
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePoint(t *testing.T) {
+	for _, test := range []struct {
+		line     string
+		unit     time.Duration
+		expected point
+		err      bool
+	}{
+		{
+			line: "cpu usage=12.5",
+			expected: point{
+				measurement: "cpu",
+				fields:      map[string]interface{}{"usage": 12.5},
+			},
+		},
+		{
+			line: "cpu,host=web-1,region=eu usage_user=12.5,count=3i,total=42u,up=t,down=FALSE,state=\"ok\" 1609459200000000000",
+			expected: point{
+				measurement: "cpu",
+				tags:        map[string]string{"host": "web-1", "region": "eu"},
+				fields: map[string]interface{}{
+					"usage_user": 12.5,
+					"count":      int64(3),
+					"total":      uint64(42),
+					"up":         true,
+					"down":       false,
+					"state":      "ok",
+				},
+				timestamp: time.Unix(1609459200, 0),
+			},
+		},
+		{
+			line: "cpu usage=1 1609459200",
+			unit: time.Second,
+			expected: point{
+				measurement: "cpu",
+				fields:      map[string]interface{}{"usage": float64(1)},
+				timestamp:   time.Unix(1609459200, 0),
+			},
+		},
+		{
+			line: "cpu usage=1 1609459200123",
+			unit: time.Millisecond,
+			expected: point{
+				measurement: "cpu",
+				fields:      map[string]interface{}{"usage": float64(1)},
+				timestamp:   time.Unix(1609459200, 123000000),
+			},
+		},
+		{ // escaped characters
+			line: `disk\ io,mount\=point=/var\,log,dev\ name=sda read\ bytes=1,msg="say \"hi\", a=b c\\" 1`,
+			expected: point{
+				measurement: "disk io",
+				tags:        map[string]string{"mount=point": "/var,log", "dev name": "sda"},
+				fields: map[string]interface{}{
+					"read bytes": float64(1),
+					"msg":        `say "hi", a=b c\`,
+				},
+				timestamp: time.Unix(0, 1),
+			},
+		},
+		{ // other backslashes are kept
+			line: `path,dir=C:\Temp value="C:\Temp"`,
+			expected: point{
+				measurement: "path",
+				tags:        map[string]string{"dir": `C:\Temp`},
+				fields:      map[string]interface{}{"value": `C:\Temp`},
+			},
+		},
+		{ // several spaces between sections
+			line: "cpu   usage=1   10",
+			expected: point{
+				measurement: "cpu",
+				fields:      map[string]interface{}{"usage": float64(1)},
+				timestamp:   time.Unix(0, 10),
+			},
+		},
+		/// errors
+		{line: "cpu", err: true},
+		{line: ",host=a usage=1", err: true},
+		{line: "cpu,host usage=1", err: true},
+		{line: "cpu usage", err: true},
+		{line: "cpu usage=", err: true},
+		{line: "cpu usage=abc", err: true},
+		{line: "cpu usage=1.5i", err: true},
+		{line: "cpu usage=-1u", err: true},
+		{line: `cpu msg="unterminated`, err: true},
+		{line: "cpu usage=1 yesterday", err: true},
+	} {
+		unit := test.unit
+		if unit == 0 {
+			unit = time.Nanosecond
+		}
+		p, err := parsePoint(test.line, unit)
+		if test.err {
+			assert.Error(t, err, test.line)
+			continue
+		}
+		if assert.NoError(t, err, test.line) {
+			assert.Equal(t, test.expected.measurement, p.measurement, test.line)
+			assert.Equal(t, test.expected.tags, p.tags, test.line)
+			assert.Equal(t, test.expected.fields, p.fields, test.line)
+			assert.True(t, test.expected.timestamp.Equal(p.timestamp), "%s: %v", test.line, p.timestamp)
+		}
+	}
+}
+
+func TestParsePoints(t *testing.T) {
+	data := []byte(`
+# comment
+cpu usage=1
+cpu usage=bad
+mem used=2i
+disk
+`)
+
+	points, err := parsePoints(data, time.Nanosecond)
+	require.Len(t, points, 2)
+	assert.Equal(t, "cpu", points[0].measurement)
+	assert.Equal(t, "mem", points[1].measurement)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "unable to parse line 4 'cpu usage=bad'")
+	}
+}
+
+func TestParsePrecision(t *testing.T) {
+	for precision, unit := range map[string]time.Duration{
+		"":   time.Nanosecond,
+		"ns": time.Nanosecond,
+		"u":  time.Microsecond,
+		"ms": time.Millisecond,
+		"s":  time.Second,
+		"h":  time.Hour,
+	} {
+		actual, err := parsePrecision(precision)
+		assert.NoError(t, err, precision)
+		assert.Equal(t, unit, actual, precision)
+	}
+
+	_, err := parsePrecision("d")
+	assert.Error(t, err)
+}
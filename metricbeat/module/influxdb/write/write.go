@@ -0,0 +1,186 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package write
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+
+	serverhelper "github.com/elastic/beats/v7/metricbeat/helper/server"
+	httpserver "github.com/elastic/beats/v7/metricbeat/helper/server/http"
+	"github.com/elastic/beats/v7/metricbeat/helper/server/udp"
+	"github.com/elastic/beats/v7/metricbeat/mb"
+	"github.com/elastic/beats/v7/metricbeat/mb/parse"
+)
+
+func init() {
+	mb.Registry.MustAddMetricSet("influxdb", "write", New,
+		mb.WithHostParser(parse.EmptyHostParser),
+		mb.DefaultMetricSet(),
+	)
+}
+
+// MetricSet receives points in the InfluxDB line protocol by HTTP, as
+// InfluxDB does in its write endpoints, and optionally by UDP.
+type MetricSet struct {
+	mb.BaseMetricSet
+	server    serverhelper.Server
+	udpServer serverhelper.Server // nil if UDP is not enabled.
+	udpUnit   time.Duration
+	events    chan mb.Event
+}
+
+// New creates a new instance of the MetricSet.
+func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
+	config := defaultConfig()
+	err := base.Module().UnpackConfig(&config)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &MetricSet{
+		BaseMetricSet: base,
+		events:        make(chan mb.Event),
+	}
+
+	m.server, err = httpserver.NewHttpServerWithHandler(base, m.handleFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	if config.UDP.Enabled {
+		m.udpServer, err = udp.NewUdpServerWithConfig(config.UDP.UdpConfig)
+		if err != nil {
+			return nil, err
+		}
+		m.udpUnit, _ = parsePrecision(config.UDP.Precision)
+	}
+
+	return m, nil
+}
+
+// Run starts the servers and reports the events generated from the points
+// they receive.
+func (m *MetricSet) Run(reporter mb.PushReporterV2) {
+	m.server.Start()
+
+	var udpEvents chan serverhelper.Event
+	if m.udpServer != nil {
+		if err := m.udpServer.Start(); err != nil {
+			m.Logger().Error(err)
+			reporter.Error(err)
+		} else {
+			udpEvents = m.udpServer.GetEvents()
+		}
+	}
+
+	for {
+		select {
+		case <-reporter.Done():
+			m.server.Stop()
+			if udpEvents != nil {
+				m.udpServer.Stop()
+			}
+			return
+		case e := <-m.events:
+			reporter.Event(e)
+		case msg := <-udpEvents:
+			data, _ := msg.GetEvent()[serverhelper.EventDataKey].([]byte)
+			points, err := parsePoints(data, m.udpUnit)
+			if err != nil {
+				reporter.Error(err)
+			}
+			for _, e := range pointsToEvents(points, time.Now()) {
+				reporter.Event(e)
+			}
+		}
+	}
+}
+
+func (m *MetricSet) handleFunc(writer http.ResponseWriter, req *http.Request) {
+	switch req.URL.Path {
+	case "/ping":
+		// Used by clients to check that the server is up.
+		writer.WriteHeader(http.StatusNoContent)
+		return
+	case "/write", "/api/v2/write":
+	default:
+		writeError(writer, http.StatusNotFound, errors.Errorf("unknown path %s", req.URL.Path))
+		return
+	}
+
+	if req.Method != http.MethodPost {
+		writeError(writer, http.StatusMethodNotAllowed, errors.Errorf("method %s not allowed", req.Method))
+		return
+	}
+
+	unit, err := parsePrecision(req.URL.Query().Get("precision"))
+	if err != nil {
+		writeError(writer, http.StatusBadRequest, err)
+		return
+	}
+
+	var body io.Reader = req.Body
+	if req.Header.Get("Content-Encoding") == "gzip" {
+		gzipReader, err := gzip.NewReader(req.Body)
+		if err != nil {
+			writeError(writer, http.StatusBadRequest, errors.Wrap(err, "invalid gzip content"))
+			return
+		}
+		defer gzipReader.Close()
+		body = gzipReader
+	}
+
+	data, err := ioutil.ReadAll(body)
+	if err != nil {
+		m.Logger().Errorf("Read error %v", err)
+		writeError(writer, http.StatusInternalServerError, err)
+		return
+	}
+
+	// The valid points are reported even if some lines are invalid, as
+	// InfluxDB does with partial writes.
+	points, parseErr := parsePoints(data, unit)
+	for _, e := range pointsToEvents(points, time.Now()) {
+		select {
+		case <-req.Context().Done():
+			return
+		case m.events <- e:
+		}
+	}
+
+	if parseErr != nil {
+		m.Logger().Debugf("Partial write: %v", parseErr)
+		writeError(writer, http.StatusBadRequest, errors.Wrap(parseErr, "partial write"))
+		return
+	}
+	writer.WriteHeader(http.StatusNoContent)
+}
+
+// writeError writes an error in the format of InfluxDB responses.
+func writeError(writer http.ResponseWriter, status int, err error) {
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(status)
+	json.NewEncoder(writer).Encode(map[string]string{"error": err.Error()})
+}
@@ -0,0 +1,216 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package write
+
+import (
+	"bytes"
+	"compress/gzip"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/metricbeat/mb"
+	mbtest "github.com/elastic/beats/v7/metricbeat/mb/testing"
+)
+
+func TestPointsToEvents(t *testing.T) {
+	ts := time.Unix(1609459200, 0)
+	received := time.Unix(1609459300, 0)
+	points := []point{
+		{measurement: "cpu", tags: map[string]string{"host": "a"}, fields: map[string]interface{}{"user": 1.5}, timestamp: ts},
+		{measurement: "cpu", tags: map[string]string{"host": "a"}, fields: map[string]interface{}{"idle": 97.0}, timestamp: ts},
+		{measurement: "cpu", tags: map[string]string{"host": "b"}, fields: map[string]interface{}{"user": 2.5}, timestamp: ts},
+		{measurement: "mem", tags: map[string]string{"host": "a"}, fields: map[string]interface{}{"used": int64(10)}, timestamp: ts},
+		{measurement: "cpu", tags: map[string]string{"host": "a"}, fields: map[string]interface{}{"user": 1.7}, timestamp: ts.Add(time.Second)},
+		{measurement: "uptime", fields: map[string]interface{}{"seconds": int64(42)}},
+	}
+
+	events := pointsToEvents(points, received)
+	require.Len(t, events, 5)
+
+	assert.Equal(t, common.MapStr{
+		"measurement": "cpu",
+		"labels":      common.MapStr{"host": "a"},
+		"metrics": common.MapStr{
+			"cpu": common.MapStr{"user": 1.5, "idle": 97.0},
+		},
+	}, events[0].ModuleFields)
+	assert.Equal(t, ts, events[0].Timestamp)
+
+	assert.Equal(t, common.MapStr{"host": "b"}, events[1].ModuleFields["labels"])
+	assert.Equal(t, "mem", events[2].ModuleFields["measurement"])
+	assert.Equal(t, ts.Add(time.Second), events[3].Timestamp)
+
+	assert.Equal(t, common.MapStr{
+		"measurement": "uptime",
+		"metrics": common.MapStr{
+			"uptime": common.MapStr{"seconds": int64(42)},
+		},
+	}, events[4].ModuleFields)
+	assert.Equal(t, received, events[4].Timestamp)
+}
+
+func TestHandleWrite(t *testing.T) {
+	m := newTestMetricSet(t)
+
+	body := "cpu,host=a user=1.5 1609459200\ncpu,host=a idle=97 1609459200\n"
+	rec, events := handle(m, httptest.NewRequest("POST", "/write?db=telegraf&precision=s", strings.NewReader(body)))
+
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	require.Len(t, events, 1)
+	assert.Equal(t, time.Unix(1609459200, 0), events[0].Timestamp)
+	assert.Equal(t, common.MapStr{"user": 1.5, "idle": float64(97)}, events[0].ModuleFields["metrics"].(common.MapStr)["cpu"])
+}
+
+func TestData(t *testing.T) {
+	m := newTestMetricSet(t)
+
+	body := "cpu,host=web-1,region=eu usage_user=12.5,usage_system=3.1,usage_idle=84.4 1609459200000000000\n"
+	_, events := handle(m, httptest.NewRequest("POST", "/write", strings.NewReader(body)))
+	require.Len(t, events, 1)
+
+	mbtest.WriteEventToDataJSON(t, mbtest.StandardizeEvent(m, events[0], mb.AddMetricSetInfo), "")
+}
+
+func TestHandleWriteGzip(t *testing.T) {
+	m := newTestMetricSet(t)
+
+	var body bytes.Buffer
+	w := gzip.NewWriter(&body)
+	w.Write([]byte("cpu user=1.5 1609459200000\n"))
+	w.Close()
+
+	req := httptest.NewRequest("POST", "/api/v2/write?precision=ms", &body)
+	req.Header.Set("Content-Encoding", "gzip")
+	rec, events := handle(m, req)
+
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	require.Len(t, events, 1)
+	assert.Equal(t, time.Unix(1609459200, 0), events[0].Timestamp)
+}
+
+func TestHandlePartialWrite(t *testing.T) {
+	m := newTestMetricSet(t)
+
+	rec, events := handle(m, httptest.NewRequest("POST", "/write", strings.NewReader("cpu user=1.5\ncpu user=\n")))
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"error":"partial write: unable to parse line 2 'cpu user='`)
+	assert.Len(t, events, 1)
+}
+
+func TestHandleErrors(t *testing.T) {
+	m := newTestMetricSet(t)
+
+	for _, test := range []struct {
+		req    *http.Request
+		status int
+	}{
+		{httptest.NewRequest("GET", "/ping", nil), http.StatusNoContent},
+		{httptest.NewRequest("GET", "/write", nil), http.StatusMethodNotAllowed},
+		{httptest.NewRequest("POST", "/query", strings.NewReader("")), http.StatusNotFound},
+		{httptest.NewRequest("POST", "/write?precision=d", strings.NewReader("cpu user=1")), http.StatusBadRequest},
+	} {
+		rec, events := handle(m, test.req)
+		assert.Equal(t, test.status, rec.Code, test.req.URL.String())
+		assert.Empty(t, events)
+	}
+}
+
+func TestUDP(t *testing.T) {
+	// Find a free port for the UDP listener.
+	conn, err := net.ListenPacket("udp", "localhost:0")
+	require.NoError(t, err)
+	port := conn.LocalAddr().(*net.UDPAddr).Port
+	conn.Close()
+
+	m := mbtest.NewPushMetricSetV2(t, map[string]interface{}{
+		"module":        "influxdb",
+		"metricsets":    []string{"write"},
+		"port":          0,
+		"udp.enabled":   true,
+		"udp.port":      port,
+		"udp.precision": "s",
+	})
+
+	// Send points until the listener is started and they are received.
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		for {
+			select {
+			case <-done:
+				return
+			case <-time.After(100 * time.Millisecond):
+				conn, err := net.Dial("udp", net.JoinHostPort("localhost", strconv.Itoa(port)))
+				if err != nil {
+					continue
+				}
+				conn.Write([]byte("cpu,host=a user=1.5 1609459200\n"))
+				conn.Close()
+			}
+		}
+	}()
+
+	events := mbtest.RunPushMetricSetV2(10*time.Second, 1, m)
+	require.NotEmpty(t, events)
+	assert.Equal(t, time.Unix(1609459200, 0), events[0].Timestamp)
+	assert.Equal(t, common.MapStr{"host": "a"}, events[0].ModuleFields["labels"])
+}
+
+func TestConfigValidate(t *testing.T) {
+	config := defaultConfig()
+	err := common.MustNewConfigFrom(map[string]interface{}{"udp.precision": "d"}).Unpack(&config)
+	assert.Error(t, err)
+}
+
+func newTestMetricSet(t *testing.T) *MetricSet {
+	return mbtest.NewPushMetricSetV2(t, map[string]interface{}{
+		"module":     "influxdb",
+		"metricsets": []string{"write"},
+	}).(*MetricSet)
+}
+
+// handle runs a request through the handler of the metricset, and returns the
+// response and the events it generates.
+func handle(m *MetricSet, req *http.Request) (*httptest.ResponseRecorder, []mb.Event) {
+	var events []mb.Event
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for e := range m.events {
+			events = append(events, e)
+		}
+	}()
+
+	rec := httptest.NewRecorder()
+	m.handleFunc(rec, req)
+	close(m.events)
+	<-done
+
+	m.events = make(chan mb.Event)
+	return rec, events
+}
@@ -32,5 +32,5 @@ func init() {
 // AssetLinux returns asset data.
 // This is the base64 encoded gzipped contents of module/linux.
 func AssetLinux() string {
-	return "eJzEmd9v2zgSx9/zVwwCHNAWVzfp7/rhgNylOBR3uQu27csudoUxObK5pkiVP+y6f/2CpBXLtmQrcaT6pWgkz3z4neHMkH4Oc1qNQQrlv58BOOEkjeE8/v/8DMCQJLQ0hgk5PAPgZJkRpRNajeEfZwCQvguF5l7SGUAuSHI7jo+eg8KCNubDx61KGsPUaF+u/9Jgc2PXrqyjAgpyRjC7flj3UffDtFLOIJvfPWnyFz6766o+LSzh02R8F6QOY31RoFltPWvDOeI6fNbmQOeg8uwOBqxDJ6wTzP49vkMckBltLfzr9iswbcju2GqCroNzo3fZNuRSq2nDwyPw4VMim5Oz0XxJHLgncHojK+QopPCGWsEIjVxlPeFtOEg5I2gD6jQUOCcwWheQawOKlqDVnq410GShB8qKTShwM6pBO5zIduVy7RXvAcd6xsja3Eu5Akto2Ix46/IrGjFVuiHMj5dilkgBSkPIV1EjYi4FEnfivLs9a5DKknFZSErqQ7r/+WJCJmznKqbLGRkCKaxbO6/+SQxwAHWBUpwIuW9+T1E3QwcMldIOJgRRxQZt7gpgzIfMkHVoXA8SxpwHqfXcl0E+wWYwwxjnCcHa76bSpNcNWfGjlpx3IupQSftoHHuWD3WNkLMjQ988WTcqyEzJZiWZzBJr7CS51Lir7VHpvswI1F3+BZewdmkh+uRQkgFLTCuewr4MufnNk0/7KBQfTgvBaNS4jKURjgZeR/T52AvZiseggdjQCmv3aGvr6hCAYZU/jTwqvgYeTVZur5E8GvY/g/Gkem500cw42p2etCnQjWGfbGsBuESxi3ciOC7I4JTAiYLAlqRcnEa2s6ZR8VQQLZkF8UP7dUDVU8o8puxpCcPpvpP0DxS+2qG4mGahMfWDHizDE6GSfE9DGAJlxx3bTB5raM/c0QdIUlM3exToIbflaYkRnglGWTDbU1IkDwk8JEchpBRp+9mncRGfXvz/NL0n3jafgB9Ef0uGkXIBXufxfBvZuTdCTdcD4BZyexN6MkHFl4K7GXgnpPiBwW1c9OatpyO4Tq9bdN6kVzRj3sRpPUzEwsICpQ9egEltY2gvLy7+ttFjb9Sc26KPOXPb7MGrCYeuub4/4GLiP59vapcQ97xrKDEMhnaGppdD1udoOHkJZ2ZvabexNLGIRn8nw4jQ+Nb2k7djMF71Js1XJb55OoBR4B3GQkt0ouF+4XSM2xgaNkM1Dao4rSFH66oCGVffrlLupcwsQ9XHXcvmhB6qTDpQxCNkSqcZLggm4VwcANQhTBuPnpnSnDI2Q9ELblIyFumIZgjjbUyB37NAXGV2N0zuy3415b6UgmE4n4cKspOHFVJBhd66pHm0avnfdG0d7QPHra8dqp1JyocX0LXHMnbgB5fNaci5bG6XWPJR1O6+waoG6jSYH4tmSvl1osNkBcn1MUAuDDE3PGDyK9tv98ppboiGAwveom5huEi/aRzSzhHKAaO7OZwlWkNMoii6RjrSDhfqdtqjYU8vZJTngglSbDUqWfvNpGUoiWdNo2qdukxT6THs5LuihQ1DZQCnNIIrkHpJpvY3EIrHQmlryRPGTeuMn05lapt3dlN9aS/yKZw/R4Lk+6dIUC1/5qfUlKPt1bs0lIvvYzj/Larw+/mh6v4lHAiiFWBaudDqa1U+9Clc/xIRQNYJ7G080aja4vauP440BKcdyn633bGGXlvQ+tepUmvZnojeEm+8aerM3fblDtg3qQsHhkCLUuo0i2xWcYT80K45wt1xt7SMeVtn4K0sOjgpG7r3+P64+YELFDLOz/fNFEPpouTn8lcUMPEOlHaNSdNtQdabUvqe2+Sx9egFGaaLQnRNe045eumarvs6o5+wZa+T+3SXmWvTyFyf0oXKdR8nhwbbhw4ME8/5Ktv5QjtQBzWu0WG6On9RGs1eRA/BQTIWumEsbCkjJyvQhu/ly7GOcn1z1RrkJuYO3JH95iqdfa63j1zHsOpo5xe7PXgbryUHOxJGCkMEbObV3Iat8vKPi2e3V//+mH3+9OvHw2iXg6NddkV7OTjay65orwZHe9UV7fXgaK+7or0ZHO1NV7S3g6O97Yr2bnC0d13R3g+O9r4r2ofB0T50LrnDt4PLtn5QQSnNyY6eNXZ8PfmT9g4PHUh+wWU1cwqtIDb82hQQumpwsDVpnP0VAAD//2DzKvg="
+	return "eJzsm19v28gRwN/1KQYBClwOCWMnd7k7PxRI40NhtImNJnlp0Qqr5UjcarnL7B8puk9fzC5pUTIpMZbIOK4QAolNcuY3f3Z2dpd5DnNcXYAUyn8ZATjhJF7Ak/DzkxGAQYnM4gVM0LERQIqWG1E4odUF/HkEAPFdyHXqJY4ApgJlai/CreegWI5r8fTHrQq8gJnRvih/0yBzLdeurMMccnRGcFverOuo6+FaKWcYn9/eadIHcNcugJ0sdDUJ3wapw1if58ysNu614exRTVcpDvQU1HR8CwPWMSesE9w+C89gCowbbS28vfkEXBu0ow1BjdB18NTobbY1udRq1nBzDzxdBeNzdDaILzCF1CM4vXYrTJmQwhtsBUNm5GrcE96aA5UzAtegTkPO5ghG6xym2oDCJWiFth00SuiBsmITClyGNec5NpHtnptqr9IecKznHK2deilXYJEZnmHaan5FI2ZKG+wBp0oxi6iASYMsXQUfIXcxkKzmMsJctUMqi8aNKSmxD9e99/kEDQ3nKqbLDA2CFNaVyqu/IgPsQF0wKQ6EhP0edRlzwJlS2sEEIYwWTFuxYj6MDVrHjDuMruEBiDkPUuu5L8h9gmeQsRDnCUKpd11p4uMGrfijlpy3TtRUSUf7KvU9Jo47knfNGpSzicHPHq1LcjQztOMCzdgiHzV5byr1huxOrvuYIajb/COVUKq0EHSmUKABi1yrNIZ9Sbn52aOP44iKT4oLwTFpNGNphMOB7Qg6j23IRjwGDcSaVlh7h7ZmVyP3ZgCG9fxh5MHjJXAyWTm0fWH/hYRHr0+NzpsZN9MCqAHImbuAu2QbBrAlE+644GyBhs0QnMgRbIHKEcxW1jR6PBZEi2aBadLIHNNlQK8HhUd1e5A4oN+3kv6eji/fT9hiNqaJqR90kgw/CBXd95SS32U1+N0jtpk81NCeuYMOkKhmLjsK9JDDssS8Z2LQaBUcxzTa+wEuNURwSo5cSCli1bNPacTB1Yvrw/w98XZ1PPobNByVI3g9DevbwJ56I9SsbAA3kFtp4YcJU+lSpC4D74QUfzByWjB6/dTTBC7j45Y5b+IjmnNvqN0MHbGwsGDSk0+AS23Dmvb87OxPa3+Mtp0yt/lo2x9H6DM3xe5qMqkfba7v2xgdwvK3D+9qmxBbt5so6iQFo8bQZsz0ssj6EARHLSAUeItJBxahZn3ACGo3SvlR2z4Yr3pzzSclPnvcgZGzW4yFlswJiT1g3JAC4BlTM/KK0xqmzLqqQAbr271EGw9jy5myPaCtV+hUZeKCIiwhg88gYwuECe00EIDahWnD0nOsdIpjnjHRC270ZCjSAc0gC7sxOfsyJuIqs7thpr7o16epL6TgjPZlqIJs5WGFlGOuzaqPavn3uG0d5EPKHOtYOwl0fEABLTWSmAPK5oxybjy3S1akCcnalrA3WFVDHZdDDQ9sUAcVVaLDZAVR9T7AVBjkbnjAqFeudvBNDeJwYKQt+I2ai3imsYPNOmRywOiuF2dBGRjkkom8a6QD7XChbqfdG/b4wBinU8EFKr5KCu5aaS1nEtNxU6tapy5iV7oPO+quaGHNUAlgM0zgDUi9RFP7HQiVhkJpa8lD7aZ1xs9mMk6bt3JjfWkv8jGc38YFUfc3cUFlfuZn2JSj7dW7MDgVXy7gyb9CIvz7yWiHhR8zYePsRAcNjqb6WpWneYqVJxEEUiawtxRmrWrGJV85ITjtmGyN4lGG3b4JvWZQeTpVaC2TVmRvMW3caerM3fZyB+x3IUVoPZDSuoBJqWmIpTUr9pDvGjV7uMvEvh/55hq45vRA1cpME9B9eY+TH2zBhKQG86szxSBtVWD6bfkrCph4B3QE1pQ03Qyy3hTS229rj16g4TrPRde0T3HKvHRN231DDNnLqB5IPclrZK5Y6ddCTfVoX4W/x8qhQXZTea5QJj5NV+OtF9qBOnjjkjkWt85fFEbzF0EDKYjW0WxIxaDMyMkKtEnRfOWMcvnuzZ17u5g7cNN1+e5N4ILLzSXXPqw62pOz7Tm4Uw52JKSLqiXwzKu5paH/8j9nP968+evv4w9X//x9N9r54GjnXdFeDo72sivaq8HRXnVF+2lwtJ+6ov08ONrPXdFeD472uivaL4Oj/dIV7dfB0X7tivbb4Gi/dUU7H346OG+bDyoo2uq1yY9bEqOv9OS/eGfx0IHkH2xZ9Zx0DhUm/FoXQLMqKdjoNEbbYIVBazc/sjxaYxS3VCsNtO6VEgiH2nBCLr/iDd3b25tPz8qVOjCVwtX1s/KEd0Nmpq0L98vTXx44b/vs+CMsXkIm0ND3Zhvr/qZuovIEb+pi2vubPQF6G94JTLcOqMydIA0PcDqB99qF+3RKXJqAC1TONtlO1zLTtGLS1iWjbp1SZd+ODwLnuFpq03R/j5V0vWc5lrSlD5NWhoK5rB+KG7b+IiBSPIu7K2KB1VFvQ3JArr1yhRZqy58VMS/8sVLipn0gxNPrr42o1Tm2erMJsKMvP4SBGo7ShSqP0ZkDGv+OPnQGx+wclswCfT1By4tyAG8bsMuIuiHnZy2bjp03HjtadnfDhPKlspT+LcnG87PyqyO7zwEhlJhWm3UGrfZm++OHbXNfPzBzX/dr7quzB2bvq7N+DQ6brwmpTbzdafbhrchH0nVrYi7ov3oc2bTKLDpcH6zcSAlKq+cilTEa5bc7WwWHokZxsTQHkYRHXYFafPJ4a9AxDf5OqtAxTX5wdegQ41q/ANldgw7qyZrPTtvqycNsy5pteFR18bDp7buriqfO7NSZPezOrNrGOTVnp+bs1Jz9HzVnQg/TmF1dJ6NudeRhNmVX14+6Fh42q313lfDUkJ0asofdkF1dn5qxUzN2asYebTP2vwEA5duFcw=="
 }
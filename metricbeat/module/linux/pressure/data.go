@@ -0,0 +1,145 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pressure
+
+import (
+	"bufio"
+	"bytes"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+// resources are the resources with pressure stall information, they are
+// reported in /proc/pressure/<resource> for the whole host and in
+// <resource>.pressure for each cgroup v2.
+var resources = []string{"cpu", "memory", "io"}
+
+// pressure contains the lines of a PSI file. Full is nil when the file has
+// no full line, as happens with cpu in cgroups in kernels older than 5.13.
+type pressure struct {
+	Some *pressureLine
+	Full *pressureLine
+}
+
+// pressureLine contains the ratios of time in which some or all the tasks
+// were stalled in the last 10, 60 and 300 seconds, as percentages, and the
+// total stall time in microseconds.
+type pressureLine struct {
+	Avg10  float64
+	Avg60  float64
+	Avg300 float64
+	Total  uint64
+}
+
+// readPressure reads a PSI file in the format:
+//
+//	some avg10=0.00 avg60=0.00 avg300=0.00 total=0
+//	full avg10=0.00 avg60=0.00 avg300=0.00 total=0
+func readPressure(path string) (*pressure, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var p pressure
+	scanner := bufio.NewScanner(bytes.NewReader(raw))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		line, err := parsePressureLine(fields[1:])
+		if err != nil {
+			return nil, errors.Wrapf(err, "error parsing %s", path)
+		}
+		switch fields[0] {
+		case "some":
+			p.Some = line
+		case "full":
+			p.Full = line
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrapf(err, "error reading %s", path)
+	}
+	if p.Some == nil {
+		return nil, errors.Errorf("no pressure stall information found in %s", path)
+	}
+	return &p, nil
+}
+
+func parsePressureLine(fields []string) (*pressureLine, error) {
+	var line pressureLine
+	for _, field := range fields {
+		kv := strings.SplitN(field, "=", 2)
+		if len(kv) != 2 {
+			return nil, errors.Errorf("unexpected field '%s'", field)
+		}
+
+		var err error
+		switch kv[0] {
+		case "avg10":
+			line.Avg10, err = strconv.ParseFloat(kv[1], 64)
+		case "avg60":
+			line.Avg60, err = strconv.ParseFloat(kv[1], 64)
+		case "avg300":
+			line.Avg300, err = strconv.ParseFloat(kv[1], 64)
+		case "total":
+			line.Total, err = strconv.ParseUint(kv[1], 10, 64)
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "error parsing value of '%s'", kv[0])
+		}
+	}
+	return &line, nil
+}
+
+func (p *pressure) toMapStr() common.MapStr {
+	m := common.MapStr{
+		"some": p.Some.toMapStr(),
+	}
+	if p.Full != nil {
+		m["full"] = p.Full.toMapStr()
+	}
+	return m
+}
+
+func (l *pressureLine) toMapStr() common.MapStr {
+	return common.MapStr{
+		"10": common.MapStr{
+			"pct": l.Avg10,
+		},
+		"60": common.MapStr{
+			"pct": l.Avg60,
+		},
+		"300": common.MapStr{
+			"pct": l.Avg300,
+		},
+		"total": common.MapStr{
+			"time": common.MapStr{
+				"us": l.Total,
+			},
+		},
+	}
+}
@@ -0,0 +1,187 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pressure
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/metric/system/process"
+	"github.com/elastic/beats/v7/metricbeat/mb"
+	"github.com/elastic/beats/v7/metricbeat/module/linux"
+)
+
+var debugf = logp.MakeDebug("linux.pressure")
+
+// init registers the MetricSet with the central registry as soon as the program
+// starts. The New function will be called later to instantiate an instance of
+// the MetricSet for each host defined in the module's configuration. After the
+// MetricSet has been created then Fetch will begin to be called periodically.
+func init() {
+	mb.Registry.MustAddMetricSet("linux", "pressure", New)
+}
+
+type config struct {
+	Cgroups struct {
+		Enabled bool `config:"enabled"`
+		Depth   int  `config:"depth" validate:"min=1"`
+	} `config:"pressure.cgroups"`
+}
+
+func defaultConfig() config {
+	var c config
+	c.Cgroups.Enabled = true
+	c.Cgroups.Depth = 1
+	return c
+}
+
+// MetricSet holds any configuration or state information. It must implement
+// the mb.MetricSet interface. And this is best achieved by embedding
+// mb.BaseMetricSet because it implements all of the required mb.MetricSet
+// interface methods except for Fetch.
+type MetricSet struct {
+	mb.BaseMetricSet
+	procPath     string
+	cgroupsPath  string // Mountpoint of the cgroup v2 hierarchy, empty if not available.
+	cgroupsDepth int
+}
+
+// New creates a new instance of the MetricSet. New is responsible for unpacking
+// any MetricSet specific configuration options if there are any.
+func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
+	cfgwarn.Beta("The linux pressure metricset is beta.")
+	linuxModule, ok := base.Module().(*linux.Module)
+	if !ok {
+		return nil, errors.New("unexpected module type")
+	}
+
+	config := defaultConfig()
+	if err := base.Module().UnpackConfig(&config); err != nil {
+		return nil, err
+	}
+
+	m := &MetricSet{
+		BaseMetricSet: base,
+		procPath:      filepath.Join(linuxModule.HostFS, "proc", "pressure"),
+		cgroupsDepth:  config.Cgroups.Depth,
+	}
+
+	if config.Cgroups.Enabled {
+		reader, err := process.NewCgroupV2Reader(linuxModule.HostFS, false)
+		switch {
+		case err == process.ErrCgroupV2Missing:
+			debugf("cgroup pressure data collection will be disabled: %v", err)
+		case err != nil:
+			return nil, errors.Wrap(err, "error initializing cgroup v2 reader")
+		default:
+			m.cgroupsPath = reader.Mountpoint()
+		}
+	}
+
+	return m, nil
+}
+
+// Fetch methods implements the data gathering and data conversion to the right
+// format. It publishes the event which is then forwarded to the output. In case
+// of an error set the Error field of mb.Event or simply call report.Error().
+func (m *MetricSet) Fetch(report mb.ReporterV2) error {
+	fields := common.MapStr{}
+	for _, resource := range resources {
+		p, err := readPressure(filepath.Join(m.procPath, resource))
+		if err != nil {
+			if os.IsNotExist(errors.Cause(err)) {
+				return errors.Wrap(err, "pressure stall information is not available, it requires Linux 4.20 or later with CONFIG_PSI")
+			}
+			return errors.Wrapf(err, "error fetching %s pressure", resource)
+		}
+		fields[resource] = p.toMapStr()
+	}
+
+	report.Event(mb.Event{MetricSetFields: fields})
+
+	if m.cgroupsPath == "" {
+		return nil
+	}
+	return m.fetchCgroups(report)
+}
+
+// fetchCgroups reports an event for each cgroup up to the configured depth
+// in the hierarchy. The root cgroup is skipped, its pressure is the same as
+// the one of the host.
+func (m *MetricSet) fetchCgroups(report mb.ReporterV2) error {
+	return filepath.Walk(m.cgroupsPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			// Cgroups can be removed while walking the hierarchy.
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return errors.Wrap(err, "error reading cgroup hierarchy")
+		}
+		if !info.IsDir() || path == m.cgroupsPath {
+			return nil
+		}
+
+		rel, err := filepath.Rel(m.cgroupsPath, path)
+		if err != nil {
+			return err
+		}
+		if strings.Count(rel, string(filepath.Separator))+1 > m.cgroupsDepth {
+			return filepath.SkipDir
+		}
+
+		fields, err := cgroupPressure(path)
+		if err != nil {
+			return err
+		}
+		if fields == nil {
+			return nil
+		}
+		fields["cgroup"] = common.MapStr{
+			"id":   info.Name(),
+			"path": "/" + filepath.ToSlash(rel),
+		}
+		report.Event(mb.Event{MetricSetFields: fields})
+		return nil
+	})
+}
+
+// cgroupPressure reads the PSI files of a cgroup. It returns nil if the
+// cgroup has none of them.
+func cgroupPressure(dir string) (common.MapStr, error) {
+	var fields common.MapStr
+	for _, resource := range resources {
+		p, err := readPressure(filepath.Join(dir, resource+".pressure"))
+		if err != nil {
+			if os.IsNotExist(errors.Cause(err)) {
+				continue
+			}
+			return nil, errors.Wrapf(err, "error fetching %s pressure of cgroup %s", resource, dir)
+		}
+		if fields == nil {
+			fields = common.MapStr{}
+		}
+		fields[resource] = p.toMapStr()
+	}
+	return fields, nil
+}
@@ -0,0 +1,147 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pressure
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	mbtest "github.com/elastic/beats/v7/metricbeat/mb/testing"
+)
+
+func TestData(t *testing.T) {
+	config := getConfig()
+	config["pressure.cgroups.enabled"] = false
+
+	f := mbtest.NewReportingMetricSetV2Error(t, config)
+	err := mbtest.WriteEventsReporterV2Error(f, t, ".")
+	if err != nil {
+		t.Fatal("write", err)
+	}
+}
+
+func TestFetch(t *testing.T) {
+	f := mbtest.NewReportingMetricSetV2Error(t, getConfig())
+	events, errs := mbtest.ReportingFetchV2Error(f)
+
+	assert.Empty(t, errs)
+	require.Len(t, events, 3)
+
+	host := events[0].MetricSetFields
+	assert.NotContains(t, host, "cgroup")
+	assertValue(t, host, "cpu.some.10.pct", 1.52)
+	assertValue(t, host, "cpu.some.total.time.us", uint64(27654193))
+	assertValue(t, host, "memory.full.60.pct", 0.04)
+	assertValue(t, host, "io.full.300.pct", 0.94)
+	assertValue(t, host, "io.some.total.time.us", uint64(98812673))
+
+	system := events[1].MetricSetFields
+	assertValue(t, system, "cgroup.id", "system.slice")
+	assertValue(t, system, "cgroup.path", "/system.slice")
+	assertValue(t, system, "cpu.full.10.pct", 0.30)
+	assertValue(t, system, "io.some.total.time.us", uint64(70122301))
+
+	user := events[2].MetricSetFields
+	assertValue(t, user, "cgroup.id", "user.slice")
+	assertValue(t, user, "cpu.some.60.pct", 0.08)
+	assert.NotContains(t, user["cpu"], "full")
+	assert.NotContains(t, user, "memory")
+	assert.NotContains(t, user, "io")
+}
+
+func TestFetchCgroupsDepth(t *testing.T) {
+	config := getConfig()
+	config["pressure.cgroups.depth"] = 2
+
+	f := mbtest.NewReportingMetricSetV2Error(t, config)
+	events, errs := mbtest.ReportingFetchV2Error(f)
+
+	assert.Empty(t, errs)
+	require.Len(t, events, 4)
+
+	var paths []interface{}
+	for _, event := range events[1:] {
+		path, _ := event.MetricSetFields.GetValue("cgroup.path")
+		paths = append(paths, path)
+	}
+	assert.Equal(t, []interface{}{"/system.slice", "/system.slice/nginx.service", "/user.slice"}, paths)
+}
+
+func TestFetchWithoutPSI(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pressure")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	config := getConfig()
+	config["hostfs"] = dir
+
+	f := mbtest.NewReportingMetricSetV2Error(t, config)
+	events, errs := mbtest.ReportingFetchV2Error(f)
+
+	assert.Empty(t, events)
+	require.Len(t, errs, 1)
+	assert.Contains(t, errs[0].Error(), "pressure stall information is not available")
+}
+
+func TestReadPressure(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pressure")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, ioutil.WriteFile(path, []byte(content), 0644))
+		return path
+	}
+
+	p, err := readPressure(write("cpu", "some avg10=0.25 avg60=1.50 avg300=12.00 total=123456\n"))
+	require.NoError(t, err)
+	assert.Equal(t, &pressureLine{Avg10: 0.25, Avg60: 1.5, Avg300: 12, Total: 123456}, p.Some)
+	assert.Nil(t, p.Full)
+
+	_, err = readPressure(write("empty", ""))
+	assert.Error(t, err)
+
+	_, err = readPressure(write("invalid", "some avg10=abc avg60=0.00 avg300=0.00 total=0\n"))
+	assert.Error(t, err)
+
+	_, err = readPressure(filepath.Join(dir, "missing"))
+	assert.True(t, os.IsNotExist(err), err)
+}
+
+func assertValue(t *testing.T, fields common.MapStr, key string, expected interface{}) {
+	t.Helper()
+	value, err := fields.GetValue(key)
+	if assert.NoError(t, err, key) {
+		assert.Equal(t, expected, value, key)
+	}
+}
+
+func getConfig() map[string]interface{} {
+	return map[string]interface{}{
+		"module":     "linux",
+		"metricsets": []string{"pressure"},
+		"hostfs":     "./_meta/testdata",
+	}
+}
@@ -32,5 +32,5 @@ func init() {
 // AssetMysql returns asset data.
 // This is the base64 encoded gzipped contents of module/mysql.
 func AssetMysql() string {
-	return "eJzkXc1z2ziyv+ev6JrLZKoc7Vz2sKl6W+XNOLuuip2s7ey8d+K0yJaIMQgwAChZ89e/QoNfkkiJ+qCc3dUlsUQBv240+hvQO3im1XvIVvabfAPghJP0Hn64Wz3+89MPbwASsrERuRNavYe/vgEA4M/AklmQAevQFRYyckbEFmItJcWOEpgZnYVHJ28AbKqNi2KtZmL+HmYoLb0BMCQJLb2HOb4BmAmSiX3Pc7wDhRk1uPzLrXL/qNFFXr7TAc6/fuNv/QaxVg6FsuBSqhG6FB0syRDoqf90DWo9xLeCzGpS/tkG1gY3R0kGo8CC+tMuoP5VEzslh633e4hgQtZmGE7QdMVPlGvjV4vpAa3g7zzipDXNJnVtCjHP5Wrtkz7q9lDiX9d+sApUmHWy8VAXljYerTVtfVhBSnQxlV0f78HlX//QS9AzR4pJFkGwjZfjpRGO3llygRlCzUEX7p2evdMmIQNvczQoJUnxB/oZgGYzEQtS8eqnhrxdFMmRKWooWKIFq8FKvQSnA0Gl/DTPCJdCKuap5wF9U/pHG6QrMCYBQuMZtLl04fUvlAVZiKW2ZPwcP4OhWfgvwtwQOjIwx9zvgiWRCmBQJTBD28JhB/BuKVSil6Nw73pBBucEibAOVUw1XOaMdYxY6qX/b6xVXBhDyslVzSVmXQ8NFf6YjDvX5vpAxomZiIMMnrTJEsptVBH+6txlRsLCy1UQ1RgVTAlyba2YtjguFFRbEd7m2pFyAiUkNDdEoGewsVGH7E6hEnqJrPijnw9Sq/lxXHhKCVSRTcl4dKScEWQ9GV53x2vryTgG4XVkFjiORqlWrcHsDCqLsf+SBUMxiYXXmKmQBNj+FAzl0hNDffu63hOysI7M2bZFGO60DeHdlkgkY4iAdihbDC2ph4z8OzYVOcQpqjlZSDHPSVEyQApGktcPQcm14JYwa5kN6Icg3HSZ1jE+02qpTRfDB8B8DEvtxTMVtmZprLNcK1JuAk9ejQh7BcuUnLdzHrzSCYGwXks4/2WELw+3d9cP/wfawP3n+6j6sxlojyTrLBPn0+882vfvPa3teu9vBDZ4T0oXzFh2m0637sfL8dG2vSFlkHXXSnEo1CkC3SK+B/3tjEU1GENh4fPHj1eN8KZoQWkHK3LN5Ox4qVXYDrS9G7aEyNslYSHDlbeyibe6GjJhQ+xWGDZIE/iQUvzMQ5Ix2oDUc5hpA7nRORlIBM6Vtk7E+xQ+LTb1wNF7BG4WFj4etTVoIeLNvbpvsYYgAoBPwroQsX39evvLj6yZUEpeMxsmrmLQTiW6TmB4Onw3RuXX29Dvel0DQ6GckLDSBRjiQMZ/KkwIpxO/SDFZ22uMYUNV92uN0zR1qpeBM+y3KJRhtkpYb/71CF+MdjrWco8UzaReRrHbdHyOFqWPPir5oJUzWh6pbXMs7Nbmh7PoW+85zkypZD2zREZghddlnm/SR1IfP319/Ac8Pl0/fX1kzeW1GjvQlS9WaegAtNrqnpOcaDBtprdftwo0m02/8vYKUr2ErIjTkHOQuPAI5l4/+djOB8yJXh7qIQRQkep3Ek5zvB17XoFxufdqhDdeJSuCFGaEtjAhslCotKVYq2TInjEUL0bA/UCuMGX2p3HCPn6Ivlx/fbwBWnh9vm4PKqf8CoSKZZH41XCptrT+mF1zZ9qvr0qKZ4JM29r5WKAROJVkg+2JdeF3L2t/9ri0Ikg0BWNkyJLz0MwqcJuVUhHEYS3LtksHkepXzhfl53fIqIpJftdHtYvSqQg7WLWHTX6rWPpWkNctgUdX3iFmB+iqUtSscBrvqOUC7sOs461g9Widfe8XyeYU+7j5PJHfdBbhVBs3ihbaiP2YF+shdZPaZRQhsxs07NpzIeIWCuiF4mIH32Ej+xTNUMjC0GvS5yFQspHwcGT74ivYirFeCX0t8KdahC5ZHwhzXeb9LD2SDjukvQ30W0FFl1cC+3g6EDC0EglvhfIRmENFurA/gSQ1d2mlVJgYhrODv1vQI1z0odvjdx1AwEMNrcKMIaZMQC/I1Gm4IT5ZtzWBVi5dKysSMjiVK5Bo5pywQAU/T372PooK26g2U2VUEJL7TT4d0HKKvXc6ZFO3AjTU5PK807gUUsKcFBnvFSFIzXF82410qdHOSaHmB61Vhi8ji5q3Xxm+iKzIesXrsFUaQpZQlyBLqDHJajRXLnF1CRW7mS/Gla2CErSrLNQ7vSJ+hrlBVUg0wg10H/vjsLMpX+8a/scoX8+y71T5PtbQupXv0GD4NMUrVML1jB416H1kRW6pzTO/W8zTvHAgrD2Qo6+pIhsh+I9SkWcj6wIpstsqMRb6Nuqo5uPjXZmlCPpzT5xlCJPuPo6j8tC/tuomZR+QsGES1tZxTLnjoFXsLfhVrsa5osBf2eN5JFeNfGrtrxgl97Bl/VqeWu19cVI45No44ztAFqcrN2I0Z8UfdCLYtl9xrjV/aOng01of0GF0GRb6qZqy+BA180wXccP8NIcDuxDXDgfHEnkhdEH6p8IdiHEsJbNTx1T44C17rk63N++Q5phXUDXbPK3rBWRm2mQbLUvdGmVXx2VrnFa/ZdVraUhiWVT1tq/1cGgZCK2xwbNeA9LX/Lmr+TLkn7n5kzL/311K8YFibRIL9dNV+toWWYZG/FEmD+OUMuSwPhFz2koH9KvgfarzxwxfJt73NpMlCvfjSYJxV/qjfqTg0Fe+W0NNnZ5HxzkL/9i2l1XD8w7exBKpfmRJtxu3huzJg0EHy1SUFa/AR66kcfLfG0RuF/CT9eP5VqByQtLkL38+jVfe0f3Ln13qpdHPLGTDrVoYvNiqeHXFXXIirmpZ/YawSzgaXI5euj5cw/WltTlKsesZteYJ68GJdWhOY8l9rfG25KV/clzMzya/VYvJafJbQXM4lRQJHfnhjuyWqPQDjwW3f/rM2KzXCdxceOBu19PfaUfDRF9SZUgSJMjKzuiwP6TaovsxSJ4feuesvQ8cMecT87hzxLXG0knPpGeIG++xkTmeq6wloQWucC9TCpXFdXkIQsmPGRYYSiZ1H1yV8ihltv5mJwCehW2kERmaUsomcP/10yfOWm+OEr6hdPXgrbJknOWtESqnCeDcW2MHt/e/3PxvdH99dwP/wyPu9qwmM3JxeiaNglICjwc6p9ANZeGv8POWR8I1272+yDGnQnjk3yqnhFzAQxbiwjqdVYF301NU2KoDf91B2XUMpLdV83Cf6kzHVyZdbJsP9abKCuq5Qs7rsiB7WnJBik6bdO7e8rIbsK+mTDEWZUdIQASJ8DpCuFQXjg9zhL4Rao1UdvrJIQn48lvjE1rWktE5ynJvXHU1d+Wytw+vDWvgFHrTiztSaPb2IBqjTT+Pji5UDDG6OZGJMEkM2S4EcHBiduNwA5MWZE/H3FFbnROwhCZOvXzNtKl5ruaVKN5+gRIXddZVWjuc046XQp8UhlGilLZJer79CXQwrFJYR8o/kmvT0zO0dmxDdR7bOBz77pR4t1IwNGv3ANbtmCXlZXdp2DVXYIs4DeVkbh7x3whNpgiKujq2SzJSQ5iANoCqOkOXUabNymNJxHrfShefzlUM2c+Itk4sM9wZvkTtR6XIROUqYZzSjoLS6ZS7OF8a7Nr+o1C/KkV46if1/xpct7/bCC3J7ojgcIBDJXjQvgy4/L40kGspD9uh/vX2Yynn1ZGS2vFruvcUxWQtGiFXpXeLlQJrmcwgLL9zY/7kpz3Wx8vUZQyPtf0HxgZZncdHb1Kt9TQybkh9bIkqgUz0Ke4hZintbu6CE2W+G+8e3cOEXARMP8/2nvE6Dsw2BD/8NoC1hMTrOCo6JxV17Y1hGAbh2IcFhkkoDFmLLUDrwsH7yDtGnu7Ad1sukdT6uch7xASGSu45QIYJToWpF2RmUi/Pi7TflvhXE9Y7kZG9Apw5MoBlWiLoeh+SetdUakvJlVfsTFgVRHNfOCooFNtOUs6sIBy26JPR8KoqHK49mLBrrSYh9dcIPfxp662o+q7dbVCmQkm9ybeRLAoDmyTCPkeFPddZ1D2zjTTRrmrX0dmDv/nBOHdwcM6gp1+Cv6pNhm7EwtzarudZNsr/KGWV1BjpWMc+Go8koyqB7iegtoHs059NIp7CcMfJBG+AUc6nr4cKPE1F+ZC8D9/3cQFgYZ5DkPUczh0BW3MSdzA6Uygfl4yOrZxnD7LtfFi31A8/XdQ9fKewjGSgXJYH8xR8lbEtlJ9uJi400XgkNTfESFxdaqn2pEXPxDjB5aaoW6uffTZuahl1iWaysGlU9rCOsl8zfIn4jO7IesF7upeRtIvsUOsMYTa+IhhdCYTIqFuBnscgBOG9zNonJGlHE/e5LO4vN59unm7qCm3oP+fkeZEPut/Jbt8Zdn6Ut/ePNw9PR6PsTQWfF+XjzaebD8ejLPKefq/zovz65ZfrA1e83UEgTt5b3bCarsLy0HCr7h6Ko+EemOoMe3l/ANnOby6FS4UC67Qhvl5kbjCzV1CEQ/F+1H8WZEOVoRpyAreuyaBzpwZ8+HwXfbm9/ztow/9/fLp+un18uv1Qn8fY56V+q+Z5VbbV3NKqvPOy/FYVaLYKS9NVFXJy2sgz43ges5A1HF5fwx5mX22wuvr77in68nDz5frhpvXOh0+fH2+umvW5e4oebh5vnoauT4oqkee64G3/6Y2OO7h2SsMAidiWirpe+uHz3d3tU2v5BuihC1keTnCWF8DopYUUFwRTIlUCqK4gYnM+ADa9BJojqePnkdBXZ4tUbMrd4NbkeaYNEMYpV/u4cM0bpgXs7U8wKxQ7p1dlz244ySzlKtQMLZSHp6c0FyEc9ruQVMLdXnFMNtT3q5zwVu9UL4syYyKhLiB/1drWHCssWUDWFTgnIDUXin60oJcK7grpxLsHVHOCB8IERJZLZm+oafL1JExqIH7IZT2GcjRjyPD1xq0pVM0FeYo2pM+X+l34I+z2Vl/eoOPNOMLZ4CGVrJkwvafxx6mXN2LCc5elCqEAyxtGq26GoWc5n2nzUOG4BBhi6877kTsq0OsymKKlBLQC9IgGYpf9dyFcCHx97PSZ1lZhIAGquwf/wgR4FLwIQjEd+253hHW10XUpyoUp8CiELuyxVJjOywUuSMTWJpiJF+8harvRWLSPjOj7Eyk+YIMOOTczRJ1rKac4mkPSgdzbpU1L68kpz4bx6gRMjWEaEkPjgnItxjkWeCAlEmPyn1SQDoEffT8r4pEALwS7c4eQc6FcQXs7hCnLzc2XE7JTNuTiXCMujDWkpYZibZJZSifTMQPBRit0dJXscvH2cKPfvWu7ld2NLHsU6Y42Gw7bQpVYKKfhVin9y9+2IrZtMGF5OmudJ+Opxj4QUhlzjoGoCmcPAxS22yiAyqEHAKp7TYrZjEyUd/02yKhimxRZvv17Nsdxos8HeGKfS8+ND6x9iK1aDbXsB8TalK4Z8s33JJPKFyjZFxgEnkFX4IyYz8m0M3GOTxBwZsbrmKjF0IhpRBfZtHB8Ma02vY8pvdzpPjX3WGLy2nxbosmgyHvY5HnjdYfnC2toSy78KMacrzoyhmyuVbggVvvBy995AbaRzH+R0cHcZs54boeMdw+z+amhzO5r/dnf+zmAz7v7Ptv3hPS6uAOaFAe3KDZXFW92LPVuiOqYHV+8jA73+P/tM9/G9cXzY1EVb/2mRKCu2vOMqRTSXooHSQ2P8V8iNWs2aA/z/k3EZVtQThcN2OyX6LH/Y9DT7UgzipKm+vTuKVQZ6u9BHo8kP+3ZVkai6+luHJuMcuazUZIJG78CGfsUQH0DxbSwq/bJt1WrSIRS6nBdEIfXSSaUsM57Hwvi1v2UMGmOBvpAUOr42UKZtscEc342RZsOynO2elm8qbiwYWo353c5nfxrY/OdlbK9RYYLmh5M+yDABWSPU3sc/vQ7p+HyWkzeMVTO03i+qKpvdrC08Pej8ndV/q1Jbp3Vt8U0XMPv5Kr+zZjqgH6KC2+9wz7lml3wzndfwNjHuf589phc+8GgSnT2Q4shXmcJJ7DssQg8HExMZVZfw27ouYhRBhmocAxzUrt/DfSCiqI/cQUX41nZplBumlgXsuyKQSfsbNV4RmsaGVUCKSZ1KSERhmK/XfjxRNjnA2THq/9dAf0YzLD1D9dtp0TYHPVokmSlMPPMk6uN8LwKzcMJs62omwfN0WBGjkx7nMGcWqJw0cW8zHttskBmaKCGfvUafi6x8noa1TqBX1NS1TcUUdIUn7QpDyHwLwHNqWzHglgSluae/SRcoJA4lXRVjROiBwtWZ7QWmIRCO9+ij9VRznBevb2+M+9MpWW3VtXwwP/aut+k/EVDsuFWsWH6hNkU7VSF50pNbdwyaSHRoezS5zT/fwAAAP//t0fTvw=="
+	return "eJzcXd1y2ziyvtdTdM3NZKoc7dzsxabqbFXW4+y6KnaytrN7zhUDkS0RaxBgAFCy5ulPNX5ESiJF6teejV0V26KArz80Gt2NBvQennH5AYql+SFGAJZbgR/gp7vl4z8//zQCyNCkmpeWK/kB/joCAHCvgUE9Rw3GMlsZKNBqnhpIlRCYWsxgqlXhHx2PAEyutE1SJad89gGmTBgcAWgUyAx+gBkbAUw5isx8cH28B8kKrHHRl12W9KhWVRn+0gKOvr+7d32HVEnLuDRgc1whtDmzsECNoCb06hrUVRM/KtTLcfi1CawJbsYEapZ4ClavtgEFaAg7Qcsaf+8Qgr6/r/UwXKDJ0j0RxoZGy8kDSsLfXYtRsjbpmhKyshTLtVe6pOuRhL4/UmNRYXyvTRxdWJp4lFK49WKElKlqItpe7sFF3/9QC1BTi9KJzL1ia9LjheYW3xu07pUllzNQlX2vpu+VzlDDu5JpJgQK/jujWQI4nfKUo0yXv9Ti7ZJIjNZeOblEtQQLZsAoMEItwCovUNCf+hluc8j5LCcO8IdUPxs/Xehpjhkg04Kj3hw6//UvJio0kAplUFMfv4LGqf+RwUwjs6hhxkqYoF0gSg+GyQymzDRwNFSjk7sFl5lanIW9j3PUbIaQcWOZTHEF1zFjrEMs1AKNpVmZVlqjtGK5YslR1yFDxJ+itqM25AdMrmvUlk956nXwqEmWYWmSKPirs+uIhDnplVfVlEmYIJTKGD5pMM4lxKkI70plUVrOBGQ404igprAxUYfMTi4zfEkM/72bB6Hk7DAWnnIEWRUT1IQOpdUcDXDpbHe6Np4OxyC8FvWcibOOWo3ZaiYNS+lNBjSmyOdkMXMuEFjzVdBYChIGu+Z1lCAVlbGoR23gD5kWvrnjJkSq5DThWSepR6iAskw0CA3SQ4FEscl5CWnO5AwN5KwsUWLWwV8T75n09dobuQbcAHOlsx79EISbLlP857X0GZcLpbPDYD66tp165txEUJCqolQSpR3DE5kRbq5gkaOldY7AS5UhcENWwtKbGXx9uL37+PB/oDTcf7lP4q91Q6vuR20ypqoo+Onsu2vt7XtPa7Oe/A1PA3lSqnLEOrepg7s9VvfD9fjgtb0WZdDqrqR0odCoDX67ivegvyWdxrAYcgNfPn26qpU3ZwaksrBEW3fuHC+5JOJtjtuzYUuJaF3iBgq2pFU2o1VXQcENGUE+q7RbkMZwnWP67PpGrZUGoWYwVRpKrUrUkHE2k8pYnjY6aKUJ5+ZUcwRu5gY+HTQ1cM7TzbnaN1hDEAHAZ26sD0G/fbv97WdDQ8GEcGNmfMcxBm01os2vm/C0f2/KJI23xv+odQsMlbRcwFJVoNEFMvQq1z6czmiQUjSmczFuMkMWB8/DzGOuFp4ZLi1qyYSzbxiV9eZfj/BVK6tSJTqQRpRToRZJasWpVOkTRSXXSlqtRFCefVWqZJXBrJO5Y+wteY5THYwskcULBMPJkyY2BUVSnz5/e/wHPD59fPr2SItfQT61c6CjLxYttAcapzoxSebD6ibpzX+3EpRbNmmNNleQqwUUVZq7MTOCzQnBjNZSiu0oYM7UYl8PwYNKpDnDAkD0Wed5eeJKlJZ8GBap8FpYIDOV9pGFZFIZTJVs6kEneI3p/Ay4H9BWOmR/aifs03Xy9eO3xxvAOdnz9fUgOuVXwGUqqoxGw+bK4PpjZs2daX59k4I/IxSKQjHvfMyZ5mwi0Pi1J1UVzV5n/Z3HpSRCptAvRhop8YBzSj85tp1Rqrw6rGXZdvBJ8N4Gn2+QqEgSzfpk5aKMBlLVQxNNFYM/KiTb4nXuihxi5wBdRUNNXTccvYYL2IdZpexkNvueBsmUmFLcfJrIbzJN2ERpay4Q+zkumu5zM7XrUPjMrrewa8/5iJtLwBdMqx28N2WjDEMyZVxUGl9TPoKA2UbCw6JpzLNuGZzOvRL6lcIfuyK06fpAmOs6T+tOh6bv0vYm0B8VVm1eSS+nAwE3EwnvuKQIzDKJqjK/gEA5s3k0Kk4YB2cHv1vQEzbvQtfjd+0hwMMKWsTMfEyZgaJNsZiGG+KTtQ3Uei5dScMz1IySnYLpmUtYMAm/jn+FAhktpczWy1SICigRvWzk04EZl2Lv7I6RvcElMI11Lo+cxgUXAmYoUZNXxEAoF8c33Uiba2Wt4HK211gV7GXnWB2varR+FeyFF1XRqV77jdIQsbi8hFhcnlOsKBINM1tewsQ2jGvsNQYlzCwLv4FLLsMzzDSTlWCa24HuY3Z+40uu4X+N8SXK3qjxfVxBaze+Q4Ph4wwvlxkZP+wwg+QjS7QLpSlJplU1y8uKks6mwj+MiayV4Ehb8rZM5MnEukCK7DYmxnzdxiqq+fR4F7IU3n52oIwINbJsORqOrgfZvxv7JqEOiFOugWVLZ63TFEtLFNOOZi8072q0omszzn3YnMfziDa2fFwE6IL2rVd71LQHYuvq1/DUIiU+KexzbZSq6aKyiXeytGeM5mhn8UiwESjZzVEbxgPG/KFhg48a7YxZllyGQuqq3hYfYmae8SJuGHWzP7ALsbY/ODd9LoTO9QUTbvfEeC4js9PGRHzwznmuVjUn75DimFcwNducRjAl6qnSxUbJUrtF2YHhe6OdRr1lrLXUKFjYVCVPovEwQWWhNJbLrdqpruLPNoMURfL5Z1f8iQX9ONpB5QOmtBsDq6dj+tpURcE0/z0kD9McC+Z2uDM+Q2MHm+A+0/lzwV7G5Hvr8YJx+/PWg/soxl3wR6klv7sVfbdamlV6nlmXs6DHsm54FAOPDaLsRpa1u3FryJ4IDGVlcx52vDyPbifN7ZLQ6u3KBaizbjw/KkabtDj+y5+P44oc3b/82eZQoqaeuajZWikDqa1Ml7QNBCVP415WJ7xW5ahxWXyxfbi+NiZHULuOVlecODs4pg3r4yi5X1m8LX3p7pzNZyfT31hicpz+RmiWdtsSrhJqzgyesa32wbUFt3/6Aq4xsgmuuHDP2a4m/8EdBRNtcHY122za60rrI7uDlla5H11rDvXOXjsfOKDPJ8dxa4uxO8f5uKPTvg53rF/x657VOuf6CntJzADtq1PlG6UrcEMf/CRxtkw7hcFsvKqDiymPoLOrd7YCcL1QB6XmBdNBy8Zw/+3zZ5e13mzF45IqPngrDWprnGl3doGy0TNajS3c3v9287/J/ce7G/gf12Inz+6d4ynaND9qQtcWhUp3XHtAhU4u72Pgr/DraLNrCn+Xo76ZccipENfy93gABK3HQxmrylhVxMC7rimqQjH+poMyHnVPzc5SzXY5duE90fGVcRttM7ZDhqYcYQd17bUuYXoEou+PYUP2qHAzFbx1TerRTD/wiX8mVBW0PNYjQUyS1eFBKBrs2nrGlKpjQh0PAYeMzoMsuM2ptJPOfPjyEmy0FAoCxZA8fXjXm+Ej7Ewza7EoaalWEWIMAJpH4ToE3BCOK3kaFezTLVeW2U1lW3+DSOrqt9l3iagTlmUaTRuC3gHdArI+Ll40r6IqdWdv4qkDg0ynOakhVdlEzuUsauztVwi4mgmqNhl8EvNS6LNKE+iUCWHqFOq7X+jIHima4MaipEdKpe1u5LGY8iTY21Sgz3ZonDYrClfFnUHyUKvqZ80VGCoddJvTrhSF3kERgAUGEtvqv4MYOeWbaZ+FyXgir8BC6SVN0oyvV8G08XSqrZV+IpqmM+TLC/aSNB8VnMr7vePF0ryZ4tj8d7zkNi0XmpWXkn4ZVHhCnYLgE83WV/NthAZFe3xxPg0eNC89LpqXGkolxH4zlL7effIlV6sDKis3sq4FlEjl2UxzsQy+MosGrCY4KAvFYZiNf1l1OGrjMyWdGrXRePKFxxhx3Krz+EhLqjFEicMNOUWqlLMqeJfhHrIs5e2lYvur1LritOMd74TiBbkImG7Oek+MHQZmG4Lhv6/FGuudu0DyOJX564EaoUqUSdvcGIZhEI4+LAM1dNBY9CgHNe/qtEluH3qbMERCqeeqNONeiDs19xQgfQfHwqQ6PDrKcFqkXeMbki4rGSiXZ66ATanem4Ukh7f1FOCSa0on5LMrYEGwGJK7cnwmoZLOhaJTwUuXrredOuq/436JbTbGzVrhisOR1EoPf9r6UxLfWxM3auN3wqVQs9HQ2bKTxa7ZEftywMYZN89JZXC050B2dN3T25k62rV3diB7AH+jjTiXLjHjPamNu/dbDwBNwILZA7f5zhWSOzAbNQeUkQuZlFr8TokPOUsyUJo+xg6UNm7P9ssZZfQRwsn068k3d5iGucmb7cv43lT5bqLkHfys4XJ3kVwAmO9nH2QdB4fPgK0+JTwYna4k5SHOji3004NsgzMKpUcDUXUgam++VVkOnFB9M8YWpV/s3Nq8Kc9OmYbKtdndlF+oo/OJFHvJULAlZm8iyXoi4rjbCkvCTDh3b67g5qxDNBWVyZNQX2tGA3vZpwfKrpH/3EyxnaMfcqVb2z25pl1khhqrkRVn7+b8RoDGBbN2A9rZyT4dBOVtbfrkY5+hQIv78nUuF/y3m883TzerTWZ/MsBl7KtygP/gjdlbEeb2/vHm4elgYTrT1K8izOPN55vrw4Wpyo4CuFcR5tvX3z7uqWZRkPCe0UApBkjQg34d+arqzoTz2I2SBrf5QzdQ8fp6gHA1A5rWd9L2Oqcb/RTtE5ZazTQrzBVU/mIGavWfFRpS+brJMdzaejvBjQpcf7lLvt7e/522LuhnOuZy+/h0e7066tLnZP+I/bx1ZleEKimWzXfFULp+B41MCKpdmo34OnwYnKrWg7A+zB3jcbUxGvH3u6fk68PN148PN42/XH/+8nhzVQ/h3VPycPN48zR0CHMmM4H6MutW6w1oOxVmgEZsa8Vqf/n6y93d7VNj+DrIOH5t3RujSwiH63fo0qOc7nCY0JWXHkBIZXmHZQBsfPEyJ3Qc9kzowwThMtVhNtg1faYMPbI0d7ujNKtod2EN2LtfYFpJ535fhYppf45ciKXfYzUQjq5PcMZ9wE+zkE7oUUF9Svug1PQqhx6y0wMoKrROuOT2YmO7YqwyaIA5W0FFwChnXCJd/LWQcFcJy98/0J2O8EDb+LwohaPX7wETqV5UKkAxQ3Sh1FgyfQ4d/rhxZw3GvqDMmfEFpgv13v/iZ3ujKnIAdApoO3G32aNBwLvsUrPnKdet9e29tA2kbpeauL7D1g5dOxXud43VH43Sj50iPOPmkc7zCqDpHiB3l51yMIGBVguYMNqoUnR91jMuB2IX7MLsb4FfHfp9xrVRGCiAxJc3IAChcIPAJd3j33u3ZlOCUuP89SUgFFxV5lAp9FYQfmEhtibBlL+Qo67MRiFWnxjJ21Mp+t2dU6Xs0w5JVlIoISbsbA5JC3JaLjdXWhqacDLPmSiPqV6YBghi2BxLxaV9A5IIltKtOytI+8BPovSvLwchAYJCv+wnzmGJicNFsCp0GVY4Wh28/zkArEufXxSrz6gNxRpxcilVNhm1gTxRIBh70m1VOLtcvB42ut27VY8d+yQ9o7DR8wbjFLaRxaf0glVwK6X67W+hOmk82mioAcYPD2bnwBPb3hNSiDnPgSiGs/sB8tPtLBSFpgcAimAm1XSKOinbPpnlrGqbVUUZPuvnaCa2+6/NiMteUWBNIbasF0ayzP7Yn3fNmPvcARRZ9AUCfZ4gIIKuwGo+m6FuZuKsO3GhpsHGJA1CEycjs4nJK+uuBVa68zGpFvUY7eBNKJa9Nm8Lpguoyg6aKMtItoN4casJ3fXqPpKEKE6V1mhKOrUgZ9QYk/FTdsCtkY5/XuDebDtmiG2fN+8g2z01lOyu4qbdc2Mgz91zpAmBXNKOR3oHe/CAx0G3G3doOPm7J0Q85EgjTTh3cromFNd2eWGpwkcbbEkX57zDFJS0U+JBWuPaGHVL9t+kNWtrUA95fxB12VaU41WjKY2rCMHsYvK0O9IORZBpdXb6GKk04iuINKXPuzrVyAhm09cZmdDzySShzzB5BTF64Nf3f0wqs2yeFFw2NomYoPunyY114XVGFxsaS97HHN1RhxxZVh+lpIwUbQwZCGl7lrHSPZszkw/Kc9a8uaXiYsTZtcuf2jnzt9gQrzuF6NlkuODSw/IuCJfQPZLfhz8dKhhcSXruvYPq8jTEi4yVwYO1xb0/CZ9q84cWuXEFgqkmhhIu7lqn+Ik98d6DnM1p9fbz1O3Z+VgoVKHsyZyWr8HaT5rJTBU/NQghm8UtZ6HGwqvNYGHisvoKsgg143RXP4myWt53Ao+gWyP+ixqKrgLfS3JGe5TMxkmTqkqEqhhmuZku61tlGvPpytXO5LSnQykElpF/iilNF/c4lbPvoTtk/ncF9OcgI1zk0poSITxdliRbSlaQwonlRngeQ3N6Y0vU7RqlzyQtkE4JNtoZzBRd2ZRczMu8p+NNTkyX4zaxkKqFFP9hldHrqU3rGP5NF0+Fd0jErN58okOR7piF+xymGYZyLEgFfcYB/cVfBsXmjAtK4F3FdpzfTuVzqsC1wMRvtFM7xJQJVQysUaRAvU/JmcpDtVYseHD/m1W9iQneiPF3ug1zPBxNyU5T2DM+g8ZmfSqHscnoU5g6B2g8+v8BAC6POag="
 }
@@ -63,7 +63,7 @@ type PromEventsGenerator interface {
 	Start()
 
 	// converts a Prometheus metric family into a list of PromEvents
-	GeneratePromEvents(mf *dto.MetricFamily) []PromEvent
+	GeneratePromEvents(mf *p.MetricFamily) []PromEvent
 
 	// Stop must be called when the generator won't be used anymore
 	Stop()
@@ -195,7 +195,7 @@ func (m *MetricSet) Close() error {
 	return nil
 }
 
-func (m *MetricSet) upMetricFamily(value float64) *dto.MetricFamily {
+func (m *MetricSet) upMetricFamily(value float64) *p.MetricFamily {
 	gauge := dto.Gauge{
 		Value: &value,
 	}
@@ -211,14 +211,16 @@ func (m *MetricSet) upMetricFamily(value float64) *dto.MetricFamily {
 		Gauge: &gauge,
 		Label: []*dto.LabelPair{&label1, &label2},
 	}
-	return &dto.MetricFamily{
-		Name:   &upMetricName,
-		Type:   &upMetricType,
-		Metric: []*dto.Metric{&metric},
+	return &p.MetricFamily{
+		MetricFamily: &dto.MetricFamily{
+			Name:   &upMetricName,
+			Type:   &upMetricType,
+			Metric: []*dto.Metric{&metric},
+		},
 	}
 }
 
-func (m *MetricSet) skipFamily(family *dto.MetricFamily) bool {
+func (m *MetricSet) skipFamily(family *p.MetricFamily) bool {
 	if family == nil {
 		return false
 	}
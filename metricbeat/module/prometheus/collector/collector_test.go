@@ -21,6 +21,7 @@ package collector
 
 import (
 	"testing"
+	"time"
 
 	"github.com/elastic/beats/v7/metricbeat/mb"
 
@@ -211,13 +212,52 @@ func TestGetPromEventsFromMetricFamily(t *testing.T) {
 		},
 	}
 
-	p := promEventGenerator{}
+	g := promEventGenerator{}
 	for _, test := range tests {
-		event := p.GeneratePromEvents(test.Family)
+		event := g.GeneratePromEvents(&p.MetricFamily{MetricFamily: test.Family})
 		assert.Equal(t, test.Event, event)
 	}
 }
 
+func TestGetPromEventsFromOpenMetricsFamily(t *testing.T) {
+	family := &p.MetricFamily{
+		MetricFamily: &dto.MetricFamily{
+			Name: proto.String("http_requests_total"),
+			Type: dto.MetricType_COUNTER.Enum(),
+			Metric: []*dto.Metric{
+				{
+					Counter: &dto.Counter{
+						Value: proto.Float64(10),
+					},
+				},
+			},
+		},
+		Type:   p.OpenMetricsCounter,
+		Extras: []*p.MetricExtras{{Created: time.Unix(1395066363, 5e8)}},
+	}
+
+	g := promEventGenerator{}
+	expected := []PromEvent{
+		{
+			Data: common.MapStr{
+				"metrics": common.MapStr{
+					"http_requests_total": float64(10),
+				},
+			},
+			Labels: common.MapStr{},
+		},
+		{
+			Data: common.MapStr{
+				"metrics": common.MapStr{
+					"http_requests_created": 1395066363.5,
+				},
+			},
+			Labels: common.MapStr{},
+		},
+	}
+	assert.Equal(t, expected, g.GeneratePromEvents(family))
+}
+
 func TestSkipMetricFamily(t *testing.T) {
 	testFamilies := []*dto.MetricFamily{
 		{
@@ -335,7 +375,7 @@ func TestSkipMetricFamily(t *testing.T) {
 	ms.excludeMetrics, _ = p.CompilePatternList(&[]string{})
 	metricsToKeep := 0
 	for _, testFamily := range testFamilies {
-		if !ms.skipFamily(testFamily) {
+		if !ms.skipFamily(&p.MetricFamily{MetricFamily: testFamily}) {
 			metricsToKeep++
 		}
 	}
@@ -346,7 +386,7 @@ func TestSkipMetricFamily(t *testing.T) {
 	ms.excludeMetrics, _ = p.CompilePatternList(&[]string{})
 	metricsToKeep = 0
 	for _, testFamily := range testFamilies {
-		if !ms.skipFamily(testFamily) {
+		if !ms.skipFamily(&p.MetricFamily{MetricFamily: testFamily}) {
 			metricsToKeep++
 		}
 	}
@@ -357,7 +397,7 @@ func TestSkipMetricFamily(t *testing.T) {
 	ms.excludeMetrics, _ = p.CompilePatternList(&[]string{"http_request_duration_microseconds_a_*"})
 	metricsToKeep = 0
 	for _, testFamily := range testFamilies {
-		if !ms.skipFamily(testFamily) {
+		if !ms.skipFamily(&p.MetricFamily{MetricFamily: testFamily}) {
 			metricsToKeep++
 		}
 	}
@@ -368,7 +408,7 @@ func TestSkipMetricFamily(t *testing.T) {
 	ms.excludeMetrics, _ = p.CompilePatternList(&[]string{"http_request_duration_microseconds_a_b_*"})
 	metricsToKeep = 0
 	for _, testFamily := range testFamilies {
-		if !ms.skipFamily(testFamily) {
+		if !ms.skipFamily(&p.MetricFamily{MetricFamily: testFamily}) {
 			metricsToKeep++
 		}
 	}
@@ -20,12 +20,12 @@ package collector
 import (
 	"math"
 	"strconv"
+	"strings"
 
 	"github.com/elastic/beats/v7/libbeat/common"
 	"github.com/elastic/beats/v7/metricbeat/helper/labelhash"
+	"github.com/elastic/beats/v7/metricbeat/helper/prometheus"
 	"github.com/elastic/beats/v7/metricbeat/mb"
-
-	dto "github.com/prometheus/client_model/go"
 )
 
 // PromEvent stores a set of one or more metrics with the same labels
@@ -51,12 +51,12 @@ func (p *promEventGenerator) Stop()  {}
 
 // DefaultPromEventsGenerator stores all Prometheus metrics using
 // only double field type in Elasticsearch.
-func (p *promEventGenerator) GeneratePromEvents(mf *dto.MetricFamily) []PromEvent {
+func (p *promEventGenerator) GeneratePromEvents(mf *prometheus.MetricFamily) []PromEvent {
 	var events []PromEvent
 
 	name := *mf.Name
 	metrics := mf.Metric
-	for _, metric := range metrics {
+	for i, metric := range metrics {
 		labels := common.MapStr{}
 
 		if len(metric.Label) != 0 {
@@ -173,6 +173,18 @@ func (p *promEventGenerator) GeneratePromEvents(mf *dto.MetricFamily) []PromEven
 				})
 			}
 		}
+
+		// OpenMetrics _created samples are reported as any other metric
+		if extras := mf.GetExtras(i); extras != nil && !extras.Created.IsZero() {
+			events = append(events, PromEvent{
+				Data: common.MapStr{
+					"metrics": common.MapStr{
+						strings.TrimSuffix(name, "_total") + "_created": float64(extras.Created.UnixNano()) / 1e9,
+					},
+				},
+				Labels: labels,
+			})
+		}
 	}
 	return events
 }
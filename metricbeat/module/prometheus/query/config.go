@@ -19,6 +19,7 @@ package query
 
 import (
 	"errors"
+	"time"
 
 	"github.com/elastic/beats/v7/libbeat/common"
 )
@@ -34,6 +35,26 @@ type QueryConfig struct {
 	Path   string        `config:"path"`
 	Params common.MapStr `config:"params"`
 	Name   string        `config:"name"`
+
+	Cursor     CursorConfig     `config:"cursor"`
+	RemoteRead RemoteReadConfig `config:"remote_read"`
+}
+
+// CursorConfig is used to fetch only the samples newer than the last one
+// fetched by a range or remote read query.
+type CursorConfig struct {
+	Enabled bool `config:"enabled"`
+
+	// Backfill is how far back the first fetch goes when there is no cursor yet.
+	// Defaults to the period of the metricset.
+	Backfill time.Duration `config:"backfill"`
+}
+
+// RemoteReadConfig is used to query the remote read API instead of the
+// HTTP query API.
+type RemoteReadConfig struct {
+	Enabled  bool     `config:"enabled"`
+	Matchers []string `config:"matchers"`
 }
 
 func defaultConfig() Config {
@@ -55,5 +76,21 @@ func (p *QueryConfig) Validate() error {
 		return errors.New("`path` can not be empty in path configuration")
 	}
 
+	if p.RemoteRead.Enabled {
+		if len(p.RemoteRead.Matchers) == 0 {
+			return errors.New("`remote_read.matchers` can not be empty in remote read queries")
+		}
+		if _, err := parseMatchers(p.RemoteRead.Matchers); err != nil {
+			return err
+		}
+	} else if p.Cursor.Enabled {
+		if _, found := p.Params["step"]; !found {
+			return errors.New("`params.step` is required when the cursor is enabled")
+		}
+		if _, err := parseStep(p.Params["step"]); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
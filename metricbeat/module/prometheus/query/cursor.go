@@ -0,0 +1,164 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package query
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/common/model"
+
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/paths"
+	"github.com/elastic/beats/v7/libbeat/statestore"
+	"github.com/elastic/beats/v7/libbeat/statestore/backend/memlog"
+	"github.com/elastic/beats/v7/metricbeat/mb"
+)
+
+const cursorStoreName = "prometheus-query"
+
+// The registry is shared by all the metricsets in the process, so they don't
+// write concurrently to the same files. It is closed with the last store.
+var (
+	cursorRegistryMu   sync.Mutex
+	cursorRegistry     *statestore.Registry
+	cursorRegistryRefs int
+)
+
+// cursorState is the persisted state of a query.
+type cursorState struct {
+	// Timestamp of the last fetched sample, in milliseconds.
+	Timestamp int64 `struct:"timestamp"`
+}
+
+// cursors keeps the timestamp of the last sample fetched by each query, so
+// following fetches only request newer samples, also after restarts.
+type cursors struct {
+	store  *statestore.Store
+	prefix string
+}
+
+func openCursors(logger *logp.Logger, prefix string) (*cursors, error) {
+	cursorRegistryMu.Lock()
+	defer cursorRegistryMu.Unlock()
+
+	if cursorRegistry == nil {
+		backend, err := memlog.New(logger, memlog.Settings{
+			Root: paths.Resolve(paths.Data, "registry"),
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create cursor registry")
+		}
+		cursorRegistry = statestore.NewRegistry(backend)
+	}
+
+	store, err := cursorRegistry.Get(cursorStoreName)
+	if err != nil {
+		if cursorRegistryRefs == 0 {
+			cursorRegistry.Close()
+			cursorRegistry = nil
+		}
+		return nil, errors.Wrap(err, "failed to open cursor store")
+	}
+	cursorRegistryRefs++
+
+	return &cursors{store: store, prefix: prefix}, nil
+}
+
+func (c *cursors) key(name string) string {
+	return c.prefix + "::" + name
+}
+
+// get returns the timestamp of the last sample fetched by a query, if any.
+func (c *cursors) get(name string) (time.Time, bool, error) {
+	key := c.key(name)
+	found, err := c.store.Has(key)
+	if err != nil || !found {
+		return time.Time{}, false, err
+	}
+
+	var state cursorState
+	if err := c.store.Get(key, &state); err != nil {
+		return time.Time{}, false, err
+	}
+	return fromMillis(state.Timestamp), true, nil
+}
+
+// set persists the timestamp of the last sample fetched by a query.
+func (c *cursors) set(name string, timestamp time.Time) error {
+	return c.store.Set(c.key(name), cursorState{Timestamp: toMillis(timestamp)})
+}
+
+func (c *cursors) close() error {
+	err := c.store.Close()
+
+	cursorRegistryMu.Lock()
+	defer cursorRegistryMu.Unlock()
+	cursorRegistryRefs--
+	if cursorRegistryRefs == 0 {
+		if closeErr := cursorRegistry.Close(); err == nil {
+			err = closeErr
+		}
+		cursorRegistry = nil
+	}
+	return err
+}
+
+// lastTimestamp returns the timestamp of the newest event.
+func lastTimestamp(events []mb.Event) (time.Time, bool) {
+	var last time.Time
+	for _, e := range events {
+		if e.Timestamp.After(last) {
+			last = e.Timestamp
+		}
+	}
+	return last, !last.IsZero()
+}
+
+// parseStep parses the step of range queries, that can be a duration or a
+// float number of seconds, as in the Prometheus API.
+func parseStep(v interface{}) (time.Duration, error) {
+	s := fmt.Sprint(v)
+	if d, err := model.ParseDuration(s); err == nil {
+		if d <= 0 {
+			return 0, errors.Errorf("step must be positive: %v", s)
+		}
+		return time.Duration(d), nil
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || f <= 0 {
+		return 0, errors.Errorf("invalid step: %v", s)
+	}
+	return time.Duration(f * float64(time.Second)), nil
+}
+
+func toMillis(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
+
+func fromMillis(ms int64) time.Time {
+	return time.Unix(0, ms*int64(time.Millisecond))
+}
+
+// formatTimestamp formats a time as expected by the Prometheus API.
+func formatTimestamp(t time.Time) string {
+	return strconv.FormatFloat(float64(toMillis(t))/1000, 'f', 3, 64)
+}
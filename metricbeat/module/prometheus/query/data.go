@@ -316,7 +316,6 @@ func convertJSONToInstantVectorResponse(body []byte) (InstantVectorResponse, err
 }
 
 func getTimestamp(num float64) time.Time {
-	sec := int64(num)
-	ns := int64((num - float64(sec)) * 1000)
-	return time.Unix(sec, ns)
+	// Timestamps have millisecond precision, round them to avoid float errors.
+	return time.Unix(0, int64(math.Round(num*1000))*int64(time.Millisecond))
 }
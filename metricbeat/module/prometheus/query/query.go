@@ -19,6 +19,7 @@ package query
 
 import (
 	"io/ioutil"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -30,6 +31,10 @@ import (
 
 const (
 	defaultScheme = "http"
+
+	// maxRangePoints is the maximum number of points per series that
+	// Prometheus returns for a range query, larger ranges are rejected.
+	maxRangePoints = 11000
 )
 
 var (
@@ -50,6 +55,11 @@ type MetricSet struct {
 	http    *helper.HTTP
 	queries []QueryConfig
 	baseURL string
+
+	// remoteRead is only set when some query uses the remote read API.
+	remoteRead *remoteReadClient
+	// cursors is only set when some query has the cursor enabled.
+	cursors *cursors
 }
 
 // New create a new instance of the MetricSet
@@ -65,47 +75,174 @@ func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &MetricSet{
+	m := &MetricSet{
 		BaseMetricSet: base,
 		http:          http,
 		queries:       config.Queries,
 		baseURL:       http.GetURI(),
-	}, nil
+	}
+
+	withCursors := false
+	for i, q := range m.queries {
+		if q.RemoteRead.Enabled && m.remoteRead == nil {
+			m.remoteRead, err = newRemoteReadClient(base)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if q.Cursor.Backfill <= 0 {
+			m.queries[i].Cursor.Backfill = base.Module().Config().Period
+		}
+		withCursors = withCursors || q.Cursor.Enabled
+	}
+
+	if withCursors {
+		m.cursors, err = openCursors(base.Logger(), base.FullyQualifiedName()+"::"+base.HostData().SanitizedURI)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
 }
 
 // Fetch methods implements the data gathering and data conversion to the right
 // format. It publishes the event which is then forwarded to the output. In case
 // of an error set the Error field of mb.Event or simply call report.Error().
 func (m *MetricSet) Fetch(reporter mb.ReporterV2) error {
+	now := time.Now()
 	for _, pathConfig := range m.queries {
-		url := m.getURL(pathConfig.Path, pathConfig.Params)
-		m.http.SetURI(url)
-		response, err := m.http.FetchResponse()
+		var events []mb.Event
+		var cursor time.Time
+		var err error
+		if pathConfig.RemoteRead.Enabled {
+			events, cursor, err = m.fetchRemoteRead(pathConfig, now)
+		} else {
+			events, cursor, err = m.fetchQuery(pathConfig, now)
+		}
 		if err != nil {
-			reporter.Error(errors.Wrapf(err, "unable to fetch data from prometheus endpoint: %v", url))
+			reporter.Error(err)
 			continue
 		}
-		defer func() {
-			if err := response.Body.Close(); err != nil {
-				m.Logger().Debug("error closing http body")
+
+		for _, e := range events {
+			if !reporter.Event(e) {
+				// The cursor is not persisted, so these samples are
+				// fetched again on restart.
+				m.Logger().Debug("Failed to report event, interrupting fetch")
+				return nil
+			}
+		}
+
+		if pathConfig.Cursor.Enabled && !cursor.IsZero() {
+			if err := m.cursors.set(pathConfig.Name, cursor); err != nil {
+				reporter.Error(errors.Wrapf(err, "failed to persist cursor of query %v", pathConfig.Name))
 			}
-		}()
+		}
+	}
+	return nil
+}
 
-		body, err := ioutil.ReadAll(response.Body)
+// Close closes the cursors store, if any.
+func (m *MetricSet) Close() error {
+	if m.cursors != nil {
+		return m.cursors.close()
+	}
+	return nil
+}
+
+// fetchQuery fetches the events of a query. If the cursor is enabled, it
+// also returns the new cursor, that is the last sample fetched, or the end of
+// the range when it had to be split in chunks to stay within maxRangePoints.
+func (m *MetricSet) fetchQuery(pathConfig QueryConfig, now time.Time) ([]mb.Event, time.Time, error) {
+	params := pathConfig.Params
+	var chunkEnd time.Time
+	if pathConfig.Cursor.Enabled {
+		step, err := parseStep(params["step"])
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		start, err := m.cursorStart(pathConfig, step, now)
 		if err != nil {
-			return err
+			return nil, time.Time{}, err
 		}
+		if start.After(now) {
+			return nil, time.Time{}, nil
+		}
+		end := now
+		if limit := start.Add((maxRangePoints - 1) * step); limit.Before(now) {
+			end, chunkEnd = limit, limit
+		}
+		params = params.Clone()
+		params["start"] = formatTimestamp(start)
+		params["end"] = formatTimestamp(end)
+	}
 
-		events, parseErr := parseResponse(body, pathConfig)
-		if parseErr != nil {
-			reporter.Error(errors.Wrapf(parseErr, "error parsing response from: %v", url))
-			continue
+	url := m.getURL(pathConfig.Path, params)
+	m.http.SetURI(url)
+	response, err := m.http.FetchResponse()
+	if err != nil {
+		return nil, time.Time{}, errors.Wrapf(err, "unable to fetch data from prometheus endpoint: %v", url)
+	}
+	defer func() {
+		if err := response.Body.Close(); err != nil {
+			m.Logger().Debug("error closing http body")
 		}
-		for _, e := range events {
-			reporter.Event(e)
+	}()
+
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	events, parseErr := parseResponse(body, pathConfig)
+	if parseErr != nil {
+		return nil, time.Time{}, errors.Wrapf(parseErr, "error parsing response from: %v", url)
+	}
+	if !chunkEnd.IsZero() {
+		// Move past the whole chunk, also when it has no samples.
+		return events, chunkEnd, nil
+	}
+	last, _ := lastTimestamp(events)
+	return events, last, nil
+}
+
+func (m *MetricSet) fetchRemoteRead(pathConfig QueryConfig, now time.Time) ([]mb.Event, time.Time, error) {
+	// Matchers are validated with the configuration.
+	matchers, _ := parseMatchers(pathConfig.RemoteRead.Matchers)
+
+	start := now.Add(-m.Module().Config().Period)
+	if pathConfig.Cursor.Enabled {
+		var err error
+		start, err = m.cursorStart(pathConfig, time.Millisecond, now)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		if start.After(now) {
+			return nil, time.Time{}, nil
 		}
 	}
-	return nil
+
+	url := m.baseURL + pathConfig.Path
+	result, err := m.remoteRead.read(url, matchers, start, now)
+	if err != nil {
+		return nil, time.Time{}, errors.Wrapf(err, "unable to fetch data from prometheus remote read endpoint: %v", url)
+	}
+	events := getEventsFromQueryResult(result, pathConfig.Name)
+	last, _ := lastTimestamp(events)
+	return events, last, nil
+}
+
+// cursorStart returns the start of the next range to fetch for a query, one
+// step after its last sample, or the backfill period if nothing was fetched yet.
+func (m *MetricSet) cursorStart(pathConfig QueryConfig, step time.Duration, now time.Time) (time.Time, error) {
+	last, found, err := m.cursors.get(pathConfig.Name)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "failed to read cursor of query %v", pathConfig.Name)
+	}
+	if !found {
+		return now.Add(-pathConfig.Cursor.Backfill), nil
+	}
+	return last.Add(step), nil
 }
 
 func (m *MetricSet) getURL(path string, queryMap common.MapStr) string {
@@ -21,10 +21,21 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"os"
 	"path/filepath"
+	"strconv"
 	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/snappy"
+	"github.com/prometheus/prometheus/prompb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/paths"
+	"github.com/elastic/beats/v7/metricbeat/mb"
 	mbtest "github.com/elastic/beats/v7/metricbeat/mb/testing"
 )
 
@@ -214,3 +225,319 @@ func TestQueryFetchEventContentString(t *testing.T) {
 		t.Logf("%s/%s event: %+v", metricSet.Module().Name(), metricSet.Name(), e.Fields.StringToPrint())
 	}
 }
+
+func TestQueryFetchRangeWithCursor(t *testing.T) {
+	withDataPath(t)
+
+	absPath, _ := filepath.Abs("./_meta/test/")
+	response, _ := ioutil.ReadFile(absPath + "/querymetrics_range_vector.json")
+
+	var requests []map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, map[string]string{
+			"query": r.URL.Query().Get("query"),
+			"start": r.URL.Query().Get("start"),
+			"end":   r.URL.Query().Get("end"),
+			"step":  r.URL.Query().Get("step"),
+		})
+		w.Header().Set("Content-Type", "application/json;")
+		w.WriteHeader(200)
+		w.Write([]byte(response))
+	}))
+	defer server.Close()
+
+	config := map[string]interface{}{
+		"module":     "prometheus",
+		"metricsets": []string{"query"},
+		"hosts":      []string{server.URL},
+		"queries": []common.MapStr{
+			common.MapStr{
+				"name": "up",
+				"path": "/api/v1/query_range",
+				"params": common.MapStr{
+					"query": "up",
+					"step":  "15s",
+				},
+				"cursor": common.MapStr{
+					"enabled":  true,
+					"backfill": "1h",
+				},
+			},
+		},
+	}
+
+	// First fetch backfills from one hour ago
+	metricSet := mbtest.NewReportingMetricSetV2Error(t, config)
+	reporter := &mbtest.CapturingReporterV2{}
+	before := time.Now()
+	require.NoError(t, metricSet.Fetch(reporter))
+	require.Empty(t, reporter.GetErrors())
+	require.Len(t, reporter.GetEvents(), 6)
+	require.NoError(t, metricSet.(*MetricSet).Close())
+
+	require.Len(t, requests, 1)
+	assert.Equal(t, "up", requests[0]["query"])
+	assert.Equal(t, "15s", requests[0]["step"])
+	start, err := strconv.ParseFloat(requests[0]["start"], 64)
+	require.NoError(t, err)
+	assert.InDelta(t, float64(before.Add(-time.Hour).Unix()), start, 5)
+
+	event := reporter.GetEvents()[2]
+	assert.Equal(t, time.Unix(1435781460, 781000000), event.Timestamp)
+	assert.Equal(t, common.MapStr{"up": float64(1)}, event.MetricSetFields)
+	assert.Equal(t, common.MapStr{"labels": map[string]string{
+		"__name__": "up",
+		"job":      "prometheus",
+		"instance": "localhost:9090",
+	}}, event.ModuleFields)
+
+	// Cursor is persisted, following fetches start one step after the last sample
+	metricSet = mbtest.NewReportingMetricSetV2Error(t, config)
+	defer metricSet.(*MetricSet).Close()
+	reporter = &mbtest.CapturingReporterV2{}
+	require.NoError(t, metricSet.Fetch(reporter))
+	require.Empty(t, reporter.GetErrors())
+
+	require.Len(t, requests, 2)
+	assert.Equal(t, "1435781475.781", requests[1]["start"])
+	assert.NotEmpty(t, requests[1]["end"])
+}
+
+func TestQueryFetchRangeInChunks(t *testing.T) {
+	withDataPath(t)
+
+	var requests []map[string]float64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start, err := strconv.ParseFloat(r.URL.Query().Get("start"), 64)
+		require.NoError(t, err)
+		end, err := strconv.ParseFloat(r.URL.Query().Get("end"), 64)
+		require.NoError(t, err)
+		requests = append(requests, map[string]float64{"start": start, "end": end})
+		w.Header().Set("Content-Type", "application/json;")
+		w.WriteHeader(200)
+		w.Write([]byte(`{"status":"success","data":{"resultType":"matrix","result":[]}}`))
+	}))
+	defer server.Close()
+
+	config := map[string]interface{}{
+		"module":     "prometheus",
+		"metricsets": []string{"query"},
+		"hosts":      []string{server.URL},
+		"queries": []common.MapStr{
+			common.MapStr{
+				"name": "up",
+				"path": "/api/v1/query_range",
+				"params": common.MapStr{
+					"query": "up",
+					"step":  "15s",
+				},
+				"cursor": common.MapStr{
+					"enabled":  true,
+					"backfill": "100h",
+				},
+			},
+		},
+	}
+
+	metricSet := mbtest.NewReportingMetricSetV2Error(t, config)
+	defer metricSet.(*MetricSet).Close()
+
+	// The backfill is longer than the maximum number of points, so it is fetched
+	// in chunks, and the cursor moves past chunks without samples.
+	for i := 0; i < 2; i++ {
+		reporter := &mbtest.CapturingReporterV2{}
+		require.NoError(t, metricSet.Fetch(reporter))
+		require.Empty(t, reporter.GetErrors())
+	}
+
+	require.Len(t, requests, 2)
+	chunk := float64((maxRangePoints - 1) * 15)
+	assert.InDelta(t, chunk, requests[0]["end"]-requests[0]["start"], 0.001)
+	assert.InDelta(t, requests[0]["end"]+15, requests[1]["start"], 0.001)
+	assert.InDelta(t, chunk, requests[1]["end"]-requests[1]["start"], 0.001)
+}
+
+// closingReporter captures the first event, and then behaves as a reporter
+// of a metricset that is being stopped.
+type closingReporter struct {
+	mbtest.CapturingReporterV2
+}
+
+func (r *closingReporter) Event(event mb.Event) bool {
+	r.CapturingReporterV2.Event(event)
+	return false
+}
+
+func TestQueryFetchStopsWhenClosing(t *testing.T) {
+	withDataPath(t)
+
+	absPath, _ := filepath.Abs("./_meta/test/")
+	response, _ := ioutil.ReadFile(absPath + "/querymetrics_range_vector.json")
+
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json;")
+		w.WriteHeader(200)
+		w.Write([]byte(response))
+	}))
+	defer server.Close()
+
+	query := func(name string) common.MapStr {
+		return common.MapStr{
+			"name": name,
+			"path": "/api/v1/query_range",
+			"params": common.MapStr{
+				"query": name,
+				"step":  "15s",
+			},
+			"cursor": common.MapStr{
+				"enabled": true,
+			},
+		}
+	}
+	config := map[string]interface{}{
+		"module":     "prometheus",
+		"metricsets": []string{"query"},
+		"hosts":      []string{server.URL},
+		"queries":    []common.MapStr{query("up"), query("down")},
+	}
+
+	metricSet := mbtest.NewReportingMetricSetV2Error(t, config)
+	defer metricSet.(*MetricSet).Close()
+
+	reporter := &closingReporter{}
+	require.NoError(t, metricSet.Fetch(reporter))
+	assert.Len(t, reporter.GetEvents(), 1)
+	assert.Equal(t, 1, requests)
+
+	// The cursor is not persisted, so the samples are fetched again on restart.
+	_, found, err := metricSet.(*MetricSet).cursors.get("up")
+	require.NoError(t, err)
+	assert.False(t, found)
+}
+
+func TestQueryFetchRemoteRead(t *testing.T) {
+	withDataPath(t)
+
+	var requests []*prompb.Query
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/api/v1/read", r.URL.Path)
+		assert.Equal(t, "snappy", r.Header.Get("Content-Encoding"))
+
+		compressed, _ := ioutil.ReadAll(r.Body)
+		data, err := snappy.Decode(nil, compressed)
+		require.NoError(t, err)
+		var req prompb.ReadRequest
+		require.NoError(t, proto.Unmarshal(data, &req))
+		require.Len(t, req.Queries, 1)
+		requests = append(requests, req.Queries[0])
+
+		resp := &prompb.ReadResponse{
+			Results: []*prompb.QueryResult{{
+				Timeseries: []*prompb.TimeSeries{
+					{
+						Labels: []*prompb.Label{
+							{Name: "__name__", Value: "job:http_requests:rate5m"},
+							{Name: "job", Value: "api"},
+						},
+						Samples: []prompb.Sample{
+							{Value: 1.5, Timestamp: 1435781430781},
+							{Value: 2.5, Timestamp: 1435781445781},
+						},
+					},
+					{
+						Labels: []*prompb.Label{
+							{Name: "__name__", Value: "job:http_requests:rate5m"},
+							{Name: "job", Value: "web"},
+						},
+						Samples: []prompb.Sample{
+							{Value: 3, Timestamp: 1435781430781},
+						},
+					},
+				},
+			}},
+		}
+		data, err = proto.Marshal(resp)
+		require.NoError(t, err)
+		w.Header().Set("Content-Type", "application/x-protobuf")
+		w.Header().Set("Content-Encoding", "snappy")
+		w.Write(snappy.Encode(nil, data))
+	}))
+	defer server.Close()
+
+	config := map[string]interface{}{
+		"module":     "prometheus",
+		"metricsets": []string{"query"},
+		"hosts":      []string{server.URL},
+		"queries": []common.MapStr{
+			common.MapStr{
+				"name": "rate5m",
+				"path": "/api/v1/read",
+				"remote_read": common.MapStr{
+					"enabled":  true,
+					"matchers": []string{"job:http_requests:rate5m", `job=~"api|web"`},
+				},
+				"cursor": common.MapStr{
+					"enabled": true,
+				},
+			},
+		},
+	}
+
+	metricSet := mbtest.NewReportingMetricSetV2Error(t, config)
+	defer metricSet.(*MetricSet).Close()
+
+	reporter := &mbtest.CapturingReporterV2{}
+	require.NoError(t, metricSet.Fetch(reporter))
+	require.Empty(t, reporter.GetErrors())
+
+	require.Len(t, requests, 1)
+	assert.Equal(t, []*prompb.LabelMatcher{
+		{Type: prompb.LabelMatcher_EQ, Name: "__name__", Value: "job:http_requests:rate5m"},
+		{Type: prompb.LabelMatcher_RE, Name: "job", Value: "api|web"},
+	}, requests[0].Matchers)
+	assert.Equal(t, int64(10000), requests[0].EndTimestampMs-requests[0].StartTimestampMs)
+
+	events := reporter.GetEvents()
+	require.Len(t, events, 3)
+	assert.Equal(t, time.Unix(1435781445, 781000000), events[1].Timestamp)
+	assert.Equal(t, common.MapStr{"rate5m": 2.5}, events[1].MetricSetFields)
+	assert.Equal(t, common.MapStr{"labels": map[string]string{
+		"__name__": "job:http_requests:rate5m",
+		"job":      "api",
+	}}, events[1].ModuleFields)
+
+	// Next fetch starts after the last sample
+	require.NoError(t, metricSet.Fetch(&mbtest.CapturingReporterV2{}))
+	require.Len(t, requests, 2)
+	assert.Equal(t, int64(1435781445782), requests[1].StartTimestampMs)
+}
+
+func TestParseMatchers(t *testing.T) {
+	matchers, err := parseMatchers([]string{"up", `job="node"`, `instance!="a\"b"`, `env!~"dev.*"`})
+	require.NoError(t, err)
+	assert.Equal(t, []*prompb.LabelMatcher{
+		{Type: prompb.LabelMatcher_EQ, Name: "__name__", Value: "up"},
+		{Type: prompb.LabelMatcher_EQ, Name: "job", Value: "node"},
+		{Type: prompb.LabelMatcher_NEQ, Name: "instance", Value: `a"b`},
+		{Type: prompb.LabelMatcher_NRE, Name: "env", Value: "dev.*"},
+	}, matchers)
+
+	for _, invalid := range []string{"", "job=node", `job="node`, `job=~"("`, `{job="node"}`} {
+		_, err := parseMatchers([]string{invalid})
+		assert.Error(t, err, invalid)
+	}
+}
+
+func withDataPath(t *testing.T) {
+	dir, err := ioutil.TempDir("", "prometheus-query")
+	require.NoError(t, err)
+	data := paths.Paths.Data
+	paths.Paths.Data = dir
+	t.Cleanup(func() {
+		paths.Paths.Data = data
+		os.RemoveAll(dir)
+	})
+}
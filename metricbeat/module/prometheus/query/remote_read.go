@@ -0,0 +1,176 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package query
+
+import (
+	"io/ioutil"
+	"math"
+	"net/http"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	"github.com/prometheus/prometheus/prompb"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/metricbeat/helper"
+	"github.com/elastic/beats/v7/metricbeat/mb"
+)
+
+const remoteReadVersion = "0.1.0"
+
+var (
+	metricNameRegexp = regexp.MustCompile(`^[a-zA-Z_:][a-zA-Z0-9_:]*$`)
+	matcherRegexp    = regexp.MustCompile(`^\s*([a-zA-Z_][a-zA-Z0-9_]*)\s*(=~|!~|!=|=)\s*("(?:[^"\\]|\\.)*")\s*$`)
+
+	matcherTypes = map[string]prompb.LabelMatcher_Type{
+		"=":  prompb.LabelMatcher_EQ,
+		"!=": prompb.LabelMatcher_NEQ,
+		"=~": prompb.LabelMatcher_RE,
+		"!~": prompb.LabelMatcher_NRE,
+	}
+)
+
+// remoteReadClient queries the remote read API of Prometheus, or any other
+// service implementing it.
+type remoteReadClient struct {
+	http *helper.HTTP
+}
+
+func newRemoteReadClient(base mb.BaseMetricSet) (*remoteReadClient, error) {
+	http, err := helper.NewHTTP(base)
+	if err != nil {
+		return nil, err
+	}
+	http.SetMethod("POST")
+	http.SetHeader("Content-Type", "application/x-protobuf")
+	http.SetHeader("Content-Encoding", "snappy")
+	http.SetHeader("Accept-Encoding", "snappy")
+	http.SetHeader("X-Prometheus-Remote-Read-Version", remoteReadVersion)
+	return &remoteReadClient{http: http}, nil
+}
+
+// read requests the samples of the series matching the given matchers between
+// start and end.
+func (c *remoteReadClient) read(url string, matchers []*prompb.LabelMatcher, start, end time.Time) (*prompb.QueryResult, error) {
+	req := &prompb.ReadRequest{
+		Queries: []*prompb.Query{{
+			StartTimestampMs: toMillis(start),
+			EndTimestampMs:   toMillis(end),
+			Matchers:         matchers,
+		}},
+	}
+	data, err := proto.Marshal(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode remote read request")
+	}
+
+	c.http.SetURI(url)
+	c.http.SetBody(snappy.Encode(nil, data))
+	response, err := c.http.FetchResponse()
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read remote read response")
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("remote read request failed with status %s: %s", response.Status, body)
+	}
+
+	decoded, err := snappy.Decode(nil, body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decompress remote read response")
+	}
+	var resp prompb.ReadResponse
+	if err := proto.Unmarshal(decoded, &resp); err != nil {
+		return nil, errors.Wrap(err, "failed to decode remote read response")
+	}
+	if len(resp.Results) != 1 {
+		return nil, errors.Errorf("unexpected number of results in remote read response: %d", len(resp.Results))
+	}
+	return resp.Results[0], nil
+}
+
+// getEventsFromQueryResult creates one event per sample, with the labels of
+// its series.
+func getEventsFromQueryResult(result *prompb.QueryResult, queryName string) []mb.Event {
+	var events []mb.Event
+	for _, series := range result.Timeseries {
+		labels := make(map[string]string, len(series.Labels))
+		for _, l := range series.Labels {
+			labels[l.Name] = l.Value
+		}
+		for _, sample := range series.Samples {
+			if math.IsNaN(sample.Value) || math.IsInf(sample.Value, 0) {
+				continue
+			}
+			events = append(events, mb.Event{
+				Timestamp:    fromMillis(sample.Timestamp),
+				ModuleFields: common.MapStr{"labels": labels},
+				MetricSetFields: common.MapStr{
+					queryName: sample.Value,
+				},
+			})
+		}
+	}
+	return events
+}
+
+// parseMatchers parses label matchers as used in PromQL selectors, like
+// `job="prometheus"` or `instance=~"node.*"`. A metric name is also accepted
+// as a matcher of the `__name__` label.
+func parseMatchers(selectors []string) ([]*prompb.LabelMatcher, error) {
+	matchers := make([]*prompb.LabelMatcher, 0, len(selectors))
+	for _, selector := range selectors {
+		if metricNameRegexp.MatchString(selector) {
+			matchers = append(matchers, &prompb.LabelMatcher{
+				Type:  prompb.LabelMatcher_EQ,
+				Name:  "__name__",
+				Value: selector,
+			})
+			continue
+		}
+
+		parts := matcherRegexp.FindStringSubmatch(selector)
+		if parts == nil {
+			return nil, errors.Errorf("invalid label matcher: %v", selector)
+		}
+		value, err := strconv.Unquote(parts[3])
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid value in label matcher: %v", selector)
+		}
+		if parts[2] == "=~" || parts[2] == "!~" {
+			if _, err := regexp.Compile(value); err != nil {
+				return nil, errors.Wrapf(err, "invalid regular expression in label matcher: %v", selector)
+			}
+		}
+		matchers = append(matchers, &prompb.LabelMatcher{
+			Type:  matcherTypes[parts[2]],
+			Name:  parts[1],
+			Value: value,
+		})
+	}
+	return matchers, nil
+}
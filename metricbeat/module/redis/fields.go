@@ -32,5 +32,5 @@ func init() {
 // AssetRedis returns asset data.
 // This is the base64 encoded gzipped contents of module/redis.
 func AssetRedis() string {
-	return "eJzkXF9v27YWf++nOMh9WAYk6u7D9hAMA5q13S3WtUXS4mJPCiUd2ZwpUiMpp+6nvyAp2bJMSvIfORmuH1rElnh+5/D8JQ95DQtc3YDEjKoXAJpqhjdwcWf+vngBkKFKJS01FfwGfnkBAGB/gwK1pKmCVDCGqcYMcikK92P0AkAiQ6LwBmbkBUBOkWXqxr5/DZwUuKFpPnpVmkelqMr6Gw9h83mwbz1AKrgmlCvQcwTKcyELYp4FwjNQmmiqtIG3Dcp82lDacMwg6y99iHpQWWRmgPHAJOpKcswgWdlHH959ePvRvF4UhGdRa+htSTafLhttVlJGkWu19VuIowGuNhPuBrUsqKjzjA/MFiDBuVWSnScaWEzwmefHAWTm86EqEpQg8gZhTYwKruASv6asyiifbX1ttUIxskT1fZeXDWqDCZWORaXLSseMKr0//lJiSjRmN3DxU/Rj9MPFYVy+d1jAYQGDBUghDF+VlJZtD/cSS0ZSp2QF+dpwklR5jrKH851nJ5i3QzgKI07ozM4V5TXoJ5upW4cELBJw4jtgqtaMDM9U+9EJJupAhgR3Hh5+jH7oYSBhIl2cxTEoKJFbV2C8sSNsHQNhDC5v33/6+OkKbu82/73/9OX+Py3oAV9bKb0j96N9rR20HT/2dbnIScJ65JoIwZDww0T7jmfU2IqJckTb+NUBrhoAQ+Irq9OK7tdPX1yM2lNelcIsUqvuaxtEKiUMszhngvjCwAip3a+UxsIiTAVXVbGJ/g67QrlEGbaVBmOczinLJPpm71RgvyiUx0KtVI9DmlSeCUkXRoV4BqUUKSqFPcFjDfZJBduP2Ws9BRZCrk5rQG7Mw/I8K8glYRXu68+dn7uBZKXRZ4MjBPtZaMKAr72+HQoIY8KGdSPmrUIgAF+qsA+YDvyHDmzrV92EbDggxu6QNwojSpREmxCmnCVckmgREZCoaGZzddSg6DfsCb+W5RLJ4gl4/oRk0ahb2xjGzBKryBMg/qIwaxDXk/C+IoB8RjlGzVP9yDOiicK9K4lTGMgcrToA5bWWidzyUEMCv7OB7XTzyez7Dyd3RguqexPiqBSMpl23uIG4wNWjkL7UaASKN0tqM1xwREALM63wOEfeaIZFaFIgiSSdB1OgNupcklmBXLtUz1i1CMI/IvbcmYEhQf1o3IhRx9hhjqVSdoWi9d1IsKEZnVodbq0G78tLkCmSarrEOEPDXURVLCvOqRf8CRLot4zMgLos2jhwmtcAwAFYi9eokftlBAsuUIhwznWU5tf2t6YyQq3Xz8a+lBx6kxXoyTc8NLylDgzpIYzQRRgnHvN5tQ7VAROCHY17Dqid5hVY4BDmJrV4BqjvmixnhKjHuVcYdLF7wHu7ZcVhkvs5VTizkBsubGIWGm2tHEqdR7brqR8Wq8H0XIS5hr09lreyK1EqqjTytOshTrU+sm9txwTJzhkPTWJqaJoklUBWFSXklKEJiIJfz4Qfy7/gs3gtoBBLhIca8oNJ0po/onpd6sGmCCTLQOg5yoY9JxtI7FS5KuxSaSI1aFrgFWhbZNoJvLLvNJZxBVEUfT8cEmWW7B0Gx9RSUixphmp7yykRlYa717c96gQjwywjSseKLDFK54TPUMWK+keDMWY10mRaa7iOKliqTjmI0lYvRuI2Ezgx3DelSOfXCTFloiGnNClKg95iVVWaolJ5xeycGFBhfWnzkMwsA5THpRQzid4VChhhiXuw0rVIssY8YIE7sN0MaKKrftjh5HQP2PeWTlPYWrGvcdfrJcIvGR9qM4eRwvTY4JFVPYRH8/a6HmWAO1PeK0wFz/rDdc1ovYfzzHltFM7Lbw6E+xPAXgGkolzFgsePkupGTem355CRe1dqDNxrwa8t3KbssXttWSWNaDY6cfu6K6OQXMKlmwhvoU4Tp159fOvi1DFhKrztBdP6R4OeidnMZi91zb5VlQ7UVU4Jn9jFGyZqKG37GunvGyZUOsesepJZIDzAwyNlDBKENTYQTR6x61qoglQUJUONuwuNPo7/KcEiML/j4kXD7D8sYAR4DseMLr+u4yF6JqHh3oSFmsc2a532j4HIv6W4T5ib+SZnHA/PA39XrbRoJmYcF/9HKchI1XzWxhbssRrgyrDT+M1nwJZhpWndsmsqdqL348YUuc+ElQ0LggMjGpVtU5W6KkHIxr+M079crXga1Y1iZ1vcsFTX7Wl/iURZs9s0hLx7+RH+rtC779oFnyEjq8O3Q8bGW0elhp6KiutQ+NkE1JLR1Bfrj1jTDA05tJwpBQtvYh+1T3Yn2NppUK40MYnmZUq4yT8vCqI0yosro5kXtgX5ItRiCL4O6ti1LQehn6RfsiEGHWJBeI6rWOT5Aa0N7dbbn6IfD0Nv45VtSvtOrZ1bS+MggC3IkrE+JmZRcJvuWHl3a5c22Jq4Z/c3BDMQMc/TdOXtKvHxEwigXWZyKpWOzWgHq9QohXFqW6vGMO7Ny4MczKnSrKeP8HDc96EOHvPlSLkP2HE0qdAPsdIOPleuTqsbtVJUpUnqH+c0nW8BffdaAZEIJE2x9HUfdCAzyhfhSuUEYadTnVC+gMuqfJmJR/79IDhTeFAR18sAMZmF248GfElPNb9XDKqhdPd7qEkzSFqv6eh5zcGgwpjEqH9x7QS7mOu+eIu3di5UgaFuqyNXHdqgOgoxw9y5wefQkmrAQIK5kLjmqLVSNo6h565oVsm0JFwZf2/S7rqyJXD/54dfe/aQgvzb6Z7Wpe560MYPWOLrNHQAYympkFSHOyePQ9kMv5McEwUEUsIzmhnjyYWEnFAmlj2G7RBTFUskmeAsDHqKxoRarLbFM7veIu8tfVzMO1XVc29H655YhRFVzxKl8tuMA0MYJT53URI9d1zQFKPwKAWdOfO4AS291WqbteF8ZEZ1rObk30eGzHGEMip7dP9UlJKKsiym4ZNZpyJUiOzYCneYiAhHpn5dEirKK8bOoENEpvM4oT3tpyeTeMU0LRl+pXwWk5JOr7VpGg+Z9Klo1WeS+jS3f8brAaLSO8KpZ11W/BxGptMyLoU8KrAPU6nKQPPSCWnMv007PpNVnDKR7n3SaD8yqeA5ncU5PXp5byCiexraj2zNPOZqBXs6XmKKdHncYWp/9tY5Wtc+6N0QDR/J2Yb419EXQRwA0REdtc5q799QcXME8xxIHcn1qc8xODnqyB7IP+z8zViQqB+FXNRH/5tFp/BMG1TuPoezwKqvjtjFFQToSg5NOIpKRaJUcYky9jcxnHI9vTvDUKKsK8+RWN0lD4uknPKEuqnSauF+52obkKYi26A1wv799qVPYgEZu2tEzgrcbU8PIw8XlyuehtLTU1TCG+M3RECiIajcglZgf2cLWkmkpoRF4qhgOgpgs74JNc0aLEj8u0LlSaq9QFFOcTPJNtIMOR2BMwh4gSsV4deSykmuI+m6/QWuwFJzyza49FzZ1AW3pJNfolTTsAQhqxC0gIJ8bR9t3RmhF3VJUozmfeXXKWC3euuZEIuqrEWsml2RglAOmTu7S3oOt64hF1SpifdZc0IZZnsCDhdoVaKqxB7P4MimQP4bE8mWDpdV8lJVCTQ0nQdrbgqrkvWIYcWuUZdEa5Se5yZEXdMcATpc1NhukzgXchFX06QRu42ctsEltwlQu4ezoKkU3UbO8FqFrbcxTu0R9ViJdIGT2Oi2k67pGOTcLvT+8e63u1ef30BZyVKoMb0GNkDGzlGrWEuSLjCLjelMjt7aZ03RorcoVmvwcElKuxKfMATBmb0ewGQj9ov6wrlhDrfPok/uO+2FDibba/XzlShzIesLOeqj6XajuXM8vc5oR7JyBp9KEiFN/PIxZZvCNhfuBE7c78fSAlfx5DPk9G5ONDyibICzVQt6zwb0Lt4zTEMHsVrQsjxI8hurF49MzCLbaeZdd/HAHoD8qxnLeiUmHjfO1OODWtnBiy7dfe4IXeCqdUXo7hkYm+a1Xtn/7k/zr1c8/kWvAQn9jis74sA9VDsLrUeQ/MLp3xUCdQ5Wz6myqfLlf02UNspjZAY/N3naLzc/Gwy/DN3TZxCdVi7mdXsl01w82juZHj7/+emN5wpXLx6GfKbnJ1Lk93awJkOw4mpl9AwLe/2hkSejSqurmrr9RmlJ+UxdQUpkRjlhVK/cD6jVkFRdFI607lbKh3JyX+/Ga9GM7bNBO/PHGqIdpO/CXmuRtUDtw9Pe2ntKI/q9Rgz2wgGaUwxd17KOEstZfLppfLVESWYIWrMBup687VCinehT11Hrgm6MJh8K5X8BAAD//0AQtHI="
+	return "eJzknF1v3Dazx+/3Uwx8LuoAidJz0V4YRYGkSXqCpo1hJzjolUxJs7vsUqRKUnbUT/9gqJfVakVJu7bWWzyJ0cb7wvn9h+QM38RXsMHiCjQm3CwALLcCr+Dihn6/WAAkaGLNM8uVvIKfFwAA7j1I0WoeG4iVEBhbTGCpVVq+GSwANApkBq9gxRYAS44iMVfu+69AshS3NumvLTL6qFZ5Vr3SY5h+7ty37iBW0jIuDdg1ApdLpVNGkMBkAsYyy40lvF0ogF2UNg4V0rzYRzRART93VMB0MI021xITiAr30buPf3z4TF9PUyaTGhag60mAfhltKbHgKG3t22FFI6q2FV4W6iQ0vhyC2QFSUrpGsveJGksouep5c4SMfv7I0wg1qGVNWBnjShq4xG+xyBMuVzsvu1ZhBLtH8yLwUhMTGhuq3Ga5DQU39nD+TGPMLCZXcPFj8EPw/cVxKj+VLFCyALEASxXpyrV2snvUa8wEi8tGlrJvtZIoXy5RDyjf++zhumdR5CeO+IpKC7msoA8nfqKaeluSgCOB0tVHVBWXk2uq/dHDZc8lSMkywsMPwfcDAiKh4s1JAoOBDKULBRSNS8P0W8yEgMu3n64/X7+Etzfb/326/nr7fy30RR9/LHJjUT91rHWFtvPHoSEXJYvEgF8jpQQyeZxrP8qEU1Sj9Musy18dcFMDjLkvyxd9eEe77pfrr2WOOtBfucEkMIXxOszETGASLoVi9jiv3RbGYuoIYyVNnm6zf+k9g/oedTDKGMZrLhKNckbYrwb1Y1Fzg3pGxAF/RizeUBOSCWRaxWgMmgmwz+rYYeZFH3iKqdLFog/06A5UlnncOM810HsmcvQ60BPPywH8FUSFRXOcY78oywTIJuq7ooAJoShUOTfvTAQ8+NqYZ4DfJqsS28VVh9tSwChEoKwbjMpQM0spzJQ94ZIFm4CBRsMTSssGLRj+Dw6kXyc5Q7Z5Bs3XyDZ1c2t3him1JPL2ZOhUxF8NJjVxVQmfcgYoV1xiUH9qmDxhlhm0z0D/ZY2uOQCXVStTS6ehQtqCexWk7Nuz9e/fS78LnnLrbx1EmCnB48KLuMHiQenkOIr399yNcKE0AlZRtcLDGmXdMhwhcJrjs3jtHQK1qZearVKUtDygZEC9ur0WUf8p8R+Re26oYIjQPiBK4k7CkjnUxrgVitZrE2F9NTp3c3hLXz1Yi1cUiy2/xzBBqoqAm1DnUnK5mmcA/UGwFfByFE0BnC8rAEhwx73UjMp3mnL8EspEofQ8Lb/qf42V4ACisG9IPjxYGRpv9NjoneqMtsMpbXGie+jnTZOqPV1oB3y3Wp+R2oFQ9MIx5npocQbUN/UoZ4Krp4XX8RB7AN6HnV7sN9mPOOSfUzq5VoHJQGm1BG3MaXzbVP24W4npXJzZYO+WtejjzlAbbizKGBdTI+Zh6yPBoiN0ZG4nFEtOmQ9pYEo2aU7EIMnTDJZcICVEJV+tVD/L/8AX9U5Bqu4R7irkOxqk1b8E1brUnRsisCQBZdeooXq79A1ErqrK1a1LY5m2YHmKL8G6SaarwJfuO3XPeAlBELxoiLxu1EnkdaEvDU5w4LVW9zxBs7vlFKncws27twPNaWqaFczY0LB7DOI1o72I0PD+0iZ1qwmSOmu4pVVwVt2shYhcu5jITRU4M+77TMXrVxGjaSKZM5alGdE7VpPHtKqzzIWrE4JqShrUEK3oswGXYabVSqMxgzr8PfEAKd0eyRrmkR64h03a3fgvH8b2D04PwL51duqJLZneclfrJUpOpqY6DAzGxzabOnkk+YDhydreVaWMqKPpvcFYycRMEVrt4Zy51rrB9epdApP9A8BBB8QqK0IlwwfNbd1M+T/4WAc8wUihd6WGcF8p+crh1tMet9eW5Jq66LZNvH3X9VHnz2LvH13nMLVc+JwwT5568/lDmacek6aq7D5Yh7PER6IXarWiiqjn7Duz0kFsja5WnzvEk4gKpd2/Jsb7WoShRa/8WWqBSY+GBy4ERAgNG6h6HLEfWjgda0ozgRaDSYr/LcnCU7/T8kUt9l+WMDya/Tmjq7c8WBGcSWq4pQX8SmNbWuf4x6CyaFVre+6xWV/lNN8f1HAe/N1mZVVdMU0pgyr+i4YgjaVBh5x3Z/OesRpRRY6s4+YZyCIpFU25pkJ8B6qhSe6ZSNlKUBIEHUuytIyjbZ6B0nV8aYoY1LY0hYyD6qDYsfoOXtxwVpvjaX+piMbH7QMhH19/hr9zzHECfIKCFZjMDP+utFLahFjlsn0KbtGHR6dRebwbDMamFSM0N94ifZOFBkYJXByeLiZ450aJJmhwaSyjgeZlzCSNPy9SRif7Ll5Sy7xwR5AvfEcM27jNCerQfccsDqzZCdyttbbaGHSMefFKVaFaLg0+6pD0j8EPx9G7fOUOpX1nmuDWanHgYfNKot4n1Cro7L5O1jRK3J27tGEr4z27vz5MT8YchByLw1Pc7tbD9wYKlDD79HgSaFfMkmtjQyrt6CY1Tr7GqtlWTWOc+4BWs+bGCpQzcN/2+ZpO8NCLE/3uxS8dEszq9GN6aYevnK7O2zaqRpFntHv0sObxegf04zsDTCOwOMas78mWDrLgcuOfqTxB2unMTrjcwGWevU7Ug3yx6Hx2D46mgVyF1TJAyFbqUK9OmM0flIMqlO5+D6dhBoVEt6Zj15WCMYE0SBlZXBtblZpA35yLB7sNLnRYupAxRfhqduiS6iRigcsyDJpjq+MRoX1bF64QIBiIcKk0NopaK2XTBJ17Q3OTV6uZNPSUCw27q5ktg9s///ilZzpb//Xqd9U9b0jdj6B1HHDGm2HoCGOmudLcFjNR1sXvDY6ZAQYxkwlPqPMslYYl40LdD3RsJ8yd00OWKCmKebq052BC5VZ3xDN5tWN+0Uvrct6iD++IWc+tK637xOqUWc897a/09pnSV0xw1hcuMmbXpQoeY+AvJeWrsntcgdU5jvi8edvLu+I2NGv2v17gaSmzeXvQUMK1LWa3FOVcJCFPZjeUqgRnN6LMkW1JmWCZC3GCNsR0vA4jbs3szkhzYXkm8BuXq5BlfHaDqzgOx7r0U9mqnkkaarnDNV4VEGQ8OUGt61yeopPZOAszpR+V2Ju3vVbyzHN46QltrP+Zt3yh8zCmZ27nNRMrueSrkNaEvYYeU/m1nb4D7Y9YxqQHvY46llktDNImTKAxRn6PyaEeLu/yCEv87lJuv4iBxaDtE3gtNqjZgolK/jrqvojTKCnZtkoGJLnbPExYxb5zElSS1VF5khyJNnCXDXiPVJ9SC9oHpTfVtQT1gliw6Hx4B7680+Kc6KvbL/bxvTrKWZNlElVuApWZMEMd9p/DGBQ0EpN2Z8n77YXOalWT54msrqbCTZSZGR+vpolm5dzvqK+yBDRNKre0tE7629vXfR7z+Di3Jwd3BwImkHsluOUWzwj7qdv5kKKmBRELPZpcyNiUS3eenawdBRnTljMRqM256KgXfKFCqzSBxr9zNHaiHtT6XAQlKPkEOV5dGyxMgN8yrjF5Rk2dBLfBAhyUW44AvEc5UDelBnq09zk1bMNthUITRQNJjrQHkbJv7QeM90oYFJexGIP10CT4hOpaD0IIpTZ0TGPpKOstrJRxCUn5oDXTxbiylNNtGeegjRYuMTlQl1dglkcmj9wjNxLFwQInkP8qVLTTcbI8em3yCGqbZayubngyedSUaMaoM2YtanlS6srmBGgvfXmCKFwqvQnzvlHC4/H3D+eSSdq02Wx3GKjRpDzWqtqhaErykpdrKBjG7tqB0NDNWtbMwL+bQCo7RC5JA/z+8debN1/eQ5brTLX7pZfcDQVCF6/RhFYzuhMspK4zOz0Zgcqio3cURQMPlyxzuysRHeuRoqBYTMMzetCgGsO8OPR+gTWfpV62Ychd0kHD39YZzQw1bZ65JxYrHreZ0b1yoBriT5RyXOg9SAyLlKZs2CfKHfTbXqLkuUXhMEkbLMLZa6hsd2tm4QF1DS6KFjomB/CeoBo6xGbDs+woz9cqjFAPdNzHDSsWE7FHkH+hsqgHUOHbYLoFWHQpNlgsxtbwBozebbBoXfu6/1wTue1x97nSf3vd07+QOeKh37Bwyj1rDbVRnjydya+S/50j8DLA2jU3VA5c/j9laWo85DP4qR7O/Xz1EzH8/GIEkerq6SDJL1Siu2ZrrR5oSQbuvvx5/b7nWt5eHoFyZde9RIc35E+usHqE4Ny17YookOK1cf6kq1PNy8q6e8VYOrNgXkLMdMIlE9wW5Rto2zfe9qpw+Q8Da8UTKbmtTlhYVZe96Nqsa/6xHdHNBoYuYXZPxFcOdR+e9ybmp+xEv1XE4K494Uvevn+x1zq7X4VPV41v7lGzFYK1YsRuz7jtWKOd7MPlTuWNcFTjySNR/jMAsnEv7Q=="
 }
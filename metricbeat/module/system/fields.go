@@ -32,5 +32,5 @@ func init() {
 // AssetSystem returns asset data.
 // This is the base64 encoded gzipped contents of module/system.
 func AssetSystem() string {
-	return "eJzsff+PGzey5+/5KwgfFhm/m5E93mzevvnhAMfzcjeAEw9sB/uAw0GmuksS37DJDsmWrPz1BxbZ39nqbqmlkYMMFtlkRiI/9YXFqmKxeEOeYHdH9E4bSL4jxDDD4Y68+IS/ePEdITHoSLHUMCnuyP/6jhBC3B+JNtRkmiRgFIv0NeHsCci7x98IFTFJIJFqRzJNV3BNzJoaQhWQSHIOkYGYLJVMiFkDkSkoaphYeRSz7wjRa6nMPJJiyVZ3xKgMviNEAQeq4Y6s6HeELBnwWN8hoBsiaAIVMuyP2aX2s0pmqf9NgBT788V97QuJpDCUCU24jCj3o+X0zfznq/NW546kguKXodn3IKiguLHjVKBYfnoEZCkVoUQzseKA8xG5JJQkGTcMv1fhYP5TZ1r+0ySiSgiLa7/OSeFSrBp/2EON/bHQ31lUIksWoEpUtU/+D/IIKgJh6Ap0EFCmQc3SyARh6YhyiOdLLmnzA0upEmruSOrGHwf+8xryL9IVMtqSY1gCRKcgDGECgRGd0gg6aKtRYFj0pKdhrQVHE5kJcyQwry+XyNwnUAL4GComZHAvh0egEyyCy+OwFITL7U2qmFTM7EiqZARagx5Czdk4fShKFvML5DmiGgD8fIo8AJDcUmYukJeCWGDkSgoSM/30chgd57QR4/Cp3y+PyRrUhkXWNbMu3ZqKmNv/WFMVb603x4QBpbLU9K5H9fv5WD8Zai2X5luSi8V7GIXPLZsDkBug/PIkwwRhYiN5JgxVO2cCFjuMczZMmYxy/MZ2zTjgb9e71LJES9WabEt1jV/SrEHlW6BUs9YX3m4o43TBgUjBd3bz/E2wr4MYeU67eLkMKmK5NDsqlIvSrBVNWqpsxKyPi85smDeloFxslgsKRyepAu29L5SA1GbmPizFjbDrh7M/oBkmksrK0GTLOCdrugEboNKvLMkSsqE8w0Xz5fb167+Rf3PTfcGxW4OV89TGpVwBjXfE0CerH0z7UZkwktAoQrVztmXTHjSAxUL5U4em5INopwj0dWvYncxIRIUTWpXlRfJmpYAaUPYXwvGN/CwVga80STlcE7Ykf28N61TKfp0a8uPrv1lo11avnHL5tMcsSrNZzs0vTnsWQG7/2SmcP1cI++cKEr/d8OvPEu18Q17rX355gMK/vNtpvFsjzYUy0vqCoIkjG3fUh5gDKs7Dh39ZK9TllPxaekaD/BPrSV0kC8amqS+WkLEb/WUSctRuf5kkDd/yLxT/Afv+ZVIy+eb/TZF5qAdwmUR+q27ApXFziBdwnSdCNMQ5k8ucDQbXAdobHsPnVnbvWzmZvuQz3W/jFPQCDxMv+hDuuY9CDt8Rnxv5oZvcX2cPVZ5YPWXyuyYrxhw/2CEq5w/2P8nDh6KMbGANXv4z/ozC/jMozyfYbaVqHhz4/PEd0TG9HS9uJM9O2adsoBjlc7d5joA3EML32s+Ql7uRz2umSUJ3REhDFmCVY8Nit41Tzkumt8b0OfoeghTQeIYHHhMuHvSUKh6GncSqjJWQVRmdRVbDlxnnux58W8UMnBwgznIgQuTgYmeGn6jlrmDoSweAx2EQRh02+SDIeyayr+6IizWnIg0/UENkpPIj4WFPypnXNEGo1lliOYOfIpr9gX7oP27fDJLg8zPI4jAgpuFRPthANrVG7WcbqpXdd06o9gnjNiaIpIi13968WcEVO0iwzwbRrdleZ/HUAMMYY2n3wYdXH/oB2uhthtJW8HsG2swSUCvQ8xTUXEMUxB6KMHvAN4/qcZn7KTXBOfGUnDhK3IntFhSQ3zPIICZG4mKIYcN6YxtPllOR89KFc56asJq8ziqoEj3TuoW+QucBAjqvZKalBCXiCdiz20xAxk/lflv4vi3MTd89vKX1EkRtfDEtIXQDiq6gGtMspWpoWVAiRloP1AYsEI9Z/2eUilOxU4rFkXQ+uTQWzUSCyVc83azm1kc5DSno/Vwx4dj70orJoh5oAYZRgjb8xHTgHISDWJn1SYg45zKfVpFc+gLmnV7W8UrkZnCEWGWqulsvkaiHVx+mlcci07vpqHkMZ+7jTFkncbtm0bpOQvemeLWgIt6y2KxJZhhnf1A7LTKh/NTLGbl3H9fUZMp9REZRZgMXVzNXljxqEnGpUfT1KsacJSCMkunumGRSmbby1yHbY45PENF80PmCmUlTfwVaO7AVWRtuCeP5j4JKvB7nteUmNWwDufakUvIiZP/h9X/82JLyknGo3XwlB2UNy2Fatcvln6YoYS6IPlNOAROEeKpT4beRNuTPRKrYhnGwcQaeTeU73iwI3S3S+cgE56gkZrWk9o58eRXD5pX96+2XICI77wmg2DGaUOCr+SEMAhPu81SyjkzfwVhwYGtpcewWb8JoUFtPmDaw4xMhY9BWW+waxd+0M+cVSAqeVdv3a7VFN5+aaxV+KYBDmIZ8PxPXnIwrvNvPsUzDeRPHdsKR8J5/d2uA3lenUKiithvMUfuYVyk3UmUrq2xi+UkYXa0UrGhxFEY5dyancbml/OrRt3cOPQz5tW5+PBqylFkzMq4tnyOW9eeA2dMz8qvEgoR/MRHLbYf+uakDQd2+VRCWdJsRoFFeJRdILCPdyg4EpED2W+S9rOlD38IZiiaIZypaxMaaaAK0i+fZAOLK7QEYMs/nQ+jM4BUCTXmmkacv2yEQlzQ+xpzYkM+Okce0RxqAF7cvxhpl+ycmVvMljYxUdzbUG2eY31fgF+Emp9qQhInMQHgNv/jHJSH9h8faYXBe3F4U2tsA3DBuLEl8Lp0I6AKJWVGjMKzUsE3Oc4kirDBTUPRs2tWhVUfShB8KU3TaS8Qt6+y6hB3l7rkhWikL339sgnTF2cIQ3Ncc7v5+UucLP36zW+wgWGeMcl28Vlb6oUflZV7ERq4CyyVLYwkaC7GYiHgWFx+OpHBVH4td7k5GNFqDJlS0/a9FtlyC0uRKQxG7etbQyGSUzxpuyMWHZ4ME62g7zF9vI3mLo5UdAiHGOlLLuT4vfq+3HFwR5AweqSeows+KDj4YosAbQ+0y/cwqEYgIyALMFvxNeK/SWOVQzd14CQWbJNif5idJDCmIWOeW98MnlzdLpAISg6GM62uSohkk0RqipyJmrujwlw6VIM8fQ3l2h5f8g8FzEcqjjGNgv6BWLBVe1MvGnHXI+w38Akl54IEpgVepktGrBBImlvK6zQv7I1V1QvxaFRyGJ6VRKYwIW9ZHt8CthSoE2o697M8HQT58+i/CkFBKdJY0DWCuQ0zQCI8SchX6UMTt1/778Ht7YXspykIt/NeHqkWHeSNDTBzpNXNkYJTYPmtprdK+guEtbVq2bpuXKliyr3fkxf9Fsv5f072qp1as5uEopdtiPRWmDYu0OwIqzw8tjlo/1VybQ8nT/szHM8ftJTFDVem5zDo6PuPwPpdFLA9pR8GVmZmlrcvjAzDXMEW5E4ZDIYTUmtzM9CNg4nQAmOifXwGN6Rrrz46GgbwvBiT1AQcgwC1idM5vHwQckayrZ+zfmtXOhi3C4kifrmCOQd9h3mq+ZWP1SmGRR1rYdKUjKuZPFvaBipVzM3hbpYXa631EhXCRjJu6D2DMFEQHWoBjALp5ebNMp4oPg4GzAbOzFcmUVilFi3cGKD+jdMvsikOrIOKUJUMljWjPJ+putL1idx+Yw3LJIgYi2p3RHrm5c7SkxFCxRzPylnC5BVW1UUzELMJL3KXyWM9aG5WtVngx0shi3KYRa7LAifN5WODmPjsLgnZ8na0gpKxnd8AtEK/Jz+17D1t/4Y21PD6uEOQLMVIp+aX74r9UkkVMEMq5jFBEJTlTRKY9BBzl29RLSWt61VmxSyaKLaZRnTLTdLASKXAVys9LSI6CLDLjUi4BfRpJmc5UyrMTb659hMkNqEgmCRu9NGJY0oybUNHGYBqOWN/3bnpX6LqUahx4u3HNqvFmE3how2ghq4g+FMNW6O2w8qQRiIR4QfqY2YI1BFHFSlDOFzR6mmTqd3lgXWEN1ugnmcYr7TrlzP7LEjvLbmlahVe0AwCzlaqKaPwpnx+jcsznf1NtbFB7Hyf/OzajWDYqWc7X0wDMeuTBLx6oNsEPaXAgM3PWmsTmLW0NoqtpYSXd85wIFUTA+u/HuLRY9AST3k2oBkY49kCGnQ6JKpAMZAwTM1BKqtOwxQ3t2684REysBsjqXJg0iLgfEROzWElrrE+CiIlIJlgS72VXXpry0w7g2CkBysys5H6A1YN5pgnlW7prb5avbax1T9XWOvwiJj99uicLiGimwZ9eWddNQSqVKdM33a1sGvvRXGdJQgdUnxSbxQIMHbZf/eJ3JHeZx8W/Ky4XlBemHY/mmNkN3H9YOvu3oLjk4r+hFdH0COzh0eXMQYW7wploytk+v+uZLounnO63+/7p5pwZmHjO98zA/olZlEwqxXe/BCgtHFDXiuoor8uPUfG6/G+sy0Vjauh19YHC6+rLj41nE8m0XhfljDYtRkrNuqB7FvhqwlbuRmXxpGR7RmzIOMLRG1J143mGQzfuML1QmRBMrF6E61rTjscY+8lvf3MI9ekREx444+rwGdtfHTJjlMSciYllvMw4JzbypiK+scO7VJWRVurKuESCw33tS9BwWwjU9FC1yhIsFtKQUkX93hasxmcrIRXM6UJu4I68ef3DP8MWT4M6YCm57uGHraNoe6hY7e7IxMofWdTLQ4fODmIz3My6X86P1AAQG6aksJIjG6oYXXCf2wtqgXtQx5rQUOcqWmkWSH5WAD99ur92ZUvOyH74RP4rbDLqbxeR6XLm7x5/u9EpRGzJomqyPC37Ho5Nh3d2nyWjTr27z5IDrSBN1SLva0vbBOt6CKPTeiK0xZtEFqw7bdBMROC0x9uLLl43gV7eUX6jG6f31wtZIKVFsXuWxrhbPphKoKBZwjhVvjAqOO3f7CwFI6sTxEynnO7KSMHINDfZeTvOdufFMHM7Okl/UxyGTS39UB+5Gp5VXuJq3Tco6/0tF5khioquxCcWRr5ud6tosnhP62dyZrsQbgndBOx04pR4XW3wXvHu4ae1HqEuLyW6uO30jkFnMW3zF71yJmKHXDs1dFxQbV3+IMPrdNx54Nj9qG+/69uvnulwpNSAvE2xj7Gq7F5TXS3wddXNjcrzd3g0RN6tqVoBuTKBixTFyNS5K3k0RwVdgbKzEHfAhKXOmHD3IUyO5GXxIp/PurpLTEz3a6rS+tlOmC2TP4JmsV1an8CQT+wPmDWsRYDvMoqylLlj6YTaf7jPXH18+8vLXolEmVJ2Qu/0Eg3uDOy6UtL+a7UmvPPudZOPl7c7jWfeeMq3z6hBjuZ/eUlOp0K5/kjB91QObS9A5tNIfRi9ek3VcxljnDsOkZbp4Su2OLINR8oH3Kz6mXEoPiOVjyHyTJxz66yFQbG4DDXTFaTLQKN7d1fGenc+Dp16U5UpiKPcpka3mToPNI4/2FfiLGFmpuVydI3QUNWRS+NmySvJeqAXXndwyFo2oTJ2RAVZAInW1h2Pm5EANYSKHfptfaxY01YyZCpW2KFPxYrK2JYV+OTCAoii+Ts6SkrTkUAJLbyDl2R+EmQXEOLRZYtTNxO218VGguiPUf3kLnYlgM8ItEb03yp62Cgoz8BaTrj119xAes1SLJ1rDSikuLHs8CMjAzXUJkD+1SwamoWx+Z5Wvpb0ZF4HMJh4bXq4R8fUapLERj6OGk2o1jJimEbdMrN2bphlczj2fcCsATZxFN8bQvNRH+5dMs+3MM9Hx9GQ7vwSYXBUuthz2E9qdUNmfTom2dHza2Vej5r9Bv2vdbZwcfj32rVEch3YRrEMZzsH09o5TzKu9KubY1GalbwgOlpDnHHQGItTfI3AxTdUPxUVg34dBcd8676T22cpjJKce8u2lUXOv5hK6Wvy7udPaEA+fg4Pav+uDRWxA5O/hcF3ZEmZKofydiZV0toLJgXlgXJ85A42mPBRY552yG8r52IsrtZuga3WZkY+fq7ACI6rgHKfw2iA0mB05X32YIYm6LmS8j2sugCQyf5+f96xlZIV24CwPiqT+6puh1X5BQ0aGbBeSVMDH+7zfGVTe/YC6DAXB0Ho8ukJeTzEbHSOFjIne4mMlnrmBRYssCWjCxv3kIrzoCz8E30Ji5TMn4jA0lS5JQpWGafK7oqdQzmWfK9zO2Ek6rICLTMVgSZ6LTMeo18CRQXyCJ78nklDT8+Sz40UUSdj3EKmPHyhHCHlZpJW16jKRL4+pQC/NskV1SSGJXNuXzeXq8rR1XkjxD0M4k7Nu7cCazhXoHxWDBNrPm0J1uAVCwnxVA1e56C1Lra501hj66xynpRPFnvr2M3JNPNMce53XuT7hlilZ6t11Rvdy15lLni9Fuuym78d6xXTd2MXqjIzlQkMtS6BGXj8I8UKtEHvg4lMZtqvuc6BmWiEKPVFvKYb6OLaQDa5Rk0OxqnZVN6X8KYGy6w3lGs0OrUFYxdF3cR0Gze7tJEVwGm6/1JPm3SzVtIYDvHZmWB1RXdJdeHa03hs5AqJZPq6c9z8Os3WFT5Y254XbZo17DyDvq5phn088SG85V67VDF3VqtrEnL5AKYI7oVDzX+T4+LkW2hx0pI/H+A6+18xQQQVsvYkgl9phTx6HIyQnIbFTDTaky8eFDf5KCjv0h2o+ct//vKmi59n9qZ9BcN5vMbqW9cVRa+1XbMmoBo/9+j7qDXuStimobWgpQq+AI4lRImMu27phPH5WofzIbxyJQ0vx0BNQYUzLGR/YV3+UyuwO16zCipb1rMgWwoCNFrjRxsatmf7ZrpfxfYWL5Bx1tNfavZpYVc7/ZcBPZEBHW8oE0hmeILWeaJMhqzQvjPHEYRXW4T6w73FrjP9Vb7eNZrghH69HKLXUGQFqz0jp6bcnXddItVl6sVtMvWeh5bavJ7cxu3d2yeN1vDSl/a089V40FPx3DM9dH+w3FtSxrPT51PqZ70+cmnUKrljv6uGTF+SbavwvPxRgM23hhOst5dmG9aQ94LMi4pqhgK7UGIjLdc636+hzvGmXFsFsy7VrrTKc+xm3GZWjSl7HIljmXXxpijPJHmFazINhb0/TzK1AdLbSzJBzcWGAu0c8aoldTRWI43S06X6K76I+mRuy9Pl+y1NFvS6L52jjufMxRsTuWzwZ5+B6M4SHmI4ni7TdWnKbUrfxY49N1F6kaaiZiPsJvP53WPZL7v15tkYQi/VNFRtQpPigI3oSY4dZj2RTd+CnfDMavKpZTD6uHSwp1Fw6zKNRlOQ3YdV4/0Ll7B0HeXnVMhwK5/BDJhQV94KKXaJzHTpgbrOx1IQ3wGfA9XmRkEEwvDdDa62q/cff+tmEGfa1K5qJ+lSkyu9TiB5eT3WGNWYZ6P0MzPvZ8bhZkGjp7JsvWTO+4+/FeQeQBXy+sz0PNoNAieeWkZrBoqqaM0iyueOVfPLMo3VtHERieWwvfdUNOyo2Aln+7pPbidhl95eJrfKiGww3zqHrPPzML7lb3N8O5a0eE2kai5qK687wG2uyIM49Qxms5tTYYMa5NEB2pFgs8fLoviTf4PeUXvjIBL/f/g4bLcpntbmYJN/7Bd69iIZayNocbuiHp0axVYrUBDbT+xLgCH0kfrw31LNvwG6EWgP4eTFL/ZTL9x/arK2KiTKuys+GeDe6+E7vMNi5L7w1z13hM1U8HJNzKq3OwZqlJ53pl1OUHiGvVTtP7H6TFZe+WLuUl7e2esAOrp6xJ6aEJlVgrRjSdl3F3wQKefYFv3Rm10higqdUjx3KZrXv7wmQnbnfad1XJXWczvzxXDt10b3VbkktGBkkF/jSme2NL0YWj8Vxx4HSi8TsGGRwWffLoWoXyrp2IgKIY27q+Af9BhEaU7lgj+xkA0fUS7zE5dRtd/zX1UyU1fJHFAk46oJL0VjXRa52fYZbc0SlHLZPvfYqEywLGyBShXbxdc5OdlzWDOKTUyep+qyZMDDqw95R1wpsMzfcttVyFnyDycc67ChvFrva4+xEYzkLNq1G+/mN7QDjXeZ4XBHHr1/+WlgZ949TPFD1HrDVy5Ggy7a1gQfqD75Q9F9HQUbcixhI1ymW3grN04cYRMhqbyaUe/zMwgLi1sFSccDsYOOQqE5QHoKluQDj0Njpmy+XQHjxh2F5Q+ZLNj0EnLDjkISA52eJTE+xBhGQR7M95psQO1IJjh7Au5dHWbcrXQbllKFb8QwQbRM/F06yolmJvMmlRmS0J0PYsOkZeJJyG0zuDyeupKwyrWRtXu4EFtR81h87302oxhsrN1XNiTziNomWtGadzTa7Da+f/J3Nvp4RZOiD6Tb6rqWJDWt23lHzJs3d79xohiAgMMGwpvHwR1prSzcuHUAYQ7sRDS3sGVYTw9C8c4XItrBiRv8mjCH5ePbh3tClaI7d68yzkRMhQm/WRAz/ZQfn020jKrvWrmcrZtkz/yn3OBxhoqQ8C4D08aGzfswYQw9PUtw2LifJUvK+GRbWWV+N27//Li+9Jim+sd3eyYJxaY9im4RhbO34Zb/GF5MqzmVtAoOXlWateQxpuHJ7es3P9zY8CeHsA+eXZ8ncEg8Pu9ge4gulazwRpidtwdtYZ9ANWzXZI91VEOEvM+Lm02fYdPCCo/KNtUmtLJJSBrPj3qh4DNe/6YxqW1M++Y8erp8LxwxZbY4nkqdLW7GETnHBsnBOQP9cVsT4kmJoUmaT4hdlr0vhn3YZr5TUg4Fk+Nu76EizuOra+ej2v8ZTbK03aWt6HL/FaJ5JOOj+PTp4X+/+z/v74kdp2xN5hF+r12DxvZjIhWXMb/pH0TR2zLNr7ii21irW1dYcv3NxqI086V/wduVwzvYld3Y6/cNO2f2ByD7CyyHz18rimydoDcnxzK4GZ64HDVrpeoMC+uGC6b10lInjkFp3/zBpFzPOm8XDMr9nrvWwmUgO04WK6jCr7ydDlf+OFcPsu6n8AZDC07b94xl11uGh83qz58qc7b9C2mBHRP6fn736EfRpZPjzPtxeUX3EEpXYNb9oIpfOLOu73c9pBIEsaQJa/WKG4rAfu6YybmMKJ+xcFPO1q+Lx5Vu/+PN7PXszeyWSEXevH59e/f6/qd/3r396T/v7/75j7//eHd3O861fW9xkIdHQuNY+V6jrGjmRwV5eNz8YCd7eNz8WHxoCG2pVM0F0aniBX1v3hwC307Vg0lBIg1cAMM/IpCJOe6pOwvLPQHDeb6WOoyq553Zf//x5s3t7c3t7b/f/P3HmdjO/F9mkWy9Ut+D+fHzR6IgkioObvoql8mMPOArjHJhKHZp2zBKFGxA6fb2/PBIuJRPnQdmDTaA4fE85Zmey1FPdZXv7h5KPr7ltFxC5A9K0xuXQoslesJX8Pn9/cvcxfe8sEJzFaZSAElk+5oSpwvgtbffrnEAO9r/vMXQ88VSytmCqtlKcipWM6lWsxeWvy+qv2gdehfPSNkxYjCgEibyt4Ls8CSSCfiuw1QQSBYQxxCTSKa7IjFITavNEH5hbUx69+pVmi04i3S2XLKviGOwLs/xBdVDA5S2cv6nHc5/aJGT6dpLFTJBDfTqRvxFjR7E3c/m9e1x4x/c2wvAP0h0IIhAIuIwFFO/kfdz5X08Uht6Lw74eujzjzY2zrCc5hh+YPug0SoR/tb4iTvTSj1TLzPO5yNUoe4Ddx/Pf8K/k6Hv5o44nZdL16Y/959ZeSbvEwRHedDtlqQH93N/i3oshPOom0LozUnsDct9p9C+gDhc/WGBIQ+70VV7+2sDgSKBCbEUU6Dz0/nO8JRycQ8NHyqbno5O3QyZ4FGRX+oXw6uhZJ7wuS7bbZepmaIZqa/DxfJUl1BL3fOBf8CMvJNKgU6x8ZqReb8pDXiu/cpazFd6p18JMK9YuvnhlYnSeQLJjHzoaPvfXeYXbv57dCf2fumSgQkgqdI13V/n3S3pgWgRsVvrXkh+Woityuei7ebvXgq6bMjUBOT2pJ/vw+zKCfBZaPvsTBMeaOsRML1uHXadAGB5DlaZdhQ3Iy41zLe0s3XISdA2EFobMS+RzIMHQnXchiWXAbsAMgS13om5Dj99dVbQOY6hmBVEzceOnwWzxTEE85IJlEkzFXR20AWQMaib+Z9nQ/1mCGpOtZnTKHQCc1bQOY4hmK2tOcsO0m/ymFiFEBdBWjyp+/rb/Z/EfbWEPKP7msWX6L7uly4Z6L6e2/nrQr3nX4rVkTYesRidJfjihvhS72bgrzOIVa4q7lM+l3DkUZtvzD5LwtUMgaOBfPnkX238mYk0M/P8QwnjnIXLBwYUdH74lNOKLzuUQ7XLpTINSvfy/oBiqfdytYL4pngzH7RmUjQTyPt43JFOO7jMtbyE5cEEZ9XQejzqiHnfiurRCJcrZi1Xc4o9972OpPn+p0z7Skb3ytoADgQOYY9EYb+ez1zVhg4BhGpFjpFBoXxDS1PqxxNBJAspObTyA71I7NcIEzGLnGWi+cnQXo4cU+IWlkje+bVR+LYHQySn1oqKNJyBjgOzlGXvNG5tVgfXVK8B3/Ukj8NsgpPRfOSRa+8W+rZ2LOjPpMuWqQ1A5b/8/wAAAP//AKSNsw=="
+	return "eJzsffuPGzfy5+/6KwgvFrH3Ztoeb5JvvvPDAo69uRvAjg0/bhc4HGSqm5K40012SLY0yl9/KD76yX5JLU07l7WRTWakqk89WCySxeI1uieHWyQPUpFkgZCiKia36Mkn/YMnC4QiIkNBU0U5u0X/WCCEkPklkgqrTKKEKEFDeYViek/Q6w9fEGYRSkjCxQFlEm/IFVJbrBAWBIU8jkmoSITWgidIbQniKRFYUbaxKIIFQnLLhVqGnK3p5hYpkZEFQoLEBEtyizZ4gdCakjiStxrQNWI4ISUx4IfqkMJnBc9S+xOPKPD3q/naVxRypjBlEsU8xLGl5uQL7OfLfMu8Qy5I/kMf9w4EJRTXQKcEBfRpEaA1FwgjSdkmBk0KgvgaYZRksaL6exayg4pQXWkI+YUoC0Kjyo+dKDFnm9ovOqSBvwD9NaBiWbIiokBV+eRf0AciQsIU3hDpBZRJIoI0VF5YMsQxiZbrmOP6B9ZcJFjdotTQHwf+85a4L+KNVjSIo2hCkEwJU4gyDQzJFIekRbaKBIqG99Irw2jVAjic8IypE4FZf5mjcu+JYCQeI8WECu7V8Ah0jIZkfu7LGYr5/joVlAuqDigVPCRSEjlEmotp+liUNIpnqHONKv9aO/DLOfIAQHyPqZqhLhkCYOgpZyii8v7ZMDkup9qx+MRv81OyJGJHQ0jNIKXbYhbF8B9bLKI9ZHOUKSJElqre8Sh+u5zqJ0Mt+Vp9S3YBvMdJ+Ni2OQK5Ijien2UoQ5TteJwxhcXBhIDVQa9zdlSoDMf6G/stjYn+6faQgkokFw1meywr+uJqS4SbArkIGl94tcM0xquYIM7iA+IMfWH0YZAiL+YAs1aQ00mYZict5cI0a6wmQQ+wYpanrc5gmTeloczazBlKU0epINJmX9pFuVSBdn3G2TWDyBbT30l9mYhKI0OiPY1jtMU7AgtU/ECTLEE7HGd60Hy9efHir+hveg0rv2raDWIFnwpdHAuCowNS+B4GEJWWKmWKIxyG2u1M3N+V1+PmjwcLQClMUvnGH2Npit6z5haBvGqQPfAMhZgZoxX0ZbF5sxEEKyLgB8zoDf3CBSIPOEljcoXoGv29QVbbWO/9YIV+fPFXgAYbQoTBP9y2RxCmWeC0+dV4z4qgm59ajVNb/H3jS9g/1iLx211+/VFWO3/o1cT/B3n5n9ntNNmt4mqmioRckEhkxNYz6l0UE+04d+//BVEoJ1uh/xf0a5EZDcpPIJOae5KSf98rhp3jZyvI2Il+noKcNNvP1DaDp/yZ4j9i3p+nJJNP/t+UmMdmAPMU8ltNA+amzSFZwJXbCJEkckou9mz04toje/4v8Pcv6HNjd+9bOZm+5L7k2Fn8YthOmpgvp8HBc+3lIB0xfV4M3OQz4mMjP3aSuxjuWc9bTidwmE35SccPQKJ0/gD/ie7e52VkA2vwjj+jgH967XlPDnsu6gcHdv/4FskI34w3txYPWBagvagkERTHSzN5joA3EMJ32h8oju30DKcaVKIEHxDjCq0InNztaGSmcRzHhdIbNO0efY9AcBAS6AMPrzSewWM8YGkGl/4mEeOl1clUKQkBHBKFHA4BwKtkFsIJ5TqL40OPCHtBFZmDDBrIkUKA/MHqoIicUAaXc/roHiGfJqORViWDw6G3lGUP5iyN1lmhWsIpSai4sJT0qVIaU+vSDGEpswQ8QH8KSfq7Tnh/uHk5yA++CR2CpyjCplGjIzZQkw2q/ZoFQwUwB06o1yN0l9A4ppKEnEXSzsY2CgLAvjwB1ERmLYVG2CcG5TOQwS9GxCGNuXv+vl8G2LgOwGqBIL9lRKogIWJD5DIlYilJ6BXPt6zuAV+vTwCWyLKEWwdiY0oDYIxwFsGptEJ7Igj6LSMZiZDiOnhFZEdDMkwsbcYLy6V5nluwir0uaqgCPZWygb4kZ063S46qgS5rmWkl0RaxAnRkDxOI8XMx9+cJfwNzMGju7BUIw3p7WkHwjgjYOSst5OAiTNXLvBZRHNJuWKWRaNgwMe51Qatohmc1i+ZwQbvUBs1EhrH0ArzbLCEZOo8oQBk9pcyo9xnMOmpbEqY7AgyTRMfwM8uheaCYsI3ankWISw5zC3siR4JoQEOybE3EThbAcjCCgDOV061nMILR3fP309pjlcnDdNIUVQWVTbQoE5Ak7rc03FZFaEWPnq4wi/Y0UluUKRrT3zGw1UooPvUsQG/MxyVWGeyJcIZ4GGZCov2WsEqdp0RhzCXk5rXSTacSwpTg6eGUHbRir87eAW3SHL8rhh3R5YoqOXQR0GMsE70cWiAMJmvCLWA8/vlXgdfivII6aKzojjjvSTmP8+2D71/894+LuhhrGpPKdd+jDP21INMo2C5+NUXddi60V/kew/tn+sEq17uiurampG8okWYoY6mgOxoTWEDpAzk34wVe6GaQLkfu6g7FCGQrdcS36OvziOyegwQ3X72IwM5ngAJk61DIg/reD0JfP1qmnDI1LRZNGCKtpt3QjR+N9tahvnXEtgHQR4xHRMKpGsRu/ZPmcUEJkiBkKKJzeHu3V68FIcuptVbSlyDkGKXpLaehiE7UmuZV1l23xjJJRu5zn4gPGI6E9/izWw10gbX5Lw75WsIEs6hjHjOPWZcylEpTWWkSc8d/eLMRZIPz8z8cxybk1G70FF89ceobdbxTEfLXavixaNCaZ/WVseOlXfqEYf3ZE/ZkgH7lujzyX5RFfN/if4a1Z1HXNQr8lm4qgkhtr0ILKOJFT5IuK5QheiJyp2r60Pd4pftjlArM62OiDhAGz6MBBOZ9AH3h+XIINTj0VANN40xqnZaKWhzKmONo0edkHVxhyQc03Jr2xADw5ObJwqeujqAMv6Jss1xjOCi7haXeYpTS3pbg58vNGEuFEsoyRQI/0h/mhPQHi1W2gL2ZFdobD1w/bqhoDB7LJyqYDWAU0bwwY1h9ZVOcH+YgTm6BKSS6mYVIN1PJpD/0ZDEwbI/K9btvTi/qUExrtFPi81dDorFlYZuuTbBdcbFlCPCxzeIKxF5IF11+fIEpdhAsT0513vVaUd4IrC3IYm1kys7MZmnEidTVZ5SFcRblHw45M4U6q4NLJ0McbuF6PYsarFfZek2ERE8lcdlnYFWDQ6iaDGppiFdPc1qeDTKskc0Ltz5UByB5pak5A4AyQNc6gQvqEtfGZe3XNZX6fKnTCfsccYAwJYFK+iz54J1CgthgCCccsMEGTkRYSNCKqD2x1/+tS+vyjfLejbWQtzME/K1/EkUkJVDOYiPv+09m3yyBlgcRUZjG8gqletcWhVsS3udr5pIPfw36lf5Iayirbv+Qv1OIShTiOMxivbBfYTBLSRfV+jQTHVyThXckKQ489JbAc6hjfp6QhLI1v2rqAv5wUWaov1YGp5cnRVDJgwhdV6kDcIhQuUHro8H8ec/Q+0//RlQLipHMknoAdD5EGQ71UYJzoff5uv3Kfp/81hzY1oo8dwv79aFu0RLeBoW4/jA30Ema4Q43RmmLLE4Oucfp4JiXCrKmD7foyf/Rkfv/Pll0QNbzkqZSpC2QqVCpYFtKHwEV54eAw5lWN5F13mzNU+Pky2X68plLDFu7bi+EGepKbTzPDVgnPuPwPlZEzGPWOLg8U0GKN+O1W8EUuiRMk9IQUgi5WTF0WxFQdj4AlOVfbOUPpVN4q+vPToYBfAuCqCrXAAR6ioimhKApom35jL0Vx0yjdjZsEDopQOlLCKVy4ZOhN1u1YRbIlCLyyAibbmSI2fIerHGkYzlteq/oNFBbvw8xY5DxHJBh3QcwooKE6vIADd/40IEPAunlgAG3fDOlUUpRx6av6l3QusXuimaGBAljTJOhltZoL2fqdrS9ZjcfWJL1moaUsPBwwXhkeDu0qMBQikcBegW3j4ko/QxRFtFQ968pnAcyc6lEttnAHiDkcY5uPYjVVWC86nFUYHhfXAULnx622Yb4nPXiCTgAsZ782Ln3sPHnn1iL4+OSQLYQI+U8bjHHbHLxd6XNIsrgthSHQReVxBkqQteA6hHA+vxxIlRLSUtmAKHOvLaYxnXyjYnjnQj2weAOwuMK4lCgVab0prDPn0ZKJjMBGz2PKxjfERHyJKGjh0ZE1jiLla9o4xLj+41hbwpd4SBvFHiYuILyenPIhNFAVrD0rmH7o3wZUlum1avMtty5E1HBd43jeIXD+0lYv3YL65JqdI1+kkl9j1+mMYV/WcPeMiitDM9BYkTtubhf9Bmlw02+WhqlYz77k3I3h8qjQO73unfIulbJMv4UcGTJb16kStR25MHv560H/JCuDrCb4xuCp9yq7RraQ+TII5MmgKCXdo8UlM1dCEFCQvtv2YA5UhzeEzWlLKPwWvYD1f6oYEUOdqB6KQuIEFw8mnINd9six4CmbNODGpxiRrAlYVE/aMqCSHCYfh4LNGUhT2Ad6ZykuClmkQ3Q+yPLwDO14d0ylAsW4HAv3uND3VEQegHnP2+w2MNCiEXo509v0IqEOJPEnupBSitIyoUqtrXa+xo5HdlJZymzJMEDqnLySXRFFF4M0so7O1PrBbbdF9jEfIXjfMrTR5ZUHQbOyzQN/ua1KF/9h4RqnMHuPjhDSy8zFU7J7fPrHnZZNCW7L2/62S1juCE9Lc+3cAm6kzENkykFvXv9ziOpY2b7kvV6dweTr5ZGKRu1P4GggSOs8FX5tcqr8jOg9meBbyCdno3imOKqkhFKsdrmcgeeryZ0Y26a5u+LNjnCYciYBLjHRhDenc406drdriciY4yyzZPAiyal0ZHiN785RPr0BIZHctwcz3FzFMcwgd6FxMv0aBtDTyhoJZZgFl0Deb0HBzviUmGh9MxkcV/Z0jw9LXhqnbDYZIkuopIkxQLbuc17S4FuGBdkiVd8R27Ryxff/+QVGW58HjGU4GvHjqNwH43k5swKsyMUSZuTimrZ7FDuhO2Gh1kTe5cnegBhOyo4A8uhHRYUyhRkuxcE+ksQQn2tw4odSM7QL4KQnz+9uTLlXCbIvv+E/u0PGdWHrNrj/uizhNcfvlzLlIR0TcPyIUJaNMGsu6cvtA9qRdyZsA4wSEdf0JINunsU18HqeqJAJ61nQps/UAVgzSmMpFA+qWOIjRdtuq4Dvdx5WrtAaeUoIDdB3katYgstaX4JIEsjPVveqdJCQdKExljYgjEv278Cl1yRZQYRlWmMD8VKQfHUhWzXm9WuGXqV29JW/JvSMNlVdkvKf6rLs9KzbJai7x4EaJEqJDBr2xDWBaMvml086iq267Y5xAV/f/A6YDPgzolXc+g2b4c+IXr4ut8U6KJm0jsGHWDau+fdnBJ1u2RgXd606LwU0z1ZVcCYc9Kx81HffNc3Xz3SoVHhAa5ntV1jldW9xbJc+GyqvmsV+a/1kRl6vcViQ9DTUjV+Ph5yytgkrfa/E8zwhgi0xbpjbgI9TCN7EGGXMA7JMxc5bK26vdxFZZtVCv0KKb2b4pdS8kciaQRD6xNR6BP9nQS1aOHROzQtSqEfLrR9wvAP85mnH1+9e9ZrkTATAhjapBdJYs4Gr0ql/r+Wa+Vb76TX9Ti/2Wm88sZLvn9EDzIy/8tacjoXskLrJvLdkj+2zaex+jB55RaLxwrGmnfkEy2Tw0esk2QdHTcHNvH9As0i3Ge4sGsItxNndt0hwug4r38I+V2BdO159UAvOvWa065Dp55UeUrYova7PjtW9FA9flhXdSCh5z4bnCvFNKEqgGctToLU4Tp8rQwXV2HXAz3Pur0knUB12vCq74qgcAvpeFQTH2GFMDvovK1PFfDw/5lUAaTPpYoSbVAFhBKo4xDYPaokOK8tfpzcoW/gHT0k3UkQDCAtqyxavxpOIK5psAgqUFje60GJEgLKaNrHfssNYHg3Jj8DayThkK8ZQnJLU0gqsOfZbXYN6rCUtQJlHjb0O85af5WIpsNCMHKo74iQlLMz+NP/NpTdmt/qaEuJwCLcHmwqawTSR5F5b+QrdOONe66D/+5Gn34IeEEF/FCgl3m7gozRNcxjOZ9CIa06oFGr+P69x4EaAK+/e6OTcwgsHGpHrR4kvC3AQ6q3kvdUgadRqV2tbkF7sqS1qBt8su8Uwo7q3Ruzobk6VKhratr27oKplypeddRnlFUE+8HnUxJQd1cOrZ/Ue1HaH8tsZfYivpOmXZbpzuel3KYyze0SSmvu+3bHrREaC9Os0AWScHsrg81t2I/A+s0UMyKwvM+rSW0s8dJ8Zb7j5ig3urRfqT3Pzz1yVkJeode/fNJZyMfPfgPA76XCcCEcwLhnXeIDWmMqClI21qaCQ8yknOHYc1UD/pqGKnbl7LZe3E12Z8b82vWe0M1WBejj5xIML11BcGz3cWqgJJR8YJTgB5pkiX+XCquu2a8o57U+DEq2vR9cN1+MNnRHGKT6lLeVH3UH9N6ANmS8Njzw7k01fndC6w0XR0HwDwL48+GYsNFKzRdOOoUM1zKwBstkp7Qtk+gYUTUfbQv7ZmVCQ8Hd8yEwvLZ8jwTZZDEWkBm0kjIq+U66OKG49mVBJM9ECPW+W57Fkc7NSF6dPkInv2Vc4fOr5HNtm6xVMSa64Nh3+8VCcmESO4eBMSoy5sYnZ8SOTfQUSxSRNTWpbyvJinO0dWXxaU8vZM+tu1fwBp8isH1odgYhiCG7dUsg4OUDSeMpB7xWokUyagdfQ61B6UzNMYtsdGwlG6aZVYruA5kXgL+ExG9LN9tyRt6pXqFmPF6tijoCVNt4pfKIgSpUIKDBcUJmoQyI1cCISKWzD8oynkk75loJU1ZbplUH8RbvSFuUG6gmOMRwA/ncairu0thQA0NU7HAsddCpDBgYFNUQ00pWD22tChLjVA72ECO62gquVEyiiysBfEW2WXUFCV+ODc5NMJSfXrXSdVet9qa5CcR2V7iqtuRgqJKHLc50j1dYFvB1Z1wqhTuYeSoWgqx5S6hAei58dqTG2bmVXZxiuacldBWPfnyFYeZG6LPSNFrYo5Vqu5069OB0EKYZDkN12rrJroJcB3e7yxAsat/5M5ueUTZtqzjO7vLVY/uqo7tlWb4zkHt7Z4IyxpZmjENx01Sy5rKUwefAgRNKeFSqlRiAz9Z7XA7hU1PW8WwMVDjUSrNOhN7iQm+R4emelUvZiJ652JwhgsOtVkjNw1rJ6k2p3nDRWcAxMnraC+92axw2eP4MoGcLoOMDZUKSQJ8itp6qDxqhfeeuIwQvt4+1B5yrQ+v2V/Gy22iBE/wwH6G3JN8VzEUn0eSS62E4S6mLrRczyVT7YaKnRU09rNtbSepOYc9seZObFEpag+2HUuaeyaHzAwyUNaZxdv79lOp5t1251Oq1tCHR05pNn6F9o/i++CNguhi8YktIIvdziw1wCG76hLrCKqsPjRNB4wrdYcBUdLox1EpvyrEl9zOPK8UIszqDybiprErAaSV8urJmH4rcTpJ1uLrStMe10jtLAJL7OYWg+mDTBm2l+LRhdR2sRgal+7nmK7aQ/Gxpy/3885a6CnrTl1aq4zUz+2DC1zUX6QoQrYSPChz380xd7s+YuwDtJVxyn2OosGrQ0IC6vj+f91K3ceE4QecaGnKRSdSQ2BMjWmmeEj21P3wLccIqq66nRsDo09LRmUaurXkGjboh2w+rxucXZkNVt+4gS8y4v4fTYAVM6CuvGGeHBI4x8wxUr3WhtNa+jgDNF9Q1dPBhKj5c6xn46duPX9oVFFOpKtfVk3QNj8JsE5I8K11RyQkMVx6s0i+sPKiOv4aeccXzJYVy3n78kot7hFRa1xeW5wNMEJrx1DZy9aw0xPHSjLDlvEJjeds4P9PPy3BNeMiblpTihIl97Se3k6hL7ueprWJFNlhvrSSr+jxOb5R9a5GUMk+4qIy8VrKNEZl/coymHiFstmvKH1C9OjrCOxLdCHReEsOFviIHuzYQkf0/QCrbQ3Er0aO0A31Xl7qX7NF6ObZIBmIrdkm5TTZdUqkE3WyIgKIW3Ue1laqGPtIf/sPF8huQO8H/4aJHcPTkHXzqiflPuL2dwjW1/P6O3QwwbznFUDEEVWWtRPVtFuUayugLRvCI+miPkkvKLqZWMKXUXgJVZorbUWXvKeo7ULa72RFylBv0XlIQnpUWaaeK0nUf/tKhr3VatEdvEBkEZjLF+kJ7/rDBsyvoPN5Kti1aHjdnCCmXwHk2WiucRBODf8G5Ir36GiUvmGE2sn7Kjz2OtF7GyI6GCjpnzS111sEfnvXh0AbcvfFBotGS6q47Moj5/ljhRnuem77yyWqPK8/VZLpiZEs3Wxu2WmlC6wqZCaKlQGrLs80WUeWeDAG6GYuIq7DeoxXPWIR9D8G4/72Hm6X5/VVYkViQu5fjNAr4H0GlNh0gslorZHr25rWo3kd23R/BM/sIS0oEuK59Qchaw5mqXEKMOqRFudb1ARp5CAmJoOnuZKpO8MMjaLr6MmRV2VgivIKpF/rstqdJ8GyE/l6CHwrfnE4xnCfzUozd3dQyd5+/wqRur3VAUXZFo7DPO62Wlvc0ji+mqmKMrgiYQGcblRo5gGMLcdkB3dOO0crX6P37d+Yb4litOI2s4nvKTyug/DnmYfl1iGBR+/SfdZMn1k36L393ymLqy+eSw5hzxeIIReMy2fqaCFisK26fJucJVJ+glXaqCNKxVuao4/h+lJooP1pJRyrg7vl7aChimkHri18w7ZqaaRD/eMH1zRxSNJyxGQBEnJTHNCxlQos2raQ0kqfFhH8shgbEqtWGRbNxccUW4p3Zwj7ZLOf4UDeR7lQJF2tlSFiEYebOCXfK0jWFTiXJO3vgyzwSuePamtVe6R4RevOolaxrToEYt519FnXpLJ/A8y4CVTG5RR/s1tengQ8ndEhsSbhJKz+KKGR1XQVta6Atl+q0XvK+NwlbjdZjrHpAKWBruFQ28BY4rGATISkc3ylsDBYaxWRyIEB0FAoZE5KeQyWO8Dg0atTzKcPBGLqjsPzOkxWd3kKG7CgkEcHTqwSItqFAd+o7iXYEVjYspvcktrswVJmGOXDogoV+2pAyJHlir/njGEmqMju3U4USfLD7637RMnbP+J5NLl0hWOlGK1SowzsQ8HBHHEGrIL2dpAQlO0hABOwWW0TBog5VYBqdEnZr35/wQQ5/ct6nK5zkK1aTc/ktBJu7mZyOr3t75xoID0IQkx2JpwMAlV1gC0O3CsDLXx5YuATYnE2H4rW9IwHEkSF+hajB8vHV3RuEhcAHcEhBItjIYwp50cFZk6vsmWgYlcaRPU42TDr4n3OC18xLRoIiCUmlgm2/Lkx6e38iTCWVaLKR5dHBHnZuSDQ9f0O3n78eXzL42yUf44DjeMAo8F4DNPFWelHqde60nlMoyRAvO82Wx5GuEEA3L15+fw3rcAehCx6MTxKdCx9nZYh6ioXSUJhTDyzsQeuQwjvD1djln5uOeEutvERwLegMN3mBSQsiNStNU01BCz4xx9HypAekgBtQsTnCAJ4ns3Nz4QiW2ep0KWW2uh7OET641O9XLAY+X9BgqPfLFU5Sx1A/gmFzMd0mN0B3FSgw8dm5BzbH7Prqyqyp4FhfSZSlzSa6DjV5IOEy5NFJevp09z9f/6+30NczIkXnWIsQ+lJC/2ybaXpR5E2Iapz847OBwY44NwibjURzMDUqvgFZxgVNhfSxob81RWt4ayAsHsuptkJo5WxrM7rvfgznXzl+cafBrcx1J4pAF4OcxLVITTTFEYZpPITpYdXuHE0g9j1L52etFx/bHGLAo8iD1HPUprn+YNtF1hIq+5bpxXC5t1N7kLW/vTwYmpdtW/VUmW+XLOO42tKYEs9FnaHkIOSizqHuoR1pBNyVMVRcKZ+wkx8JfAnK4CWxOaxvW5i1v3dnB07Q9v22d+68INY4ofHhSASA9BTmcGocB7QeKoDsLWr8OH/78ua/XwYvgpfBDex6vHzx4ub2xZuff7p99fM/39z+9MPff7y9val9tcO88Pct4EB3HxCOImFbwdO8zzBm6O7D7ntgdvdh92P+oZxMh2xwIOKVzuPiuXwvXx4DH1gVDunFJEjCFZmBwj9qIBNr3Ep3EZVbAYbrHLbSvaj86VwO7L9+vH55c3N9c/Nf13//MWD7wP4mCHkSjMP84fNHKAniIvJO+sLZJEB30KUQ8RXc5iAR2lHotAo9+OujXb8IHXN+n6XD1EBUHC3hOsmSM3KMPo4WH9YOZL0moT2xT6/NFlrEdSb8lHx+++aZS/GtLsBopvcHtNZNeGNHBsV4ReLK07yQ5hME1P7HjT6derLmPFhhEWx4jNkm4GITPAH9Pin/oC5M8con0IiIIiKhzD3lCOShtz6xj0JghuCthigiEQp5enByQFV3nbD+wlap9Pb58zRbxTSU2XpNHzSO/MNdRgS1LPUj/SMs2OOc/wRy1oQrJ6bpfJnbRHugdTdk75AWevMitgucoPnGcN8c1/7NUVOcI2PfizwShGcj4jgUUz9h/Evp+WJUId2JgzyQIzUBa+NMV/qeog9oBRWMdgn/t8Yzbt1W6mENb0UvR7iCY2qy1/bj+U/698jz+1NP5/kaymVYnj/b+gMIIHZL7qQMutkt3Y94gCO/0n7MGMwPvLGx4ANRBtK9LLdNzD2/7wHlgGkdtqMrcMBFZOIpEpgQS85CJz9y4YOhwnRKu8AK7Hjb9DSbbFdI39p7gMLeVXvWlJeSbsPnqngJpNiayfuk2ytCEF3thhr8QL/0GKDXXAgiU9izgspE2woT3mmjDD2HiPlcHuRzRtRzmu6+f67CFC5l22otz6tMqFWJ/ncJ2q06UD/91u2ycBkgF+kW11fCQy09EC38fQXn76afhu6+rdlCIWSYOtO267dTgrYYMrUALp70631YXDkDPoDWFWfq8IiEjIDKbeOw6wwAi3OwEttR2gxjLslyj6m6JNoaQjieWxZIlsh3MlPFDScWs4CdAxmCWh7YUhL26KAdjqGYBQl3c8AMOIZgXlOmbVLfCro46BzIGNT1/Z9HQ/1yCGo4glzi8P6xQTscQzBDrLnIDNIN2cLwIXZIsyhdDE10ejBBgvPlTQXFYlhyM7v09YC+vHnU9DWL5pi+fnkzRfp66eSvDXXHvziopnJhUcdXV2MHoq+GxNdqoyV7r4ZtnKuYT9m9hOCkjQL3ZkyQyKFHA271575a+zVlaaaW7kMJjWPqLx/osQxs877/5GSlrEIqWNQFgX0g2av7I4ql3vLNhkTX7qULSaR+Fre2gdylYxpNt61o36S1O78WjJerJFhNx/cVKx+NxHxDWdRk0XHx8ESZ3/ycSVvJqPcch2jAcwh7Igr4uuNc9gYve3+tyAkIXjl2g0tTHBRzalMjaJCsOI8JZmORwNcQZRGF2836BVR7MtSpEU8qdKJF7I20eqldB4aQT+0VJWuYAB15uDj+McEREUNj7QDu+p3xD8NigrHRcuSRaw8IcIfysaA9k84voNUBLRBCCCGEFv9vAFT1l44="
 }
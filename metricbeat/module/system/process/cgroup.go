@@ -21,17 +21,21 @@ import (
 	"strconv"
 
 	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/metric/system/process"
 	"github.com/elastic/gosigar/cgroup"
 )
 
 // cgroupStatsToMap returns a MapStr containing the data from the stats object.
-// If stats is nil then nil is returned.
+// If stats is nil or has no subsystems then nil is returned.
 func cgroupStatsToMap(stats *cgroup.Stats, perCPU bool) common.MapStr {
 	if stats == nil {
 		return nil
 	}
+	if stats.CPU == nil && stats.CPUAccounting == nil && stats.Memory == nil && stats.BlockIO == nil {
+		return nil
+	}
 
-	cgroup := common.MapStr{}
+	cgroup := common.MapStr{"version": 1}
 
 	// id and path are only available when all subsystems share a common path.
 	if stats.ID != "" {
@@ -221,3 +225,125 @@ func cgroupBlockIOToMapStr(blockIO *cgroup.BlockIOSubsystem) common.MapStr {
 		},
 	}
 }
+
+// cgroupV2StatsToMap returns a MapStr containing the data from the cgroup v2
+// stats object, using the same fields as the v1 subsystems. If stats is nil
+// then nil is returned.
+func cgroupV2StatsToMap(stats *process.CgroupV2Stats) common.MapStr {
+	if stats == nil {
+		return nil
+	}
+
+	cgroup := common.MapStr{
+		"version": 2,
+		"id":      stats.ID,
+		"path":    stats.Path,
+	}
+
+	if cpu := stats.CPU; cpu != nil {
+		cgroup["cpu"] = common.MapStr{
+			"id":   stats.ID,
+			"path": stats.Path,
+			"stats": common.MapStr{
+				"periods": cpu.Periods,
+				"throttled": common.MapStr{
+					"periods": cpu.ThrottledPeriods,
+					"ns":      cpu.ThrottledTimeNanos,
+				},
+			},
+		}
+		cgroup["cpuacct"] = common.MapStr{
+			"id":   stats.ID,
+			"path": stats.Path,
+			"total": common.MapStr{
+				"ns": cpu.UsageNanos,
+			},
+			"stats": common.MapStr{
+				"system": common.MapStr{
+					"ns": cpu.SystemNanos,
+				},
+				"user": common.MapStr{
+					"ns": cpu.UserNanos,
+				},
+			},
+		}
+	}
+
+	if memory := stats.Memory; memory != nil {
+		mem := common.MapStr{
+			"usage": common.MapStr{
+				"bytes": memory.Usage,
+			},
+		}
+		if memory.Limit > 0 {
+			mem["limit"] = common.MapStr{
+				"bytes": memory.Limit,
+			}
+		}
+
+		memStats := common.MapStr{
+			"swap": common.MapStr{
+				"bytes": memory.SwapUsage,
+			},
+		}
+		// Names of the memory.stat keys in the fields of the v1 memory.stat.
+		for key, field := range map[string]string{
+			"active_anon":   "active_anon",
+			"active_file":   "active_file",
+			"file":          "cache",
+			"inactive_anon": "inactive_anon",
+			"inactive_file": "inactive_file",
+			"file_mapped":   "mapped_file",
+			"anon":          "rss",
+			"anon_thp":      "rss_huge",
+			"unevictable":   "unevictable",
+		} {
+			if value, found := memory.Stats[key]; found {
+				memStats[field] = common.MapStr{"bytes": value}
+			}
+		}
+		if value, found := memory.Stats["pgfault"]; found {
+			memStats["page_faults"] = value
+		}
+		if value, found := memory.Stats["pgmajfault"]; found {
+			memStats["major_page_faults"] = value
+		}
+
+		cgroup["memory"] = common.MapStr{
+			"id":    stats.ID,
+			"path":  stats.Path,
+			"mem":   mem,
+			"stats": memStats,
+			"events": common.MapStr{
+				"low":      memory.Events.Low,
+				"high":     memory.Events.High,
+				"max":      memory.Events.Max,
+				"oom":      memory.Events.OOM,
+				"oom_kill": memory.Events.OOMKill,
+			},
+		}
+	}
+
+	if io := stats.IO; io != nil {
+		cgroup["blkio"] = common.MapStr{
+			"id":   stats.ID,
+			"path": stats.Path,
+			"total": common.MapStr{
+				"bytes": io.TotalBytes(),
+				"ios":   io.TotalIOs(),
+			},
+		}
+	}
+
+	if pids := stats.Pids; pids != nil {
+		pidsMap := common.MapStr{
+			"current": pids.Current,
+		}
+		if pids.Limit > 0 {
+			pidsMap["limit"] = pids.Limit
+		}
+		cgroup["pids"] = pidsMap
+	}
+
+	return cgroup
+}
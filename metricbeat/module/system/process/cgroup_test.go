@@ -0,0 +1,99 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build darwin || freebsd || linux || windows
+// +build darwin freebsd linux windows
+
+package process
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/metric/system/process"
+	"github.com/elastic/gosigar/cgroup"
+)
+
+func TestCgroupStatsToMapWithoutSubsystems(t *testing.T) {
+	assert.Nil(t, cgroupStatsToMap(nil, false))
+	assert.Nil(t, cgroupStatsToMap(&cgroup.Stats{Metadata: cgroup.Metadata{ID: "nginx.service", Path: "/system.slice/nginx.service"}}, false))
+}
+
+func TestCgroupV2StatsToMap(t *testing.T) {
+	stats := &process.CgroupV2Stats{
+		ID:   "nginx.service",
+		Path: "/system.slice/nginx.service",
+		CPU: &process.CgroupV2CPU{
+			UsageNanos:         1535000000,
+			UserNanos:          1020000000,
+			SystemNanos:        515000000,
+			Periods:            120,
+			ThrottledPeriods:   7,
+			ThrottledTimeNanos: 42000000,
+		},
+		Memory: &process.CgroupV2Memory{
+			Usage: 25165824,
+			Stats: map[string]uint64{
+				"anon":       14155776,
+				"file":       9814016,
+				"pgmajfault": 66,
+			},
+			Events: process.CgroupV2MemoryEvents{High: 3, OOM: 1, OOMKill: 1},
+		},
+		IO: &process.CgroupV2IO{
+			Devices: []process.CgroupV2IODevice{
+				{Major: 8, ReadBytes: 1000, WriteBytes: 24, ReadIOs: 3, WriteIOs: 1},
+			},
+		},
+		Pids: &process.CgroupV2Pids{Current: 3, Limit: 4915},
+	}
+
+	m := cgroupV2StatsToMap(stats)
+
+	assert.Equal(t, 2, m["version"])
+	assert.Equal(t, "nginx.service", m["id"])
+	assert.Equal(t, "/system.slice/nginx.service", m["path"])
+
+	flat := m.Flatten()
+	for field, value := range map[string]interface{}{
+		"cpu.stats.periods":              uint64(120),
+		"cpu.stats.throttled.periods":    uint64(7),
+		"cpu.stats.throttled.ns":         uint64(42000000),
+		"cpuacct.total.ns":               uint64(1535000000),
+		"cpuacct.stats.user.ns":          uint64(1020000000),
+		"cpuacct.stats.system.ns":        uint64(515000000),
+		"memory.mem.usage.bytes":         uint64(25165824),
+		"memory.stats.rss.bytes":         uint64(14155776),
+		"memory.stats.cache.bytes":       uint64(9814016),
+		"memory.stats.major_page_faults": uint64(66),
+		"memory.events.high":             uint64(3),
+		"memory.events.oom_kill":         uint64(1),
+		"blkio.total.bytes":              uint64(1024),
+		"blkio.total.ios":                uint64(4),
+		"pids.current":                   uint64(3),
+		"pids.limit":                     uint64(4915),
+	} {
+		assert.Equal(t, value, flat[field], field)
+	}
+
+	_, found := flat["memory.mem.limit.bytes"]
+	assert.False(t, found, "no memory limit is set")
+	assert.Nil(t, cgroupV2StatsToMap(nil))
+	assert.Equal(t, common.MapStr{"version": 2, "id": "/", "path": "/"}, cgroupV2StatsToMap(&process.CgroupV2Stats{ID: "/", Path: "/"}))
+}
@@ -46,10 +46,11 @@ func init() {
 // MetricSet that fetches process metrics.
 type MetricSet struct {
 	mb.BaseMetricSet
-	stats   *process.Stats
-	cgroup  *cgroup.Reader
-	perCPU  bool
-	IsAgent bool
+	stats    *process.Stats
+	cgroup   *cgroup.Reader
+	cgroupV2 *process.CgroupV2Reader
+	perCPU   bool
+	IsAgent  bool
 }
 
 // New creates and returns a new MetricSet.
@@ -92,6 +93,17 @@ func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
 					return nil, errors.Wrap(err, "error initializing cgroup reader")
 				}
 			}
+
+			// Hosts using the unified hierarchy don't have the v1 controllers
+			// mounted, processes in these hosts are read with the v2 reader.
+			m.cgroupV2, err = process.NewCgroupV2Reader(systemModule.HostFS, true)
+			if err != nil {
+				if err == process.ErrCgroupV2Missing {
+					debugf("cgroup v2 data collection will be disabled: %v", err)
+				} else {
+					return nil, errors.Wrap(err, "error initializing cgroup v2 reader")
+				}
+			}
 		}
 	}
 
@@ -106,20 +118,15 @@ func (m *MetricSet) Fetch(r mb.ReporterV2) error {
 		return errors.Wrap(err, "process stats")
 	}
 
-	if m.cgroup != nil {
+	if m.cgroup != nil || m.cgroupV2 != nil {
 		for _, proc := range procs {
 			pid, ok := proc["pid"].(int)
 			if !ok {
 				debugf("error converting pid to int for proc %+v", proc)
 				continue
 			}
-			stats, err := m.cgroup.GetStatsForProcess(pid)
-			if err != nil {
-				debugf("error getting cgroups stats for pid=%d, %v", pid, err)
-				continue
-			}
 
-			if statsMap := cgroupStatsToMap(stats, m.perCPU); statsMap != nil {
+			if statsMap := m.cgroupStats(pid); statsMap != nil {
 				proc["cgroup"] = statsMap
 			}
 		}
@@ -181,6 +188,29 @@ func (m *MetricSet) Fetch(r mb.ReporterV2) error {
 	return nil
 }
 
+// cgroupStats returns the cgroup metrics of the process. The v2 reader is used
+// when the process has no v1 controllers.
+func (m *MetricSet) cgroupStats(pid int) common.MapStr {
+	if m.cgroup != nil {
+		stats, err := m.cgroup.GetStatsForProcess(pid)
+		if err != nil {
+			debugf("error getting cgroups stats for pid=%d, %v", pid, err)
+		} else if statsMap := cgroupStatsToMap(stats, m.perCPU); statsMap != nil {
+			return statsMap
+		}
+	}
+
+	if m.cgroupV2 != nil {
+		stats, err := m.cgroupV2.GetStatsForProcess(pid)
+		if err != nil {
+			debugf("error getting cgroup v2 stats for pid=%d, %v", pid, err)
+			return nil
+		}
+		return cgroupV2StatsToMap(stats)
+	}
+	return nil
+}
+
 func getAndRemove(from common.MapStr, field string) interface{} {
 	if v, ok := from[field]; ok {
 		delete(from, field)
@@ -12,6 +12,7 @@ import (
 	_ "github.com/elastic/beats/v7/x-pack/filebeat/input/azureeventhub"
 	_ "github.com/elastic/beats/v7/x-pack/filebeat/input/googlepubsub"
 	_ "github.com/elastic/beats/v7/x-pack/filebeat/input/netflow"
+	_ "github.com/elastic/beats/v7/x-pack/filebeat/input/otlp"
 	_ "github.com/elastic/beats/v7/x-pack/filebeat/input/s3"
 	_ "github.com/elastic/beats/v7/x-pack/filebeat/module/activemq"
 	_ "github.com/elastic/beats/v7/x-pack/filebeat/module/aws"
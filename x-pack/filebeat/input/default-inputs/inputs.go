@@ -14,6 +14,7 @@ import (
 	"github.com/elastic/beats/v7/x-pack/filebeat/input/http_endpoint"
 	"github.com/elastic/beats/v7/x-pack/filebeat/input/httpjson"
 	"github.com/elastic/beats/v7/x-pack/filebeat/input/o365audit"
+	"github.com/elastic/beats/v7/x-pack/filebeat/input/otlp"
 	"github.com/elastic/beats/v7/x-pack/filebeat/input/s3"
 )
 
@@ -30,6 +31,7 @@ func xpackInputs(info beat.Info, log *logp.Logger, store beater.StateStore) []v2
 		http_endpoint.Plugin(),
 		httpjson.Plugin(log, store),
 		o365audit.Plugin(log, store),
-		s3.Plugin(),
+		otlp.Plugin(),
+		s3.Plugin(log, store),
 	}
 }
@@ -7,45 +7,102 @@ package http_endpoint
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
+	"strings"
 
+	"github.com/elastic/beats/v7/libbeat/common"
 	"github.com/elastic/beats/v7/libbeat/common/transport/tlscommon"
 )
 
 // Config contains information about httpjson configuration
 type config struct {
 	TLS           *tlscommon.ServerConfig `config:"ssl"`
-	BasicAuth     bool                    `config:"basic_auth"`
-	Username      string                  `config:"username"`
-	Password      string                  `config:"password"`
-	ResponseCode  int                     `config:"response_code" validate:"positive"`
-	ResponseBody  string                  `config:"response_body"`
 	ListenAddress string                  `config:"listen_address"`
 	ListenPort    string                  `config:"listen_port"`
-	URL           string                  `config:"url"`
-	Prefix        string                  `config:"prefix"`
-	ContentType   string                  `config:"content_type"`
-	SecretHeader  string                  `config:"secret.header"`
-	SecretValue   string                  `config:"secret.value"`
+
+	// Settings of the default route, also used as defaults for the routes
+	// configured under routes.
+	DefaultRoute routeConfig      `config:",inline"`
+	Routes       []*common.Config `config:"routes"`
+}
+
+// routeConfig contains the settings of an URL served by the input.
+type routeConfig struct {
+	BasicAuth    bool       `config:"basic_auth"`
+	Username     string     `config:"username"`
+	Password     string     `config:"password"`
+	ResponseCode int        `config:"response_code" validate:"positive"`
+	ResponseBody string     `config:"response_body"`
+	URL          string     `config:"url"`
+	Prefix       string     `config:"prefix"`
+	ContentType  string     `config:"content_type"`
+	Dataset      string     `config:"dataset"`
+	SecretHeader string     `config:"secret.header"`
+	SecretValue  string     `config:"secret.value"`
+	HMAC         hmacConfig `config:"hmac"`
+	SplitArrays  bool       `config:"split_arrays"`
+}
+
+// hmacConfig configures the validation of HMAC signatures of request bodies.
+type hmacConfig struct {
+	Header   string `config:"header"`
+	Key      string `config:"key"`
+	Type     string `config:"type"`
+	Prefix   string `config:"prefix"`
+	Encoding string `config:"encoding"`
 }
 
 func defaultConfig() config {
 	return config{
-		BasicAuth:     false,
-		Username:      "",
-		Password:      "",
-		ResponseCode:  200,
-		ResponseBody:  `{"message": "success"}`,
 		ListenAddress: "127.0.0.1",
 		ListenPort:    "8000",
-		URL:           "/",
-		Prefix:        "json",
-		ContentType:   "application/json",
-		SecretHeader:  "",
-		SecretValue:   "",
+		DefaultRoute: routeConfig{
+			BasicAuth:    false,
+			Username:     "",
+			Password:     "",
+			ResponseCode: 200,
+			ResponseBody: `{"message": "success"}`,
+			URL:          "/",
+			Prefix:       "json",
+			ContentType:  "application/json",
+			SecretHeader: "",
+			SecretValue:  "",
+			HMAC: hmacConfig{
+				Type:     "sha256",
+				Encoding: "hex",
+			},
+		},
 	}
 }
 
 func (c *config) Validate() error {
+	return c.DefaultRoute.Validate()
+}
+
+// routes returns the settings of all the URLs served by the input. Routes
+// inherit the settings of the default route they don't override.
+func (c *config) routes() ([]routeConfig, error) {
+	if len(c.Routes) == 0 {
+		return []routeConfig{c.DefaultRoute}, nil
+	}
+
+	routes := make([]routeConfig, 0, len(c.Routes))
+	urls := map[string]bool{}
+	for i, cfg := range c.Routes {
+		route := c.DefaultRoute
+		if err := cfg.Unpack(&route); err != nil {
+			return nil, fmt.Errorf("invalid configuration for route %d: %w", i, err)
+		}
+		if urls[route.URL] {
+			return nil, fmt.Errorf("url %q is configured in more than one route", route.URL)
+		}
+		urls[route.URL] = true
+		routes = append(routes, route)
+	}
+	return routes, nil
+}
+
+func (c *routeConfig) Validate() error {
 	if !json.Valid([]byte(c.ResponseBody)) {
 		return errors.New("response_body must be valid JSON")
 	}
@@ -60,5 +117,19 @@ func (c *config) Validate() error {
 		return errors.New("Both secret.header and secret.value must be set")
 	}
 
+	if (c.HMAC.Header != "" && c.HMAC.Key == "") || (c.HMAC.Header == "" && c.HMAC.Key != "") {
+		return errors.New("Both hmac.header and hmac.key must be set")
+	}
+
+	if _, found := hmacHashes[strings.ToLower(c.HMAC.Type)]; !found {
+		return fmt.Errorf("hmac.type %q is not supported", c.HMAC.Type)
+	}
+
+	switch strings.ToLower(c.HMAC.Encoding) {
+	case "hex", "base64":
+	default:
+		return fmt.Errorf("hmac.encoding %q is not supported, use hex or base64", c.HMAC.Encoding)
+	}
+
 	return nil
 }
@@ -5,43 +5,74 @@
 package http_endpoint
 
 import (
+	"bufio"
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"mime"
 	"net/http"
 	"time"
 
-	stateless "github.com/elastic/beats/v7/filebeat/input/v2/input-stateless"
 	"github.com/elastic/beats/v7/libbeat/beat"
 	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/acker"
+	"github.com/elastic/beats/v7/libbeat/common/atomic"
 	"github.com/elastic/beats/v7/libbeat/logp"
 )
 
 type httpHandler struct {
 	log       *logp.Logger
-	publisher stateless.Publisher
-
-	messageField string
-	responseCode int
-	responseBody string
+	publisher beat.Client
+	// done is closed when the input stops.
+	done <-chan struct{}
+
+	messageField  string
+	dataset       string
+	ndjson        bool
+	splitArrays   bool
+	hmacValidator *hmacValidator
+	responseCode  int
+	responseBody  string
 }
 
 var errBodyEmpty = errors.New("Body cannot be empty")
 var errUnsupportedType = errors.New("Only JSON objects are accepted")
+var errInputStopped = errors.New("Input is stopping")
+var errRequestCanceled = errors.New("Request canceled before events were acknowledged")
 
 // Triggers if middleware validation returns successful
 func (h *httpHandler) apiResponse(w http.ResponseWriter, r *http.Request) {
-	obj, status, err := httpReadJsonObject(r.Body)
+	contents, status, err := httpReadBody(r.Body)
+	if err != nil {
+		sendErrorResponse(w, status, err)
+		return
+	}
+
+	if status, err := h.hmacValidator.ValidateBody(r.Header, contents); err != nil {
+		sendErrorResponse(w, status, err)
+		return
+	}
+
+	var objs []common.MapStr
+	if h.ndjson {
+		objs, status, err = decodeNDJSON(contents)
+	} else {
+		objs, status, err = decodeJSON(contents, h.splitArrays)
+	}
 	if err != nil {
-		w.Header().Add("Content-Type", "application/json")
 		sendErrorResponse(w, status, err)
 		return
 	}
 
-	h.publishEvent(obj)
+	if err := h.publishAndWait(r.Context(), objs); err != nil {
+		sendErrorResponse(w, http.StatusServiceUnavailable, err)
+		return
+	}
+
 	w.Header().Add("Content-Type", "application/json")
 	h.sendResponse(w, h.responseCode, h.responseBody)
 }
@@ -51,15 +82,70 @@ func (h *httpHandler) sendResponse(w http.ResponseWriter, status int, message st
 	io.WriteString(w, message)
 }
 
-func (h *httpHandler) publishEvent(obj common.MapStr) {
+// publishAndWait publishes an event for each object and blocks until all of
+// them have been acknowledged.
+func (h *httpHandler) publishAndWait(ctx context.Context, objs []common.MapStr) error {
+	b := newBatch(len(objs))
+	for _, obj := range objs {
+		h.publisher.Publish(h.createEvent(obj, b))
+	}
+
+	select {
+	case <-b.acked:
+		return nil
+	case <-ctx.Done():
+		return errRequestCanceled
+	case <-h.done:
+		return errInputStopped
+	}
+}
+
+func (h *httpHandler) createEvent(obj common.MapStr, b *batch) beat.Event {
 	event := beat.Event{
 		Timestamp: time.Now().UTC(),
 		Fields: common.MapStr{
 			h.messageField: obj,
 		},
+		Private: b,
+	}
+	if h.dataset != "" {
+		event.Fields.Put("event.dataset", h.dataset)
+	}
+	return event
+}
+
+// batch tracks the events created from a request. The request is answered
+// once all of them have been acknowledged.
+type batch struct {
+	pending atomic.Int64
+	acked   chan struct{}
+}
+
+func newBatch(n int) *batch {
+	b := &batch{acked: make(chan struct{})}
+	b.pending.Store(int64(n))
+	if n == 0 {
+		close(b.acked)
+	}
+	return b
+}
+
+func (b *batch) done() {
+	if b.pending.Dec() == 0 {
+		close(b.acked)
 	}
+}
 
-	h.publisher.Publish(event)
+func newACKHandler() beat.ACKer {
+	return acker.ConnectionOnly(
+		acker.EventPrivateReporter(func(_ int, privates []interface{}) {
+			for _, private := range privates {
+				if b, ok := private.(*batch); ok {
+					b.done()
+				}
+			}
+		}),
+	)
 }
 
 func withValidator(v validator, handler http.HandlerFunc) http.HandlerFunc {
@@ -78,26 +164,79 @@ func sendErrorResponse(w http.ResponseWriter, status int, err error) {
 	fmt.Fprintf(w, `{"message": %q}`, err.Error())
 }
 
-func httpReadJsonObject(body io.Reader) (obj common.MapStr, status int, err error) {
+func httpReadBody(body io.Reader) (contents []byte, status int, err error) {
 	if body == http.NoBody {
 		return nil, http.StatusNotAcceptable, errBodyEmpty
 	}
 
-	contents, err := ioutil.ReadAll(body)
+	contents, err = ioutil.ReadAll(body)
 	if err != nil {
 		return nil, http.StatusInternalServerError, fmt.Errorf("failed reading body: %w", err)
 	}
+	return contents, 0, nil
+}
 
-	if !isObject(contents) {
+// decodeJSON decodes a JSON object. When splitArrays is set, an array of JSON
+// objects is also accepted and decoded into one object per element.
+func decodeJSON(contents []byte, splitArrays bool) (objs []common.MapStr, status int, err error) {
+	switch {
+	case isObject(contents):
+		obj := common.MapStr{}
+		if err := json.Unmarshal(contents, &obj); err != nil {
+			return nil, http.StatusBadRequest, fmt.Errorf("Malformed JSON body: %w", err)
+		}
+		return []common.MapStr{obj}, 0, nil
+	case splitArrays && isArray(contents):
+		if err := json.Unmarshal(contents, &objs); err != nil {
+			return nil, http.StatusBadRequest, fmt.Errorf("Malformed JSON body: %w", err)
+		}
+		for _, obj := range objs {
+			if obj == nil {
+				return nil, http.StatusBadRequest, errUnsupportedType
+			}
+		}
+		return objs, 0, nil
+	default:
 		return nil, http.StatusBadRequest, errUnsupportedType
 	}
+}
 
-	obj = common.MapStr{}
-	if err := json.Unmarshal(contents, &obj); err != nil {
-		return nil, http.StatusBadRequest, fmt.Errorf("Malformed JSON body: %w", err)
+// decodeNDJSON decodes a JSON object per line. Empty lines are ignored.
+func decodeNDJSON(contents []byte) (objs []common.MapStr, status int, err error) {
+	scanner := bufio.NewScanner(bytes.NewReader(contents))
+	scanner.Buffer(nil, len(contents)+1)
+	for line := 1; scanner.Scan(); line++ {
+		data := bytes.TrimSpace(scanner.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+		if !isObject(data) {
+			return nil, http.StatusBadRequest, fmt.Errorf("line %d: %w", line, errUnsupportedType)
+		}
+		obj := common.MapStr{}
+		if err := json.Unmarshal(data, &obj); err != nil {
+			return nil, http.StatusBadRequest, fmt.Errorf("Malformed JSON body on line %d: %w", line, err)
+		}
+		objs = append(objs, obj)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, http.StatusBadRequest, fmt.Errorf("failed reading body: %w", err)
 	}
+	return objs, 0, nil
+}
 
-	return obj, 0, nil
+// isNDJSON returns true if the content type is one of the media types used
+// for newline delimited JSON.
+func isNDJSON(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	switch mediaType {
+	case "application/x-ndjson", "application/ndjson", "application/jsonl", "application/x-jsonlines":
+		return true
+	}
+	return false
 }
 
 func isObject(b []byte) bool {
@@ -107,3 +246,8 @@ func isObject(b []byte) bool {
 	}
 	return false
 }
+
+func isArray(b []byte) bool {
+	arr := bytes.TrimLeft(b, " \t\r\n")
+	return len(arr) > 0 && arr[0] == '['
+}
@@ -0,0 +1,222 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package http_endpoint
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+// ackClient records published events and acknowledges them once ack is
+// closed.
+type ackClient struct {
+	mu     sync.Mutex
+	events []beat.Event
+	ack    chan struct{}
+}
+
+func newACKClient(ackImmediately bool) *ackClient {
+	c := &ackClient{ack: make(chan struct{})}
+	if ackImmediately {
+		close(c.ack)
+	}
+	return c
+}
+
+func (c *ackClient) Publish(event beat.Event) {
+	c.mu.Lock()
+	c.events = append(c.events, event)
+	c.mu.Unlock()
+	go func() {
+		<-c.ack
+		event.Private.(*batch).done()
+	}()
+}
+
+func (c *ackClient) PublishAll(events []beat.Event) {
+	for _, e := range events {
+		c.Publish(e)
+	}
+}
+
+func (c *ackClient) Close() error { return nil }
+
+func (c *ackClient) published() []beat.Event {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]beat.Event(nil), c.events...)
+}
+
+func newTestServer(t *testing.T, settings map[string]interface{}, client beat.Client) *httptest.Server {
+	conf := defaultConfig()
+	require.NoError(t, common.MustNewConfigFrom(settings).Unpack(&conf))
+	e, err := newHTTPEndpoint(conf)
+	require.NoError(t, err)
+
+	done := make(chan struct{})
+	server := httptest.NewServer(e.newMux(logp.NewLogger("http_endpoint_test"), client, done))
+	t.Cleanup(func() {
+		close(done)
+		server.Close()
+	})
+	return server
+}
+
+func post(t *testing.T, url, contentType string, header http.Header, body string) (int, string) {
+	req, err := http.NewRequest(http.MethodPost, url, strings.NewReader(body))
+	require.NoError(t, err)
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	req.Header.Set("Content-Type", contentType)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp.StatusCode, string(data)
+}
+
+func TestRoutes(t *testing.T) {
+	client := newACKClient(true)
+	server := newTestServer(t, map[string]interface{}{
+		"prefix": "webhook",
+		"routes": []map[string]interface{}{
+			{"url": "/github", "dataset": "github.audit"},
+			{"url": "/bulk", "dataset": "bulk", "content_type": "application/x-ndjson", "response_code": 202},
+		},
+	}, client)
+
+	status, body := post(t, server.URL+"/github", "application/json", nil, `{"action":"opened"}`)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, `{"message": "success"}`, body)
+
+	status, _ = post(t, server.URL+"/bulk", "application/x-ndjson", nil, "{\"id\":1}\n\n{\"id\":2}\n")
+	assert.Equal(t, http.StatusAccepted, status)
+
+	status, _ = post(t, server.URL+"/bulk", "application/json", nil, `{"id":3}`)
+	assert.Equal(t, http.StatusUnsupportedMediaType, status)
+
+	status, _ = post(t, server.URL+"/", "application/json", nil, `{"id":4}`)
+	assert.Equal(t, http.StatusNotFound, status)
+
+	events := client.published()
+	require.Len(t, events, 3)
+	assert.Equal(t, common.MapStr{
+		"webhook": common.MapStr{"action": "opened"},
+		"event":   common.MapStr{"dataset": "github.audit"},
+	}, events[0].Fields)
+	for i, e := range events[1:] {
+		assert.Equal(t, common.MapStr{
+			"webhook": common.MapStr{"id": float64(i + 1)},
+			"event":   common.MapStr{"dataset": "bulk"},
+		}, e.Fields)
+	}
+}
+
+func TestDuplicateRoutes(t *testing.T) {
+	conf := defaultConfig()
+	require.NoError(t, common.MustNewConfigFrom(map[string]interface{}{
+		"routes": []map[string]interface{}{{"url": "/a"}, {"url": "/a"}},
+	}).Unpack(&conf))
+	_, err := newHTTPEndpoint(conf)
+	assert.Error(t, err)
+}
+
+func TestHMAC(t *testing.T) {
+	sign := func(body string) string {
+		mac := hmac.New(sha256.New, []byte("secret"))
+		mac.Write([]byte(body))
+		return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+	}
+
+	client := newACKClient(true)
+	server := newTestServer(t, map[string]interface{}{
+		"hmac.header": "X-Hub-Signature-256",
+		"hmac.key":    "secret",
+		"hmac.type":   "sha256",
+		"hmac.prefix": "sha256=",
+	}, client)
+
+	const body = `{"zen":"Keep it logically awesome."}`
+	tests := map[string]struct {
+		header http.Header
+		status int
+	}{
+		"valid":   {header: http.Header{"X-Hub-Signature-256": {sign(body)}}, status: http.StatusOK},
+		"missing": {status: http.StatusUnauthorized},
+		"invalid": {header: http.Header{"X-Hub-Signature-256": {sign(body + " ")}}, status: http.StatusUnauthorized},
+		"prefix":  {header: http.Header{"X-Hub-Signature-256": {strings.TrimPrefix(sign(body), "sha256=")}}, status: http.StatusUnauthorized},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			status, _ := post(t, server.URL, "application/json", test.header, body)
+			assert.Equal(t, test.status, status)
+		})
+	}
+	assert.Len(t, client.published(), 1)
+}
+
+func TestSplitArrays(t *testing.T) {
+	client := newACKClient(true)
+	server := newTestServer(t, map[string]interface{}{
+		"routes": []map[string]interface{}{
+			{"url": "/object"},
+			{"url": "/array", "split_arrays": true},
+		},
+	}, client)
+
+	status, _ := post(t, server.URL+"/object", "application/json", nil, `[{"id":1},{"id":2}]`)
+	assert.Equal(t, http.StatusBadRequest, status)
+
+	status, _ = post(t, server.URL+"/array", "application/json", nil, `[{"id":1},{"id":2}]`)
+	assert.Equal(t, http.StatusOK, status)
+
+	status, _ = post(t, server.URL+"/array", "application/json", nil, `[{"id":3},"id"]`)
+	assert.Equal(t, http.StatusBadRequest, status)
+
+	assert.Len(t, client.published(), 2)
+}
+
+func TestResponseAfterACK(t *testing.T) {
+	client := newACKClient(false)
+	server := newTestServer(t, map[string]interface{}{"split_arrays": true}, client)
+
+	responded := make(chan int)
+	go func() {
+		status, _ := post(t, server.URL, "application/json", nil, `[{"id":1},{"id":2}]`)
+		responded <- status
+	}()
+
+	select {
+	case <-responded:
+		t.Fatal("response sent before events were acknowledged")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	close(client.ack)
+	select {
+	case status := <-responded:
+		assert.Equal(t, http.StatusOK, status)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for response")
+	}
+	assert.Len(t, client.published(), 2)
+}
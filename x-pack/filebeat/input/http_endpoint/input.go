@@ -11,10 +11,11 @@ import (
 	"net/http"
 
 	v2 "github.com/elastic/beats/v7/filebeat/input/v2"
-	stateless "github.com/elastic/beats/v7/filebeat/input/v2/input-stateless"
+	"github.com/elastic/beats/v7/libbeat/beat"
 	"github.com/elastic/beats/v7/libbeat/common"
 	"github.com/elastic/beats/v7/libbeat/common/transport/tlscommon"
 	"github.com/elastic/beats/v7/libbeat/feature"
+	"github.com/elastic/beats/v7/libbeat/logp"
 	"github.com/elastic/go-concert/ctxtool"
 )
 
@@ -24,6 +25,7 @@ const (
 
 type httpEndpoint struct {
 	config    config
+	routes    []routeConfig
 	addr      string
 	tlsConfig *tls.Config
 }
@@ -33,11 +35,11 @@ func Plugin() v2.Plugin {
 		Name:       inputName,
 		Stability:  feature.Beta,
 		Deprecated: false,
-		Manager:    stateless.NewInputManager(configure),
+		Manager:    v2.ConfigureWith(configure),
 	}
 }
 
-func configure(cfg *common.Config) (stateless.Input, error) {
+func configure(cfg *common.Config) (v2.Input, error) {
 	conf := defaultConfig()
 	if err := cfg.Unpack(&conf); err != nil {
 		return nil, err
@@ -51,6 +53,11 @@ func newHTTPEndpoint(config config) (*httpEndpoint, error) {
 		return nil, err
 	}
 
+	routes, err := config.routes()
+	if err != nil {
+		return nil, err
+	}
+
 	addr := fmt.Sprintf("%v:%v", config.ListenAddress, config.ListenPort)
 
 	var tlsConfig *tls.Config
@@ -64,6 +71,7 @@ func newHTTPEndpoint(config config) (*httpEndpoint, error) {
 
 	return &httpEndpoint{
 		config:    config,
+		routes:    routes,
 		tlsConfig: tlsConfig,
 		addr:      addr,
 	}, nil
@@ -79,36 +87,25 @@ func (e *httpEndpoint) Test(_ v2.TestContext) error {
 	return l.Close()
 }
 
-func (e *httpEndpoint) Run(ctx v2.Context, publisher stateless.Publisher) error {
+func (e *httpEndpoint) Run(ctx v2.Context, pipeline beat.PipelineConnector) error {
 	log := ctx.Logger.With("address", e.addr)
 
-	validator := &apiValidator{
-		basicAuth:    e.config.BasicAuth,
-		username:     e.config.Username,
-		password:     e.config.Password,
-		method:       http.MethodPost,
-		contentType:  e.config.ContentType,
-		secretHeader: e.config.SecretHeader,
-		secretValue:  e.config.SecretValue,
-	}
-
-	handler := &httpHandler{
-		log:          log,
-		publisher:    publisher,
-		messageField: e.config.Prefix,
-		responseCode: e.config.ResponseCode,
-		responseBody: e.config.ResponseBody,
+	client, err := pipeline.ConnectWith(beat.ClientConfig{
+		CloseRef:   ctx.Cancelation,
+		ACKHandler: newACKHandler(),
+	})
+	if err != nil {
+		return err
 	}
+	defer client.Close()
 
-	mux := http.NewServeMux()
-	mux.HandleFunc(e.config.URL, withValidator(validator, handler.apiResponse))
-	server := &http.Server{Addr: e.addr, TLSConfig: e.tlsConfig, Handler: mux}
-	_, cancel := ctxtool.WithFunc(ctxtool.FromCanceller(ctx.Cancelation), func() {
+	stdCtx := ctxtool.FromCanceller(ctx.Cancelation)
+	server := &http.Server{Addr: e.addr, TLSConfig: e.tlsConfig, Handler: e.newMux(log, client, stdCtx.Done())}
+	_, cancel := ctxtool.WithFunc(stdCtx, func() {
 		server.Close()
 	})
 	defer cancel()
 
-	var err error
 	if server.TLSConfig != nil {
 		log.Infof("Starting HTTPS server on %s", server.Addr)
 		//certificate is already loaded. That's why the parameters are empty
@@ -123,3 +120,35 @@ func (e *httpEndpoint) Run(ctx v2.Context, publisher stateless.Publisher) error
 	}
 	return nil
 }
+
+// newMux creates a handler serving all the configured routes.
+func (e *httpEndpoint) newMux(log *logp.Logger, client beat.Client, done <-chan struct{}) *http.ServeMux {
+	mux := http.NewServeMux()
+	for _, route := range e.routes {
+		validator := &apiValidator{
+			basicAuth:    route.BasicAuth,
+			username:     route.Username,
+			password:     route.Password,
+			method:       http.MethodPost,
+			contentType:  route.ContentType,
+			secretHeader: route.SecretHeader,
+			secretValue:  route.SecretValue,
+		}
+
+		handler := &httpHandler{
+			log:           log.With("url", route.URL),
+			publisher:     client,
+			done:          done,
+			messageField:  route.Prefix,
+			dataset:       route.Dataset,
+			ndjson:        isNDJSON(route.ContentType),
+			splitArrays:   route.SplitArrays,
+			hmacValidator: newHMACValidator(route.HMAC),
+			responseCode:  route.ResponseCode,
+			responseBody:  route.ResponseBody,
+		}
+
+		mux.HandleFunc(route.URL, withValidator(validator, handler.apiResponse))
+	}
+	return mux
+}
@@ -5,9 +5,17 @@
 package http_endpoint
 
 import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"hash"
 	"net/http"
+	"strings"
 )
 
 type validator interface {
@@ -52,3 +60,70 @@ func (v *apiValidator) ValidateHeader(r *http.Request) (int, error) {
 
 	return 0, nil
 }
+
+var errMissingHMACHeader = errors.New("Missing HMAC header")
+var errIncorrectHMAC = errors.New("Invalid HMAC signature")
+
+var hmacHashes = map[string]func() hash.Hash{
+	"sha1":   sha1.New,
+	"sha256": sha256.New,
+	"sha512": sha512.New,
+}
+
+// hmacValidator checks the HMAC signature that webhook senders compute
+// over the request body with a shared key.
+type hmacValidator struct {
+	header   string
+	key      []byte
+	hash     func() hash.Hash
+	prefix   string
+	encoding string
+}
+
+func newHMACValidator(c hmacConfig) *hmacValidator {
+	if c.Header == "" {
+		return nil
+	}
+	return &hmacValidator{
+		header:   c.Header,
+		key:      []byte(c.Key),
+		hash:     hmacHashes[strings.ToLower(c.Type)],
+		prefix:   c.Prefix,
+		encoding: strings.ToLower(c.Encoding),
+	}
+}
+
+// ValidateBody checks the signature of the request body.
+func (v *hmacValidator) ValidateBody(header http.Header, body []byte) (int, error) {
+	if v == nil {
+		return 0, nil
+	}
+
+	value := header.Get(v.header)
+	if value == "" {
+		return http.StatusUnauthorized, errMissingHMACHeader
+	}
+	if !strings.HasPrefix(value, v.prefix) {
+		return http.StatusUnauthorized, errIncorrectHMAC
+	}
+	value = strings.TrimPrefix(value, v.prefix)
+
+	var signature []byte
+	var err error
+	switch v.encoding {
+	case "base64":
+		signature, err = base64.StdEncoding.DecodeString(value)
+	default:
+		signature, err = hex.DecodeString(value)
+	}
+	if err != nil {
+		return http.StatusUnauthorized, errIncorrectHMAC
+	}
+
+	mac := hmac.New(v.hash, v.key)
+	mac.Write(body)
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return http.StatusUnauthorized, errIncorrectHMAC
+	}
+	return 0, nil
+}
@@ -0,0 +1,129 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package httpjson
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	cursor "github.com/elastic/beats/v7/filebeat/input/v2/input-cursor"
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+// chainStepConfig configures a request that is executed for each event
+// returned by the previous request.
+type chainStepConfig struct {
+	URL             *urlTpl `config:"url" validate:"required"`
+	HTTPMethod      string  `config:"http_method"`
+	HTTPHeaders     tplMap  `config:"http_headers"`
+	HTTPRequestBody tplMap  `config:"http_request_body"`
+	JSONObjects     string  `config:"json_objects_array"`
+	SplitEventsBy   string  `config:"split_events_by"`
+}
+
+func (c *chainStepConfig) Validate() error {
+	switch strings.ToUpper(c.HTTPMethod) {
+	case "", "GET", "POST":
+	default:
+		return fmt.Errorf("httpjson input: Invalid chain http_method, %s", c.HTTPMethod)
+	}
+	return nil
+}
+
+type chainStep struct {
+	url           *urlTpl
+	method        string
+	headers       tplMap
+	reqBody       tplMap
+	jsonObjects   string
+	splitEventsBy string
+}
+
+func newChainFromConfig(config config) []chainStep {
+	steps := make([]chainStep, 0, len(config.Chain))
+	for _, c := range config.Chain {
+		method := strings.ToUpper(c.HTTPMethod)
+		if method == "" {
+			method = "GET"
+		}
+		steps = append(steps, chainStep{
+			url:           c.URL,
+			method:        method,
+			headers:       c.HTTPHeaders,
+			reqBody:       c.HTTPRequestBody,
+			jsonObjects:   c.JSONObjects,
+			splitEventsBy: c.SplitEventsBy,
+		})
+	}
+	return steps
+}
+
+// requestInfo renders the request of the step for the parent event in trCtx.
+func (s chainStep) requestInfo(trCtx *transformContext) (*requestInfo, error) {
+	url := s.url.Execute(trCtx, nil)
+	if url == "" {
+		return nil, fmt.Errorf("chain url template rendered an empty url")
+	}
+	headers, err := executeTemplates(s.headers, trCtx)
+	if err != nil {
+		return nil, err
+	}
+	ri := &requestInfo{
+		url:        url,
+		method:     s.method,
+		contentMap: common.MapStr{},
+		headers:    headers,
+	}
+	if s.method == "POST" && s.reqBody != nil {
+		body, err := executeTemplates(s.reqBody, trCtx)
+		if err != nil {
+			return nil, err
+		}
+		ri.contentMap.Update(body)
+	}
+	return ri, nil
+}
+
+// processChainStep executes the chain step for the parent event. Events
+// returned by the last step are published, the events of other steps are
+// passed to the next step.
+func (r *requester) processChainStep(
+	ctx context.Context,
+	publisher cursor.Publisher,
+	trCtx *transformContext,
+	step int,
+	parent common.MapStr,
+) error {
+	s := r.chain[step]
+	stepCtx := trCtx.withParent(parent)
+
+	ri, err := s.requestInfo(stepCtx)
+	if err != nil {
+		return fmt.Errorf("failed to build chain request %d: %w", step+1, err)
+	}
+
+	resp, data, err := r.executeRequest(ctx, ri)
+	if err != nil {
+		return fmt.Errorf("chain request %d: %w", step+1, err)
+	}
+
+	body, events, err := r.decodeResponse(data, s.jsonObjects, s.splitEventsBy)
+	if err != nil {
+		return fmt.Errorf("chain request %d: %w", step+1, err)
+	}
+	stepCtx.lastResponse = &response{header: resp.Header, body: body}
+
+	if step+1 < len(r.chain) {
+		for _, e := range events {
+			if err := r.processChainStep(ctx, publisher, stepCtx, step+1, e); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return r.publishEvents(publisher, stepCtx, events)
+}
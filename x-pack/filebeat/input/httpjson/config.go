@@ -23,9 +23,9 @@ type config struct {
 	APIKey               string            `config:"api_key"`
 	AuthenticationScheme string            `config:"authentication_scheme"`
 	HTTPClientTimeout    time.Duration     `config:"http_client_timeout"`
-	HTTPHeaders          common.MapStr     `config:"http_headers"`
+	HTTPHeaders          tplMap            `config:"http_headers"`
 	HTTPMethod           string            `config:"http_method" validate:"required"`
-	HTTPRequestBody      common.MapStr     `config:"http_request_body"`
+	HTTPRequestBody      tplMap            `config:"http_request_body"`
 	Interval             time.Duration     `config:"interval"`
 	JSONObjects          string            `config:"json_objects_array"`
 	SplitEventsBy        string            `config:"split_events_by"`
@@ -38,6 +38,18 @@ type config struct {
 	TLS                  *tlscommon.Config `config:"ssl"`
 	URL                  *urlConfig        `config:"url" validate:"required"`
 	DateCursor           *dateCursorConfig `config:"date_cursor"`
+	Cursor               cursorConfig      `config:"cursor"`
+	Chain                []chainStepConfig `config:"chain"`
+	Response             *responseConfig   `config:"response"`
+}
+
+// cursorConfig maps the names of persisted cursor values to the templates
+// used to update them after each published event.
+type cursorConfig map[string]cursorEntryConfig
+
+type cursorEntryConfig struct {
+	Value   *valueTpl `config:"value" validate:"required"`
+	Default *valueTpl `config:"default"`
 }
 
 // Pagination contains information about httpjson pagination settings
@@ -95,6 +107,9 @@ func (t *templateConfig) Unpack(in string) error {
 
 type urlConfig struct {
 	*url.URL
+	raw string
+	// tpl is only set when the url contains template actions.
+	tpl *urlTpl
 }
 
 func (u *urlConfig) Unpack(in string) error {
@@ -103,11 +118,26 @@ func (u *urlConfig) Unpack(in string) error {
 		return err
 	}
 
-	*u = urlConfig{URL: parsed}
+	*u = urlConfig{URL: parsed, raw: in}
+
+	if isTemplated(in) {
+		u.tpl = &urlTpl{}
+		if err := u.tpl.Unpack(in); err != nil {
+			return fmt.Errorf("invalid url template: %w", err)
+		}
+	}
 
 	return nil
 }
 
+// template returns the url as a template if it contains template actions.
+func (u *urlConfig) template() *urlTpl {
+	if u == nil {
+		return nil
+	}
+	return u.tpl
+}
+
 // IsEnabled returns true if the `enable` field is set to true in the yaml.
 func (dc *dateCursorConfig) isEnabled() bool {
 	return dc != nil && (dc.Enabled == nil || *dc.Enabled)
@@ -164,6 +194,12 @@ func (c *config) Validate() error {
 			return errors.New("invalid configuration: oauth2 and api_key or authentication_scheme cannot be set simultaneously")
 		}
 	}
+	if c.DateCursor != nil && len(c.Cursor) > 0 {
+		return errors.New("invalid configuration: both date_cursor and cursor cannot be set simultaneously")
+	}
+	if c.DateCursor.isEnabled() && c.URL != nil && isTemplated(c.URL.raw) {
+		return errors.New("invalid configuration: date_cursor cannot be used with a templated url")
+	}
 	return nil
 }
 
@@ -111,6 +111,48 @@ func TestConfigValidationCase7(t *testing.T) {
 	}
 }
 
+func TestConfigValidationCase8(t *testing.T) {
+	m := map[string]interface{}{
+		"date_cursor.url_field": "since",
+		"cursor.last.value":     "[[.event.ts]]",
+		"url":                   "localhost",
+	}
+	cfg := common.MustNewConfigFrom(m)
+	conf := newDefaultConfig()
+	if err := cfg.Unpack(&conf); err == nil {
+		t.Fatal("Configuration validation failed. date_cursor and cursor cannot coexist.")
+	}
+}
+
+func TestConfigValidationCase9(t *testing.T) {
+	m := map[string]interface{}{
+		"response.transforms": []map[string]interface{}{
+			{
+				"set":    map[string]interface{}{"target": "a", "value": "b"},
+				"delete": map[string]interface{}{"target": "c"},
+			},
+		},
+		"url": "localhost",
+	}
+	cfg := common.MustNewConfigFrom(m)
+	conf := newDefaultConfig()
+	if err := cfg.Unpack(&conf); err == nil {
+		t.Fatal("Configuration validation failed. a transform can only define one action.")
+	}
+}
+
+func TestConfigValidationCase10(t *testing.T) {
+	m := map[string]interface{}{
+		"http_headers": map[string]interface{}{"X-Since": "[[.cursor.since"},
+		"url":          "localhost",
+	}
+	cfg := common.MustNewConfigFrom(m)
+	conf := newDefaultConfig()
+	if err := cfg.Unpack(&conf); err == nil {
+		t.Fatal("Configuration validation failed. http_headers templates must be valid.")
+	}
+}
+
 func TestConfigMustFailWithInvalidURL(t *testing.T) {
 	m := map[string]interface{}{
 		"url": "::invalid::",
@@ -11,13 +11,16 @@ import (
 	"math/rand"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"golang.org/x/sync/errgroup"
 
 	v2 "github.com/elastic/beats/v7/filebeat/input/v2"
+	"github.com/elastic/beats/v7/libbeat/beat"
 	"github.com/elastic/beats/v7/libbeat/common"
 	"github.com/elastic/beats/v7/libbeat/logp"
 	beattest "github.com/elastic/beats/v7/libbeat/publisher/testing"
@@ -215,6 +218,49 @@ func TestStatelessHTTPJSONInput(t *testing.T) {
 			handler:  oauth2Handler,
 			expected: []string{`{"hello": "world"}`},
 		},
+		{
+			name: "Test request chaining",
+			setupServer: func(t *testing.T, h http.HandlerFunc, config map[string]interface{}) {
+				server := httptest.NewServer(h)
+				config["url"] = server.URL + "/ids"
+				config["chain"] = []map[string]interface{}{
+					{
+						"url":          server.URL + "/items/[[.parent.id]]",
+						"http_headers": map[string]interface{}{"X-Total": "[[.last_response.body.total]]"},
+					},
+				}
+				t.Cleanup(server.Close)
+			},
+			baseConfig: map[string]interface{}{
+				"http_method":        "GET",
+				"interval":           0,
+				"json_objects_array": "ids",
+			},
+			handler: chainHandler(),
+			expected: []string{
+				`{"id":"a","total":"2"}`,
+				`{"id":"b","total":"2"}`,
+			},
+		},
+		{
+			name:        "Test response transforms",
+			setupServer: newTestServer(httptest.NewServer),
+			baseConfig: map[string]interface{}{
+				"http_method": "GET",
+				"interval":    0,
+				"response.transforms": []map[string]interface{}{
+					{"split": map[string]interface{}{"target": "hello"}},
+					{"set": map[string]interface{}{"target": "planet", "value": "earth"}},
+					{"delete": map[string]interface{}{"target": "space"}},
+					{"append": map[string]interface{}{"target": "tags", "value": "[[.event.world]]", "default": "none"}},
+				},
+			},
+			handler: defaultHandler("GET", ""),
+			expected: []string{
+				`{"world":"moon","planet":"earth","tags":["moon"]}`,
+				`{"planet":"earth","tags":["none"]}`,
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -433,3 +479,92 @@ func paginationHandler() http.HandlerFunc {
 		count += 1
 	}
 }
+
+func chainHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch r.URL.Path {
+		case "/ids":
+			_, _ = w.Write([]byte(`{"total":2,"ids":[{"id":"a"},{"id":"b"}]}`))
+		case "/items/a", "/items/b":
+			if r.Header.Get("X-Total") != "2" {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"error":"wrong header value"}`))
+				return
+			}
+			id := strings.TrimPrefix(r.URL.Path, "/items/")
+			_, _ = fmt.Fprintf(w, `{"id":%q,"total":%q}`, id, r.Header.Get("X-Total"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}
+}
+
+type cursorPublisher struct {
+	events  chan beat.Event
+	cursors chan interface{}
+}
+
+func (p *cursorPublisher) Publish(event beat.Event, cursor interface{}) error {
+	p.events <- event
+	p.cursors <- cursor
+	return nil
+}
+
+func TestCursorHTTPJSONInput(t *testing.T) {
+	var count int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		if count == 2 {
+			_, _ = w.Write([]byte(`[]`))
+			return
+		}
+		expected := []string{"init", "2"}[count]
+		if got := r.URL.Query().Get("since"); got != expected {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = fmt.Fprintf(w, `{"error":"expected since=%s, got %s"}`, expected, got)
+			return
+		}
+		count++
+		_, _ = fmt.Fprintf(w, `[{"ts":"%d"},{"ts":"%d"}]`, 2*count-1, 2*count)
+	}))
+	t.Cleanup(server.Close)
+
+	cfg := common.MustNewConfigFrom(map[string]interface{}{
+		"url":                    server.URL + "?since=[[.cursor.last_ts]]",
+		"interval":               "100ms",
+		"cursor.last_ts.value":   "[[.event.ts]]",
+		"cursor.last_ts.default": "init",
+	})
+	conf := newDefaultConfig()
+	require.NoError(t, cfg.Unpack(&conf))
+
+	sources, input, err := newCursorInput(conf)
+	require.NoError(t, err)
+	assert.Equal(t, "httpjson-cursor", input.Name())
+	require.Len(t, sources, 1)
+	src := sources[0].(*source)
+
+	pub := &cursorPublisher{events: make(chan beat.Event), cursors: make(chan interface{})}
+
+	ctx, cancel := newV2Context()
+	t.Cleanup(cancel)
+
+	var g errgroup.Group
+	g.Go(func() error { return run(ctx, src.config, src.tlsConfig, pub, nil) })
+
+	for i := 1; i <= 4; i++ {
+		select {
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for event %d", i)
+		case got := <-pub.events:
+			val, err := got.Fields.GetValue("message")
+			assert.NoError(t, err)
+			assert.JSONEq(t, fmt.Sprintf(`{"ts":"%d"}`, i), val.(string))
+			state := (<-pub.cursors).(cursorState)
+			assert.Equal(t, common.MapStr{"last_ts": fmt.Sprint(i)}, state.Cursor)
+		}
+	}
+	cancel()
+	assert.NoError(t, g.Wait())
+}
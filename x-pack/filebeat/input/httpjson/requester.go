@@ -21,6 +21,7 @@ import (
 
 type requestInfo struct {
 	url        string
+	method     string
 	contentMap common.MapStr
 	headers    common.MapStr
 }
@@ -31,15 +32,19 @@ type requester struct {
 	dateCursor  *dateCursor
 	rateLimiter *rateLimiter
 	pagination  *pagination
+	chain       []chainStep
+	transforms  transforms
 
 	method        string
-	reqBody       common.MapStr
-	headers       common.MapStr
+	urlTpl        *urlTpl
+	reqBody       tplMap
+	headers       tplMap
 	noHTTPBody    bool
 	apiKey        string
 	authScheme    string
 	jsonObjects   string
 	splitEventsBy string
+	cursor        cursorConfig
 
 	cursorState cursorState
 }
@@ -57,14 +62,18 @@ func newRequester(
 		rateLimiter:   rateLimiter,
 		dateCursor:    dateCursor,
 		pagination:    pagination,
+		chain:         newChainFromConfig(config),
+		transforms:    newTransformsFromConfig(config.Response),
 		method:        config.HTTPMethod,
-		reqBody:       config.HTTPRequestBody.Clone(),
-		headers:       config.HTTPHeaders.Clone(),
+		urlTpl:        config.URL.template(),
+		reqBody:       config.HTTPRequestBody,
+		headers:       config.HTTPHeaders,
 		noHTTPBody:    config.NoHTTPBody,
 		apiKey:        config.APIKey,
 		authScheme:    config.AuthenticationScheme,
 		splitEventsBy: config.SplitEventsBy,
 		jsonObjects:   config.JSONObjects,
+		cursor:        config.Cursor,
 	}
 }
 
@@ -75,18 +84,14 @@ type response struct {
 
 // processHTTPRequest processes HTTP request, and handles pagination if enabled
 func (r *requester) processHTTPRequest(ctx context.Context, publisher cursor.Publisher) error {
-	ri := &requestInfo{
-		url:        r.dateCursor.getURL(r.cursorState.LastDateCursorValue),
-		contentMap: common.MapStr{},
-		headers:    r.headers,
-	}
+	trCtx := &transformContext{cursor: r.initialCursor()}
 
-	if r.method == "POST" && r.reqBody != nil {
-		ri.contentMap.Update(common.MapStr(r.reqBody))
+	ri, err := r.initialRequestInfo(trCtx)
+	if err != nil {
+		return err
 	}
 
 	var (
-		m, v     interface{}
 		response response
 		lastObj  common.MapStr
 	)
@@ -95,71 +100,28 @@ func (r *requester) processHTTPRequest(ctx context.Context, publisher cursor.Pub
 	hasNext := true
 
 	for hasNext {
-		resp, err := r.rateLimiter.execute(
-			ctx,
-			func(ctx context.Context) (*http.Response, error) {
-				req, err := r.createHTTPRequest(ctx, ri)
-				if err != nil {
-					return nil, fmt.Errorf("failed to create http request: %w", err)
-				}
-				msg, err := r.client.Do(req)
-				if err != nil {
-					return nil, fmt.Errorf("failed to execute http client.Do: %w", err)
-				}
-				return msg, nil
-			},
-		)
+		resp, responseData, err := r.executeRequest(ctx, ri)
 		if err != nil {
 			return err
 		}
 
 		response.header = resp.Header
-		responseData, err := ioutil.ReadAll(resp.Body)
+		body, events, err := r.decodeResponse(responseData, r.jsonObjects, r.splitEventsBy)
 		if err != nil {
-			return fmt.Errorf("failed to read http response: %w", err)
+			return err
+		}
+		if body != nil {
+			response.body = body
 		}
-		_ = resp.Body.Close()
 
-		if err = json.Unmarshal(responseData, &m); err != nil {
-			r.log.Debug("failed to unmarshal http.response.body", string(responseData))
-			return fmt.Errorf("failed to unmarshal http.response.body: %w", err)
+		lastObj = nil
+		if len(events) > 0 {
+			lastObj = events[len(events)-1].Clone()
 		}
 
-		switch obj := m.(type) {
-		// Top level Array
-		case []interface{}:
-			lastObj, err = r.processEventArray(publisher, obj)
-			if err != nil {
-				return err
-			}
-		case map[string]interface{}:
-			response.body = obj
-			if r.jsonObjects == "" {
-				lastObj, err = r.processEventArray(publisher, []interface{}{obj})
-				if err != nil {
-					return err
-				}
-			} else {
-				v, err = common.MapStr(obj).GetValue(r.jsonObjects)
-				if err != nil {
-					if err == common.ErrKeyNotFound {
-						break
-					}
-					return err
-				}
-				switch ts := v.(type) {
-				case []interface{}:
-					lastObj, err = r.processEventArray(publisher, ts)
-					if err != nil {
-						return err
-					}
-				default:
-					return fmt.Errorf("content of %s is not a valid array", r.jsonObjects)
-				}
-			}
-		default:
-			r.log.Debug("http.response.body is not a valid JSON object", string(responseData))
-			return fmt.Errorf("http.response.body is not a valid JSON object, but a %T", obj)
+		trCtx.lastResponse = &response
+		if err := r.processEvents(ctx, publisher, trCtx, events); err != nil {
+			return err
 		}
 
 		ri, hasNext, err = r.pagination.nextRequestInfo(ri, response, lastObj)
@@ -168,13 +130,130 @@ func (r *requester) processHTTPRequest(ctx context.Context, publisher cursor.Pub
 		}
 
 		if lastObj != nil && r.dateCursor.enabled {
-			r.updateCursorState(ri.url, r.dateCursor.getNextValue(common.MapStr(lastObj)))
+			r.updateCursorState(ri.url, r.dateCursor.getNextValue(lastObj))
 		}
 	}
 
 	return nil
 }
 
+// initialRequestInfo builds the first request of an execution, rendering
+// the templates in the url, headers and body.
+func (r *requester) initialRequestInfo(trCtx *transformContext) (*requestInfo, error) {
+	url := r.dateCursor.getURL(r.cursorState.LastDateCursorValue)
+	if r.urlTpl != nil {
+		url = r.urlTpl.Execute(trCtx, nil)
+		if url == "" {
+			return nil, fmt.Errorf("url template rendered an empty url")
+		}
+	}
+
+	headers, err := executeTemplates(r.headers, trCtx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render http_headers: %w", err)
+	}
+
+	ri := &requestInfo{
+		url:        url,
+		method:     r.method,
+		contentMap: common.MapStr{},
+		headers:    headers,
+	}
+
+	if r.method == "POST" && r.reqBody != nil {
+		body, err := executeTemplates(r.reqBody, trCtx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to render http_request_body: %w", err)
+		}
+		ri.contentMap.Update(body)
+	}
+
+	return ri, nil
+}
+
+// executeRequest executes the request honoring the rate limit and returns
+// the response and its body.
+func (r *requester) executeRequest(ctx context.Context, ri *requestInfo) (*http.Response, []byte, error) {
+	resp, err := r.rateLimiter.execute(
+		ctx,
+		func(ctx context.Context) (*http.Response, error) {
+			req, err := r.createHTTPRequest(ctx, ri)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create http request: %w", err)
+			}
+			msg, err := r.client.Do(req)
+			if err != nil {
+				return nil, fmt.Errorf("failed to execute http client.Do: %w", err)
+			}
+			return msg, nil
+		},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	responseData, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read http response: %w", err)
+	}
+	_ = resp.Body.Close()
+
+	return resp, responseData, nil
+}
+
+// decodeResponse extracts the events contained in a response. It returns the
+// response body if it is a JSON object, and the list of events.
+func (r *requester) decodeResponse(responseData []byte, jsonObjects, splitEventsBy string) (common.MapStr, []common.MapStr, error) {
+	var m interface{}
+	if err := json.Unmarshal(responseData, &m); err != nil {
+		r.log.Debug("failed to unmarshal http.response.body", string(responseData))
+		return nil, nil, fmt.Errorf("failed to unmarshal http.response.body: %w", err)
+	}
+
+	switch obj := m.(type) {
+	// Top level Array
+	case []interface{}:
+		events, err := splitEventArray(splitEventsBy, obj)
+		return nil, events, err
+	case map[string]interface{}:
+		if jsonObjects == "" {
+			events, err := splitEventArray(splitEventsBy, []interface{}{obj})
+			return obj, events, err
+		}
+		v, err := common.MapStr(obj).GetValue(jsonObjects)
+		if err != nil {
+			if err == common.ErrKeyNotFound {
+				return obj, nil, nil
+			}
+			return nil, nil, err
+		}
+		switch ts := v.(type) {
+		case []interface{}:
+			events, err := splitEventArray(splitEventsBy, ts)
+			return obj, events, err
+		default:
+			return nil, nil, fmt.Errorf("content of %s is not a valid array", jsonObjects)
+		}
+	default:
+		r.log.Debug("http.response.body is not a valid JSON object", string(responseData))
+		return nil, nil, fmt.Errorf("http.response.body is not a valid JSON object, but a %T", obj)
+	}
+}
+
+// processEvents publishes the events of a response, or passes them to the
+// request chain if there is one.
+func (r *requester) processEvents(ctx context.Context, publisher cursor.Publisher, trCtx *transformContext, events []common.MapStr) error {
+	if len(r.chain) == 0 {
+		return r.publishEvents(publisher, trCtx, events)
+	}
+	for _, e := range events {
+		if err := r.processChainStep(ctx, publisher, trCtx, 0, e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // createHTTPRequest creates an HTTP/HTTPs request for the input
 func (r *requester) createHTTPRequest(ctx context.Context, ri *requestInfo) (*http.Request, error) {
 	var body io.Reader
@@ -187,7 +266,7 @@ func (r *requester) createHTTPRequest(ctx context.Context, ri *requestInfo) (*ht
 		}
 		body = bytes.NewReader(b)
 	}
-	req, err := http.NewRequest(r.method, ri.url, body)
+	req, err := http.NewRequest(ri.method, ri.url, body)
 	if err != nil {
 		return nil, err
 	}
@@ -212,27 +291,41 @@ func (r *requester) createHTTPRequest(ctx context.Context, ri *requestInfo) (*ht
 	return req, nil
 }
 
-// processEventArray publishes an event for each object contained in the array. It returns the last object in the array and an error if any.
-func (r *requester) processEventArray(publisher cursor.Publisher, events []interface{}) (map[string]interface{}, error) {
-	var last map[string]interface{}
-	for _, t := range events {
+// splitEventArray splits each object contained in the array. It returns an
+// error if the array contains anything other than objects.
+func splitEventArray(splitEventsBy string, objs []interface{}) ([]common.MapStr, error) {
+	var events []common.MapStr
+	for _, t := range objs {
 		switch v := t.(type) {
 		case map[string]interface{}:
-			for _, e := range splitEvent(r.splitEventsBy, v) {
-				last = e
-				d, err := json.Marshal(e)
-				if err != nil {
-					return nil, fmt.Errorf("failed to marshal %+v: %w", e, err)
-				}
-				if err := publisher.Publish(makeEvent(string(d)), r.cursorState); err != nil {
-					return nil, fmt.Errorf("failed to publish: %w", err)
-				}
+			for _, e := range splitEvent(splitEventsBy, v) {
+				events = append(events, e)
 			}
 		default:
 			return nil, fmt.Errorf("expected only JSON objects in the array but got a %T", v)
 		}
 	}
-	return last, nil
+	return events, nil
+}
+
+// publishEvents applies the response transforms and publishes an event for
+// each resulting object, along with the updated cursor.
+func (r *requester) publishEvents(publisher cursor.Publisher, trCtx *transformContext, events []common.MapStr) error {
+	events, err := r.transforms.run(trCtx, events)
+	if err != nil {
+		return fmt.Errorf("failed to transform response: %w", err)
+	}
+	for _, e := range events {
+		d, err := json.Marshal(e)
+		if err != nil {
+			return fmt.Errorf("failed to marshal %+v: %w", e, err)
+		}
+		r.updateCursor(trCtx.withEvent(e))
+		if err := publisher.Publish(makeEvent(string(d)), r.cursorState); err != nil {
+			return fmt.Errorf("failed to publish: %w", err)
+		}
+	}
+	return nil
 }
 
 func splitEvent(splitKey string, event map[string]interface{}) []map[string]interface{} {
@@ -282,6 +375,46 @@ func splitEvent(splitKey string, event map[string]interface{}) []map[string]inte
 type cursorState struct {
 	LastCalledURL       string
 	LastDateCursorValue string
+	Cursor              common.MapStr
+}
+
+// initialCursor returns the cursor state for a new execution. Values that
+// were never stored are set to their rendered default.
+func (r *requester) initialCursor() common.MapStr {
+	cursor := r.cursorState.Cursor.Clone()
+	if cursor == nil {
+		cursor = common.MapStr{}
+	}
+	trCtx := &transformContext{cursor: cursor}
+	for name, c := range r.cursor {
+		if _, found := cursor[name]; found || c.Default == nil {
+			continue
+		}
+		if val := c.Default.Execute(trCtx, nil); val != "" {
+			cursor[name] = val
+		}
+	}
+	return cursor
+}
+
+// updateCursor evaluates the cursor templates for the event in trCtx.
+// Values that render empty keep their previous value. The cursor map is
+// replaced rather than modified, as the published states are only
+// serialized once the events are acknowledged.
+func (r *requester) updateCursor(trCtx *transformContext) {
+	if len(r.cursor) == 0 {
+		return
+	}
+	updated := r.cursorState.Cursor.Clone()
+	if updated == nil {
+		updated = common.MapStr{}
+	}
+	for name, c := range r.cursor {
+		if val := c.Value.Execute(trCtx, nil); val != "" {
+			updated[name] = val
+		}
+	}
+	r.cursorState.Cursor = updated
 }
 
 func (r *requester) updateCursorState(url, value string) {
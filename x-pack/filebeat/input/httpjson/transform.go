@@ -0,0 +1,247 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package httpjson
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+// transformContext holds the data that templates can reference.
+type transformContext struct {
+	// cursor is the cursor state at the start of the current execution.
+	cursor common.MapStr
+	// lastResponse is the response the current events were extracted from.
+	lastResponse *response
+	// parent is the event of the previous chain step that triggered the
+	// current request.
+	parent common.MapStr
+	// event is the event currently being transformed.
+	event common.MapStr
+}
+
+func (trCtx *transformContext) data() map[string]interface{} {
+	lastResponse := common.MapStr{
+		"header": http.Header{},
+		"body":   common.MapStr{},
+	}
+	if trCtx.lastResponse != nil {
+		if trCtx.lastResponse.header != nil {
+			lastResponse["header"] = trCtx.lastResponse.header
+		}
+		if trCtx.lastResponse.body != nil {
+			lastResponse["body"] = trCtx.lastResponse.body
+		}
+	}
+	return map[string]interface{}{
+		"cursor":        nonNil(trCtx.cursor),
+		"last_response": lastResponse,
+		"parent":        nonNil(trCtx.parent),
+		"event":         nonNil(trCtx.event),
+	}
+}
+
+// withParent returns a copy of the context for a request triggered by parent.
+// The last response is kept until the new request gets a response.
+func (trCtx transformContext) withParent(parent common.MapStr) *transformContext {
+	trCtx.parent = parent
+	trCtx.event = nil
+	return &trCtx
+}
+
+// withEvent returns a copy of the context for transforming event.
+func (trCtx transformContext) withEvent(event common.MapStr) *transformContext {
+	trCtx.event = event
+	return &trCtx
+}
+
+func nonNil(m common.MapStr) common.MapStr {
+	if m == nil {
+		return common.MapStr{}
+	}
+	return m
+}
+
+type responseConfig struct {
+	Transforms []transformConfig `config:"transforms"`
+}
+
+type transformConfig struct {
+	Set    *setConfig    `config:"set"`
+	Delete *deleteConfig `config:"delete"`
+	Append *appendConfig `config:"append"`
+	Split  *splitConfig  `config:"split"`
+}
+
+type setConfig struct {
+	Target  string    `config:"target" validate:"required"`
+	Value   *valueTpl `config:"value" validate:"required"`
+	Default *valueTpl `config:"default"`
+}
+
+type deleteConfig struct {
+	Target string `config:"target" validate:"required"`
+}
+
+type appendConfig setConfig
+
+type splitConfig struct {
+	Target     string `config:"target" validate:"required"`
+	KeepParent bool   `config:"keep_parent"`
+}
+
+func (c *transformConfig) Validate() error {
+	var n int
+	for _, set := range []bool{c.Set != nil, c.Delete != nil, c.Append != nil, c.Split != nil} {
+		if set {
+			n++
+		}
+	}
+	if n != 1 {
+		return errors.New("invalid configuration: each transform must define exactly one of set, delete, append or split")
+	}
+	return nil
+}
+
+// transform modifies the list of events extracted from a response.
+type transform interface {
+	run(trCtx *transformContext, events []common.MapStr) ([]common.MapStr, error)
+}
+
+type transforms []transform
+
+func newTransformsFromConfig(config *responseConfig) transforms {
+	if config == nil {
+		return nil
+	}
+	var ts transforms
+	for _, c := range config.Transforms {
+		switch {
+		case c.Set != nil:
+			ts = append(ts, (*setTransform)(c.Set))
+		case c.Delete != nil:
+			ts = append(ts, (*deleteTransform)(c.Delete))
+		case c.Append != nil:
+			ts = append(ts, (*appendTransform)(c.Append))
+		case c.Split != nil:
+			ts = append(ts, (*splitTransform)(c.Split))
+		}
+	}
+	return ts
+}
+
+// run applies all transforms in order.
+func (ts transforms) run(trCtx *transformContext, events []common.MapStr) ([]common.MapStr, error) {
+	var err error
+	for _, t := range ts {
+		events, err = t.run(trCtx, events)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return events, nil
+}
+
+// setTransform sets the target field to the rendered value.
+type setTransform setConfig
+
+func (t *setTransform) run(trCtx *transformContext, events []common.MapStr) ([]common.MapStr, error) {
+	for _, e := range events {
+		val := t.Value.Execute(trCtx.withEvent(e), t.Default)
+		if val == "" {
+			continue
+		}
+		if _, err := e.Put(t.Target, val); err != nil {
+			return nil, fmt.Errorf("failed to set %q: %w", t.Target, err)
+		}
+	}
+	return events, nil
+}
+
+// deleteTransform removes the target field.
+type deleteTransform deleteConfig
+
+func (t *deleteTransform) run(_ *transformContext, events []common.MapStr) ([]common.MapStr, error) {
+	for _, e := range events {
+		if err := e.Delete(t.Target); err != nil && err != common.ErrKeyNotFound {
+			return nil, fmt.Errorf("failed to delete %q: %w", t.Target, err)
+		}
+	}
+	return events, nil
+}
+
+// appendTransform appends the rendered value to the target field, turning
+// it into a list if needed.
+type appendTransform setConfig
+
+func (t *appendTransform) run(trCtx *transformContext, events []common.MapStr) ([]common.MapStr, error) {
+	for _, e := range events {
+		val := t.Value.Execute(trCtx.withEvent(e), t.Default)
+		if val == "" {
+			continue
+		}
+
+		var list []interface{}
+		switch existing, err := e.GetValue(t.Target); {
+		case err == common.ErrKeyNotFound:
+		case err != nil:
+			return nil, fmt.Errorf("failed to append to %q: %w", t.Target, err)
+		default:
+			switch vv := existing.(type) {
+			case []interface{}:
+				list = append(list, vv...)
+			default:
+				list = append(list, vv)
+			}
+		}
+
+		if _, err := e.Put(t.Target, append(list, val)); err != nil {
+			return nil, fmt.Errorf("failed to append to %q: %w", t.Target, err)
+		}
+	}
+	return events, nil
+}
+
+// splitTransform creates an event per element in the target array. The
+// elements replace the whole event, or only the target field if keep_parent
+// is set. Events without the target field are kept as they are.
+type splitTransform splitConfig
+
+func (t *splitTransform) run(_ *transformContext, events []common.MapStr) ([]common.MapStr, error) {
+	var out []common.MapStr
+	for _, e := range events {
+		v, err := e.GetValue(t.Target)
+		if err != nil {
+			out = append(out, e)
+			continue
+		}
+
+		elems, ok := v.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("split target %q is not an array but a %T", t.Target, v)
+		}
+
+		for _, elem := range elems {
+			if t.KeepParent {
+				clone := e.Clone()
+				if _, err := clone.Put(t.Target, elem); err != nil {
+					return nil, fmt.Errorf("failed to split %q: %w", t.Target, err)
+				}
+				out = append(out, clone)
+				continue
+			}
+
+			obj, ok := elem.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("split target %q must contain only objects unless keep_parent is set, but got a %T", t.Target, elem)
+			}
+			out = append(out, common.MapStr(obj))
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,330 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package httpjson
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"text/template"
+	"text/template/parse"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+const (
+	// valueTpl templates use different delimiters than date_cursor.value_template,
+	// so they do not collide with the Go templates some APIs expect in parameters.
+	leftDelim  = "[["
+	rightDelim = "]]"
+
+	// text/template renders missing values this way.
+	noValue = "<no value>"
+)
+
+// valueTpl is a template that is evaluated against the transform context
+// to build request URLs, headers and bodies, cursor values and the values
+// set by response transforms.
+type valueTpl struct {
+	*template.Template
+}
+
+func (t *valueTpl) Unpack(in string) error {
+	tpl, err := parseValueTpl(in)
+	if err != nil {
+		return err
+	}
+
+	*t = valueTpl{Template: tpl}
+
+	return nil
+}
+
+// urlTpl is a valueTpl used to build request URLs. The values it renders are
+// query escaped.
+type urlTpl struct {
+	valueTpl
+}
+
+func (t *urlTpl) Unpack(in string) error {
+	if err := t.valueTpl.Unpack(in); err != nil {
+		return err
+	}
+	escapeActions(t.Template)
+	return nil
+}
+
+// escapeActions pipes the output of all the actions of the template through
+// queryEscape.
+func escapeActions(tpl *template.Template) {
+	tpl.Funcs(template.FuncMap{"queryEscape": queryEscape})
+
+	var walk func(node parse.Node)
+	walk = func(node parse.Node) {
+		switch n := node.(type) {
+		case *parse.ListNode:
+			if n == nil {
+				return
+			}
+			for _, child := range n.Nodes {
+				walk(child)
+			}
+		case *parse.ActionNode:
+			// Variable declarations don't render anything.
+			if len(n.Pipe.Decl) > 0 {
+				return
+			}
+			escape := parse.NewIdentifier("queryEscape").SetTree(tpl.Tree).SetPos(n.Pos)
+			n.Pipe.Cmds = append(n.Pipe.Cmds, &parse.CommandNode{
+				NodeType: parse.NodeCommand,
+				Pos:      n.Pos,
+				Args:     []parse.Node{escape},
+			})
+		case *parse.IfNode:
+			walk(n.List)
+			walk(n.ElseList)
+		case *parse.RangeNode:
+			walk(n.List)
+			walk(n.ElseList)
+		case *parse.WithNode:
+			walk(n.List)
+			walk(n.ElseList)
+		}
+	}
+	walk(tpl.Tree.Root)
+}
+
+// queryEscape escapes a value printed as text/template would. Nil values are
+// rendered empty.
+func queryEscape(v interface{}) string {
+	if v == nil {
+		return ""
+	}
+	return url.QueryEscape(fmt.Sprint(v))
+}
+
+func parseValueTpl(in string) (*template.Template, error) {
+	return template.New("").
+		Option("missingkey=error").
+		Funcs(template.FuncMap{
+			"now":            now,
+			"parseDuration":  parseDuration,
+			"parseDate":      parseDate,
+			"parseTimestamp": parseTimestamp,
+			"formatDate":     formatDate,
+			"toJSON":         toJSON,
+		}).
+		Delims(leftDelim, rightDelim).
+		Parse(in)
+}
+
+// isTemplated returns true if the string contains template actions.
+func isTemplated(s string) bool {
+	return strings.Contains(s, leftDelim)
+}
+
+// Execute renders the template. If rendering fails or returns an empty value
+// the default template is rendered instead. An empty string is returned when
+// no value could be rendered.
+func (t *valueTpl) Execute(trCtx *transformContext, defaultVal *valueTpl) string {
+	if t != nil && t.Template != nil {
+		if val, err := executeTpl(t.Template, trCtx); err == nil && val != "" {
+			return val
+		}
+	}
+	if defaultVal != nil && defaultVal.Template != nil {
+		if val, err := executeTpl(defaultVal.Template, trCtx); err == nil {
+			return val
+		}
+	}
+	return ""
+}
+
+func executeTpl(tpl *template.Template, trCtx *transformContext) (string, error) {
+	buf := new(bytes.Buffer)
+	if err := tpl.Execute(buf, trCtx.data()); err != nil {
+		return "", err
+	}
+	val := buf.String()
+	if val == noValue {
+		return "", nil
+	}
+	return val, nil
+}
+
+// tplMap is a map, like http_headers or http_request_body, whose string values
+// containing template actions are parsed when unpacked.
+type tplMap common.MapStr
+
+func (m *tplMap) Unpack(in interface{}) error {
+	raw, ok := in.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("expected a map, got %T", in)
+	}
+	parsed, err := parseTemplates(raw)
+	if err != nil {
+		return err
+	}
+	*m = tplMap(parsed)
+	return nil
+}
+
+func parseTemplates(m map[string]interface{}) (common.MapStr, error) {
+	out := make(common.MapStr, len(m))
+	for k, v := range m {
+		parsed, err := parseTemplatesValue(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid template in %q: %w", k, err)
+		}
+		out[k] = parsed
+	}
+	return out, nil
+}
+
+func parseTemplatesValue(v interface{}) (interface{}, error) {
+	switch vv := v.(type) {
+	case string:
+		if !isTemplated(vv) {
+			return vv, nil
+		}
+		var tpl valueTpl
+		if err := tpl.Unpack(vv); err != nil {
+			return nil, err
+		}
+		return &tpl, nil
+	case map[string]interface{}:
+		return parseTemplates(vv)
+	case []interface{}:
+		out := make([]interface{}, len(vv))
+		for i, e := range vv {
+			parsed, err := parseTemplatesValue(e)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = parsed
+		}
+		return out, nil
+	}
+	return v, nil
+}
+
+// executeTemplates returns a copy of m where all the templates have been
+// rendered.
+func executeTemplates(m map[string]interface{}, trCtx *transformContext) (common.MapStr, error) {
+	if m == nil {
+		return nil, nil
+	}
+	out := make(common.MapStr, len(m))
+	for k, v := range m {
+		rendered, err := executeTemplatesValue(v, trCtx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to render %q: %w", k, err)
+		}
+		out[k] = rendered
+	}
+	return out, nil
+}
+
+func executeTemplatesValue(v interface{}, trCtx *transformContext) (interface{}, error) {
+	switch vv := v.(type) {
+	case *valueTpl:
+		return executeTpl(vv.Template, trCtx)
+	case common.MapStr:
+		return executeTemplates(vv, trCtx)
+	case map[string]interface{}:
+		return executeTemplates(vv, trCtx)
+	case []interface{}:
+		out := make([]interface{}, len(vv))
+		for i, e := range vv {
+			rendered, err := executeTemplatesValue(e, trCtx)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = rendered
+		}
+		return out, nil
+	}
+	return v, nil
+}
+
+var predefinedLayouts = map[string]string{
+	"ANSIC":       time.ANSIC,
+	"UnixDate":    time.UnixDate,
+	"RubyDate":    time.RubyDate,
+	"RFC822":      time.RFC822,
+	"RFC822Z":     time.RFC822Z,
+	"RFC850":      time.RFC850,
+	"RFC1123":     time.RFC1123,
+	"RFC1123Z":    time.RFC1123Z,
+	"RFC3339":     time.RFC3339,
+	"RFC3339Nano": time.RFC3339Nano,
+	"Kitchen":     time.Kitchen,
+}
+
+func layout(layouts []string) string {
+	if len(layouts) == 0 {
+		return time.RFC3339
+	}
+	if l, found := predefinedLayouts[layouts[0]]; found {
+		return l
+	}
+	return layouts[0]
+}
+
+// now returns the current time in UTC, optionally shifted by a duration.
+func now(add ...time.Duration) time.Time {
+	t := timeNow().UTC()
+	if len(add) == 0 {
+		return t
+	}
+	return t.Add(add[0])
+}
+
+func parseDuration(s string) time.Duration {
+	d, _ := time.ParseDuration(s)
+	return d
+}
+
+func parseDate(date string, layouts ...string) time.Time {
+	t, err := time.Parse(layout(layouts), date)
+	if err != nil {
+		return time.Time{}
+	}
+	return t.UTC()
+}
+
+func parseTimestamp(s interface{}) time.Time {
+	switch ts := s.(type) {
+	case float64:
+		return time.Unix(int64(ts), 0).UTC()
+	case int:
+		return time.Unix(int64(ts), 0).UTC()
+	case int64:
+		return time.Unix(ts, 0).UTC()
+	case string:
+		i, err := strconv.ParseInt(ts, 10, 64)
+		if err != nil {
+			return time.Time{}
+		}
+		return time.Unix(i, 0).UTC()
+	}
+	return time.Time{}
+}
+
+func formatDate(date time.Time, layouts ...string) string {
+	return date.UTC().Format(layout(layouts))
+}
+
+func toJSON(v interface{}) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
@@ -0,0 +1,107 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package httpjson
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+func TestValueTpl(t *testing.T) {
+	timeNow = func() time.Time {
+		t, _ := time.Parse(time.RFC3339, "2002-10-02T15:00:00Z")
+		return t
+	}
+	t.Cleanup(func() { timeNow = time.Now })
+
+	trCtx := &transformContext{
+		cursor: common.MapStr{"since": "2002-10-02T14:00:00Z"},
+		lastResponse: &response{
+			header: http.Header{"Next-Page": []string{"2"}},
+			body:   common.MapStr{"total": 3},
+		},
+		parent: common.MapStr{"id": "abc"},
+		event:  common.MapStr{"ts": float64(1033570800)},
+	}
+
+	cases := map[string]struct {
+		value, defaultValue, expected string
+	}{
+		"cursor":         {value: "since=[[.cursor.since]]", expected: "since=2002-10-02T14:00:00Z"},
+		"header":         {value: `[[.last_response.header.Get "Next-Page"]]`, expected: "2"},
+		"body":           {value: "[[.last_response.body.total]]", expected: "3"},
+		"parent":         {value: "/items/[[.parent.id]]", expected: "/items/abc"},
+		"missing":        {value: "[[.event.missing]]", defaultValue: "none", expected: "none"},
+		"missing cursor": {value: "[[.cursor.missing]]", expected: ""},
+		"now":            {value: `[[formatDate (now (parseDuration "-1h"))]]`, expected: "2002-10-02T14:00:00Z"},
+		"layout":         {value: `[[formatDate (parseDate .cursor.since) "2006-01-02"]]`, expected: "2002-10-02"},
+		"timestamp":      {value: `[[formatDate (parseTimestamp .event.ts) "RFC1123"]]`, expected: "Wed, 02 Oct 2002 15:00:00 UTC"},
+		"json":           {value: "[[toJSON .parent]]", expected: `{"id":"abc"}`},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var value valueTpl
+			require.NoError(t, value.Unpack(c.value))
+			var defaultValue *valueTpl
+			if c.defaultValue != "" {
+				defaultValue = &valueTpl{}
+				require.NoError(t, defaultValue.Unpack(c.defaultValue))
+			}
+			assert.Equal(t, c.expected, value.Execute(trCtx, defaultValue))
+		})
+	}
+}
+
+func TestURLTplEscapesValues(t *testing.T) {
+	trCtx := &transformContext{
+		cursor: common.MapStr{"since": "2002-10-02T14:00:00+02:00"},
+		parent: common.MapStr{"id": "a b/c&d"},
+	}
+
+	var tpl urlTpl
+	require.NoError(t, tpl.Unpack(`http://localhost/items/[[.parent.id]]?since=[[.cursor.since]][[if .cursor.since]]&all=[[true]][[end]][[$x := 1]]`))
+	assert.Equal(t, "http://localhost/items/a+b%2Fc%26d?since=2002-10-02T14%3A00%3A00%2B02%3A00&all=true", tpl.Execute(trCtx, nil))
+}
+
+func TestTplMap(t *testing.T) {
+	trCtx := &transformContext{
+		cursor: common.MapStr{"since": "2002-10-02T14:00:00Z"},
+	}
+
+	cfg := common.MustNewConfigFrom(map[string]interface{}{
+		"body": map[string]interface{}{
+			"query":  map[string]interface{}{"since": "[[.cursor.since]]"},
+			"fields": []interface{}{"a", "[[.cursor.since]]"},
+			"limit":  10,
+		},
+	})
+	var c struct {
+		Body tplMap `config:"body"`
+	}
+	require.NoError(t, cfg.Unpack(&c))
+
+	// templates are parsed once when unpacked
+	assert.IsType(t, &valueTpl{}, c.Body["query"].(common.MapStr)["since"])
+
+	body, err := executeTemplates(c.Body, trCtx)
+	require.NoError(t, err)
+	assert.Equal(t, common.MapStr{
+		"query":  common.MapStr{"since": "2002-10-02T14:00:00Z"},
+		"fields": []interface{}{"a", "2002-10-02T14:00:00Z"},
+		"limit":  uint64(10),
+	}, body)
+
+	cfg = common.MustNewConfigFrom(map[string]interface{}{
+		"body": map[string]interface{}{"since": "[[.cursor.since"},
+	})
+	assert.Error(t, cfg.Unpack(&c))
+}
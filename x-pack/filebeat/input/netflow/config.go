@@ -16,11 +16,17 @@ import (
 type config struct {
 	udp.Config                `config:",inline"`
 	harvester.ForwarderConfig `config:",inline"`
-	Protocols                 []string      `config:"protocols"`
-	ExpirationTimeout         time.Duration `config:"expiration_timeout"`
-	PacketQueueSize           int           `config:"queue_size"`
-	CustomDefinitions         []string      `config:"custom_definitions"`
-	DetectSequenceReset       bool          `config:"detect_sequence_reset"`
+	Protocols                 []string            `config:"protocols"`
+	ExpirationTimeout         time.Duration       `config:"expiration_timeout"`
+	PacketQueueSize           int                 `config:"queue_size"`
+	CustomDefinitions         []string            `config:"custom_definitions"`
+	DetectSequenceReset       bool                `config:"detect_sequence_reset"`
+	TemplateCache             templateCacheConfig `config:"template_cache"`
+}
+
+type templateCacheConfig struct {
+	Enabled bool          `config:"enabled"`
+	MaxAge  time.Duration `config:"max_age" validate:"min=0"`
 }
 
 var defaultConfig = config{
@@ -36,4 +42,8 @@ var defaultConfig = config{
 	ExpirationTimeout:   time.Minute * 30,
 	PacketQueueSize:     8192,
 	DetectSequenceReset: true,
+	TemplateCache: templateCacheConfig{
+		Enabled: false,
+		MaxAge:  24 * time.Hour,
+	},
 }
@@ -58,6 +58,8 @@ func toBeatEventCommon(flow record.Record) (event beat.Event) {
 		flow.Fields["type"] = "netflow_flow"
 	case record.Options:
 		flow.Fields["type"] = "netflow_options"
+	case record.Counters:
+		flow.Fields["type"] = "netflow_counters"
 	default:
 		flow.Fields["type"] = "netflow_unknown"
 	}
@@ -69,8 +71,11 @@ func toBeatEventCommon(flow record.Record) (event beat.Event) {
 		"category": []string{"network_traffic", "network"},
 		"action":   flow.Fields["type"],
 	}
-	if ecsEvent["action"] == "netflow_flow" {
+	switch ecsEvent["action"] {
+	case "netflow_flow":
 		ecsEvent["type"] = []string{"connection"}
+	case "netflow_counters":
+		ecsEvent["kind"] = "metric"
 	}
 	// ECS Fields -- device
 	ecsDevice := common.MapStr{}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/elastic/beats/v7/x-pack/filebeat/input/netflow/decoder/fields"
+	"github.com/elastic/beats/v7/x-pack/filebeat/input/netflow/decoder/template"
 )
 
 // Config stores the configuration used by the NetFlow Collector.
@@ -19,6 +20,7 @@ type Config struct {
 	expiration  time.Duration
 	detectReset bool
 	fields      fields.FieldDict
+	cache       template.Cache
 }
 
 var defaultCfg = Config{
@@ -81,6 +83,14 @@ func (c *Config) WithCustomFields(dicts ...fields.FieldDict) *Config {
 	return c
 }
 
+// WithTemplateCache sets the cache used to persist NetFlow V9/IPFIX
+// templates. Templates are restored from the cache when a session is
+// created. A nil cache disables persistence.
+func (c *Config) WithTemplateCache(cache template.Cache) *Config {
+	c.cache = cache
+	return c
+}
+
 // Protocols returns a list of the protocols enabled.
 func (c *Config) Protocols() []string {
 	return c.protocols
@@ -109,3 +119,9 @@ func (c *Config) Fields() fields.FieldDict {
 	}
 	return c.fields
 }
+
+// TemplateCache returns the configured template cache, or nil if templates
+// are not persisted.
+func (c *Config) TemplateCache() template.Cache {
+	return c.cache
+}
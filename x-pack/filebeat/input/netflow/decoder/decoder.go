@@ -18,6 +18,7 @@ import (
 	"github.com/elastic/beats/v7/x-pack/filebeat/input/netflow/decoder/config"
 	"github.com/elastic/beats/v7/x-pack/filebeat/input/netflow/decoder/protocol"
 	"github.com/elastic/beats/v7/x-pack/filebeat/input/netflow/decoder/record"
+	"github.com/elastic/beats/v7/x-pack/filebeat/input/netflow/decoder/sflow"
 )
 
 // Decoder is a NetFlow decoder that accepts network packets from an Exporter
@@ -86,6 +87,10 @@ func (p *Decoder) Read(buf *bytes.Buffer, source net.Addr) (records []record.Rec
 		return nil, io.EOF
 	}
 	version := binary.BigEndian.Uint16(buf.Bytes()[:2])
+	if version == 0 && sflow.IsDatagram(buf.Bytes()) {
+		// sFlow uses a 32-bit version field.
+		version = sflow.ProtocolID
+	}
 
 	handler, exists := p.protos[version]
 	if !exists {
@@ -0,0 +1,227 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package sflow
+
+import (
+	"encoding/binary"
+	"net"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/x-pack/filebeat/input/netflow/decoder/record"
+)
+
+var errShortRead = errors.New("short read")
+
+// xdrReader reads XDR-encoded (RFC 4506) values from a buffer. The first
+// error is sticky: once a read fails, all following reads return zero values.
+type xdrReader struct {
+	buf []byte
+	err error
+}
+
+func (r *xdrReader) next(n int) []byte {
+	if r.err != nil {
+		return nil
+	}
+	if n < 0 || n > len(r.buf) {
+		r.err = errShortRead
+		return nil
+	}
+	data := r.buf[:n]
+	r.buf = r.buf[n:]
+	return data
+}
+
+func (r *xdrReader) uint32() uint32 {
+	if data := r.next(4); data != nil {
+		return binary.BigEndian.Uint32(data)
+	}
+	return 0
+}
+
+func (r *xdrReader) uint64() uint64 {
+	if data := r.next(8); data != nil {
+		return binary.BigEndian.Uint64(data)
+	}
+	return 0
+}
+
+// fixed reads fixed-length opaque data, which is padded to a multiple of 4.
+func (r *xdrReader) fixed(n int) []byte {
+	data := r.next(n)
+	if pad := (4 - n%4) % 4; pad > 0 {
+		r.next(pad)
+	}
+	if r.err != nil {
+		return nil
+	}
+	return data
+}
+
+// opaque reads variable-length opaque data.
+func (r *xdrReader) opaque() []byte {
+	n := r.uint32()
+	if r.err != nil {
+		return nil
+	}
+	if uint64(n) > uint64(len(r.buf)) {
+		r.err = errShortRead
+		return nil
+	}
+	return r.fixed(int(n))
+}
+
+const (
+	etherTypeIPv4 = 0x0800
+	etherTypeIPv6 = 0x86dd
+	etherTypeVLAN = 0x8100
+	etherTypeQinQ = 0x88a8
+
+	ipProtoICMP   = 1
+	ipProtoTCP    = 6
+	ipProtoUDP    = 17
+	ipProtoICMPv6 = 58
+	ipProtoSCTP   = 132
+
+	ethernetHeaderLength = 14
+	vlanTagLength        = 4
+	ipv4MinHeaderLength  = 20
+	ipv6HeaderLength     = 40
+)
+
+// decodeEthernet parses the sampled ethernet frame header and the headers
+// it encapsulates. Truncated headers populate as many fields as possible.
+func decodeEthernet(data []byte, fields record.Map) {
+	if len(data) < ethernetHeaderLength {
+		return
+	}
+	fields["destinationMacAddress"] = net.HardwareAddr(append([]byte(nil), data[0:6]...))
+	fields["sourceMacAddress"] = net.HardwareAddr(append([]byte(nil), data[6:12]...))
+	etherType := binary.BigEndian.Uint16(data[12:14])
+	data = data[ethernetHeaderLength:]
+	for etherType == etherTypeVLAN || etherType == etherTypeQinQ {
+		if len(data) < vlanTagLength {
+			return
+		}
+		// The outermost tag is the VLAN the frame was received on.
+		if _, found := fields["vlanId"]; !found {
+			fields["vlanId"] = uint64(binary.BigEndian.Uint16(data[0:2]) & 0x0fff)
+		}
+		etherType = binary.BigEndian.Uint16(data[2:4])
+		data = data[vlanTagLength:]
+	}
+	fields["ethernetType"] = uint64(etherType)
+	switch etherType {
+	case etherTypeIPv4:
+		decodeIPv4(data, fields)
+	case etherTypeIPv6:
+		decodeIPv6(data, fields)
+	}
+}
+
+func decodeIPv4(data []byte, fields record.Map) {
+	if len(data) < ipv4MinHeaderLength || data[0]>>4 != 4 {
+		return
+	}
+	headerLength := int(data[0]&0x0f) * 4
+	if headerLength < ipv4MinHeaderLength {
+		return
+	}
+	proto := data[9]
+	fields["ipVersion"] = uint64(4)
+	fields["ipClassOfService"] = uint64(data[1])
+	fields["ipTotalLength"] = uint64(binary.BigEndian.Uint16(data[2:4]))
+	fields["ipTTL"] = uint64(data[8])
+	fields["protocolIdentifier"] = uint64(proto)
+	fields["sourceIPv4Address"] = net.IP(append([]byte(nil), data[12:16]...))
+	fields["destinationIPv4Address"] = net.IP(append([]byte(nil), data[16:20]...))
+
+	// Only the first fragment carries the transport header.
+	if fragmentOffset := binary.BigEndian.Uint16(data[6:8]) & 0x1fff; fragmentOffset != 0 {
+		return
+	}
+	if len(data) < headerLength {
+		return
+	}
+	decodeTransport(proto, data[headerLength:], fields)
+}
+
+func decodeIPv6(data []byte, fields record.Map) {
+	if len(data) < ipv6HeaderLength || data[0]>>4 != 6 {
+		return
+	}
+	header := binary.BigEndian.Uint32(data[0:4])
+	proto := data[6]
+	fields["ipVersion"] = uint64(6)
+	fields["ipClassOfService"] = uint64((header >> 20) & 0xff)
+	fields["flowLabelIPv6"] = uint64(header & 0x000fffff)
+	fields["ipTotalLength"] = uint64(binary.BigEndian.Uint16(data[4:6])) + ipv6HeaderLength
+	fields["ipTTL"] = uint64(data[7])
+	fields["sourceIPv6Address"] = net.IP(append([]byte(nil), data[8:24]...))
+	fields["destinationIPv6Address"] = net.IP(append([]byte(nil), data[24:40]...))
+	data = data[ipv6HeaderLength:]
+
+	// Skip extension headers to reach the transport header.
+	for {
+		switch proto {
+		case 0, 43, 60: // Hop-by-hop, routing, destination options
+			if len(data) < 8 {
+				fields["protocolIdentifier"] = uint64(proto)
+				return
+			}
+			length := (int(data[1]) + 1) * 8
+			if len(data) < length {
+				fields["protocolIdentifier"] = uint64(data[0])
+				return
+			}
+			proto, data = data[0], data[length:]
+			continue
+		case 44: // Fragment
+			if len(data) < 8 {
+				fields["protocolIdentifier"] = uint64(proto)
+				return
+			}
+			next := data[0]
+			fields["protocolIdentifier"] = uint64(next)
+			if fragmentOffset := binary.BigEndian.Uint16(data[2:4]) >> 3; fragmentOffset != 0 {
+				return
+			}
+			decodeTransport(next, data[8:], fields)
+			return
+		}
+		break
+	}
+	fields["protocolIdentifier"] = uint64(proto)
+	decodeTransport(proto, data, fields)
+}
+
+func decodeTransport(proto uint8, data []byte, fields record.Map) {
+	switch proto {
+	case ipProtoTCP:
+		if len(data) < 14 {
+			break
+		}
+		fields["sourceTransportPort"] = uint64(binary.BigEndian.Uint16(data[0:2]))
+		fields["destinationTransportPort"] = uint64(binary.BigEndian.Uint16(data[2:4]))
+		fields["tcpControlBits"] = uint64(binary.BigEndian.Uint16(data[12:14]) & 0x01ff)
+	case ipProtoUDP, ipProtoSCTP:
+		if len(data) < 4 {
+			break
+		}
+		fields["sourceTransportPort"] = uint64(binary.BigEndian.Uint16(data[0:2]))
+		fields["destinationTransportPort"] = uint64(binary.BigEndian.Uint16(data[2:4]))
+	case ipProtoICMP:
+		if len(data) < 2 {
+			break
+		}
+		fields["icmpTypeCodeIPv4"] = uint64(binary.BigEndian.Uint16(data[0:2]))
+	case ipProtoICMPv6:
+		if len(data) < 2 {
+			break
+		}
+		fields["icmpTypeCodeIPv6"] = uint64(binary.BigEndian.Uint16(data[0:2]))
+	}
+}
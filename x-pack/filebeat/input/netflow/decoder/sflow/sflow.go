@@ -0,0 +1,388 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package sflow
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"log"
+	"net"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/x-pack/filebeat/input/netflow/decoder/config"
+	"github.com/elastic/beats/v7/x-pack/filebeat/input/netflow/decoder/protocol"
+	"github.com/elastic/beats/v7/x-pack/filebeat/input/netflow/decoder/record"
+)
+
+const (
+	ProtocolName = "sflow"
+	LogPrefix    = "[sflow] "
+
+	// ProtocolID identifies sFlow v5 in the protocol registry. sFlow
+	// datagrams start with a 32-bit version number, which makes the first
+	// 16 bits always zero. Use an ID outside of the range of NetFlow versions.
+	ProtocolID uint16 = 0xf005
+
+	// Version is the supported sFlow datagram version.
+	Version uint32 = 5
+)
+
+// Sample formats (enterprise 0).
+const (
+	formatFlowSample            = 1
+	formatCounterSample         = 2
+	formatFlowSampleExpanded    = 3
+	formatCounterSampleExpanded = 4
+)
+
+// Flow and counter record formats (enterprise 0).
+const (
+	formatRawPacketHeader       = 1
+	formatExtendedSwitch        = 1001
+	formatGenericIfaceCounters  = 1
+	formatEthernetIfaceCounters = 2
+)
+
+const (
+	agentAddressTypeIPv4 = 1
+	agentAddressTypeIPv6 = 2
+
+	headerProtocolEthernet = 1
+	headerProtocolIPv4     = 11
+	headerProtocolIPv6     = 12
+
+	interfaceFormatIndex  = 0
+	interfaceIndexUnknown = 0x3fffffff
+
+	maxSamplesPerDatagram = 1024
+	maxRecordsPerSample   = 1024
+
+	datagramHeaderMinLength     = 28
+	rawPacketHeaderFixedLength  = 16
+	extendedSwitchRecordLength  = 16
+	genericIfaceCountersLength  = 88
+	ethernetIfaceCountersLength = 52
+)
+
+// IsDatagram returns true if the given packet starts with an sFlow v5
+// datagram header.
+func IsDatagram(data []byte) bool {
+	return len(data) >= 4 && binary.BigEndian.Uint32(data[:4]) == Version
+}
+
+// SFlowProtocol decodes sFlow v5 datagrams into flow records for flow
+// samples and counter records for counter samples.
+type SFlowProtocol struct {
+	logger *log.Logger
+}
+
+var _ protocol.Protocol = (*SFlowProtocol)(nil)
+
+func init() {
+	protocol.Registry.Register(ProtocolName, New)
+}
+
+func New(config config.Config) protocol.Protocol {
+	return &SFlowProtocol{
+		logger: log.New(config.LogOutput(), LogPrefix, 0),
+	}
+}
+
+func (*SFlowProtocol) Version() uint16 {
+	return ProtocolID
+}
+
+func (*SFlowProtocol) Start() error {
+	return nil
+}
+
+func (*SFlowProtocol) Stop() error {
+	return nil
+}
+
+// DatagramHeader is the header of an sFlow v5 datagram.
+type DatagramHeader struct {
+	Version        uint32
+	AgentAddress   net.IP
+	SubAgentID     uint32
+	SequenceNumber uint32
+	Uptime         uint32
+	NumSamples     uint32
+}
+
+func (p *SFlowProtocol) OnPacket(buf *bytes.Buffer, source net.Addr) (flows []record.Record, err error) {
+	r := xdrReader{buf: buf.Bytes()}
+	header, err := readDatagramHeader(&r)
+	if err != nil {
+		p.logger.Printf("Unable to read sFlow header: %v", err)
+		return nil, errors.Wrapf(err, "error reading header")
+	}
+	if header.NumSamples > maxSamplesPerDatagram {
+		return nil, fmt.Errorf("too many samples in datagram: %d", header.NumSamples)
+	}
+
+	p.logger.Printf("Packet from:%s agent:%s seq:%d samples:%d", source, header.AgentAddress, header.SequenceNumber, header.NumSamples)
+	for i := uint32(0); i < header.NumSamples; i++ {
+		format, sample := r.uint32(), r.opaque()
+		if r.err != nil {
+			return flows, errors.Wrapf(r.err, "error reading sample %d", i)
+		}
+		var records []record.Record
+		switch format {
+		case formatFlowSample, formatFlowSampleExpanded:
+			records, err = decodeFlowSample(&xdrReader{buf: sample}, format == formatFlowSampleExpanded)
+		case formatCounterSample, formatCounterSampleExpanded:
+			records, err = decodeCounterSample(&xdrReader{buf: sample}, format == formatCounterSampleExpanded)
+		default:
+			p.logger.Printf("Ignoring sample with unsupported format %d:%d", format>>12, format&0xfff)
+			continue
+		}
+		if err != nil {
+			p.logger.Printf("Error decoding sample %d: %v", i, err)
+			return flows, errors.Wrapf(err, "error decoding sample")
+		}
+		flows = append(flows, records...)
+	}
+
+	// sFlow datagrams carry no export time.
+	now := time.Now().UTC()
+	metadata := header.ExporterMetadata(source, now)
+	for idx := range flows {
+		flows[idx].Exporter = metadata
+		flows[idx].Timestamp = now
+	}
+	return flows, nil
+}
+
+func readDatagramHeader(r *xdrReader) (header DatagramHeader, err error) {
+	if len(r.buf) < datagramHeaderMinLength {
+		return header, errShortRead
+	}
+	header.Version = r.uint32()
+	if header.Version != Version {
+		return header, fmt.Errorf("unsupported sFlow version %d", header.Version)
+	}
+	switch addrType := r.uint32(); addrType {
+	case agentAddressTypeIPv4:
+		header.AgentAddress = net.IP(r.fixed(net.IPv4len))
+	case agentAddressTypeIPv6:
+		header.AgentAddress = net.IP(r.fixed(net.IPv6len))
+	default:
+		return header, fmt.Errorf("unsupported agent address type %d", addrType)
+	}
+	header.SubAgentID = r.uint32()
+	header.SequenceNumber = r.uint32()
+	header.Uptime = r.uint32()
+	header.NumSamples = r.uint32()
+	return header, r.err
+}
+
+// ExporterMetadata returns the exporter metadata for records in the datagram.
+func (h DatagramHeader) ExporterMetadata(source net.Addr, timestamp time.Time) record.Map {
+	return record.Map{
+		"version":      uint64(h.Version),
+		"timestamp":    timestamp,
+		"uptimeMillis": uint64(h.Uptime),
+		"address":      source.String(),
+		"agentAddress": h.AgentAddress.String(),
+		"subAgentId":   uint64(h.SubAgentID),
+	}
+}
+
+func decodeFlowSample(r *xdrReader, expanded bool) ([]record.Record, error) {
+	fields := record.Map{}
+	r.uint32() // sequence number
+	if expanded {
+		r.uint32() // source ID type
+		r.uint32() // source ID index
+	} else {
+		r.uint32() // source ID
+	}
+	fields["samplingInterval"] = uint64(r.uint32())
+	r.uint32() // sample pool
+	r.uint32() // drops
+
+	var input, output uint32
+	if expanded {
+		input = readExpandedInterface(r)
+		output = readExpandedInterface(r)
+	} else {
+		input, output = r.uint32(), r.uint32()
+		if input>>30 != interfaceFormatIndex {
+			input = interfaceIndexUnknown
+		}
+		if output>>30 != interfaceFormatIndex {
+			output = interfaceIndexUnknown
+		}
+		input &= interfaceIndexUnknown
+		output &= interfaceIndexUnknown
+	}
+	if input != interfaceIndexUnknown {
+		fields["ingressInterface"] = uint64(input)
+	}
+	if output != interfaceIndexUnknown {
+		fields["egressInterface"] = uint64(output)
+	}
+
+	numRecords := r.uint32()
+	if r.err != nil {
+		return nil, r.err
+	}
+	if numRecords > maxRecordsPerSample {
+		return nil, fmt.Errorf("too many records in flow sample: %d", numRecords)
+	}
+	for i := uint32(0); i < numRecords; i++ {
+		format, data := r.uint32(), r.opaque()
+		if r.err != nil {
+			return nil, r.err
+		}
+		switch format {
+		case formatRawPacketHeader:
+			if err := decodeRawPacketHeader(data, fields); err != nil {
+				return nil, err
+			}
+		case formatExtendedSwitch:
+			if len(data) < extendedSwitchRecordLength {
+				return nil, errShortRead
+			}
+			fields["vlanId"] = uint64(binary.BigEndian.Uint32(data[0:4]))
+			fields["postVlanId"] = uint64(binary.BigEndian.Uint32(data[8:12]))
+		}
+	}
+	return []record.Record{{Type: record.Flow, Fields: fields}}, nil
+}
+
+// readExpandedInterface reads an interface from an expanded flow sample.
+// Interfaces not given as an ifIndex are returned as unknown.
+func readExpandedInterface(r *xdrReader) uint32 {
+	format, value := r.uint32(), r.uint32()
+	if format != interfaceFormatIndex {
+		return interfaceIndexUnknown
+	}
+	return value
+}
+
+func decodeRawPacketHeader(data []byte, fields record.Map) error {
+	if len(data) < rawPacketHeaderFixedLength {
+		return errShortRead
+	}
+	headerProtocol := binary.BigEndian.Uint32(data[0:4])
+	frameLength := binary.BigEndian.Uint32(data[4:8])
+	headerLength := binary.BigEndian.Uint32(data[12:16])
+	header := data[rawPacketHeaderFixedLength:]
+	if uint32(len(header)) < headerLength {
+		return errShortRead
+	}
+	header = header[:headerLength]
+
+	fields["octetDeltaCount"] = uint64(frameLength)
+	fields["packetDeltaCount"] = uint64(1)
+	switch headerProtocol {
+	case headerProtocolEthernet:
+		decodeEthernet(header, fields)
+	case headerProtocolIPv4:
+		decodeIPv4(header, fields)
+	case headerProtocolIPv6:
+		decodeIPv6(header, fields)
+	}
+	return nil
+}
+
+func decodeCounterSample(r *xdrReader, expanded bool) ([]record.Record, error) {
+	fields := record.Map{}
+	r.uint32() // sequence number
+	if expanded {
+		r.uint32() // source ID type
+		r.uint32() // source ID index
+	} else {
+		r.uint32() // source ID
+	}
+	numRecords := r.uint32()
+	if r.err != nil {
+		return nil, r.err
+	}
+	if numRecords > maxRecordsPerSample {
+		return nil, fmt.Errorf("too many records in counter sample: %d", numRecords)
+	}
+	for i := uint32(0); i < numRecords; i++ {
+		format, data := r.uint32(), r.opaque()
+		if r.err != nil {
+			return nil, r.err
+		}
+		switch format {
+		case formatGenericIfaceCounters:
+			if len(data) < genericIfaceCountersLength {
+				return nil, errShortRead
+			}
+			decodeCounters(&xdrReader{buf: data}, genericIfaceCounters, fields)
+		case formatEthernetIfaceCounters:
+			if len(data) < ethernetIfaceCountersLength {
+				return nil, errShortRead
+			}
+			decodeCounters(&xdrReader{buf: data}, ethernetIfaceCounters, fields)
+		}
+	}
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	return []record.Record{{Type: record.Counters, Fields: fields}}, nil
+}
+
+type counter struct {
+	name string
+	// size in bytes, either 4 or 8
+	size int
+}
+
+var genericIfaceCounters = []counter{
+	{"ifIndex", 4},
+	{"ifType", 4},
+	{"ifSpeed", 8},
+	{"ifDirection", 4},
+	{"ifStatus", 4},
+	{"ifInOctets", 8},
+	{"ifInUcastPkts", 4},
+	{"ifInMulticastPkts", 4},
+	{"ifInBroadcastPkts", 4},
+	{"ifInDiscards", 4},
+	{"ifInErrors", 4},
+	{"ifInUnknownProtos", 4},
+	{"ifOutOctets", 8},
+	{"ifOutUcastPkts", 4},
+	{"ifOutMulticastPkts", 4},
+	{"ifOutBroadcastPkts", 4},
+	{"ifOutDiscards", 4},
+	{"ifOutErrors", 4},
+	{"ifPromiscuousMode", 4},
+}
+
+var ethernetIfaceCounters = []counter{
+	{"dot3StatsAlignmentErrors", 4},
+	{"dot3StatsFcsErrors", 4},
+	{"dot3StatsSingleCollisionFrames", 4},
+	{"dot3StatsMultipleCollisionFrames", 4},
+	{"dot3StatsSqeTestErrors", 4},
+	{"dot3StatsDeferredTransmissions", 4},
+	{"dot3StatsLateCollisions", 4},
+	{"dot3StatsExcessiveCollisions", 4},
+	{"dot3StatsInternalMacTransmitErrors", 4},
+	{"dot3StatsCarrierSenseErrors", 4},
+	{"dot3StatsFrameTooLongs", 4},
+	{"dot3StatsInternalMacReceiveErrors", 4},
+	{"dot3StatsSymbolErrors", 4},
+}
+
+func decodeCounters(r *xdrReader, counters []counter, fields record.Map) {
+	for _, c := range counters {
+		if c.size == 8 {
+			fields[c.name] = r.uint64()
+		} else {
+			fields[c.name] = uint64(r.uint32())
+		}
+	}
+}
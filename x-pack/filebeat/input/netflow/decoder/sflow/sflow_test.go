@@ -0,0 +1,221 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package sflow
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/x-pack/filebeat/input/netflow/decoder/config"
+	"github.com/elastic/beats/v7/x-pack/filebeat/input/netflow/decoder/protocol"
+	"github.com/elastic/beats/v7/x-pack/filebeat/input/netflow/decoder/record"
+	"github.com/elastic/beats/v7/x-pack/filebeat/input/netflow/decoder/test"
+)
+
+// xdrWriter builds XDR-encoded test datagrams.
+type xdrWriter struct {
+	bytes.Buffer
+}
+
+func (w *xdrWriter) uint32(values ...uint32) *xdrWriter {
+	for _, v := range values {
+		binary.Write(w, binary.BigEndian, v)
+	}
+	return w
+}
+
+func (w *xdrWriter) uint64(v uint64) *xdrWriter {
+	binary.Write(w, binary.BigEndian, v)
+	return w
+}
+
+func (w *xdrWriter) opaque(data []byte) *xdrWriter {
+	w.uint32(uint32(len(data)))
+	w.Write(data)
+	w.Write(make([]byte, (4-len(data)%4)%4))
+	return w
+}
+
+func mustDecodeHex(t testing.TB, s string) []byte {
+	data, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return data
+}
+
+// Ethernet + 802.1Q + IPv4 + TCP SYN from 10.0.0.1:49152 to 192.168.1.2:443.
+const sampledFrame = "00112233445566778899aabb" + "8100" + "0064" + "0800" +
+	"4510003c1c4640004006" + "0000" + "0a000001" + "c0a80102" +
+	"c00001bb00000001000000005002ffff00000000"
+
+func flowSample(t testing.TB) []byte {
+	frame := mustDecodeHex(t, sampledFrame)
+
+	var header xdrWriter
+	header.uint32(headerProtocolEthernet, 1514, 4)
+	header.opaque(frame)
+
+	var sw xdrWriter
+	sw.uint32(100, 0, 200, 0)
+
+	var sample xdrWriter
+	sample.uint32(7, 3, 512, 1000, 0, 3, interfaceIndexUnknown, 2)
+	sample.uint32(formatRawPacketHeader).opaque(header.Bytes())
+	sample.uint32(formatExtendedSwitch).opaque(sw.Bytes())
+	return sample.Bytes()
+}
+
+func counterSample() []byte {
+	var generic xdrWriter
+	generic.uint32(3, 6).uint64(1000000000).uint32(1, 3)
+	generic.uint64(123456).uint32(100, 10, 5, 1, 2, 0)
+	generic.uint64(654321).uint32(200, 20, 6, 3, 4, 1)
+
+	var ethernet xdrWriter
+	for i := uint32(1); i <= 13; i++ {
+		ethernet.uint32(i)
+	}
+
+	var sample xdrWriter
+	sample.uint32(9, 3, 2)
+	sample.uint32(formatGenericIfaceCounters).opaque(generic.Bytes())
+	sample.uint32(formatEthernetIfaceCounters).opaque(ethernet.Bytes())
+	return sample.Bytes()
+}
+
+func datagram(samples ...[]byte) *bytes.Buffer {
+	var w xdrWriter
+	w.uint32(Version, agentAddressTypeIPv4)
+	w.Write(net.ParseIP("10.1.1.1").To4())
+	w.uint32(1, 42, 123456, uint32(len(samples)))
+	for i, s := range samples {
+		format := uint32(formatFlowSample)
+		if i%2 == 1 {
+			format = formatCounterSample
+		}
+		w.uint32(format).opaque(s)
+	}
+	return bytes.NewBuffer(w.Bytes())
+}
+
+func assertContains(t testing.TB, expected record.Map, actual record.Map) {
+	for key, value := range expected {
+		assert.Equal(t, value, actual[key], key)
+	}
+}
+
+func TestSFlowProtocol_New(t *testing.T) {
+	proto := New(config.Defaults())
+
+	assert.Nil(t, proto.Start())
+	assert.Equal(t, ProtocolID, proto.Version())
+	assert.Nil(t, proto.Stop())
+}
+
+func newTestProtocol(t *testing.T) protocol.Protocol {
+	cfg := config.Defaults()
+	return New(*cfg.WithLogOutput(test.TestLogWriter{TB: t}))
+}
+
+func TestSFlowProtocol_OnPacket(t *testing.T) {
+	proto := newTestProtocol(t)
+	buf := datagram(flowSample(t), counterSample())
+	assert.True(t, IsDatagram(buf.Bytes()))
+
+	records, err := proto.OnPacket(buf, test.MakeAddress(t, "10.1.1.1:6343"))
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+
+	flow := records[0]
+	assert.Equal(t, record.Flow, flow.Type)
+	test.AssertMapEqual(t, record.Map{
+		"samplingInterval":         uint64(512),
+		"ingressInterface":         uint64(3),
+		"octetDeltaCount":          uint64(1514),
+		"packetDeltaCount":         uint64(1),
+		"sourceMacAddress":         net.HardwareAddr{0x66, 0x77, 0x88, 0x99, 0xaa, 0xbb},
+		"destinationMacAddress":    net.HardwareAddr{0x00, 0x11, 0x22, 0x33, 0x44, 0x55},
+		"vlanId":                   uint64(100),
+		"postVlanId":               uint64(200),
+		"ethernetType":             uint64(0x0800),
+		"ipVersion":                uint64(4),
+		"ipClassOfService":         uint64(0x10),
+		"ipTotalLength":            uint64(60),
+		"ipTTL":                    uint64(64),
+		"protocolIdentifier":       uint64(6),
+		"sourceIPv4Address":        net.ParseIP("10.0.0.1").To4(),
+		"destinationIPv4Address":   net.ParseIP("192.168.1.2").To4(),
+		"sourceTransportPort":      uint64(49152),
+		"destinationTransportPort": uint64(443),
+		"tcpControlBits":           uint64(2),
+	}, flow.Fields)
+	assert.NotContains(t, flow.Fields, "egressInterface")
+
+	counters := records[1]
+	assert.Equal(t, record.Counters, counters.Type)
+	assert.Len(t, counters.Fields, len(genericIfaceCounters)+len(ethernetIfaceCounters))
+	assertContains(t, record.Map{
+		"ifIndex":                           uint64(3),
+		"ifType":                            uint64(6),
+		"ifSpeed":                           uint64(1000000000),
+		"ifInOctets":                        uint64(123456),
+		"ifInUcastPkts":                     uint64(100),
+		"ifInErrors":                        uint64(2),
+		"ifOutOctets":                       uint64(654321),
+		"ifOutErrors":                       uint64(4),
+		"ifPromiscuousMode":                 uint64(1),
+		"dot3StatsAlignmentErrors":          uint64(1),
+		"dot3StatsSymbolErrors":             uint64(13),
+		"dot3StatsInternalMacReceiveErrors": uint64(12),
+	}, counters.Fields)
+
+	for _, r := range records {
+		assert.Contains(t, r.Exporter, "timestamp")
+		assertContains(t, record.Map{
+			"version":      uint64(5),
+			"uptimeMillis": uint64(123456),
+			"address":      "10.1.1.1:6343",
+			"agentAddress": "10.1.1.1",
+			"subAgentId":   uint64(1),
+		}, r.Exporter)
+	}
+}
+
+func TestDecodeIPv6(t *testing.T) {
+	// IPv6 with a hop-by-hop header followed by UDP 53 -> 5353.
+	packet := mustDecodeHex(t, "6a812345002000ff"+
+		"20010db8000000000000000000000001"+
+		"20010db8000000000000000000000002"+
+		"1100000000000000"+
+		"003514e900080000")
+	fields := record.Map{}
+	decodeIPv6(packet, fields)
+	test.AssertMapEqual(t, record.Map{
+		"ipVersion":                uint64(6),
+		"ipClassOfService":         uint64(0xa8),
+		"flowLabelIPv6":            uint64(0x12345),
+		"ipTotalLength":            uint64(72),
+		"ipTTL":                    uint64(255),
+		"protocolIdentifier":       uint64(17),
+		"sourceIPv6Address":        net.ParseIP("2001:db8::1"),
+		"destinationIPv6Address":   net.ParseIP("2001:db8::2"),
+		"sourceTransportPort":      uint64(53),
+		"destinationTransportPort": uint64(5353),
+	}, fields)
+}
+
+func TestTruncatedDatagram(t *testing.T) {
+	proto := newTestProtocol(t)
+	data := datagram(flowSample(t)).Bytes()
+	for n := 0; n < len(data); n++ {
+		_, err := proto.OnPacket(bytes.NewBuffer(data[:n]), test.MakeAddress(t, "10.1.1.1:6343"))
+		assert.Error(t, err, "length %d", n)
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package template
+
+// Cache persists the templates received from an exporter, so that they can
+// be restored after a restart instead of waiting for the exporter to resend
+// them.
+type Cache interface {
+	// Load returns the template definitions stored for the given session key,
+	// or an empty list if none are stored.
+	Load(key string) ([]Definition, error)
+
+	// Store replaces the template definitions stored for the given session key.
+	Store(key string, definitions []Definition) error
+}
+
+// Definition is the serializable form of a template as it was received
+// from an exporter.
+type Definition struct {
+	ID          uint16            `json:"id"`
+	Fields      []FieldDefinition `json:"fields"`
+	ScopeFields int               `json:"scope_fields,omitempty"`
+	IsOptions   bool              `json:"is_options,omitempty"`
+}
+
+// FieldDefinition is the serializable form of a template field.
+type FieldDefinition struct {
+	EnterpriseID uint32 `json:"pen,omitempty"`
+	FieldID      uint16 `json:"id"`
+	Length       uint16 `json:"length"`
+}
+
+// Definition returns the serializable form of the template.
+func (t *Template) Definition() Definition {
+	def := Definition{
+		ID:          t.ID,
+		Fields:      make([]FieldDefinition, len(t.Fields)),
+		ScopeFields: t.ScopeFields,
+		IsOptions:   t.IsOptions,
+	}
+	for i, field := range t.Fields {
+		def.Fields[i] = FieldDefinition{
+			EnterpriseID: field.Key.EnterpriseID,
+			FieldID:      field.Key.FieldID,
+			Length:       field.Length,
+		}
+	}
+	return def
+}
@@ -37,6 +37,9 @@ type Template struct {
 type FieldTemplate struct {
 	Length uint16
 	Info   *fields.Field
+	// Key identifies the field as defined by the exporter. It is only set
+	// for fields of templates received from the network.
+	Key fields.Key
 }
 
 func PopulateFieldMap(dest record.Map, fields []FieldTemplate, variableLength bool, buffer *bytes.Buffer) error {
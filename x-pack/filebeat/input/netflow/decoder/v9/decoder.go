@@ -102,36 +102,57 @@ func (d DecoderV9) GetFields() fields.FieldDict {
 }
 
 func ReadFields(d Decoder, buf *bytes.Buffer, count int) (record template.Template, err error) {
-	knownFields := d.GetFields()
-	logger := d.GetLogger()
-	record.Fields = make([]template.FieldTemplate, count)
+	record.Fields = make([]template.FieldTemplate, 0, count)
 	for i := 0; i < count; i++ {
 		key, length, err := d.ReadFieldDefinition(buf)
 		if err != nil {
 			return template.Template{}, io.EOF
 		}
-		field := template.FieldTemplate{
-			Length: length,
-		}
-		if length == template.VariableLength {
-			record.VariableLength = true
-			record.Length += 1
-		} else {
-			record.Length += int(field.Length)
-		}
-		if fieldInfo, found := knownFields[key]; found {
-			min, max := fieldInfo.Decoder.MinLength(), fieldInfo.Decoder.MaxLength()
-			if length == template.VariableLength || min <= field.Length && field.Length <= max {
-				field.Info = fieldInfo
-			} else if logger != nil {
-				logger.Printf("Size of field %s in template is out of bounds (size=%d, min=%d, max=%d)", fieldInfo.Name, field.Length, min, max)
-			}
+		appendField(d, &record, key, length)
+	}
+	return record, nil
+}
+
+// TemplateFromDefinition rebuilds a template from its serialized definition,
+// resolving the fields known by the decoder.
+func TemplateFromDefinition(d Decoder, def template.Definition) *template.Template {
+	record := template.Template{
+		ID:          def.ID,
+		Fields:      make([]template.FieldTemplate, 0, len(def.Fields)),
+		ScopeFields: def.ScopeFields,
+		IsOptions:   def.IsOptions,
+	}
+	for _, field := range def.Fields {
+		key := fields.Key{EnterpriseID: field.EnterpriseID, FieldID: field.FieldID}
+		appendField(d, &record, key, field.Length)
+	}
+	return &record
+}
+
+func appendField(d Decoder, record *template.Template, key fields.Key, length uint16) {
+	knownFields := d.GetFields()
+	logger := d.GetLogger()
+	field := template.FieldTemplate{
+		Length: length,
+		Key:    key,
+	}
+	if length == template.VariableLength {
+		record.VariableLength = true
+		record.Length += 1
+	} else {
+		record.Length += int(field.Length)
+	}
+	if fieldInfo, found := knownFields[key]; found {
+		min, max := fieldInfo.Decoder.MinLength(), fieldInfo.Decoder.MaxLength()
+		if length == template.VariableLength || min <= field.Length && field.Length <= max {
+			field.Info = fieldInfo
 		} else if logger != nil {
-			logger.Printf("Field %v in template not found", key)
+			logger.Printf("Size of field %s in template is out of bounds (size=%d, min=%d, max=%d)", fieldInfo.Name, field.Length, min, max)
 		}
-		record.Fields[i] = field
+	} else if logger != nil {
+		logger.Printf("Field %v in template not found", key)
 	}
-	return record, nil
+	record.Fields = append(record.Fields, field)
 }
 
 func ReadTemplateFlowSet(d Decoder, buf *bytes.Buffer) (templates []*template.Template, err error) {
@@ -42,6 +42,7 @@ type SessionState struct {
 	lastSequence uint32
 	logger       *log.Logger
 	Delete       atomic.Bool
+	restoreOnce  sync.Once
 }
 
 // NewSession creates a new session.
@@ -72,6 +73,18 @@ func (s *SessionState) GetTemplate(id uint16) (template *template.Template) {
 	return template
 }
 
+// Definitions returns the serializable definitions of all the templates in
+// the session.
+func (s *SessionState) Definitions() []template.Definition {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	defs := make([]template.Definition, 0, len(s.Templates))
+	for _, wrapper := range s.Templates {
+		defs = append(defs, wrapper.Template.Definition())
+	}
+	return defs
+}
+
 // ExpireTemplates will remove those templates that have not been used
 // since the last call to ExpireTemplates.
 func (s *SessionState) ExpireTemplates() (alive int, removed int) {
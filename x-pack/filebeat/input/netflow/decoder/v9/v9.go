@@ -6,6 +6,7 @@ package v9
 
 import (
 	"bytes"
+	"fmt"
 	"log"
 	"net"
 	"time"
@@ -15,6 +16,7 @@ import (
 	"github.com/elastic/beats/v7/x-pack/filebeat/input/netflow/decoder/config"
 	"github.com/elastic/beats/v7/x-pack/filebeat/input/netflow/decoder/protocol"
 	"github.com/elastic/beats/v7/x-pack/filebeat/input/netflow/decoder/record"
+	"github.com/elastic/beats/v7/x-pack/filebeat/input/netflow/decoder/template"
 )
 
 const (
@@ -31,6 +33,7 @@ type NetflowV9Protocol struct {
 	timeout     time.Duration
 	done        chan struct{}
 	detectReset bool
+	cache       template.Cache
 }
 
 func init() {
@@ -49,6 +52,7 @@ func NewProtocolWithDecoder(decoder Decoder, config config.Config, logger *log.L
 		logger:      logger,
 		timeout:     config.ExpirationTimeout(),
 		detectReset: config.SequenceResetEnabled(),
+		cache:       config.TemplateCache(),
 	}
 }
 
@@ -79,15 +83,25 @@ func (p *NetflowV9Protocol) OnPacket(buf *bytes.Buffer, source net.Addr) (flows
 	}
 	buf = payload
 
-	session := p.Session.GetOrCreate(MakeSessionKey(source, header.SourceID))
+	sessionKey := MakeSessionKey(source, header.SourceID)
+	session := p.Session.GetOrCreate(sessionKey)
 	remote := source.String()
 
+	// Session state changed in a way that has to be persisted.
+	var updated bool
+
 	p.logger.Printf("Packet from:%s src:%d seq:%d", remote, header.SourceID, header.SequenceNo)
 	if p.detectReset {
 		if prev, reset := session.CheckReset(header.SequenceNo); reset {
 			p.logger.Printf("Session %s reset (sequence=%d last=%d)", remote, header.SequenceNo, prev)
+			updated = true
 		}
 	}
+	if p.cache != nil {
+		session.restoreOnce.Do(func() {
+			p.restoreTemplates(session, cacheKey(header.Version, sessionKey))
+		})
+	}
 
 	for ; numFlowSets > 0; numFlowSets-- {
 		set, err := p.decoder.ReadSetHeader(buf)
@@ -107,6 +121,14 @@ func (p *NetflowV9Protocol) OnPacket(buf *bytes.Buffer, source net.Addr) (flows
 			return nil, errors.Wrapf(err, "error parsing set")
 		}
 		flows = append(flows, f...)
+		if set.SetID < 256 {
+			updated = true
+		}
+	}
+	if updated && p.cache != nil {
+		if err := p.cache.Store(cacheKey(header.Version, sessionKey), session.Definitions()); err != nil {
+			p.logger.Printf("Failed to persist templates of session %s: %v", remote, err)
+		}
 	}
 	metadata := header.ExporterMetadata(source)
 	for idx := range flows {
@@ -140,3 +162,26 @@ func (p *NetflowV9Protocol) parseSet(
 	}
 	return flows, nil
 }
+
+// restoreTemplates adds the templates persisted for a session to the
+// session.
+func (p *NetflowV9Protocol) restoreTemplates(session *SessionState, key string) {
+	defs, err := p.cache.Load(key)
+	if err != nil {
+		p.logger.Printf("Failed to restore templates of session %s: %v", key, err)
+		return
+	}
+	for _, def := range defs {
+		session.AddTemplate(TemplateFromDefinition(p.decoder, def))
+	}
+	if len(defs) > 0 {
+		p.logger.Printf("Restored %d templates of session %s", len(defs), key)
+	}
+}
+
+// cacheKey returns the key used to persist the templates of a session. The
+// protocol version is part of the key, as NetFlow V9 and IPFIX sessions
+// are managed independently.
+func cacheKey(version uint16, key SessionKey) string {
+	return fmt.Sprintf("%d-%s-%d", version, key.Addr, key.SourceID)
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/elastic/beats/v7/x-pack/filebeat/input/netflow/decoder/config"
 	"github.com/elastic/beats/v7/x-pack/filebeat/input/netflow/decoder/fields"
+	"github.com/elastic/beats/v7/x-pack/filebeat/input/netflow/decoder/template"
 	"github.com/elastic/beats/v7/x-pack/filebeat/input/netflow/decoder/test"
 )
 
@@ -249,3 +250,68 @@ func TestCustomFields(t *testing.T) {
 	assert.Contains(t, flows[0].Fields, "customField")
 	assert.Equal(t, flows[0].Fields["customField"], "Hello :)")
 }
+
+type mapTemplateCache map[string][]template.Definition
+
+func (c mapTemplateCache) Load(key string) ([]template.Definition, error) {
+	return c[key], nil
+}
+
+func (c mapTemplateCache) Store(key string, defs []template.Definition) error {
+	c[key] = defs
+	return nil
+}
+
+func TestTemplateCache(t *testing.T) {
+	addr := test.MakeAddress(t, "127.0.0.1:12345")
+	templatePacket := []uint16{
+		// Header
+		// Version, Count, Uptime, Ts, SeqNo, Source
+		9, 1, 11, 11, 22, 22, 33, 33, 0, 1234,
+		// Set #1 (template)
+		0, 20, /*len of set*/
+		999, 3, /*len*/
+		1, 4, // Fields
+		2, 4,
+		3, 4,
+	}
+	flowsPacket := []uint16{
+		// Header
+		// Version, Count, Uptime, Ts, SeqNo, Source
+		9, 1, 11, 11, 22, 22, 33, 34, 0, 1234,
+		// Set #1 (template)
+		999, 16, /*len of set*/
+		1, 1,
+		2, 2,
+		3, 3,
+	}
+
+	cache := mapTemplateCache{}
+	cfg := config.Defaults()
+	cfg.WithTemplateCache(cache).WithLogOutput(test.TestLogWriter{TB: t})
+
+	proto := New(cfg)
+	flows, err := proto.OnPacket(test.MakePacket(templatePacket), addr)
+	assert.NoError(t, err)
+	assert.Empty(t, flows)
+	if assert.Len(t, cache["9-127.0.0.1:12345-1234"], 1) {
+		def := cache["9-127.0.0.1:12345-1234"][0]
+		assert.Equal(t, uint16(999), def.ID)
+		assert.Equal(t, template.FieldDefinition{FieldID: 2, Length: 4}, def.Fields[1])
+	}
+
+	// A new instance restores the templates of the session from the cache.
+	proto = New(cfg)
+	flows, err = proto.OnPacket(test.MakePacket(flowsPacket), addr)
+	assert.NoError(t, err)
+	if assert.Len(t, flows, 1) {
+		assert.Equal(t, uint64(0x00010001), flows[0].Fields["octetDeltaCount"])
+	}
+
+	// Other sessions are not affected.
+	otherSource := append([]uint16{}, flowsPacket...)
+	otherSource[9] = 4321
+	flows, err = New(cfg).OnPacket(test.MakePacket(otherSource), addr)
+	assert.NoError(t, err)
+	assert.Empty(t, flows)
+}
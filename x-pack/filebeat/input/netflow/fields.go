@@ -19,5 +19,5 @@ func init() {
 // AssetNetflow returns asset data.
 // This is the base64 encoded gzipped contents of input/netflow.
 func AssetNetflow() string {
-	return "eJysXU2T5CbSvs+vUPjyXl5P+Gv2Yw572nWsD7vrgw97IxDKknBLQAOq6vKv3wAkFVWCahL1HBzhnn4eEkiS/ELzbfMC16+NAHsa5eVT01huR/jafPNvsD+P8vLNp6bpwDDNleVSfG3+9qlpmuZnDmNnmpOWU7P8ZkNF1/zy68+//LdxVObzp6Y5+V/76iHfNoJOEA/l/tirgq9Nr+Wslp8kRnt3xM/Lr8XjxWO6UbYfroO+wPUidRf9PDO0+/PbAB7WyNM2vAYmdbegWuia9trYgZsGziDs5087MeBNSW1B70SJ5/+OIP8CSztqaaNhpBa6xsrGDrBxNx2cOYPGDtQ2PQjQ4becXEHgzxHf44LF0tKu02DM3d/l1+4dsd2ffywi/p9xSnCR+mUdo+Gi+eXXr+6vm5PUE41XL5bJyFkzIPxx5CDVKEWPE+k/rQF9pu6vm05O1Mnxd7ekl4GzIV61pgVHbzKCWT6BsXRSScE6agEn2G98Aq/fDuqULuxvZvRZufHJxMeRpzcMvzT/lBePutcupSVzGzZQ07QAotGzEFz0/++2MIwPTIout05n0IZLkZSRCwv93ekoEHM9jAtxMxvoEkdPMguWdDBaSpichd2dQb9CO5yi7KUKGBDO0uHG09JKJkfCOxCWn3jCWphBaruHckXYSI0h8kScVnO2t3kZqGWKMCmsliNpuTU73Lo1O+RyIK2mwjgVIe4/aDhX55/I3t4sYPUcpzSc+BsZQfR2KF4s0bvBiJNMn2hiqXLbaiwX3mBUTzrmQM98B66aPtTNnisi4M2SQSq85G2vyLJv1BAxT21Ct9PjOmg88Rp8veBKGksmRo0l1eYg4qg1Rd6WgOiIuRoyK+JMPgZqLNW2AuxFr7af8siqTVzwaZ4IV8RKS8eclmfQ9O0A+mZi/nTkgOLA8aBVB3s3ehWL15eRtjB6klLrwCZF3C8QJrtgnMuNIu8XcKmMhk5q5KIPRuxMx9J9XXF07KXmdphQq0KZ5Wfw50fOCKPvwbwbK6Agei4AtTgL5M5Pfg4IDl63HPRwXjBHdSOYwBjawxEKv1bB7a6g8fZdy9mCJoZVuhNH7uIi7KRGQ6xUyzHDbO4DFO88uRMAGqEdK2KSHe58giaaik5O2FMaPNmEhM8vCmv3A+RWcbkdEJCTpv0Ewm7OOfN7j7kKDzjpi5JOlGV3ew3L02PH6lrFch6pSO1J1nL5YdEorsg+THzfMndcA0vuRz5gip1C3E396FLi0B4AbxaEmyYZgHag99CMst+ff2MpeyEGN3fPkcD/cJTgx6MEe58BSfDlKMHe30ES/PkowV+OEvz1KMH339V4nPW26Yhx26JY4v6/BhflmMrh6wWHG7TWv1piKDzwNNLeEOrixvy9n4Gu9508nQxgnF2pL1R3zss2ltp5v5nP1PGsRPDfSMedbvUzN0N5HuzBPFYFQEYzYjU9nTgjXHSw9+ky6SFjq3BUqXHxJuqUMSYo9+tiFE6NV2em46fgyISYT0meUMzcRs2j5T4louEmx4kyK0sTO8GXwonuMZvzRtCh0kivoH9YzuNiLtEnJEli+B/7eeAoOmpp6UQ2F4Z2v1PmznlNXk1pONczrIUFfBQTI5EZGi2V2kLditT+gq+vDdwJgDfpDwLU3AlLKlEDNeVOI5PTJAVRWirQlgMiQJO3QlswEuXQfVpp76BlD8k+VsbpyuhsA6O6K5fXVwTKI1ewoN1FuVTWypHhAFRBLUxqpDZp9bJLeXFxHBuoEG4hi82lhxmTAGQt9JIrq1CtUMNFeDhRYjzULXdAX7vNn6AKWBgvLpZiB63FriMzLatHrsOGkQUVtQNXQsO4wUQ/lf0dQ1nNYK7GwkS44JZERXr8TLpZL0HWM4In04gI0LMIZ2xNzVbkZHsh9ZEbayWovTKFdCdc2NoJbPjqGWwM1bd+urRTdI3t60pFsKj0VxGxRqW/ShflBa4ujHKuenFIsK/WVooeV2srFHbzWpJ1sFxK0qHy1bNnqHWs4uzV3VjFqLnbqvi4rgcHjFUYh7asclgHPDgsvM4gGOACG4ek7EXIywhdDz6Ngia4cNG5SwwVFzrgrHtvrKTPceGwIRmN7aepxMWFeWTFWNHrKGkXgRFxAVeYwk9I9If5YQ6Ljz7u5Sw1H8dSK9zH5Qw6p7iloC3d51OGpag4ijbz5AtbrzPVUOxbRBa2iuCxlIqoAT5mv3H9JY/oDhQOfBMa3hRmb2tUyhngtTifAeaPiyFbtq5czvNPhA/Fm+F/X/q8Z+nOO3OFQyja+bS0V7piHWdyHIFZWZGmuoPicg8hwEc36S2wrZMUWUtd4FFz40KEX6zaBskttVdL4K/tq6hw2xzyxGuR2tS4ig6pzFCJdIanDjnrvqq9pqabznvUgm6FvromTEdwqHt2YVGbHLUadiP6iI5gNy+pee95RL9OiWigY3F7miPxL0+KDa7glrvIKmcN01upwSgpnCuEgp24hgsdR6yMoVP5rE/liVE85KzJqaIAVn+Jx949oyPCvW95XbcL2AG0AFvnp2/od1yPvE1fCZ4aj3zPvLTfv+JbiQJMaS41t9fSyQYUm42VE+jaUTc8dvgJrJYEziw1aFYhbyiL6CVUBuZOkgvXyWJAxphHoORgebMZIdcHJw8PyZ7ePYs1UMPVcEZHvG90EB/66Op0McLWq9btGOHWfSnX44PCxZvzt1tl+nrxKA2zihirgU6oKU/0jawUqHEdsLJOs8ZJU/eFsAHYi5mL7+EVa5hE9PRyUTlJLkh1FUsq+joDMhgyYHzTIm5+8V5UFJEe8GgdvMPja0kPq1wzgUcG/BTuGfCTuAVmDPTSvlz+omCNySqwHbV06ed3Pu3IacvH1IXYSjkCFc9rvKF9AHPJCbi420asPTLoho0IbObpVknDFdEiFh8oUJZr1yqOoXAJhVwMhWNxBErKZA9ApvS1InAuNhVSXCf+x9IXlkxKZm+Ne7AFNgj+OiOuTC7CS3TfIzaGBH66pS9/6/6gwshylP1e27MTt7PvsaiBgmD6qix0Vei2V+RMR95xe/Wdm8VHjCt3GIhRvFAneg3kBfay5TUIc+JvoW2oAeJjWxwu0YOC03UDq5e1lZuKj1fASkRjb1K3ES7ZNmL+DV0eu76/W6qztc/31sZHVe69b1jvydYO7MFVwybreO9NU6p5xDwzuiG1bHMXbSfndky4CP6mHrl4ISdN3TRRlcdbEfC+KxVhRNb0Qh3BTvyPeRSDwh+bQXSWl0yJerGGrP03eIsQs4QfF7PQ1shxtkBA60T7RU6H/Idp+BkLi01oOGK4UOYRXuFhJzjQfv6OA++nd7wHY8lAzeBu44TTkt4vD4jOUKYnvQyMsFUeKmerZks0FS5s5qXWKoGlpY8mPHbV6bqRH9G4sZeNCuKXBzNhqb2H4jRUozZ5O9o4/2JWysVunIx84ntZc4dylJcaGJPixH2aiYxwhv0VmwOmHBNv1HHOX4Kk5lFNigi37CmGoGot9MWqmmcBccTlMzBRYTkrTv2kSGaB+mCQ0vxM3Z3ivC+luUH2VZ25tjP1F3QIX7dHfOUt6XkO3O4+8sxzvQy4kcPbH2Kgz/nu6dVbcLUvX+7gFaXjJX0/C36sP3Mlur0gO0zVakm7Y1TwQbODD5NokoJbqeOvpMT5R2yqOcG2pWORXD7THWyYFwhhQG7IlPUrwRkLChnaRGgxT768j/lKmqV1T5sdEN3kcLGq9iH1w2fB0tfuU62twmr7fpttvrHYoB9hx+9eGbXQS309QGHm9iNo/FdAsc/JQ9fI6CsDhigNJtVdkcla3YOXpFlxfPgAZ3JSI5TDw+NuZoNF822fa147q7qlTPcJ7sN0JU1LdaJ9AGFJNaBSOCyhD2T8q33N2zk8NgI7yGKvSJ/Yj1++fEd+59aCrnnqtGNAv3V6YHgWv2eW1df4O9inwrMh4UNbAAobaoIHMrIRwYFX0fcs1W+j72lCqxOaZJ//CibqcBot0CDzaFtGNrxrrUw7h4VRlPG7L/m+v5ZHkt4JBpwIPtcRdtGXRcq18tZLWauRUTdmrTZuV1z9dwqSFLiK60Zx5Kmz3/kJOnfD41+Mr+8vo1J+zRPGh/x80kV8UnWq+9bGirv/xiHCfQ/WeflcG+HCWOocVUv3p+BpR+GOoXj5M/iDjY1HPrq0u7E+4itQB9MT0bPNj2A6mO3ISVMRxi9fN1xoDnwKt55h/ebIwYVN0tQ/Bz9Is73JPsiTWJSql2wJMY7wBCNbneZbbDRe8aMm30OvNR52uVaeibdEtr8Ds6HSQvb/0MA7F8COIeyQC3MSnlC2xfORpDxbvMMmv9JfCn4SESc/VfuI94tfnLHfwXs696WZoR3Y+6CWs5fi16GPDLMwvBfF3nuEx/+bDA5s5vYZMj+q7zZDf//AIRlVdtaw1tGRVR7PIIWFN4v/OloMxqWyooWuBlaV+SK8uQqbqAE/hU6ym0ds6WiSLR+B8MnsI/33QJPhpkPMb7C+8Z7a2fin5AjPOfVIzmSKwVkWP76G1xmcQ5TOBz0XfgUPMvH8uAxqqe4TQcNz8NrKn3tCm0ULaiu8fB+iuTiv6v0fvB1BO4lfZ2kpgTcG0EGXeaj3pEFz0GAGOeKQfqF9Dp/2KdTzHfKmJtcO/p5mUCMFUYOmBnNy6RtZn0CAsJqXf6eAvpGWtxWoBUEUaL9UCKiZ2/DPk5V/Xpq+kfVTEG5I4R/Mu9UyMLVjacvuqoW+8Xvg/XDTjxoG/9mmagJtyUSVchM5KErE9GEirdtaJ1s/yjY69YfmeVaiwMv5XwAAAP//WmxoCA=="
+	return "eJzMXc2SJClyvtdTYHvRpadtfnpaUh9ktrLVmPqg3TnMQTeMDDwimCKABiKrcp9e5sRPRmZGZOFEdZVs5tKV+X3ugAOOu0P+wB7h9IUZiLW2Tw+MRRU1fGF/+TvE37R9+ssDYxJC5ZWLypov7D8eGGPsNwVaBlZ727Hxm0wYyb7+/tvX/2VIFT4+MFanr31JkB+YER0sReF/8eTgC2u87d34lxVpL0r8OH5tKW8pE6XMf5yEPsLpyXq5+PuGaPz/jxYSjNl6Fu+hsl6O3XMAyQ4nFlsVGBzBxI/sHwaYrS9oxsZz7KEPU1dwm0QGZv38p8r2JoIPHx9uGgPPzvoIfkF824svNOd/IAopomAetIggWbQstjBzMwlHVQGLrYisAQN++Ba2bmj21OVr3b7UVkjpIYSLz7ZH4AW18f//GlX8l4Cd9WT94ySDKcO+/v4FP2a19Z2IHx9WdQq29xVwdS150Epb09BU+schgD8K/JhJ2wnU42/YpU+tqtplr7EDIH3YUCyqDkIUnVtVTIoINMX+UB2keYlQNN1hfDek9w7l805prcIrdc1/26eEurQu520FIbBWBHYAMMz3xijTfMAhHORDZY3c6qcj+KCsuZI26KhMhAY8Tc1pSo/ErA8gN2SLBkzkr27WIS0p1ujTR/b199mkbZ16bvg0ib6dk8CC6JyGrd4K/YEn6OtZ/IW2f5u17A8/EHSc9FM1V0bC88MLet3RadAHh97XogI2Lp8f2df5T0nEuvTV7eFVhaOED0wEJqFWZtgqvv71739lqv7j5GBdreAA5PfVK4lIs+6gYmAOPBtm3rpGUnmo4uXMG7QKrfXx1dSSvdPwzDor4Qv7kfXm0dgn84H9xOpe6x+Gjz+wn1krdD3/8xemzAf2idk+rqsfooh9+M66D0I+sv9Ukf3IVEjGL2SnzPhRWpAPKrKf0kfW4SRR1gg9YVeVV4bbKkIM38EitgT2lQiRu8c3Fdr1Oqr3EHzwVsj3ECxVqISXbykSvLf+LQWOc5g7b6N9M8G2j288a1Di208blPo+8wYlv8/EQclvPXNQ5ttOHedtp0LV2z5w3A+/x+51K1ja+AvH3ShwoVVjOjBv2vCF/LoK7yQ5KNNo4JXF84iyhtdedPDmaqR57f4fKBK+AY8Q3ssQJNTgPUgevTChUwG74s21wHDJeSTeXDw848FZHd9Th6SyEZp3opoG472MohLeK/A8gAnwTjqkZYFHazkKet/h8FCBOr5XT4RTd7D6LYUn545L0FHw9K11qTc4J6rHIuAg6hyjzYQlt7eymisJJqparcRvh3PoDVQ5XmkRArc1xzijqiAXGivHK2uit5rjAf9hK1j2sBEiTbMbQ4bcXXoZeXDljp9WQmUj2N3HOQ+1euYaTBPb3BYr06AwPttO5vhICFGZdA4vbvSSg9zyG3BR86Gs9cpxA8+Rt9bRNT80jo/jJgI3fXcAnykXocuGl+DLFXc2RN4NZ7XS5WDBUboUpbUEjOThFHjvOAbhKdAQhY8FYGd3KO3snl7rlFFd33HleLRR6C0r30CL5x3o8xLzmWQtSzslg5dCiyb2jfQilmRqWhxAJ1Uye0xVnePIyysrh0U9f1FUzQjO1THlMpRphiX8KHSmljNO6MZ6FdsuV2LqFVFF9Jtw/tg+5rcvgZXUBVAwjTJA6pwRomQ2YEi5yXGiD/OFMlXHnJ3kHYQgGthDkfpqSIQW0KDdcW/7iO5+RZx2036aBdvYi7OwndOBR+vGaUYZ3CsoeT9LMwA8wTomxJ3A0ibGCyNtR52lgyerZObXp40iRp2rXyeeqZDaiyZFuCbnvBKrSa51HdNWuMNJH40UT29boz1llG+wSfbSXItYjlqYtTHZXLmSWDJKOX6buL8/MLhgvJR0fMmbpe3Uh2YPGndVDs8RDEaHeAtCwtZJ+KX5H6KoHnmgtT2tISv4n/cS/LKX4NNegl/3EnzeS/Cvewn+bS/Bv+8l+OnHXIbdq0paJPYsbvMpluO/S3CLEFM+fNrgaEJL/avxDEUH1lo0gQs8N44KK5kLnfY7W9cBYv4KXlv/JLxE7/xuLcUNMJnj0ZnBf+NSoW01vQoteBLHeXksOgAFnyLWda2qezVHNzgZYhFOOKdHb6LMGJcESuY2c4mimfHkzEhVD47McOZzVpmYK/2ciPZw1qMWVbS5gZ3BTaSpnjCz88bJRyUtTuB/HufjuFySZ8gqSVD/hJ0UWKyb25DZhRHyT1HhPC+JqzkPx3KG8czn6VG5JZLmfElvnQNZHNOa8MVhrUsF6Ev6lQIle8IYSvQggjW5BlPZrrOpNMeBjwoIBzR7Ln0eFol86G1Y6XP+JLnaDMi2ojHygkVX+fqiWeZ/u4MIHjfKsdY5HzlMgCJohM6lBLWS+V35hOe4qhXGgF4DbhhNgoWgZP4KPcbKMhuzNK2hqj4fuwyMp3rWW6vAkvgNYArG02FDIH5Zvk5Bo9BS7CS58rZYchl2kGyEKRVcCB3kDpmHu7rfsQ8UX8wQTiEChr9UTJHf4rGTvR8PWfcI7jRjQUBuxTDHptAsfcNRjbF+z441EZRumcaij2RiaQNmfHELZobiXX89tZO1jY3Haypskfqj67tM/dHRaZwe4YTHKHTVradnWouF32Rr6RRnr2U1D7axWSbUdvbsHmqS9blIVjaql3MWn1b1gMBlrIiGjlWh2FjtFgvfejAV0A42iBQV1rJrkA2ktAGZ4EkZaZ+I50KU3Hu8XjV42uBp2CEYTQyYqELcMjG/bvObujpx0lbIBfhzPlg5SuInnZLH9lEmSzp9XOqZOfo7QysqncsrkGAqIKe3UsgwF7U8RYe+S4mtb73wW3XHGwSDIRQRXJ3xCON6E/0mjdENWoKLbZnS8OxylVauzKRwAZ6S8xvA7ekylpfjlpiv5/ETV232YKTvj1fcM5uEyxUN4YRMYemNyz4bqmENNWBYkh6muoDSYg/DAZ9cpDfC5kpSYi51hC+KG0ciemeVFkjOob1SArSLcDIFnh8ia1WK9KHEVUSkC20hEjOfZcjeNwXIsmq65FEbMSf6yPNoJlg6c6Usbtaj1MLOREt9StmwY6xXTeLB0rShSdyD0NnlaUiSXhTJBWBMQuHJ6u7VxxuYh+CskUCE1crDk9CaquNQqXz0dXZQD+iQo+d1QQKsfBNfeveV0JBvKwdVVu0CsQVvYPZjN6bvS+gXXI9NtWeCu4vHJlza+NM3einRAHNeYaXnKbexA6rqQ7Qd+FKpM54qvoPoLYdjxSlB8zOKUkvoAvTS8iflgWfPliVoVdhmzyyR04WTqzdQ7gmeVgPXnoKqhKb7RjvxadYX2uICW25a52lE6/cxXU8/FI7eXNrdCsPXo0cZquh4iB5ER2pyJ575REGSi8A5gE9TeTondfJXXrVQPYY+ex+esKGyDrJByhQ2Uhm+yHTQwNaJbz1s7eMbqga8WGoNsX3LsbibgMgYywIbvMDTc0lXvVzSgGsGehMuGeiNOB/MKkzfpwqY7AGcz2QFWCxKGev5A/eglTgovbYhHqzVIMz9HG8KhK2vfxvyDTzh9UYz1ciQCzYWYIxQzZm0rf5/kSUdFES1Va61jl85Q9ECCltnKBoLnjOctTpf8RlBc7GFsebUqX+OdWGrQcnNXeMSHKFqjfrWQz6BMsPbgAgHPQTw10v6Nincz26QbLVtTvkNj32qsSiBgqn8yeElmhI01nMdhVZSxRPGNPOnuMI8ScWDU5k20Xjgj3DK/LYRtBl/PtoOOcCQKed8tqXhVmpQaLYeYPKy5nRT9vQasJZQ2Ltq2zLfsGeJ23fotrHT/bsxO1t6fW+EB5fvvc/YVIhRKjiBi8Su5vFewDjrek25ZnRGenvY2mil7Q96xUVIO7VW5nF4OoaYeTwnAafBoYUoFpmNMoIb9Wnwm4QOUfxFuq+sBYu5PIZZ8aksPtXf5NrAOstAns0iDsHqPo6PhGTbUHoqWB2psOUSOkwxknN7Ay/wsFc4yH7+DQfdT5eqwdeTWhFafhS6h8zxSoDFHNqoSc8DE9aqBLV9dH3kXpgGgwSmHCueKdjJpsskX6NpsseBGpqef5hJeg8eCp4BPWmQ56lN8y965/DsprhWnYrZk1LbpxJYZU2Nd2QrzDQfQWcD1xyTtKjTnL8VkpJLNWtEtG5fYxgM9QBNtqlus4CROzgCdMJEVYU9Pdsb0oNBzqsjVp4Del/Oq0CsqzoqH3uRrnwmNeYI7poDu9GMbQ7a6F7z9H25DjTJw/UhHmC6j57ZeyNuWbNDiYNcwAtSx2P4vjdqX33mlAc43yDbTbV4m7SUCl6pdfBqGnXWqGj98pWUZfyR6GKtsc3hWCIXBhPHNSwpRFhArL+3+uXgQgRHPNos0KbvUg1qyEeHKMquNiOQXOTwFF3pReqrZ8HWYy53rbYI6+PLZbabndsH8iXs5b1XDII31p92UOAPJ7wCTfpdFiJ+rBrRKTMQsOgzrFVXrHf7FXhwSfPPh1fwyuL18nz4cLm7isNynepGp7j2punmMl0GuHfTnQPun3ZzXcXeP72icp93c2UnBtYJ00Em3dr36tAno+4gtjbbK/J19cuvv/7I/1QRn2+6e4ZfV+GG4c4JPovh3vl9nWCoD5Cg8o+EV2UBJCzOfL4nIrsgAFnsG16yjC7LXpr0LzrJbfwLiV4hjDbQTOpRI7KInl2nIjCGnZVpMrEDZE/Qe4WBpkKKdSSWIS2Sb5XnWspSi1xUY5Za47zFzelvsje2SvG5jGIlzZR99Et214HEHZ5+Y3y6f7lI5Y9TgtKd1/H5VRdx0yJK39qYcOMAjEtcyCcYVufxuTauTIgCHdUomtx9bYMhu/s38DsLG0fPoeiscLNjnR2HIr5Um7AzPLG4tvkaTBccuy6R7mSaXjccaQrK76fHDssZpjdHdnbsKg29R6blaCfNfCd7J88FfMdVuBU19vAMiyx9kC7g9N5YFvlmn3aUe3GUS/Xp1IHbw59QxSHTwqeF/hq+uQHcMKQdBItZlcneA25JlCzGrr7SnwtWjjQMN/jxZxZKu78RfQOl4OSDRlU9hlKG3gTVGJB0PP03GVA4RofuILelpmoz8vsHiKyEi70fO4uc5UkM1kS89Ex+HW0JpoWyFh1dDCx6O2+BDycTxTMN2lnZa2rqqLMHpYGrLigyqAsqSEL72ojh1fQOY7pKnr/ujQ7i4iCFce+NZPAmS5LvMbwb4lY86L7yE7i1IRZCo/ANUMFTKf/WFdpNNNYr0r38+Rd6iu7/wfMeNGr8rbdRpJ+OAgly46LeuuoIj62H0FpNQ6aOTjF80ayh7o9QWmq2ysHvQ4e377hrvQiUmSue+XQFAkz0KtsrwzsIB3UoQI0IjjUc2FUEaOgPuCQeCM9L41WJ8SmIwB2YdGEeeytAd9CnTJrJClPhd6ua9mwfJQwYQionwIyncA4bslOVBdOrqTQNa5lujbaHxazf1U58evdlL+f/BgCC54E1"
 }
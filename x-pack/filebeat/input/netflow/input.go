@@ -45,15 +45,17 @@ type packet struct {
 }
 
 type netflowInput struct {
-	mutex     sync.Mutex
-	udp       *udp.Server
-	decoder   *decoder.Decoder
-	outlet    channel.Outleter
-	forwarder *harvester.Forwarder
-	logger    *logp.Logger
-	queueC    chan packet
-	queueSize int
-	started   bool
+	mutex          sync.Mutex
+	udp            *udp.Server
+	decoder        *decoder.Decoder
+	cache          *templateCache
+	closeCacheOnce sync.Once
+	outlet         channel.Outleter
+	forwarder      *harvester.Forwarder
+	logger         *logp.Logger
+	queueC         chan packet
+	queueSize      int
+	started        bool
 }
 
 func init() {
@@ -111,13 +113,27 @@ func NewInput(
 		}
 		customFields = append(customFields, f)
 	}
-	decoder, err := decoder.NewDecoder(decoder.NewConfig().
+	decoderConfig := decoder.NewConfig().
 		WithProtocols(config.Protocols...).
 		WithExpiration(config.ExpirationTimeout).
 		WithLogOutput(&logDebugWrapper{Logger: logger}).
 		WithCustomFields(customFields...).
-		WithSequenceResetEnabled(config.DetectSequenceReset))
+		WithSequenceResetEnabled(config.DetectSequenceReset)
+
+	var cache *templateCache
+	if config.TemplateCache.Enabled {
+		cache, err = newTemplateCache(config.Host, config.TemplateCache.MaxAge)
+		if err != nil {
+			return nil, err
+		}
+		decoderConfig.WithTemplateCache(cache)
+	}
+
+	decoder, err := decoder.NewDecoder(decoderConfig)
 	if err != nil {
+		if cache != nil {
+			cache.Close()
+		}
 		return nil, errors.Wrapf(err, "error initializing netflow decoder")
 	}
 
@@ -125,6 +141,7 @@ func NewInput(
 		outlet:    out,
 		forwarder: harvester.NewForwarder(out),
 		decoder:   decoder,
+		cache:     cache,
 		logger:    logger,
 		queueSize: config.PacketQueueSize,
 	}
@@ -151,6 +168,7 @@ func (p *netflowInput) Run() {
 		if err := p.decoder.Start(); err != nil {
 			logger.Errorw("Failed to start netflow decoder", "error", err)
 			p.outlet.Close()
+			p.closeCache()
 			return
 		}
 
@@ -161,6 +179,7 @@ func (p *netflowInput) Run() {
 			p.outlet.Close()
 			p.decoder.Stop()
 			close(p.queueC)
+			p.closeCache()
 			return
 		}
 
@@ -185,6 +204,10 @@ func (p *netflowInput) Stop() {
 		logger.Info("Stopping UDP input")
 		p.udp.Stop()
 		p.started = false
+	} else {
+		// Inputs can be stopped without being run, as when checking their
+		// configuration, release the cache so it can be opened again.
+		p.closeCache()
 	}
 }
 
@@ -234,6 +257,7 @@ func (p *netflowInput) packetDispatch(data []byte, metadata inputsource.NetworkM
 }
 
 func (p *netflowInput) recvRoutine() {
+	defer p.closeCache()
 	for packet := range p.queueC {
 		flows, err := p.decoder.Read(bytes.NewBuffer(packet.data), packet.source)
 		if err != nil {
@@ -249,3 +273,15 @@ func (p *netflowInput) recvRoutine() {
 		}
 	}
 }
+
+// closeCache closes the template cache, if any. It can be called multiple times.
+func (p *netflowInput) closeCache() {
+	if p.cache == nil {
+		return
+	}
+	p.closeCacheOnce.Do(func() {
+		if err := p.cache.Close(); err != nil {
+			p.logger.Warnf("Error closing NetFlow template cache: %v", err)
+		}
+	})
+}
@@ -0,0 +1,52 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package netflow
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/filebeat/channel"
+	"github.com/elastic/beats/v7/filebeat/input"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/paths"
+)
+
+type testOutlet struct{}
+
+func (testOutlet) Close() error            { return nil }
+func (testOutlet) Done() <-chan struct{}   { return nil }
+func (testOutlet) OnEvent(beat.Event) bool { return true }
+
+func TestNewInputStopWithoutRun(t *testing.T) {
+	dir, err := ioutil.TempDir("", "netflow")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	data := paths.Paths.Data
+	paths.Paths.Data = dir
+	defer func() { paths.Paths.Data = data }()
+
+	cfg := common.MustNewConfigFrom(common.MapStr{
+		"host":                   "localhost:0",
+		"template_cache.enabled": true,
+	})
+	connector := channel.ConnectorFunc(func(*common.Config, beat.ClientConfig) (channel.Outleter, error) {
+		return testOutlet{}, nil
+	})
+
+	// Inputs created to check the configuration are stopped without being
+	// run, the template cache must be released for the next input.
+	in, err := NewInput(cfg, connector, input.Context{})
+	require.NoError(t, err)
+	in.Stop()
+
+	in, err = NewInput(cfg, connector, input.Context{})
+	require.NoError(t, err)
+	in.Stop()
+}
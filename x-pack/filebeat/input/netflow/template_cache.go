@@ -0,0 +1,97 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package netflow
+
+import (
+	"crypto/sha1"
+	"encoding/base64"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/v7/x-pack/filebeat/input/netflow/decoder/template"
+	"github.com/elastic/beats/v7/x-pack/libbeat/persistentcache"
+)
+
+// templateCache persists NetFlow V9 and IPFIX templates on disk, so they
+// are available right after a restart.
+type templateCache struct {
+	cache  *persistentcache.PersistentCache
+	maxAge time.Duration
+
+	mutex sync.Mutex
+	// last definitions written per session, to avoid rewriting templates
+	// that exporters periodically resend unchanged.
+	stored map[string]storedTemplates
+}
+
+type storedTemplates struct {
+	definitions []template.Definition
+	timestamp   time.Time
+}
+
+var _ template.Cache = (*templateCache)(nil)
+
+func newTemplateCache(host string, maxAge time.Duration) (*templateCache, error) {
+	cache, err := persistentcache.New("netflow-"+sanitizeCacheName(host), persistentcache.Options{
+		Timeout: maxAge,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("creating template cache: %w", err)
+	}
+	return &templateCache{
+		cache:  cache,
+		maxAge: maxAge,
+		stored: make(map[string]storedTemplates),
+	}, nil
+}
+
+// Load returns the templates stored for a session. Sessions without
+// templates in the cache, or with expired templates, return an empty list.
+func (c *templateCache) Load(key string) ([]template.Definition, error) {
+	var defs []template.Definition
+	if err := c.cache.Get(key, &defs); err != nil {
+		return nil, nil
+	}
+	return defs, nil
+}
+
+// Store persists the templates of a session. Unchanged templates are only
+// written again once half of the max age has passed, to keep them from
+// expiring.
+func (c *templateCache) Store(key string, defs []template.Definition) error {
+	sort.Slice(defs, func(i, j int) bool { return defs[i].ID < defs[j].ID })
+
+	now := time.Now()
+	c.mutex.Lock()
+	last, found := c.stored[key]
+	if found && reflect.DeepEqual(last.definitions, defs) && (c.maxAge == 0 || now.Sub(last.timestamp) < c.maxAge/2) {
+		c.mutex.Unlock()
+		return nil
+	}
+	c.mutex.Unlock()
+
+	if err := c.cache.Put(key, defs); err != nil {
+		return err
+	}
+
+	c.mutex.Lock()
+	c.stored[key] = storedTemplates{definitions: defs, timestamp: now}
+	c.mutex.Unlock()
+	return nil
+}
+
+// Close releases the resources associated with the cache.
+func (c *templateCache) Close() error {
+	return c.cache.Close()
+}
+
+// sanitizeCacheName returns a unique string that can be used safely as part of a file name
+func sanitizeCacheName(name string) string {
+	hash := sha1.Sum([]byte(name))
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
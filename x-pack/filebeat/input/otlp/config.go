@@ -0,0 +1,27 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package otlp
+
+import (
+	"github.com/dustin/go-humanize"
+
+	"github.com/elastic/beats/v7/libbeat/common/cfgtype"
+	"github.com/elastic/beats/v7/libbeat/common/transport/tlscommon"
+)
+
+type config struct {
+	TLS            *tlscommon.ServerConfig `config:"ssl"`
+	ListenAddress  string                  `config:"listen_address"`
+	ListenPort     string                  `config:"listen_port"`
+	MaxMessageSize cfgtype.ByteSize        `config:"max_message_size" validate:"nonzero,positive"`
+}
+
+func defaultConfig() config {
+	return config{
+		ListenAddress:  "127.0.0.1",
+		ListenPort:     "4318",
+		MaxMessageSize: 10 * humanize.MiByte,
+	}
+}
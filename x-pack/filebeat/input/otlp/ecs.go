@@ -0,0 +1,171 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package otlp
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/x-pack/libbeat/common/otlp"
+)
+
+// resourceAttributes maps the OpenTelemetry semantic conventions for
+// resources to their ECS fields.
+var resourceAttributes = map[string]string{
+	"service.name":            "service.name",
+	"service.version":         "service.version",
+	"service.instance.id":     "service.node.name",
+	"host.name":               "host.name",
+	"host.id":                 "host.id",
+	"host.type":               "host.type",
+	"host.arch":               "host.architecture",
+	"os.name":                 "host.os.name",
+	"os.version":              "host.os.version",
+	"os.description":          "host.os.full",
+	"process.pid":             "process.pid",
+	"process.parent_pid":      "process.parent.pid",
+	"process.executable.name": "process.name",
+	"process.executable.path": "process.executable",
+	"process.command_line":    "process.command_line",
+	"process.owner":           "user.name",
+	"container.id":            "container.id",
+	"container.name":          "container.name",
+	"container.runtime":       "container.runtime",
+	"container.image.name":    "container.image.name",
+	"container.image.tag":     "container.image.tag",
+	"k8s.namespace.name":      "kubernetes.namespace",
+	"k8s.node.name":           "kubernetes.node.name",
+	"k8s.pod.name":            "kubernetes.pod.name",
+	"k8s.pod.uid":             "kubernetes.pod.uid",
+	"k8s.container.name":      "kubernetes.container.name",
+	"k8s.deployment.name":     "kubernetes.deployment.name",
+	"k8s.replicaset.name":     "kubernetes.replicaset.name",
+	"k8s.statefulset.name":    "kubernetes.statefulset.name",
+	"cloud.provider":          "cloud.provider",
+	"cloud.account.id":        "cloud.account.id",
+	"cloud.region":            "cloud.region",
+	"cloud.availability_zone": "cloud.availability_zone",
+}
+
+// logAttributes maps the OpenTelemetry semantic conventions for log record
+// attributes to their ECS fields.
+var logAttributes = map[string]string{
+	"exception.type":       "error.type",
+	"exception.message":    "error.message",
+	"exception.stacktrace": "error.stack_trace",
+	"code.function":        "log.origin.function",
+	"code.filepath":        "log.origin.file.name",
+	"code.lineno":          "log.origin.file.line",
+	"log.file.path":        "log.file.path",
+}
+
+// severityNames are the short names of the ranges of severity numbers.
+var severityNames = []string{"TRACE", "DEBUG", "INFO", "WARN", "ERROR", "FATAL"}
+
+// newEvent creates the event of a log record. now is used as timestamp when
+// the record has none.
+func newEvent(res *otlp.Resource, scope *otlp.Scope, rec *otlp.LogRecord, now time.Time) beat.Event {
+	fields := common.MapStr{}
+	mapAttributes(fields, res.Attributes, resourceAttributes, "otlp.resource.attributes")
+
+	if scope.Name != "" {
+		fields.Put("otlp.scope.name", scope.Name)
+	}
+	if scope.Version != "" {
+		fields.Put("otlp.scope.version", scope.Version)
+	}
+	if len(scope.Attributes) > 0 {
+		fields.Put("otlp.scope.attributes", scope.Attributes.Clone())
+	}
+
+	mapAttributes(fields, rec.Attributes, logAttributes, "otlp.log.attributes")
+
+	if msg, ok := bodyMessage(rec.Body); ok {
+		fields["message"] = msg
+	}
+
+	if level := severityLevel(rec); level != "" {
+		fields.Put("log.level", level)
+	}
+	if rec.SeverityNumber > 0 {
+		fields.Put("event.severity", rec.SeverityNumber)
+	}
+	if len(rec.TraceID) > 0 {
+		fields.Put("trace.id", hex.EncodeToString(rec.TraceID))
+	}
+	if len(rec.SpanID) > 0 {
+		fields.Put("span.id", hex.EncodeToString(rec.SpanID))
+	}
+	if rec.Flags != 0 {
+		fields.Put("otlp.log.flags", rec.Flags)
+	}
+
+	timestamp := now
+	switch {
+	case rec.TimeUnixNano != 0:
+		timestamp = time.Unix(0, int64(rec.TimeUnixNano))
+	case rec.ObservedTimeUnixNano != 0:
+		timestamp = time.Unix(0, int64(rec.ObservedTimeUnixNano))
+	}
+	if rec.ObservedTimeUnixNano != 0 {
+		fields.Put("event.created", time.Unix(0, int64(rec.ObservedTimeUnixNano)).UTC())
+	}
+
+	return beat.Event{
+		Timestamp: timestamp.UTC(),
+		Fields:    fields,
+	}
+}
+
+// mapAttributes puts the attributes with an ECS equivalent in their ECS
+// fields, and keeps the rest under otherField.
+func mapAttributes(fields common.MapStr, attrs common.MapStr, ecs map[string]string, otherField string) {
+	other := common.MapStr{}
+	for k, v := range attrs {
+		if field, found := ecs[k]; found {
+			fields.Put(field, v)
+		} else {
+			other[k] = v
+		}
+	}
+	if len(other) > 0 {
+		fields.Put(otherField, other)
+	}
+}
+
+// bodyMessage converts the body of a log record to a message. Structured
+// bodies are encoded in JSON.
+func bodyMessage(body interface{}) (string, bool) {
+	switch v := body.(type) {
+	case nil:
+		return "", false
+	case string:
+		return v, true
+	case common.MapStr, []interface{}, []byte:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v), true
+		}
+		return string(data), true
+	default:
+		return fmt.Sprint(v), true
+	}
+}
+
+// severityLevel returns the severity text of the record, or the short name
+// of its severity number if it has none.
+func severityLevel(rec *otlp.LogRecord) string {
+	if rec.SeverityText != "" {
+		return rec.SeverityText
+	}
+	if rec.SeverityNumber < 1 || int(rec.SeverityNumber) > 4*len(severityNames) {
+		return ""
+	}
+	return severityNames[(rec.SeverityNumber-1)/4]
+}
@@ -0,0 +1,23 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+// Code generated by beats/dev-tools/cmd/asset/asset.go - DO NOT EDIT.
+
+package otlp
+
+import (
+	"github.com/elastic/beats/v7/libbeat/asset"
+)
+
+func init() {
+	if err := asset.SetFields("filebeat", "otlp", asset.ModuleFieldsPri, AssetOtlp); err != nil {
+		panic(err)
+	}
+}
+
+// AssetOtlp returns asset data.
+// This is the base64 encoded gzipped contents of input/otlp.
+func AssetOtlp() string {
+	return "eJy0k7GvmzAQxnf+ik9v6fIeSzeGSlXUjO3Qqh0rB5+JFeOj9pmI/74yFBTKixQpiWCAO993v7M/v+FEQwUW1xWAWHFU4eVbR/4HOWpJwvBSAJpiHWwnln2FTwUA7C05HWECt5AjYVUDx01EoJpsTxqHYVySu8D6LklZAGYUqEaxN3jV0sKRQzJ0VKEJnOaIJqOSk99jYQWjXKQlteF7CCOw5rxkDRQ5hZpKJRLsIQnFZc2Mb5wSIU/6InOFNb+fFyWwGXHmJpCjEnSBdapJjynHzesU1uw/CI6qn7djepQH/Um2V4684Mvu+7Tl5WaSWHNHZf6+qJ8GONFw5nAj/lfV0gxufZSQWvKismumHu9PcQ2opxAt+/uYfk4iD8R65nHfhZeDz4Rz3OTrwkH/b7ut1VZSV2yXeY1TzRbVsW9uo9zn+i3g67//M0XBYRBCzV6U9XFM/Pq4gwRVr++LcaqJZfF3AMX8hLw="
+}
@@ -0,0 +1,206 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package otlp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common/acker"
+	"github.com/elastic/beats/v7/libbeat/common/atomic"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/x-pack/libbeat/common/otlp"
+)
+
+// logsPath is the path of the OTLP/HTTP logs endpoint.
+const logsPath = "/v1/logs"
+
+var (
+	errInputStopped    = errors.New("input is stopping")
+	errRequestCanceled = errors.New("request canceled before events were acknowledged")
+)
+
+// logsHandler receives ExportLogsServiceRequests, and answers them once all
+// their events have been acknowledged.
+type logsHandler struct {
+	log       *logp.Logger
+	publisher beat.Client
+	// done is closed when the input stops.
+	done           <-chan struct{}
+	maxMessageSize int64
+	now            func() time.Time
+}
+
+func (h *logsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "only POST requests are supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	isJSON, err := otlp.IsJSON(r.Header.Get("Content-Type"))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("unsupported content type, use %s or %s", otlp.ContentTypeProtobuf, otlp.ContentTypeJSON), http.StatusUnsupportedMediaType)
+		return
+	}
+
+	body, status, err := otlp.ReadBody(r, h.maxMessageSize)
+	if err != nil {
+		otlp.SendStatus(w, isJSON, status, err)
+		return
+	}
+
+	var req *otlp.LogsRequest
+	if isJSON {
+		req, err = otlp.UnmarshalLogsJSON(body)
+	} else {
+		req, err = otlp.UnmarshalLogsProto(body)
+	}
+	if err != nil {
+		otlp.SendStatus(w, isJSON, http.StatusBadRequest, fmt.Errorf("failed to decode request: %w", err))
+		return
+	}
+
+	events, rejected := h.createEvents(req)
+	if rejected > 0 {
+		h.log.Debugf("Rejected %d log records with invalid trace or span IDs", rejected)
+	}
+
+	b := newBatch(len(events))
+	if err := h.publishAndWait(r.Context(), b, events); err != nil {
+		otlp.SendStatus(w, isJSON, http.StatusServiceUnavailable, err)
+		return
+	}
+
+	var resp otlp.LogsResponse
+	if dropped := b.dropped.Load(); dropped > 0 || rejected > 0 {
+		resp.RejectedLogRecords = rejected + dropped
+		resp.ErrorMessage = fmt.Sprintf("%d log records had invalid trace or span IDs, %d events were dropped by the pipeline", rejected, dropped)
+		h.log.Debugf("Partial success: %s", resp.ErrorMessage)
+	}
+	if isJSON {
+		data, _ := resp.MarshalJSON()
+		otlp.SendResponse(w, otlp.ContentTypeJSON, http.StatusOK, data)
+	} else {
+		otlp.SendResponse(w, otlp.ContentTypeProtobuf, http.StatusOK, resp.MarshalProto())
+	}
+}
+
+// createEvents creates the events for the log records of the request.
+// Records with invalid trace or span IDs are rejected.
+func (h *logsHandler) createEvents(req *otlp.LogsRequest) (events []beat.Event, rejected int64) {
+	now := h.now()
+	events = make([]beat.Event, 0, req.Len())
+	for i := range req.ResourceLogs {
+		rl := &req.ResourceLogs[i]
+		for j := range rl.ScopeLogs {
+			sl := &rl.ScopeLogs[j]
+			for k := range sl.LogRecords {
+				rec := &sl.LogRecords[k]
+				if !validID(rec.TraceID, 16) || !validID(rec.SpanID, 8) {
+					rejected++
+					continue
+				}
+				events = append(events, newEvent(&rl.Resource, &sl.Scope, rec, now))
+			}
+		}
+	}
+	return events, rejected
+}
+
+// validID returns true if the ID is unset, or it has the expected size and
+// it is not all zeros.
+func validID(id []byte, size int) bool {
+	if len(id) == 0 {
+		return true
+	}
+	if len(id) != size {
+		return false
+	}
+	for _, b := range id {
+		if b != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// publishAndWait publishes the events and blocks until all of them have been
+// acknowledged, or dropped.
+func (h *logsHandler) publishAndWait(ctx context.Context, b *batch, events []beat.Event) error {
+	for _, event := range events {
+		event.Private = b
+		h.publisher.Publish(event)
+	}
+
+	select {
+	case <-b.acked:
+		return nil
+	case <-ctx.Done():
+		return errRequestCanceled
+	case <-h.done:
+		return errInputStopped
+	}
+}
+
+// batch tracks the events created from a request. The request is answered
+// once all of them have been acknowledged or dropped.
+type batch struct {
+	pending atomic.Int64
+	dropped atomic.Int64
+	acked   chan struct{}
+}
+
+func newBatch(n int) *batch {
+	b := &batch{acked: make(chan struct{})}
+	b.pending.Store(int64(n))
+	if n == 0 {
+		close(b.acked)
+	}
+	return b
+}
+
+func (b *batch) done() {
+	if b.pending.Dec() == 0 {
+		close(b.acked)
+	}
+}
+
+// drop accounts for an event that is not going to be acknowledged.
+func (b *batch) drop() {
+	b.dropped.Inc()
+	b.done()
+}
+
+func newACKHandler() beat.ACKer {
+	return acker.ConnectionOnly(
+		acker.EventPrivateReporter(func(_ int, privates []interface{}) {
+			for _, private := range privates {
+				if b, ok := private.(*batch); ok {
+					b.done()
+				}
+			}
+		}),
+	)
+}
+
+// clientEvents accounts for the events dropped by the pipeline client, these
+// events are never acknowledged.
+type clientEvents struct{}
+
+func (clientEvents) Closing()               {}
+func (clientEvents) Closed()                {}
+func (clientEvents) Published()             {}
+func (clientEvents) FilteredOut(beat.Event) {}
+
+func (clientEvents) DroppedOnPublish(event beat.Event) {
+	if b, ok := event.Private.(*batch); ok {
+		b.drop()
+	}
+}
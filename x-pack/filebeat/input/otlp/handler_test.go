@@ -0,0 +1,250 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package otlp
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+// ackClient records published events and acknowledges them, or reports
+// them as dropped if drop is set.
+type ackClient struct {
+	mu     sync.Mutex
+	events []beat.Event
+	drop   bool
+}
+
+func (c *ackClient) Publish(event beat.Event) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.drop {
+		clientEvents{}.DroppedOnPublish(event)
+		return
+	}
+	c.events = append(c.events, event)
+	go event.Private.(*batch).done()
+}
+
+func (c *ackClient) PublishAll(events []beat.Event) {
+	for _, e := range events {
+		c.Publish(e)
+	}
+}
+
+func (c *ackClient) Close() error { return nil }
+
+func (c *ackClient) published() []beat.Event {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]beat.Event(nil), c.events...)
+}
+
+var testTime = time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+
+func newTestServer(t *testing.T, settings map[string]interface{}, client beat.Client) *httptest.Server {
+	conf := defaultConfig()
+	require.NoError(t, common.MustNewConfigFrom(settings).Unpack(&conf))
+	in, err := newOTLPInput(conf)
+	require.NoError(t, err)
+
+	done := make(chan struct{})
+	server := httptest.NewServer(in.newMux(logp.NewLogger("otlp_test"), client, done))
+	t.Cleanup(func() {
+		close(done)
+		server.Close()
+	})
+	return server
+}
+
+func post(t *testing.T, url, contentType string, header http.Header, body []byte) (int, []byte) {
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	require.NoError(t, err)
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	req.Header.Set("Content-Type", contentType)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	if resp.StatusCode == http.StatusOK {
+		assert.Equal(t, contentType, resp.Header.Get("Content-Type"))
+	}
+	return resp.StatusCode, data
+}
+
+const logsJSON = `{
+  "resourceLogs": [{
+    "resource": {
+      "attributes": [
+        {"key": "service.name", "value": {"stringValue": "checkout"}},
+        {"key": "k8s.pod.name", "value": {"stringValue": "checkout-5d9f"}},
+        {"key": "telemetry.sdk.language", "value": {"stringValue": "go"}}
+      ]
+    },
+    "scopeLogs": [{
+      "scope": {"name": "checkout/logger", "version": "1.2.0"},
+      "logRecords": [
+        {
+          "timeUnixNano": "1601553600000000000",
+          "observedTimeUnixNano": "1601553601000000000",
+          "severityNumber": 17,
+          "body": {"stringValue": "payment failed"},
+          "attributes": [
+            {"key": "exception.type", "value": {"stringValue": "TimeoutError"}},
+            {"key": "order.id", "value": {"intValue": "42"}}
+          ],
+          "traceId": "5b8efff798038103d269b633813fc60c",
+          "spanId": "eee19b7ec3c1b174",
+          "flags": 1
+        },
+        {
+          "severityText": "Information",
+          "body": {"kvlistValue": {"values": [{"key": "user", "value": {"stringValue": "alice"}}]}}
+        },
+        {
+          "body": {"stringValue": "invalid trace id"},
+          "traceId": "5b8e"
+        }
+      ]
+    }]
+  }]
+}`
+
+func TestLogsJSON(t *testing.T) {
+	client := &ackClient{}
+	server := newTestServer(t, nil, client)
+
+	status, body := post(t, server.URL+logsPath, "application/json", nil, []byte(logsJSON))
+	assert.Equal(t, http.StatusOK, status)
+	assert.JSONEq(t, `{"partialSuccess": {
+	  "rejectedLogRecords": "1",
+	  "errorMessage": "1 log records had invalid trace or span IDs, 0 events were dropped by the pipeline"
+	}}`, string(body))
+
+	events := client.published()
+	require.Len(t, events, 2)
+
+	assert.Equal(t, testTime, events[0].Timestamp)
+	assert.Equal(t, common.MapStr{
+		"message": "payment failed",
+		"service": common.MapStr{"name": "checkout"},
+		"kubernetes": common.MapStr{
+			"pod": common.MapStr{"name": "checkout-5d9f"},
+		},
+		"log":   common.MapStr{"level": "ERROR"},
+		"error": common.MapStr{"type": "TimeoutError"},
+		"event": common.MapStr{
+			"severity": int32(17),
+			"created":  testTime.Add(time.Second),
+		},
+		"trace": common.MapStr{"id": "5b8efff798038103d269b633813fc60c"},
+		"span":  common.MapStr{"id": "eee19b7ec3c1b174"},
+		"otlp": common.MapStr{
+			"resource": common.MapStr{
+				"attributes": common.MapStr{"telemetry.sdk.language": "go"},
+			},
+			"scope": common.MapStr{
+				"name":    "checkout/logger",
+				"version": "1.2.0",
+			},
+			"log": common.MapStr{
+				"attributes": common.MapStr{"order.id": int64(42)},
+				"flags":      uint32(1),
+			},
+		},
+	}, events[0].Fields)
+
+	assert.Equal(t, `{"user":"alice"}`, events[1].Fields["message"])
+	level, _ := events[1].Fields.GetValue("log.level")
+	assert.Equal(t, "Information", level)
+}
+
+func TestLogsProtobuf(t *testing.T) {
+	client := &ackClient{}
+	server := newTestServer(t, nil, client)
+
+	record := protowire.AppendTag(nil, 2, protowire.VarintType)
+	record = protowire.AppendVarint(record, 9)
+	record = protowire.AppendTag(record, 5, protowire.BytesType)
+	record = protowire.AppendBytes(record, protowire.AppendString(protowire.AppendTag(nil, 1, protowire.BytesType), "hello"))
+	scopeLogs := protowire.AppendBytes(protowire.AppendTag(nil, 2, protowire.BytesType), record)
+	resourceLogs := protowire.AppendBytes(protowire.AppendTag(nil, 2, protowire.BytesType), scopeLogs)
+	req := protowire.AppendBytes(protowire.AppendTag(nil, 1, protowire.BytesType), resourceLogs)
+
+	var gz bytes.Buffer
+	w := gzip.NewWriter(&gz)
+	w.Write(req)
+	w.Close()
+
+	status, body := post(t, server.URL+logsPath, "application/x-protobuf", http.Header{"Content-Encoding": {"gzip"}}, gz.Bytes())
+	assert.Equal(t, http.StatusOK, status)
+	assert.Empty(t, body)
+
+	events := client.published()
+	require.Len(t, events, 1)
+	assert.Equal(t, common.MapStr{
+		"message": "hello",
+		"log":     common.MapStr{"level": "INFO"},
+		"event":   common.MapStr{"severity": int32(9)},
+	}, events[0].Fields)
+}
+
+func TestPartialSuccessOnDrop(t *testing.T) {
+	client := &ackClient{drop: true}
+	server := newTestServer(t, nil, client)
+
+	status, body := post(t, server.URL+logsPath, "application/json", nil, []byte(logsJSON))
+	assert.Equal(t, http.StatusOK, status)
+	assert.JSONEq(t, `{"partialSuccess": {
+	  "rejectedLogRecords": "3",
+	  "errorMessage": "1 log records had invalid trace or span IDs, 2 events were dropped by the pipeline"
+	}}`, string(body))
+}
+
+func TestInvalidRequests(t *testing.T) {
+	client := &ackClient{}
+	server := newTestServer(t, map[string]interface{}{"max_message_size": 64}, client)
+
+	tests := map[string]struct {
+		contentType string
+		body        string
+		status      int
+	}{
+		"content type": {contentType: "text/plain", body: "{}", status: http.StatusUnsupportedMediaType},
+		"bad json":     {contentType: "application/json", body: "{", status: http.StatusBadRequest},
+		"bad protobuf": {contentType: "application/x-protobuf", body: "\x0a\x05", status: http.StatusBadRequest},
+		"too large":    {contentType: "application/json", body: `{"resourceLogs": [` + string(bytes.Repeat([]byte("{},"), 30)) + `{}]}`, status: http.StatusRequestEntityTooLarge},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			status, _ := post(t, server.URL+logsPath, test.contentType, nil, []byte(test.body))
+			assert.Equal(t, test.status, status)
+		})
+	}
+
+	resp, err := http.Get(server.URL + logsPath)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+
+	assert.Empty(t, client.published())
+}
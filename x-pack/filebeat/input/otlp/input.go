@@ -0,0 +1,130 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+// Package otlp implements an input receiving OpenTelemetry logs sent with the
+// OTLP/HTTP protocol.
+package otlp
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	v2 "github.com/elastic/beats/v7/filebeat/input/v2"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/transport/tlscommon"
+	"github.com/elastic/beats/v7/libbeat/feature"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/go-concert/ctxtool"
+)
+
+const (
+	inputName = "otlp"
+)
+
+type otlpInput struct {
+	config    config
+	addr      string
+	tlsConfig *tls.Config
+}
+
+func Plugin() v2.Plugin {
+	return v2.Plugin{
+		Name:       inputName,
+		Stability:  feature.Experimental,
+		Deprecated: false,
+		Info:       "OpenTelemetry logs receiver",
+		Doc:        "The otlp input receives logs sent with the OTLP/HTTP protocol",
+		Manager:    v2.ConfigureWith(configure),
+	}
+}
+
+func configure(cfg *common.Config) (v2.Input, error) {
+	conf := defaultConfig()
+	if err := cfg.Unpack(&conf); err != nil {
+		return nil, err
+	}
+
+	return newOTLPInput(conf)
+}
+
+func newOTLPInput(config config) (*otlpInput, error) {
+	addr := net.JoinHostPort(config.ListenAddress, config.ListenPort)
+
+	var tlsConfig *tls.Config
+	tlsConfigBuilder, err := tlscommon.LoadTLSServerConfig(config.TLS)
+	if err != nil {
+		return nil, err
+	}
+	if tlsConfigBuilder != nil {
+		tlsConfig = tlsConfigBuilder.BuildModuleConfig(addr)
+	}
+
+	return &otlpInput{
+		config:    config,
+		addr:      addr,
+		tlsConfig: tlsConfig,
+	}, nil
+}
+
+func (*otlpInput) Name() string { return inputName }
+
+func (in *otlpInput) Test(_ v2.TestContext) error {
+	l, err := net.Listen("tcp", in.addr)
+	if err != nil {
+		return err
+	}
+	return l.Close()
+}
+
+func (in *otlpInput) Run(ctx v2.Context, pipeline beat.PipelineConnector) error {
+	log := ctx.Logger.With("address", in.addr)
+
+	client, err := pipeline.ConnectWith(beat.ClientConfig{
+		CloseRef:   ctx.Cancelation,
+		ACKHandler: newACKHandler(),
+		Events:     clientEvents{},
+	})
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	stdCtx := ctxtool.FromCanceller(ctx.Cancelation)
+	server := &http.Server{Addr: in.addr, TLSConfig: in.tlsConfig, Handler: in.newMux(log, client, stdCtx.Done())}
+	_, cancel := ctxtool.WithFunc(stdCtx, func() {
+		server.Close()
+	})
+	defer cancel()
+
+	if server.TLSConfig != nil {
+		log.Infof("Starting OTLP/HTTPS server on %s", server.Addr)
+		// The certificate is already loaded in the TLS config.
+		err = server.ListenAndServeTLS("", "")
+	} else {
+		log.Infof("Starting OTLP/HTTP server on %s", server.Addr)
+		err = server.ListenAndServe()
+	}
+
+	if err != nil && err != http.ErrServerClosed {
+		return fmt.Errorf("unable to start server: %w", err)
+	}
+	return nil
+}
+
+// newMux creates a handler serving the OTLP/HTTP endpoints.
+func (in *otlpInput) newMux(log *logp.Logger, client beat.Client, done <-chan struct{}) *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.Handle(logsPath, &logsHandler{
+		log:            log.With("url", logsPath),
+		publisher:      client,
+		done:           done,
+		maxMessageSize: int64(in.config.MaxMessageSize),
+		now:            time.Now,
+	})
+	return mux
+}
@@ -261,34 +261,38 @@ func (c *s3Collector) handleSQSMessage(m sqs.Message) ([]s3Info, error) {
 			return nil, fmt.Errorf("url.QueryUnescape failed for '%s': %w", record.S3.object.Key, err)
 		}
 
-		if len(c.config.FileSelectors) == 0 {
-			s3Infos = append(s3Infos, s3Info{
-				region:                   record.AwsRegion,
-				name:                     record.S3.bucket.Name,
-				key:                      filename,
-				arn:                      record.S3.bucket.Arn,
-				expandEventListFromField: c.config.ExpandEventListFromField,
-			})
-			continue
+		if info, ok := c.newS3Info(record.S3.bucket.Name, record.S3.bucket.Arn, record.AwsRegion, filename); ok {
+			s3Infos = append(s3Infos, info)
 		}
+	}
+	return s3Infos, nil
+}
 
-		for _, fs := range c.config.FileSelectors {
-			if fs.Regex == nil {
-				continue
-			}
-			if fs.Regex.MatchString(filename) {
-				s3Infos = append(s3Infos, s3Info{
-					region:                   record.AwsRegion,
-					name:                     record.S3.bucket.Name,
-					key:                      filename,
-					arn:                      record.S3.bucket.Arn,
-					expandEventListFromField: fs.ExpandEventListFromField,
-				})
-				break
-			}
+// newS3Info creates the s3Info for an object, applying the configured file
+// selectors. It returns false if no file selector matches the object key.
+func (c *s3Collector) newS3Info(bucketName, bucketARN, region, key string) (s3Info, bool) {
+	info := s3Info{
+		region: region,
+		name:   bucketName,
+		key:    key,
+		arn:    bucketARN,
+	}
+
+	if len(c.config.FileSelectors) == 0 {
+		info.expandEventListFromField = c.config.ExpandEventListFromField
+		return info, true
+	}
+
+	for _, fs := range c.config.FileSelectors {
+		if fs.Regex == nil {
+			continue
+		}
+		if fs.Regex.MatchString(key) {
+			info.expandEventListFromField = fs.ExpandEventListFromField
+			return info, true
 		}
 	}
-	return s3Infos, nil
+	return info, false
 }
 
 func (c *s3Collector) handleS3Objects(svc s3iface.ClientAPI, s3Infos []s3Info, errC chan error) error {
@@ -545,7 +549,9 @@ func readStringAndTrimDelimiter(reader *bufio.Reader) (string, error) {
 }
 
 func createEvent(log string, offset int, info s3Info, objectHash string, s3Ctx *s3Context) beat.Event {
-	s3Ctx.Inc()
+	if s3Ctx != nil {
+		s3Ctx.Inc()
+	}
 
 	event := beat.Event{
 		Timestamp: time.Now().UTC(),
@@ -570,7 +576,9 @@ func createEvent(log string, offset int, info s3Info, objectHash string, s3Ctx *
 				"region":   info.region,
 			},
 		},
-		Private: s3Ctx,
+	}
+	if s3Ctx != nil {
+		event.Private = s3Ctx
 	}
 	event.SetID(objectHash + "-" + fmt.Sprintf("%012d", offset))
 
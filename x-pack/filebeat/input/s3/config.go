@@ -5,6 +5,7 @@
 package s3
 
 import (
+	"errors"
 	"fmt"
 	"regexp"
 	"time"
@@ -13,7 +14,12 @@ import (
 )
 
 type config struct {
-	QueueURL                 string              `config:"queue_url" validate:"nonzero,required"`
+	QueueURL                 string              `config:"queue_url"`
+	BucketARN                string              `config:"bucket_arn"`
+	BucketListPrefix         string              `config:"bucket_list_prefix"`
+	BucketListInterval       time.Duration       `config:"bucket_list_interval"`
+	BucketListMaxRetries     int                 `config:"bucket_list_max_retries"`
+	NumberOfWorkers          int                 `config:"number_of_workers"`
 	VisibilityTimeout        time.Duration       `config:"visibility_timeout"`
 	FipsEnabled              bool                `config:"fips_enabled"`
 	AwsConfig                awscommon.ConfigAWS `config:",inline"`
@@ -31,13 +37,36 @@ type FileSelectorCfg struct {
 
 func defaultConfig() config {
 	return config{
-		VisibilityTimeout: 300 * time.Second,
-		APITimeout:        120 * time.Second,
-		FipsEnabled:       false,
+		VisibilityTimeout:    300 * time.Second,
+		APITimeout:           120 * time.Second,
+		FipsEnabled:          false,
+		BucketListInterval:   120 * time.Second,
+		BucketListMaxRetries: 3,
+		NumberOfWorkers:      5,
 	}
 }
 
 func (c *config) Validate() error {
+	if c.QueueURL == "" && c.BucketARN == "" {
+		return errors.New("one of queue_url or bucket_arn must be set")
+	}
+	if c.QueueURL != "" && c.BucketARN != "" {
+		return errors.New("queue_url and bucket_arn can not be used together")
+	}
+	if c.BucketARN != "" {
+		if _, err := getBucketNameFromARN(c.BucketARN); err != nil {
+			return err
+		}
+		if c.BucketListInterval <= 0 {
+			return fmt.Errorf("bucket_list_interval %v must be larger than 0s", c.BucketListInterval)
+		}
+		if c.BucketListMaxRetries < 0 {
+			return fmt.Errorf("bucket_list_max_retries %v must not be negative", c.BucketListMaxRetries)
+		}
+		if c.NumberOfWorkers <= 0 {
+			return fmt.Errorf("number_of_workers %v must be larger than 0", c.NumberOfWorkers)
+		}
+	}
 	if c.VisibilityTimeout < 0 || c.VisibilityTimeout.Hours() > 12 {
 		return fmt.Errorf("visibility timeout %v is not within the "+
 			"required range 0s to 12h", c.VisibilityTimeout)
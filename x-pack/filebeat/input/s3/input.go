@@ -11,23 +11,33 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
 
 	v2 "github.com/elastic/beats/v7/filebeat/input/v2"
+	cursor "github.com/elastic/beats/v7/filebeat/input/v2/input-cursor"
 	"github.com/elastic/beats/v7/libbeat/beat"
 	"github.com/elastic/beats/v7/libbeat/common"
 	"github.com/elastic/beats/v7/libbeat/common/acker"
 	"github.com/elastic/beats/v7/libbeat/feature"
+	"github.com/elastic/beats/v7/libbeat/logp"
 	awscommon "github.com/elastic/beats/v7/x-pack/libbeat/common/aws"
 	"github.com/elastic/go-concert/ctxtool"
 )
 
 const inputName = "s3"
 
-func Plugin() v2.Plugin {
+func Plugin(log *logp.Logger, store cursor.StateStore) v2.Plugin {
 	return v2.Plugin{
 		Name:       inputName,
 		Stability:  feature.Beta,
 		Deprecated: false,
 		Info:       "Collect logs from s3",
-		Manager:    v2.ConfigureWith(configure),
+		Manager: inputManager{
+			sqs: v2.ConfigureWith(configure),
+			poller: &cursor.InputManager{
+				Logger:     log,
+				StateStore: store,
+				Type:       inputName,
+				Configure:  pollerConfigure,
+			},
+		},
 	}
 }
 
@@ -0,0 +1,48 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package s3
+
+import (
+	"go.uber.org/multierr"
+
+	"github.com/elastic/go-concert/unison"
+
+	v2 "github.com/elastic/beats/v7/filebeat/input/v2"
+	cursor "github.com/elastic/beats/v7/filebeat/input/v2/input-cursor"
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+// inputManager wraps the input manager used for SQS notifications and the
+// cursor input manager used for bucket polling. It will create one or the
+// other based on the config that is passed.
+type inputManager struct {
+	sqs    v2.InputManager
+	poller *cursor.InputManager
+}
+
+var _ v2.InputManager = inputManager{}
+
+// Init initializes both wrapped input managers.
+func (m inputManager) Init(grp unison.Group, mode v2.Mode) error {
+	return multierr.Append(
+		m.sqs.Init(grp, mode),
+		m.poller.Init(grp, mode),
+	)
+}
+
+// Create creates a bucket polling input if bucket_arn is configured,
+// otherwise it creates an input reading S3 notifications from SQS.
+func (m inputManager) Create(cfg *common.Config) (v2.Input, error) {
+	config := defaultConfig()
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, err
+	}
+
+	if config.BucketARN != "" {
+		return m.poller.Create(cfg)
+	}
+
+	return m.sqs.Create(cfg)
+}
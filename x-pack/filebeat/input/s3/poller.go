@@ -0,0 +1,401 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package s3
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/s3manager"
+
+	"github.com/elastic/go-concert/ctxtool"
+	"github.com/elastic/go-concert/timed"
+
+	v2 "github.com/elastic/beats/v7/filebeat/input/v2"
+	cursor "github.com/elastic/beats/v7/filebeat/input/v2/input-cursor"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	awscommon "github.com/elastic/beats/v7/x-pack/libbeat/common/aws"
+)
+
+// pollerInput collects S3 objects by periodically listing a bucket instead
+// of reading notifications from SQS.
+type pollerInput struct{}
+
+// pollerSource is the bucket and prefix a pollerInput lists objects from.
+type pollerSource struct {
+	config config
+}
+
+// pollerState is the cursor persisted for a bucket. All keys sorting
+// lexicographically before or equal to StartAfter have been processed and
+// acknowledged. Objects holds the ETags of objects that have been processed
+// after that position.
+type pollerState struct {
+	StartAfter string            `struct:"start_after"`
+	Objects    map[string]string `struct:"objects"`
+}
+
+// pollerObject tracks an object listed during the current polling cycle.
+type pollerObject struct {
+	key  string
+	etag string
+	done bool
+}
+
+// s3Poller lists a bucket on an interval and dispatches new or modified
+// objects to a bounded number of workers.
+type s3Poller struct {
+	cancellation context.Context
+	logger       *logp.Logger
+
+	collector  *s3Collector
+	publisher  cursor.Publisher
+	bucketName string
+	bucketARN  string
+	region     string
+
+	// maxRetries is the number of times an object that failed is retried
+	// before it is skipped.
+	maxRetries int
+
+	mu    sync.Mutex
+	state pollerState
+	// objects listed in the current cycle that are not yet covered by
+	// state.StartAfter, in lexicographic order.
+	listed []*pollerObject
+	// failures counts the failed attempts of objects not processed yet.
+	failures map[string]int
+}
+
+// objectPublisher implements beat.Client for the s3Collector. It holds back
+// the most recent event of an object, such that the cursor update marking the
+// object as processed can be published with the object's final event.
+type objectPublisher struct {
+	publisher cursor.Publisher
+	pending   *beat.Event
+}
+
+func pollerConfigure(cfg *common.Config) ([]cursor.Source, cursor.Input, error) {
+	config := defaultConfig()
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, nil, err
+	}
+	return []cursor.Source{&pollerSource{config: config}}, &pollerInput{}, nil
+}
+
+func (src *pollerSource) Name() string {
+	if src.config.BucketListPrefix == "" {
+		return src.config.BucketARN
+	}
+	return src.config.BucketARN + "/" + src.config.BucketListPrefix
+}
+
+func (in *pollerInput) Name() string { return inputName }
+
+func (in *pollerInput) Test(src cursor.Source, _ v2.TestContext) error {
+	_, err := awscommon.GetAWSCredentials(src.(*pollerSource).config.AwsConfig)
+	if err != nil {
+		return fmt.Errorf("getAWSCredentials failed: %w", err)
+	}
+	return nil
+}
+
+// Run lists the bucket every bucket_list_interval until the input is stopped.
+func (in *pollerInput) Run(
+	ctx v2.Context,
+	src cursor.Source,
+	cursor cursor.Cursor,
+	publisher cursor.Publisher,
+) error {
+	config := src.(*pollerSource).config
+	cancellation := ctxtool.FromCanceller(ctx.Cancelation)
+	log := ctx.Logger.With("bucket_arn", config.BucketARN)
+
+	bucketName, err := getBucketNameFromARN(config.BucketARN)
+	if err != nil {
+		return err
+	}
+
+	awsConfig, err := awscommon.GetAWSCredentials(config.AwsConfig)
+	if err != nil {
+		return fmt.Errorf("getAWSCredentials failed: %w", err)
+	}
+
+	s3Servicename := "s3"
+	if config.FipsEnabled {
+		s3Servicename = "s3-fips"
+	}
+
+	regionName, err := getBucketRegion(cancellation, config, awsConfig, s3Servicename, bucketName)
+	if err != nil {
+		err := fmt.Errorf("getBucketRegion failed: %w", err)
+		log.Error(err)
+		return err
+	}
+	log = log.With("region", regionName)
+	awsConfig.Region = regionName
+
+	var state pollerState
+	if err := cursor.Unpack(&state); err != nil {
+		return fmt.Errorf("failed to read bucket polling state: %w", err)
+	}
+	if state.Objects == nil {
+		state.Objects = map[string]string{}
+	}
+
+	log.Infof("bucket list interval is set to %v", config.BucketListInterval)
+	log.Infof("number of workers is set to %v", config.NumberOfWorkers)
+	log.Infof("aws api timeout is set to %v", config.APITimeout)
+
+	p := &s3Poller{
+		cancellation: cancellation,
+		logger:       log,
+		collector: &s3Collector{
+			cancellation: cancellation,
+			logger:       log,
+			config:       &config,
+			s3:           s3.New(awscommon.EnrichAWSConfigWithEndpoint(config.AwsConfig.Endpoint, s3Servicename, regionName, awsConfig)),
+		},
+		publisher:  publisher,
+		bucketName: bucketName,
+		bucketARN:  config.BucketARN,
+		region:     regionName,
+		maxRetries: config.BucketListMaxRetries,
+		state:      state,
+		failures:   map[string]int{},
+	}
+	p.run()
+	return nil
+}
+
+func (p *s3Poller) run() {
+	defer p.logger.Info("s3 bucket poller has stopped.")
+	p.logger.Info("s3 bucket poller has started.")
+	for p.cancellation.Err() == nil {
+		p.poll()
+		if err := timed.Wait(p.cancellation, p.collector.config.BucketListInterval); err != nil {
+			return
+		}
+	}
+}
+
+// poll lists all objects after the current start_after position and
+// processes every object that is new or whose ETag changed. poll returns
+// once all dispatched objects have been processed.
+func (p *s3Poller) poll() {
+	config := p.collector.config
+
+	p.mu.Lock()
+	p.listed = nil
+	input := &s3.ListObjectsV2Input{Bucket: awssdk.String(p.bucketName)}
+	if config.BucketListPrefix != "" {
+		input.Prefix = awssdk.String(config.BucketListPrefix)
+	}
+	if p.state.StartAfter != "" {
+		input.StartAfter = awssdk.String(p.state.StartAfter)
+	}
+	p.mu.Unlock()
+
+	workers := make(chan struct{}, config.NumberOfWorkers)
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	for {
+		resp, err := p.listObjects(input)
+		if err != nil {
+			if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == awssdk.ErrCodeRequestCanceled {
+				return
+			}
+			p.logger.Error(fmt.Errorf("s3 ListObjectsV2Request failed for bucket '%s': %w", p.bucketName, err))
+			return
+		}
+
+		for _, object := range resp.Contents {
+			key := awssdk.StringValue(object.Key)
+			entry, ok := p.track(key, awssdk.StringValue(object.ETag))
+			if !ok {
+				continue
+			}
+
+			info, ok := p.collector.newS3Info(p.bucketName, p.bucketARN, p.region, key)
+			if !ok {
+				p.finish(entry, nil, nil)
+				continue
+			}
+
+			select {
+			case <-p.cancellation.Done():
+				return
+			case workers <- struct{}{}:
+			}
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer func() { <-workers }()
+				p.processObject(entry, info)
+			}()
+		}
+
+		if !awssdk.BoolValue(resp.IsTruncated) || resp.NextContinuationToken == nil {
+			return
+		}
+		input.ContinuationToken = resp.NextContinuationToken
+	}
+}
+
+func (p *s3Poller) listObjects(input *s3.ListObjectsV2Input) (*s3.ListObjectsV2Response, error) {
+	req := p.collector.s3.ListObjectsV2Request(input)
+
+	// The Context will interrupt the request if the timeout expires.
+	ctx, cancelFn := context.WithTimeout(p.cancellation, p.collector.config.APITimeout)
+	defer cancelFn()
+
+	return req.Send(ctx)
+}
+
+// track registers a listed object with the current cycle. It returns false if
+// the object has already been processed with the same ETag.
+func (p *s3Poller) track(key, etag string) (*pollerObject, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if processed, ok := p.state.Objects[key]; ok && processed == etag {
+		return nil, false
+	}
+	entry := &pollerObject{key: key, etag: etag}
+	p.listed = append(p.listed, entry)
+	return entry, true
+}
+
+func (p *s3Poller) processObject(entry *pollerObject, info s3Info) {
+	p.logger.Debugf("Processing file from s3 bucket \"%s\" with name \"%s\"", info.name, info.key)
+
+	pub := &objectPublisher{publisher: p.publisher}
+	collector := *p.collector
+	collector.publisher = pub
+
+	err := collector.createEventsFromS3Info(collector.s3, info, nil)
+	if err != nil {
+		p.logger.Error(fmt.Errorf("createEventsFromS3Info failed processing file from s3 bucket \"%s\" with name \"%s\": %w", info.name, info.key, err))
+	}
+	if err := p.finish(entry, pub, err); err != nil {
+		p.logger.Debugf("Publishing final event of '%s' failed: %v", info.key, err)
+	}
+}
+
+// finish marks an object as processed, unless processing failed, and
+// publishes the object's final event together with the updated state.
+// Failed objects are retried in the next polling cycles, up to maxRetries
+// times, then they are skipped so they don't block the listing position.
+func (p *s3Poller) finish(entry *pollerObject, pub *objectPublisher, err error) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err != nil {
+		p.failures[entry.key]++
+		if p.failures[entry.key] <= p.maxRetries {
+			if pub == nil {
+				return nil
+			}
+			return pub.flush(nil)
+		}
+		p.logger.Errorf("Skipping object '%s' of s3 bucket '%s' after %d failed attempts: %v", entry.key, p.bucketName, p.failures[entry.key], err)
+	}
+	delete(p.failures, entry.key)
+
+	entry.done = true
+	p.state.Objects[entry.key] = entry.etag
+	p.advance()
+
+	if pub == nil {
+		return nil
+	}
+	return pub.flush(p.state.clone())
+}
+
+// advance moves StartAfter past all leading objects of the current cycle
+// that are done, and forgets about the ETags of objects no longer listed
+// in subsequent cycles.
+func (p *s3Poller) advance() {
+	i := 0
+	for ; i < len(p.listed) && p.listed[i].done; i++ {
+		p.state.StartAfter = p.listed[i].key
+	}
+	if i == 0 {
+		return
+	}
+	p.listed = p.listed[i:]
+
+	for key := range p.state.Objects {
+		if key <= p.state.StartAfter {
+			delete(p.state.Objects, key)
+		}
+	}
+}
+
+func (s pollerState) clone() pollerState {
+	objects := make(map[string]string, len(s.Objects))
+	for k, v := range s.Objects {
+		objects[k] = v
+	}
+	return pollerState{StartAfter: s.StartAfter, Objects: objects}
+}
+
+// Publish publishes the previously held back event and holds back event.
+func (p *objectPublisher) Publish(event beat.Event) {
+	p.flush(nil)
+	p.pending = &event
+}
+
+// PublishAll publishes all events.
+func (p *objectPublisher) PublishAll(events []beat.Event) {
+	for _, event := range events {
+		p.Publish(event)
+	}
+}
+
+// Close is required to implement beat.Client. The underlying pipeline client
+// is owned by the cursor input manager.
+func (p *objectPublisher) Close() error { return nil }
+
+func (p *objectPublisher) flush(cursorUpdate interface{}) error {
+	if p.pending == nil {
+		return nil
+	}
+	event := *p.pending
+	p.pending = nil
+	return p.publisher.Publish(event, cursorUpdate)
+}
+
+// getBucketNameFromARN returns the bucket name of an S3 bucket ARN in the
+// format arn:{PARTITION}:s3:::{BUCKET_NAME}.
+func getBucketNameFromARN(bucketARN string) (string, error) {
+	parts := strings.SplitN(bucketARN, ":", 6)
+	if len(parts) != 6 || parts[0] != "arn" || parts[2] != "s3" || parts[5] == "" || strings.Contains(parts[5], "/") {
+		return "", fmt.Errorf("bucket_arn '%s' is not in format: arn:{PARTITION}:s3:::{BUCKET_NAME}", bucketARN)
+	}
+	return parts[5], nil
+}
+
+// getBucketRegion looks up the region the bucket is located in, using the
+// region of the AWS configuration as hint.
+func getBucketRegion(ctx context.Context, config config, awsConfig awssdk.Config, serviceName, bucketName string) (string, error) {
+	if awsConfig.Region == "" {
+		awsConfig.Region = "us-east-1"
+	}
+	svc := s3.New(awscommon.EnrichAWSConfigWithEndpoint(config.AwsConfig.Endpoint, serviceName, awsConfig.Region, awsConfig))
+
+	ctx, cancelFn := context.WithTimeout(ctx, config.APITimeout)
+	defer cancelFn()
+	return s3manager.GetBucketRegionWithClient(ctx, svc, bucketName)
+}
@@ -0,0 +1,266 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package s3
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"sync"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/s3iface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+// mockBucketClient serves a listing of objects, each containing two lines.
+type mockBucketClient struct {
+	s3iface.ClientAPI
+	objects map[string]string // key -> etag
+}
+
+func (m *mockBucketClient) ListObjectsV2Request(input *s3.ListObjectsV2Input) s3.ListObjectsV2Request {
+	var keys []string
+	for key := range m.objects {
+		if key > awssdk.StringValue(input.StartAfter) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	var contents []s3.Object
+	for _, key := range keys {
+		contents = append(contents, s3.Object{Key: awssdk.String(key), ETag: awssdk.String(m.objects[key])})
+	}
+
+	httpReq, _ := http.NewRequest("", "", nil)
+	return s3.ListObjectsV2Request{
+		Request: &awssdk.Request{
+			Data:        &s3.ListObjectsV2Output{Contents: contents, IsTruncated: awssdk.Bool(false)},
+			HTTPRequest: httpReq,
+		},
+		Input: input,
+	}
+}
+
+func (m *mockBucketClient) GetObjectRequest(input *s3.GetObjectInput) s3.GetObjectRequest {
+	key := awssdk.StringValue(input.Key)
+	body := ioutil.NopCloser(bytes.NewReader([]byte(key + " line 1\n" + key + " line 2")))
+	httpReq, _ := http.NewRequest("", "", nil)
+	return s3.GetObjectRequest{
+		Request: &awssdk.Request{
+			Data:        &s3.GetObjectOutput{Body: body},
+			HTTPRequest: httpReq,
+		},
+	}
+}
+
+type publishedEvent struct {
+	event  beat.Event
+	cursor interface{}
+}
+
+type mockCursorPublisher struct {
+	mu     sync.Mutex
+	events []publishedEvent
+}
+
+func (m *mockCursorPublisher) Publish(event beat.Event, cursor interface{}) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.events = append(m.events, publishedEvent{event: event, cursor: cursor})
+	return nil
+}
+
+func newTestPoller(t *testing.T, client s3iface.ClientAPI, pub *mockCursorPublisher, settings map[string]interface{}) *s3Poller {
+	config := defaultConfig()
+	require.NoError(t, common.MustNewConfigFrom(settings).Unpack(&config))
+
+	log := logp.NewLogger(inputName)
+	return &s3Poller{
+		cancellation: context.Background(),
+		logger:       log,
+		collector: &s3Collector{
+			cancellation: context.Background(),
+			logger:       log,
+			config:       &config,
+			s3:           client,
+		},
+		publisher:  pub,
+		bucketName: "test-bucket",
+		bucketARN:  config.BucketARN,
+		region:     "us-east-1",
+		maxRetries: config.BucketListMaxRetries,
+		state:      pollerState{Objects: map[string]string{}},
+		failures:   map[string]int{},
+	}
+}
+
+func TestPollerPoll(t *testing.T) {
+	client := &mockBucketClient{objects: map[string]string{
+		"logs/a": "etag-a",
+		"logs/b": "etag-b",
+		"logs/c": "etag-c",
+	}}
+	pub := &mockCursorPublisher{}
+	p := newTestPoller(t, client, pub, map[string]interface{}{
+		"bucket_arn":        "arn:aws:s3:::test-bucket",
+		"number_of_workers": 2,
+	})
+
+	p.poll()
+
+	require.Len(t, pub.events, 6)
+	var updates []pollerState
+	for _, e := range pub.events {
+		if e.cursor != nil {
+			updates = append(updates, e.cursor.(pollerState))
+		}
+	}
+	require.Len(t, updates, 3)
+	last := updates[len(updates)-1]
+	assert.Equal(t, "logs/c", last.StartAfter)
+	assert.Empty(t, last.Objects)
+
+	// objects up to start_after are not processed again
+	p.poll()
+	assert.Len(t, pub.events, 6)
+
+	// new objects are picked up in the next cycle
+	client.objects["logs/d"] = "etag-d"
+	p.poll()
+	require.Len(t, pub.events, 8)
+	assert.Equal(t, "logs/d line 2", pub.events[7].event.Fields["message"])
+	assert.Equal(t, pollerState{StartAfter: "logs/d", Objects: map[string]string{}}, pub.events[7].cursor)
+}
+
+func TestPollerFileSelectors(t *testing.T) {
+	client := &mockBucketClient{objects: map[string]string{
+		"a.log": "etag-a",
+		"b.txt": "etag-b",
+		"c.log": "etag-c",
+	}}
+	pub := &mockCursorPublisher{}
+	p := newTestPoller(t, client, pub, map[string]interface{}{
+		"bucket_arn": "arn:aws:s3:::test-bucket",
+		"file_selectors": []map[string]interface{}{
+			{"regex": `\.log$`},
+		},
+	})
+	require.NoError(t, p.collector.config.Validate())
+
+	p.poll()
+
+	assert.Len(t, pub.events, 4)
+	assert.Equal(t, "c.log", p.state.StartAfter)
+	for _, e := range pub.events {
+		assert.NotEqual(t, "b.txt", e.event.Fields.Flatten()["aws.s3.object.key"])
+	}
+}
+
+func TestPollerAdvance(t *testing.T) {
+	p := &s3Poller{state: pollerState{Objects: map[string]string{}}, failures: map[string]int{}}
+	a, _ := p.track("a", "1")
+	b, _ := p.track("b", "1")
+	c, _ := p.track("c", "1")
+
+	// out of order completion does not move start_after
+	require.NoError(t, p.finish(b, nil, nil))
+	assert.Equal(t, "", p.state.StartAfter)
+	assert.Equal(t, map[string]string{"b": "1"}, p.state.Objects)
+
+	require.NoError(t, p.finish(a, nil, nil))
+	assert.Equal(t, "b", p.state.StartAfter)
+	assert.Empty(t, p.state.Objects)
+
+	// already processed objects with unchanged ETag are skipped
+	p.state.Objects["d"] = "1"
+	_, ok := p.track("d", "1")
+	assert.False(t, ok)
+	_, ok = p.track("d", "2")
+	assert.True(t, ok)
+
+	require.NoError(t, p.finish(c, nil, nil))
+	assert.Equal(t, "c", p.state.StartAfter)
+}
+
+func TestPollerSkipFailedObject(t *testing.T) {
+	p := &s3Poller{
+		logger:     logp.NewLogger(inputName),
+		bucketName: "test-bucket",
+		maxRetries: 2,
+		state:      pollerState{Objects: map[string]string{}},
+		failures:   map[string]int{},
+	}
+	failure := errors.New("failed to read object")
+
+	// failed objects are retried up to maxRetries times
+	a, _ := p.track("a", "1")
+	b, _ := p.track("b", "1")
+	require.NoError(t, p.finish(b, nil, nil))
+	require.NoError(t, p.finish(a, nil, failure))
+	assert.Equal(t, "", p.state.StartAfter)
+	assert.Equal(t, map[string]string{"b": "1"}, p.state.Objects)
+
+	p.listed = nil
+	a, ok := p.track("a", "1")
+	require.True(t, ok)
+	require.NoError(t, p.finish(a, nil, failure))
+	assert.Equal(t, "", p.state.StartAfter)
+
+	// then skipped, moving start_after past them
+	p.listed = nil
+	a, ok = p.track("a", "1")
+	require.True(t, ok)
+	require.NoError(t, p.finish(a, nil, failure))
+	assert.Equal(t, "a", p.state.StartAfter)
+	assert.Empty(t, p.failures)
+}
+
+func TestGetBucketNameFromARN(t *testing.T) {
+	name, err := getBucketNameFromARN("arn:aws:s3:::test-bucket")
+	assert.NoError(t, err)
+	assert.Equal(t, "test-bucket", name)
+
+	for _, arn := range []string{"test-bucket", "arn:aws:sqs:::test", "arn:aws:s3:::", "arn:aws:s3:::bucket/key"} {
+		_, err := getBucketNameFromARN(arn)
+		assert.Error(t, err, arn)
+	}
+}
+
+func TestConfigQueueOrBucket(t *testing.T) {
+	tests := map[string]struct {
+		settings map[string]interface{}
+		fail     bool
+	}{
+		"queue":   {settings: map[string]interface{}{"queue_url": "https://sqs.us-east-1.amazonaws.com/1234/q"}},
+		"bucket":  {settings: map[string]interface{}{"bucket_arn": "arn:aws:s3:::test-bucket"}},
+		"none":    {settings: map[string]interface{}{}, fail: true},
+		"both":    {settings: map[string]interface{}{"queue_url": "https://sqs.us-east-1.amazonaws.com/1234/q", "bucket_arn": "arn:aws:s3:::test-bucket"}, fail: true},
+		"workers": {settings: map[string]interface{}{"bucket_arn": "arn:aws:s3:::test-bucket", "number_of_workers": 0}, fail: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			config := defaultConfig()
+			err := common.MustNewConfigFrom(test.settings).Unpack(&config)
+			if test.fail {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -92,7 +92,7 @@ func newV2Context() (v2.Context, func()) {
 }
 
 func setupInput(t *testing.T, cfg *common.Config) (*s3Collector, chan beat.Event) {
-	inp, err := Plugin().Manager.Create(cfg)
+	inp, err := Plugin(logp.NewLogger("s3_test"), nil).Manager.Create(cfg)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -0,0 +1,65 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package otlp
+
+import (
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+)
+
+// ErrTooLarge is returned by ReadBody when the request body is larger than
+// the maximum size.
+var ErrTooLarge = errors.New("request body is too large")
+
+// ReadBody reads the possibly gzip compressed body of a request, up to
+// maxSize bytes once decompressed. On failure, the HTTP status to answer
+// with is returned.
+func ReadBody(r *http.Request, maxSize int64) (body []byte, status int, err error) {
+	var reader io.Reader = r.Body
+	switch r.Header.Get("Content-Encoding") {
+	case "":
+	case "gzip":
+		gz, err := gzip.NewReader(r.Body)
+		if err != nil {
+			return nil, http.StatusBadRequest, fmt.Errorf("failed to decompress body: %w", err)
+		}
+		defer gz.Close()
+		reader = gz
+	default:
+		return nil, http.StatusUnsupportedMediaType, fmt.Errorf("unsupported content encoding %q", r.Header.Get("Content-Encoding"))
+	}
+
+	body, err = ioutil.ReadAll(io.LimitReader(reader, maxSize+1))
+	if err != nil {
+		return nil, http.StatusBadRequest, fmt.Errorf("failed reading body: %w", err)
+	}
+	if int64(len(body)) > maxSize {
+		return nil, http.StatusRequestEntityTooLarge, ErrTooLarge
+	}
+	return body, 0, nil
+}
+
+// SendResponse answers a request with the encoded response message.
+func SendResponse(w http.ResponseWriter, contentType string, status int, body []byte) {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(status)
+	w.Write(body)
+}
+
+// SendStatus answers a failed request with a google.rpc.Status, as expected
+// by OTLP clients, encoded in JSON or protobuf like the request.
+func SendStatus(w http.ResponseWriter, isJSON bool, status int, err error) {
+	s := Status{Message: err.Error()}
+	if isJSON {
+		data, _ := s.MarshalJSON()
+		SendResponse(w, ContentTypeJSON, status, data)
+	} else {
+		SendResponse(w, ContentTypeProtobuf, status, s.MarshalProto())
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package otlp
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadBody(t *testing.T) {
+	newRequest := func(encoding string, body []byte) *http.Request {
+		r := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body))
+		if encoding != "" {
+			r.Header.Set("Content-Encoding", encoding)
+		}
+		return r
+	}
+	compress := func(data []byte) []byte {
+		var buf bytes.Buffer
+		gz := gzip.NewWriter(&buf)
+		gz.Write(data)
+		gz.Close()
+		return buf.Bytes()
+	}
+
+	body, _, err := ReadBody(newRequest("", []byte("0123456789")), 10)
+	require.NoError(t, err)
+	assert.Equal(t, "0123456789", string(body))
+
+	body, _, err = ReadBody(newRequest("gzip", compress([]byte("0123456789"))), 10)
+	require.NoError(t, err)
+	assert.Equal(t, "0123456789", string(body))
+
+	_, status, err := ReadBody(newRequest("gzip", compress(make([]byte, 11))), 10)
+	assert.True(t, errors.Is(err, ErrTooLarge))
+	assert.Equal(t, http.StatusRequestEntityTooLarge, status)
+
+	_, status, err = ReadBody(newRequest("gzip", []byte("not compressed")), 10)
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusBadRequest, status)
+
+	_, status, err = ReadBody(newRequest("br", []byte("0123456789")), 10)
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusUnsupportedMediaType, status)
+}
+
+func TestSendStatus(t *testing.T) {
+	w := httptest.NewRecorder()
+	SendStatus(w, true, http.StatusBadRequest, errors.New("invalid"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, ContentTypeJSON, w.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{"message":"invalid"}`, w.Body.String())
+
+	w = httptest.NewRecorder()
+	SendStatus(w, false, http.StatusServiceUnavailable, errors.New("stopping"))
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.Equal(t, ContentTypeProtobuf, w.Header().Get("Content-Type"))
+	assert.Equal(t, Status{Message: "stopping"}.MarshalProto(), w.Body.Bytes())
+}
@@ -0,0 +1,163 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package otlp
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+// The OTLP JSON encoding follows the protobuf JSON mapping, with the
+// exception of trace and span IDs, that are hex encoded. 64 bits integers
+// can be encoded as numbers or as strings.
+
+type jsonUint64 uint64
+
+func (v *jsonUint64) UnmarshalJSON(b []byte) error {
+	n, err := strconv.ParseUint(string(unquote(b)), 10, 64)
+	*v = jsonUint64(n)
+	return err
+}
+
+type jsonInt64 int64
+
+func (v *jsonInt64) UnmarshalJSON(b []byte) error {
+	n, err := strconv.ParseInt(string(unquote(b)), 10, 64)
+	*v = jsonInt64(n)
+	return err
+}
+
+func unquote(b []byte) []byte {
+	if len(b) >= 2 && b[0] == '"' && b[len(b)-1] == '"' {
+		return b[1 : len(b)-1]
+	}
+	return b
+}
+
+// jsonID is a trace or span ID.
+type jsonID []byte
+
+func (id *jsonID) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := hex.DecodeString(s)
+	if err != nil {
+		return fmt.Errorf("invalid id %q: %w", s, err)
+	}
+	*id = v
+	return nil
+}
+
+type jsonResource struct {
+	Attributes             []jsonKeyValue `json:"attributes"`
+	DroppedAttributesCount uint32         `json:"droppedAttributesCount"`
+}
+
+func (r *jsonResource) toResource() Resource {
+	return Resource{
+		Attributes:             keyValuesToMap(r.Attributes),
+		DroppedAttributesCount: r.DroppedAttributesCount,
+	}
+}
+
+type jsonScope struct {
+	Name                   string         `json:"name"`
+	Version                string         `json:"version"`
+	Attributes             []jsonKeyValue `json:"attributes"`
+	DroppedAttributesCount uint32         `json:"droppedAttributesCount"`
+}
+
+func (s *jsonScope) toScope() Scope {
+	return Scope{
+		Name:                   s.Name,
+		Version:                s.Version,
+		Attributes:             keyValuesToMap(s.Attributes),
+		DroppedAttributesCount: s.DroppedAttributesCount,
+	}
+}
+
+type jsonKeyValue struct {
+	Key   string        `json:"key"`
+	Value *jsonAnyValue `json:"value"`
+}
+
+type jsonAnyValue struct {
+	StringValue *string     `json:"stringValue"`
+	BoolValue   *bool       `json:"boolValue"`
+	IntValue    *jsonInt64  `json:"intValue"`
+	DoubleValue *float64    `json:"doubleValue"`
+	ArrayValue  *jsonValues `json:"arrayValue"`
+	KvlistValue *jsonKVList `json:"kvlistValue"`
+	BytesValue  []byte      `json:"bytesValue"`
+}
+
+type jsonValues struct {
+	Values []*jsonAnyValue `json:"values"`
+}
+
+type jsonKVList struct {
+	Values []jsonKeyValue `json:"values"`
+}
+
+func (v *jsonAnyValue) value() interface{} {
+	switch {
+	case v == nil:
+		return nil
+	case v.StringValue != nil:
+		return *v.StringValue
+	case v.BoolValue != nil:
+		return *v.BoolValue
+	case v.IntValue != nil:
+		return int64(*v.IntValue)
+	case v.DoubleValue != nil:
+		return *v.DoubleValue
+	case v.ArrayValue != nil:
+		values := make([]interface{}, 0, len(v.ArrayValue.Values))
+		for _, elem := range v.ArrayValue.Values {
+			values = append(values, elem.value())
+		}
+		return values
+	case v.KvlistValue != nil:
+		return keyValuesToMap(v.KvlistValue.Values)
+	case v.BytesValue != nil:
+		return v.BytesValue
+	}
+	return nil
+}
+
+func keyValuesToMap(kvs []jsonKeyValue) common.MapStr {
+	m := make(common.MapStr, len(kvs))
+	for _, kv := range kvs {
+		m[kv.Key] = kv.Value.value()
+	}
+	return m
+}
+
+// marshalJSONPartialSuccess encodes the partialSuccess field shared by the
+// export responses, rejectedField is the name of the field counting the
+// rejected items.
+func marshalJSONPartialSuccess(rejectedField string, rejected int64, errorMessage string) []byte {
+	var buf bytes.Buffer
+	buf.WriteString("{")
+	if rejected != 0 || errorMessage != "" {
+		buf.WriteString(`"partialSuccess":{`)
+		fmt.Fprintf(&buf, `%q:"%d"`, rejectedField, rejected)
+		if errorMessage != "" {
+			msg, _ := json.Marshal(errorMessage)
+			buf.WriteString(`,"errorMessage":`)
+			buf.Write(msg)
+		}
+		buf.WriteString("}")
+	}
+	buf.WriteString("}")
+	return buf.Bytes()
+}
@@ -0,0 +1,267 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package otlp
+
+import (
+	"encoding/json"
+
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+// LogsRequest is an opentelemetry.proto.collector.logs.v1.ExportLogsServiceRequest.
+type LogsRequest struct {
+	ResourceLogs []ResourceLogs
+}
+
+// ResourceLogs contains the logs produced by a resource.
+type ResourceLogs struct {
+	Resource  Resource
+	ScopeLogs []ScopeLogs
+	SchemaURL string
+}
+
+// ScopeLogs contains the logs produced by an instrumentation scope.
+type ScopeLogs struct {
+	Scope      Scope
+	LogRecords []LogRecord
+	SchemaURL  string
+}
+
+// LogRecord is a single log entry.
+type LogRecord struct {
+	TimeUnixNano           uint64
+	ObservedTimeUnixNano   uint64
+	SeverityNumber         int32
+	SeverityText           string
+	Body                   interface{}
+	Attributes             common.MapStr
+	DroppedAttributesCount uint32
+	Flags                  uint32
+	TraceID                []byte
+	SpanID                 []byte
+}
+
+// Len returns the number of log records in the request.
+func (r *LogsRequest) Len() int {
+	var n int
+	for _, rl := range r.ResourceLogs {
+		for _, sl := range rl.ScopeLogs {
+			n += len(sl.LogRecords)
+		}
+	}
+	return n
+}
+
+// UnmarshalLogsProto decodes a protobuf encoded ExportLogsServiceRequest.
+func UnmarshalLogsProto(b []byte) (*LogsRequest, error) {
+	req := &LogsRequest{}
+	err := forEachField(b, func(num protowire.Number, typ protowire.Type, val []byte) error {
+		if num != 1 { // resource_logs
+			return nil
+		}
+		b, err := bytesValue(typ, val)
+		if err != nil {
+			return err
+		}
+		rl, err := decodeResourceLogs(b)
+		req.ResourceLogs = append(req.ResourceLogs, rl)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeResourceLogs(b []byte) (ResourceLogs, error) {
+	var rl ResourceLogs
+	err := forEachField(b, func(num protowire.Number, typ protowire.Type, val []byte) error {
+		var err error
+		switch num {
+		case 1: // resource
+			var b []byte
+			if b, err = bytesValue(typ, val); err == nil {
+				rl.Resource, err = decodeResource(b)
+			}
+		case 2, 1000: // scope_logs, deprecated instrumentation_library_logs
+			var b []byte
+			if b, err = bytesValue(typ, val); err == nil {
+				var sl ScopeLogs
+				sl, err = decodeScopeLogs(b)
+				rl.ScopeLogs = append(rl.ScopeLogs, sl)
+			}
+		case 3: // schema_url
+			rl.SchemaURL, err = stringValue(typ, val)
+		}
+		return err
+	})
+	if rl.Resource.Attributes == nil {
+		rl.Resource.Attributes = common.MapStr{}
+	}
+	return rl, err
+}
+
+func decodeScopeLogs(b []byte) (ScopeLogs, error) {
+	var sl ScopeLogs
+	err := forEachField(b, func(num protowire.Number, typ protowire.Type, val []byte) error {
+		var err error
+		switch num {
+		case 1: // scope
+			var b []byte
+			if b, err = bytesValue(typ, val); err == nil {
+				sl.Scope, err = decodeScope(b)
+			}
+		case 2: // log_records
+			var b []byte
+			if b, err = bytesValue(typ, val); err == nil {
+				var rec LogRecord
+				rec, err = decodeLogRecord(b)
+				sl.LogRecords = append(sl.LogRecords, rec)
+			}
+		case 3: // schema_url
+			sl.SchemaURL, err = stringValue(typ, val)
+		}
+		return err
+	})
+	if sl.Scope.Attributes == nil {
+		sl.Scope.Attributes = common.MapStr{}
+	}
+	return sl, err
+}
+
+func decodeLogRecord(b []byte) (LogRecord, error) {
+	rec := LogRecord{Attributes: common.MapStr{}}
+	err := forEachField(b, func(num protowire.Number, typ protowire.Type, val []byte) error {
+		var err error
+		switch num {
+		case 1: // time_unix_nano
+			rec.TimeUnixNano, err = fixed64Value(typ, val)
+		case 2: // severity_number
+			var v uint64
+			v, err = varintValue(typ, val)
+			rec.SeverityNumber = int32(v)
+		case 3: // severity_text
+			rec.SeverityText, err = stringValue(typ, val)
+		case 5: // body
+			var b []byte
+			if b, err = bytesValue(typ, val); err == nil {
+				rec.Body, err = decodeAnyValue(b)
+			}
+		case 6: // attributes
+			err = decodeKeyValueField(rec.Attributes, typ, val)
+		case 7: // dropped_attributes_count
+			var v uint64
+			v, err = varintValue(typ, val)
+			rec.DroppedAttributesCount = uint32(v)
+		case 8: // flags
+			rec.Flags, err = fixed32Value(typ, val)
+		case 9: // trace_id
+			var v []byte
+			v, err = bytesValue(typ, val)
+			rec.TraceID = append([]byte(nil), v...)
+		case 10: // span_id
+			var v []byte
+			v, err = bytesValue(typ, val)
+			rec.SpanID = append([]byte(nil), v...)
+		case 11: // observed_time_unix_nano
+			rec.ObservedTimeUnixNano, err = fixed64Value(typ, val)
+		}
+		return err
+	})
+	return rec, err
+}
+
+type jsonLogsRequest struct {
+	ResourceLogs []struct {
+		Resource  jsonResource    `json:"resource"`
+		ScopeLogs []jsonScopeLogs `json:"scopeLogs"`
+		// Deprecated name of scopeLogs.
+		InstrumentationLibraryLogs []jsonScopeLogs `json:"instrumentationLibraryLogs"`
+		SchemaURL                  string          `json:"schemaUrl"`
+	} `json:"resourceLogs"`
+}
+
+type jsonScopeLogs struct {
+	Scope jsonScope `json:"scope"`
+	// Deprecated name of scope.
+	InstrumentationLibrary *jsonScope `json:"instrumentationLibrary"`
+	LogRecords             []struct {
+		TimeUnixNano           jsonUint64     `json:"timeUnixNano"`
+		ObservedTimeUnixNano   jsonUint64     `json:"observedTimeUnixNano"`
+		SeverityNumber         int32          `json:"severityNumber"`
+		SeverityText           string         `json:"severityText"`
+		Body                   *jsonAnyValue  `json:"body"`
+		Attributes             []jsonKeyValue `json:"attributes"`
+		DroppedAttributesCount uint32         `json:"droppedAttributesCount"`
+		Flags                  uint32         `json:"flags"`
+		TraceID                jsonID         `json:"traceId"`
+		SpanID                 jsonID         `json:"spanId"`
+	} `json:"logRecords"`
+	SchemaURL string `json:"schemaUrl"`
+}
+
+// UnmarshalLogsJSON decodes a JSON encoded ExportLogsServiceRequest.
+func UnmarshalLogsJSON(b []byte) (*LogsRequest, error) {
+	var in jsonLogsRequest
+	if err := json.Unmarshal(b, &in); err != nil {
+		return nil, err
+	}
+
+	req := &LogsRequest{ResourceLogs: make([]ResourceLogs, 0, len(in.ResourceLogs))}
+	for _, inRL := range in.ResourceLogs {
+		rl := ResourceLogs{
+			Resource:  inRL.Resource.toResource(),
+			SchemaURL: inRL.SchemaURL,
+		}
+		for _, inSL := range append(inRL.ScopeLogs, inRL.InstrumentationLibraryLogs...) {
+			scope := inSL.Scope
+			if inSL.InstrumentationLibrary != nil {
+				scope = *inSL.InstrumentationLibrary
+			}
+			sl := ScopeLogs{
+				Scope:      scope.toScope(),
+				LogRecords: make([]LogRecord, 0, len(inSL.LogRecords)),
+				SchemaURL:  inSL.SchemaURL,
+			}
+			for _, inRec := range inSL.LogRecords {
+				sl.LogRecords = append(sl.LogRecords, LogRecord{
+					TimeUnixNano:           uint64(inRec.TimeUnixNano),
+					ObservedTimeUnixNano:   uint64(inRec.ObservedTimeUnixNano),
+					SeverityNumber:         inRec.SeverityNumber,
+					SeverityText:           inRec.SeverityText,
+					Body:                   inRec.Body.value(),
+					Attributes:             keyValuesToMap(inRec.Attributes),
+					DroppedAttributesCount: inRec.DroppedAttributesCount,
+					Flags:                  inRec.Flags,
+					TraceID:                inRec.TraceID,
+					SpanID:                 inRec.SpanID,
+				})
+			}
+			rl.ScopeLogs = append(rl.ScopeLogs, sl)
+		}
+		req.ResourceLogs = append(req.ResourceLogs, rl)
+	}
+	return req, nil
+}
+
+// LogsResponse is an opentelemetry.proto.collector.logs.v1.ExportLogsServiceResponse.
+type LogsResponse struct {
+	// RejectedLogRecords is the number of log records that were not
+	// accepted. The response reports a partial success when it is not zero.
+	RejectedLogRecords int64
+	ErrorMessage       string
+}
+
+// MarshalProto encodes the response in protobuf.
+func (r LogsResponse) MarshalProto() []byte {
+	return appendPartialSuccess(nil, r.RejectedLogRecords, r.ErrorMessage)
+}
+
+// MarshalJSON encodes the response in JSON.
+func (r LogsResponse) MarshalJSON() ([]byte, error) {
+	return marshalJSONPartialSuccess("rejectedLogRecords", r.RejectedLogRecords, r.ErrorMessage), nil
+}
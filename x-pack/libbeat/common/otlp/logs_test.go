@@ -0,0 +1,213 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package otlp
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+// message builds protobuf encoded messages for the tests.
+type message []byte
+
+func (m message) bytes(num protowire.Number, v []byte) message {
+	m = protowire.AppendTag(m, num, protowire.BytesType)
+	return protowire.AppendBytes(m, v)
+}
+
+func (m message) string(num protowire.Number, v string) message {
+	return m.bytes(num, []byte(v))
+}
+
+func (m message) varint(num protowire.Number, v uint64) message {
+	m = protowire.AppendTag(m, num, protowire.VarintType)
+	return protowire.AppendVarint(m, v)
+}
+
+func (m message) fixed64(num protowire.Number, v uint64) message {
+	m = protowire.AppendTag(m, num, protowire.Fixed64Type)
+	return protowire.AppendFixed64(m, v)
+}
+
+func (m message) fixed32(num protowire.Number, v uint32) message {
+	m = protowire.AppendTag(m, num, protowire.Fixed32Type)
+	return protowire.AppendFixed32(m, v)
+}
+
+func keyValue(key string, value message) message {
+	return message(nil).string(1, key).bytes(2, value)
+}
+
+func TestUnmarshalLogsProto(t *testing.T) {
+	resource := message(nil).
+		bytes(1, keyValue("service.name", message(nil).string(1, "checkout"))).
+		bytes(1, keyValue("host.cpus", message(nil).varint(3, 8)))
+	scope := message(nil).string(1, "io.opentelemetry.log").string(2, "1.0.0")
+	body := message(nil).bytes(6, message(nil).
+		bytes(1, keyValue("ratio", message(nil).fixed64(4, math.Float64bits(0.5)))).
+		bytes(1, keyValue("tags", message(nil).bytes(5, message(nil).
+			bytes(1, message(nil).string(1, "a")).
+			bytes(1, message(nil).varint(2, 1))))))
+	record := message(nil).
+		fixed64(1, 1600000000000000000).
+		varint(2, 17).
+		string(3, "ERROR").
+		bytes(5, body).
+		bytes(6, keyValue("exception.type", message(nil).string(1, "IOError"))).
+		varint(7, 2).
+		fixed32(8, 1).
+		bytes(9, []byte{0x5b, 0x8e, 0xff, 0xf7, 0x98, 0x03, 0x81, 0x03, 0xd2, 0x69, 0xb6, 0x33, 0x81, 0x3f, 0xc6, 0x0c}).
+		bytes(10, []byte{0xee, 0xe1, 0x9b, 0x7e, 0xc3, 0xc1, 0xb1, 0x74}).
+		fixed64(11, 1600000000000000001).
+		varint(99, 1) // unknown fields are ignored
+	req := message(nil).bytes(1, message(nil).
+		bytes(1, resource).
+		bytes(2, message(nil).bytes(1, scope).bytes(2, record).bytes(2, message(nil).bytes(5, message(nil).string(1, "second")))).
+		bytes(1000, message(nil).bytes(2, message(nil).bytes(5, message(nil).string(1, "legacy")))).
+		string(3, "https://opentelemetry.io/schemas/1.9.0"))
+
+	logs, err := UnmarshalLogsProto(req)
+	require.NoError(t, err)
+	require.Len(t, logs.ResourceLogs, 1)
+	assert.Equal(t, 3, logs.Len())
+
+	rl := logs.ResourceLogs[0]
+	assert.Equal(t, "https://opentelemetry.io/schemas/1.9.0", rl.SchemaURL)
+	assert.Equal(t, common.MapStr{"service.name": "checkout", "host.cpus": int64(8)}, rl.Resource.Attributes)
+	require.Len(t, rl.ScopeLogs, 2)
+	assert.Equal(t, Scope{Name: "io.opentelemetry.log", Version: "1.0.0", Attributes: common.MapStr{}}, rl.ScopeLogs[0].Scope)
+
+	assert.Equal(t, LogRecord{
+		TimeUnixNano:         1600000000000000000,
+		ObservedTimeUnixNano: 1600000000000000001,
+		SeverityNumber:       17,
+		SeverityText:         "ERROR",
+		Body: common.MapStr{
+			"ratio": 0.5,
+			"tags":  []interface{}{"a", true},
+		},
+		Attributes:             common.MapStr{"exception.type": "IOError"},
+		DroppedAttributesCount: 2,
+		Flags:                  1,
+		TraceID:                []byte{0x5b, 0x8e, 0xff, 0xf7, 0x98, 0x03, 0x81, 0x03, 0xd2, 0x69, 0xb6, 0x33, 0x81, 0x3f, 0xc6, 0x0c},
+		SpanID:                 []byte{0xee, 0xe1, 0x9b, 0x7e, 0xc3, 0xc1, 0xb1, 0x74},
+	}, rl.ScopeLogs[0].LogRecords[0])
+	assert.Equal(t, "second", rl.ScopeLogs[0].LogRecords[1].Body)
+	assert.Equal(t, "legacy", rl.ScopeLogs[1].LogRecords[0].Body)
+}
+
+func TestUnmarshalLogsProtoErrors(t *testing.T) {
+	tests := map[string][]byte{
+		"truncated":  message(nil).string(1, "abc")[:3],
+		"wire type":  message(nil).varint(1, 1),
+		"nested":     message(nil).bytes(1, message(nil).fixed32(3, 1)),
+		"bad varint": {0x08, 0xff},
+	}
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := UnmarshalLogsProto(data)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestUnmarshalLogsJSON(t *testing.T) {
+	const data = `{
+	  "resourceLogs": [{
+	    "resource": {
+	      "attributes": [
+	        {"key": "service.name", "value": {"stringValue": "checkout"}},
+	        {"key": "host.cpus", "value": {"intValue": "8"}}
+	      ]
+	    },
+	    "scopeLogs": [{
+	      "scope": {"name": "io.opentelemetry.log", "version": "1.0.0"},
+	      "logRecords": [{
+	        "timeUnixNano": "1600000000000000000",
+	        "observedTimeUnixNano": 1600000000000000001,
+	        "severityNumber": 17,
+	        "severityText": "ERROR",
+	        "body": {"kvlistValue": {"values": [
+	          {"key": "ratio", "value": {"doubleValue": 0.5}},
+	          {"key": "tags", "value": {"arrayValue": {"values": [{"stringValue": "a"}, {"boolValue": true}]}}}
+	        ]}},
+	        "attributes": [{"key": "exception.type", "value": {"stringValue": "IOError"}}],
+	        "droppedAttributesCount": 2,
+	        "flags": 1,
+	        "traceId": "5b8efff798038103d269b633813fc60c",
+	        "spanId": "eee19b7ec3c1b174"
+	      }]
+	    }],
+	    "instrumentationLibraryLogs": [{
+	      "instrumentationLibrary": {"name": "legacy"},
+	      "logRecords": [{"body": {"bytesValue": "aGVsbG8="}}]
+	    }]
+	  }]
+	}`
+
+	logs, err := UnmarshalLogsJSON([]byte(data))
+	require.NoError(t, err)
+	require.Len(t, logs.ResourceLogs, 1)
+	assert.Equal(t, 2, logs.Len())
+
+	rl := logs.ResourceLogs[0]
+	assert.Equal(t, common.MapStr{"service.name": "checkout", "host.cpus": int64(8)}, rl.Resource.Attributes)
+	require.Len(t, rl.ScopeLogs, 2)
+	assert.Equal(t, Scope{Name: "io.opentelemetry.log", Version: "1.0.0", Attributes: common.MapStr{}}, rl.ScopeLogs[0].Scope)
+	assert.Equal(t, LogRecord{
+		TimeUnixNano:         1600000000000000000,
+		ObservedTimeUnixNano: 1600000000000000001,
+		SeverityNumber:       17,
+		SeverityText:         "ERROR",
+		Body: common.MapStr{
+			"ratio": 0.5,
+			"tags":  []interface{}{"a", true},
+		},
+		Attributes:             common.MapStr{"exception.type": "IOError"},
+		DroppedAttributesCount: 2,
+		Flags:                  1,
+		TraceID:                []byte{0x5b, 0x8e, 0xff, 0xf7, 0x98, 0x03, 0x81, 0x03, 0xd2, 0x69, 0xb6, 0x33, 0x81, 0x3f, 0xc6, 0x0c},
+		SpanID:                 []byte{0xee, 0xe1, 0x9b, 0x7e, 0xc3, 0xc1, 0xb1, 0x74},
+	}, rl.ScopeLogs[0].LogRecords[0])
+	assert.Equal(t, "legacy", rl.ScopeLogs[1].Scope.Name)
+	assert.Equal(t, []byte("hello"), rl.ScopeLogs[1].LogRecords[0].Body)
+
+	_, err = UnmarshalLogsJSON([]byte(`{"resourceLogs": [{"scopeLogs": [{"logRecords": [{"traceId": "xyz"}]}]}]}`))
+	assert.Error(t, err)
+}
+
+func TestLogsResponse(t *testing.T) {
+	assert.Empty(t, LogsResponse{}.MarshalProto())
+	assert.Equal(t,
+		[]byte(message(nil).bytes(1, message(nil).varint(1, 2).string(2, "dropped"))),
+		LogsResponse{RejectedLogRecords: 2, ErrorMessage: "dropped"}.MarshalProto())
+
+	data, err := LogsResponse{}.MarshalJSON()
+	require.NoError(t, err)
+	assert.JSONEq(t, `{}`, string(data))
+
+	data, err = LogsResponse{RejectedLogRecords: 2, ErrorMessage: `"quoted"`}.MarshalJSON()
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"partialSuccess": {"rejectedLogRecords": "2", "errorMessage": "\"quoted\""}}`, string(data))
+}
+
+func TestIsJSON(t *testing.T) {
+	isJSON, err := IsJSON("application/json; charset=utf-8")
+	assert.NoError(t, err)
+	assert.True(t, isJSON)
+
+	isJSON, err = IsJSON("application/x-protobuf")
+	assert.NoError(t, err)
+	assert.False(t, isJSON)
+
+	_, err = IsJSON("text/plain")
+	assert.Error(t, err)
+}
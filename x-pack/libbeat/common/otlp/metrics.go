@@ -0,0 +1,458 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package otlp
+
+import (
+	"math"
+
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+// MetricsRequest is an opentelemetry.proto.collector.metrics.v1.ExportMetricsServiceRequest.
+type MetricsRequest struct {
+	ResourceMetrics []ResourceMetrics
+}
+
+// ResourceMetrics contains the metrics produced by a resource.
+type ResourceMetrics struct {
+	Resource     Resource
+	ScopeMetrics []ScopeMetrics
+	SchemaURL    string
+}
+
+// ScopeMetrics contains the metrics produced by an instrumentation scope.
+type ScopeMetrics struct {
+	Scope     Scope
+	Metrics   []Metric
+	SchemaURL string
+}
+
+// MetricType is the type of the data of a metric.
+type MetricType int
+
+// Metric types. Exponential histograms are recognized, but their data points
+// are not decoded.
+const (
+	MetricTypeEmpty MetricType = iota
+	MetricTypeGauge
+	MetricTypeSum
+	MetricTypeHistogram
+	MetricTypeExponentialHistogram
+	MetricTypeSummary
+)
+
+// AggregationTemporality defines how the values of sums and histograms are
+// aggregated over time.
+type AggregationTemporality int32
+
+// Aggregation temporalities.
+const (
+	AggregationTemporalityUnspecified AggregationTemporality = 0
+	AggregationTemporalityDelta       AggregationTemporality = 1
+	AggregationTemporalityCumulative  AggregationTemporality = 2
+)
+
+// DataPointFlagNoRecordedValue is set in the flags of the data points that
+// replace a value that is not available anymore, they should be ignored.
+const DataPointFlagNoRecordedValue = 1
+
+// Metric is a metric and its data points. Only the data points of its type
+// are set.
+type Metric struct {
+	Name        string
+	Description string
+	Unit        string
+	Type        MetricType
+
+	// AggregationTemporality and IsMonotonic are only set for sums and
+	// histograms.
+	AggregationTemporality AggregationTemporality
+	IsMonotonic            bool
+
+	NumberDataPoints    []NumberDataPoint
+	HistogramDataPoints []HistogramDataPoint
+	SummaryDataPoints   []SummaryDataPoint
+}
+
+// NumberDataPoint is a data point of a gauge or a sum.
+type NumberDataPoint struct {
+	Attributes        common.MapStr
+	StartTimeUnixNano uint64
+	TimeUnixNano      uint64
+	// IsInt is true if the value is an integer, stored in IntValue, and
+	// false if it is a double, stored in DoubleValue.
+	IsInt       bool
+	IntValue    int64
+	DoubleValue float64
+	Flags       uint32
+}
+
+// HistogramDataPoint is a data point of a histogram with explicit buckets.
+// BucketCounts has one more element than ExplicitBounds, for the bucket
+// above the last bound.
+type HistogramDataPoint struct {
+	Attributes        common.MapStr
+	StartTimeUnixNano uint64
+	TimeUnixNano      uint64
+	Count             uint64
+	Sum               float64
+	HasSum            bool
+	BucketCounts      []uint64
+	ExplicitBounds    []float64
+	Min               float64
+	HasMin            bool
+	Max               float64
+	HasMax            bool
+	Flags             uint32
+}
+
+// SummaryDataPoint is a data point of a summary.
+type SummaryDataPoint struct {
+	Attributes        common.MapStr
+	StartTimeUnixNano uint64
+	TimeUnixNano      uint64
+	Count             uint64
+	Sum               float64
+	QuantileValues    []ValueAtQuantile
+	Flags             uint32
+}
+
+// ValueAtQuantile is the value of a quantile of a summary.
+type ValueAtQuantile struct {
+	Quantile float64
+	Value    float64
+}
+
+// Len returns the number of data points in the request, exponential
+// histograms are not counted.
+func (r *MetricsRequest) Len() int {
+	var n int
+	for _, rm := range r.ResourceMetrics {
+		for _, sm := range rm.ScopeMetrics {
+			for _, m := range sm.Metrics {
+				n += len(m.NumberDataPoints) + len(m.HistogramDataPoints) + len(m.SummaryDataPoints)
+			}
+		}
+	}
+	return n
+}
+
+// UnmarshalMetricsProto decodes a protobuf encoded ExportMetricsServiceRequest.
+func UnmarshalMetricsProto(b []byte) (*MetricsRequest, error) {
+	req := &MetricsRequest{}
+	err := forEachField(b, func(num protowire.Number, typ protowire.Type, val []byte) error {
+		if num != 1 { // resource_metrics
+			return nil
+		}
+		b, err := bytesValue(typ, val)
+		if err != nil {
+			return err
+		}
+		rm, err := decodeResourceMetrics(b)
+		req.ResourceMetrics = append(req.ResourceMetrics, rm)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeResourceMetrics(b []byte) (ResourceMetrics, error) {
+	var rm ResourceMetrics
+	err := forEachField(b, func(num protowire.Number, typ protowire.Type, val []byte) error {
+		var err error
+		switch num {
+		case 1: // resource
+			var b []byte
+			if b, err = bytesValue(typ, val); err == nil {
+				rm.Resource, err = decodeResource(b)
+			}
+		case 2, 1000: // scope_metrics, deprecated instrumentation_library_metrics
+			var b []byte
+			if b, err = bytesValue(typ, val); err == nil {
+				var sm ScopeMetrics
+				sm, err = decodeScopeMetrics(b)
+				rm.ScopeMetrics = append(rm.ScopeMetrics, sm)
+			}
+		case 3: // schema_url
+			rm.SchemaURL, err = stringValue(typ, val)
+		}
+		return err
+	})
+	if rm.Resource.Attributes == nil {
+		rm.Resource.Attributes = common.MapStr{}
+	}
+	return rm, err
+}
+
+func decodeScopeMetrics(b []byte) (ScopeMetrics, error) {
+	var sm ScopeMetrics
+	err := forEachField(b, func(num protowire.Number, typ protowire.Type, val []byte) error {
+		var err error
+		switch num {
+		case 1: // scope
+			var b []byte
+			if b, err = bytesValue(typ, val); err == nil {
+				sm.Scope, err = decodeScope(b)
+			}
+		case 2: // metrics
+			var b []byte
+			if b, err = bytesValue(typ, val); err == nil {
+				var m Metric
+				m, err = decodeMetric(b)
+				sm.Metrics = append(sm.Metrics, m)
+			}
+		case 3: // schema_url
+			sm.SchemaURL, err = stringValue(typ, val)
+		}
+		return err
+	})
+	if sm.Scope.Attributes == nil {
+		sm.Scope.Attributes = common.MapStr{}
+	}
+	return sm, err
+}
+
+func decodeMetric(b []byte) (Metric, error) {
+	var m Metric
+	err := forEachField(b, func(num protowire.Number, typ protowire.Type, val []byte) error {
+		var err error
+		switch num {
+		case 1: // name
+			m.Name, err = stringValue(typ, val)
+		case 2: // description
+			m.Description, err = stringValue(typ, val)
+		case 3: // unit
+			m.Unit, err = stringValue(typ, val)
+		case 5: // gauge
+			m.Type = MetricTypeGauge
+			err = decodeMetricData(&m, typ, val)
+		case 7: // sum
+			m.Type = MetricTypeSum
+			err = decodeMetricData(&m, typ, val)
+		case 9: // histogram
+			m.Type = MetricTypeHistogram
+			err = decodeMetricData(&m, typ, val)
+		case 10: // exponential_histogram
+			m.Type = MetricTypeExponentialHistogram
+			_, err = bytesValue(typ, val)
+		case 11: // summary
+			m.Type = MetricTypeSummary
+			err = decodeMetricData(&m, typ, val)
+		}
+		return err
+	})
+	return m, err
+}
+
+// decodeMetricData decodes a Gauge, Sum, Histogram or Summary message, the
+// type of the metric must be set before calling it. These messages share
+// the numbers of their fields.
+func decodeMetricData(m *Metric, typ protowire.Type, val []byte) error {
+	b, err := bytesValue(typ, val)
+	if err != nil {
+		return err
+	}
+	return forEachField(b, func(num protowire.Number, typ protowire.Type, val []byte) error {
+		var err error
+		switch num {
+		case 1: // data_points
+			var b []byte
+			if b, err = bytesValue(typ, val); err != nil {
+				return err
+			}
+			switch m.Type {
+			case MetricTypeGauge, MetricTypeSum:
+				var dp NumberDataPoint
+				dp, err = decodeNumberDataPoint(b)
+				m.NumberDataPoints = append(m.NumberDataPoints, dp)
+			case MetricTypeHistogram:
+				var dp HistogramDataPoint
+				dp, err = decodeHistogramDataPoint(b)
+				m.HistogramDataPoints = append(m.HistogramDataPoints, dp)
+			case MetricTypeSummary:
+				var dp SummaryDataPoint
+				dp, err = decodeSummaryDataPoint(b)
+				m.SummaryDataPoints = append(m.SummaryDataPoints, dp)
+			}
+		case 2: // aggregation_temporality
+			if m.Type == MetricTypeSum || m.Type == MetricTypeHistogram {
+				var v uint64
+				v, err = varintValue(typ, val)
+				m.AggregationTemporality = AggregationTemporality(v)
+			}
+		case 3: // is_monotonic
+			if m.Type == MetricTypeSum {
+				var v uint64
+				v, err = varintValue(typ, val)
+				m.IsMonotonic = protowire.DecodeBool(v)
+			}
+		}
+		return err
+	})
+}
+
+func decodeNumberDataPoint(b []byte) (NumberDataPoint, error) {
+	dp := NumberDataPoint{Attributes: common.MapStr{}}
+	err := forEachField(b, func(num protowire.Number, typ protowire.Type, val []byte) error {
+		var err error
+		switch num {
+		case 2: // start_time_unix_nano
+			dp.StartTimeUnixNano, err = fixed64Value(typ, val)
+		case 3: // time_unix_nano
+			dp.TimeUnixNano, err = fixed64Value(typ, val)
+		case 4: // as_double
+			var v uint64
+			v, err = fixed64Value(typ, val)
+			dp.DoubleValue = math.Float64frombits(v)
+			dp.IsInt = false
+		case 6: // as_int
+			var v uint64
+			v, err = fixed64Value(typ, val)
+			dp.IntValue = int64(v)
+			dp.IsInt = true
+		case 7: // attributes
+			err = decodeKeyValueField(dp.Attributes, typ, val)
+		case 8: // flags
+			var v uint64
+			v, err = varintValue(typ, val)
+			dp.Flags = uint32(v)
+		}
+		return err
+	})
+	return dp, err
+}
+
+func decodeHistogramDataPoint(b []byte) (HistogramDataPoint, error) {
+	dp := HistogramDataPoint{Attributes: common.MapStr{}}
+	err := forEachField(b, func(num protowire.Number, typ protowire.Type, val []byte) error {
+		var err error
+		var v uint64
+		switch num {
+		case 2: // start_time_unix_nano
+			dp.StartTimeUnixNano, err = fixed64Value(typ, val)
+		case 3: // time_unix_nano
+			dp.TimeUnixNano, err = fixed64Value(typ, val)
+		case 4: // count
+			dp.Count, err = fixed64Value(typ, val)
+		case 5: // sum
+			v, err = fixed64Value(typ, val)
+			dp.Sum, dp.HasSum = math.Float64frombits(v), true
+		case 6: // bucket_counts
+			err = appendFixed64Values(&dp.BucketCounts, typ, val)
+		case 7: // explicit_bounds
+			var bits []uint64
+			err = appendFixed64Values(&bits, typ, val)
+			for _, v := range bits {
+				dp.ExplicitBounds = append(dp.ExplicitBounds, math.Float64frombits(v))
+			}
+		case 9: // attributes
+			err = decodeKeyValueField(dp.Attributes, typ, val)
+		case 10: // flags
+			v, err = varintValue(typ, val)
+			dp.Flags = uint32(v)
+		case 11: // min
+			v, err = fixed64Value(typ, val)
+			dp.Min, dp.HasMin = math.Float64frombits(v), true
+		case 12: // max
+			v, err = fixed64Value(typ, val)
+			dp.Max, dp.HasMax = math.Float64frombits(v), true
+		}
+		return err
+	})
+	return dp, err
+}
+
+func decodeSummaryDataPoint(b []byte) (SummaryDataPoint, error) {
+	dp := SummaryDataPoint{Attributes: common.MapStr{}}
+	err := forEachField(b, func(num protowire.Number, typ protowire.Type, val []byte) error {
+		var err error
+		switch num {
+		case 2: // start_time_unix_nano
+			dp.StartTimeUnixNano, err = fixed64Value(typ, val)
+		case 3: // time_unix_nano
+			dp.TimeUnixNano, err = fixed64Value(typ, val)
+		case 4: // count
+			dp.Count, err = fixed64Value(typ, val)
+		case 5: // sum
+			var v uint64
+			v, err = fixed64Value(typ, val)
+			dp.Sum = math.Float64frombits(v)
+		case 6: // quantile_values
+			var b []byte
+			if b, err = bytesValue(typ, val); err == nil {
+				var q ValueAtQuantile
+				q, err = decodeValueAtQuantile(b)
+				dp.QuantileValues = append(dp.QuantileValues, q)
+			}
+		case 7: // attributes
+			err = decodeKeyValueField(dp.Attributes, typ, val)
+		case 8: // flags
+			var v uint64
+			v, err = varintValue(typ, val)
+			dp.Flags = uint32(v)
+		}
+		return err
+	})
+	return dp, err
+}
+
+func decodeValueAtQuantile(b []byte) (ValueAtQuantile, error) {
+	var q ValueAtQuantile
+	err := forEachField(b, func(num protowire.Number, typ protowire.Type, val []byte) error {
+		var err error
+		var v uint64
+		switch num {
+		case 1: // quantile
+			v, err = fixed64Value(typ, val)
+			q.Quantile = math.Float64frombits(v)
+		case 2: // value
+			v, err = fixed64Value(typ, val)
+			q.Value = math.Float64frombits(v)
+		}
+		return err
+	})
+	return q, err
+}
+
+// appendFixed64Values decodes a repeated fixed64 or double field, that can
+// be packed or not.
+func appendFixed64Values(values *[]uint64, typ protowire.Type, val []byte) error {
+	if typ == protowire.Fixed64Type {
+		v, _ := protowire.ConsumeFixed64(val)
+		*values = append(*values, v)
+		return nil
+	}
+
+	b, err := bytesValue(typ, val)
+	if err != nil {
+		return err
+	}
+	for len(b) > 0 {
+		v, n := protowire.ConsumeFixed64(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		*values = append(*values, v)
+		b = b[n:]
+	}
+	return nil
+}
+
+// MetricsResponse is an opentelemetry.proto.collector.metrics.v1.ExportMetricsServiceResponse.
+type MetricsResponse struct {
+	// RejectedDataPoints is the number of data points that were not
+	// accepted. The response reports a partial success when it is not zero.
+	RejectedDataPoints int64
+	ErrorMessage       string
+}
+
+// MarshalProto encodes the response in protobuf.
+func (r MetricsResponse) MarshalProto() []byte {
+	return appendPartialSuccess(nil, r.RejectedDataPoints, r.ErrorMessage)
+}
@@ -0,0 +1,166 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package otlp
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+func (m message) double(num protowire.Number, v float64) message {
+	return m.fixed64(num, math.Float64bits(v))
+}
+
+func TestUnmarshalMetricsProto(t *testing.T) {
+	resource := message(nil).bytes(1, keyValue("service.name", message(nil).string(1, "checkout")))
+	attrs := keyValue("http.method", message(nil).string(1, "GET"))
+
+	gauge := message(nil).string(1, "process.threads").string(3, "{threads}").
+		bytes(5, message(nil).bytes(1, message(nil).
+			fixed64(3, 1600000000000000000).
+			fixed64(6, 12)))
+	sum := message(nil).string(1, "http.server.requests").
+		bytes(7, message(nil).
+			bytes(1, message(nil).
+				bytes(7, attrs).
+				fixed64(2, 1500000000000000000).
+				fixed64(3, 1600000000000000000).
+				double(4, 42.5).
+				varint(8, 1)).
+			varint(2, 2).
+			varint(3, 1))
+
+	// The bucket counts are packed, the bounds are not.
+	var counts []byte
+	for _, c := range []uint64{1, 4, 2} {
+		counts = protowire.AppendFixed64(counts, c)
+	}
+	histogram := message(nil).string(1, "http.server.duration").
+		bytes(9, message(nil).
+			bytes(1, message(nil).
+				bytes(9, attrs).
+				fixed64(3, 1600000000000000000).
+				fixed64(4, 7).
+				double(5, 1.25).
+				bytes(6, counts).
+				double(7, 0.1).
+				double(7, 0.5).
+				double(11, 0.01).
+				double(12, 0.9)).
+			varint(2, 1))
+	summary := message(nil).string(1, "rpc.latency").
+		bytes(11, message(nil).bytes(1, message(nil).
+			fixed64(3, 1600000000000000000).
+			fixed64(4, 10).
+			double(5, 20).
+			bytes(6, message(nil).double(1, 0.5).double(2, 1.5)).
+			bytes(6, message(nil).double(1, 0.99).double(2, 4))))
+	exponential := message(nil).string(1, "exponential").bytes(10, message(nil).bytes(1, message(nil).fixed64(4, 1)))
+
+	scope := message(nil).string(1, "io.opentelemetry.metrics")
+	req := message(nil).bytes(1, message(nil).
+		bytes(1, resource).
+		bytes(2, message(nil).bytes(1, scope).
+			bytes(2, gauge).bytes(2, sum).bytes(2, histogram).bytes(2, summary).bytes(2, exponential)).
+		bytes(1000, message(nil).bytes(2, message(nil).string(1, "legacy"))).
+		string(3, "https://opentelemetry.io/schemas/1.9.0"))
+
+	metrics, err := UnmarshalMetricsProto(req)
+	require.NoError(t, err)
+	require.Len(t, metrics.ResourceMetrics, 1)
+	assert.Equal(t, 4, metrics.Len())
+
+	rm := metrics.ResourceMetrics[0]
+	assert.Equal(t, "https://opentelemetry.io/schemas/1.9.0", rm.SchemaURL)
+	assert.Equal(t, common.MapStr{"service.name": "checkout"}, rm.Resource.Attributes)
+	require.Len(t, rm.ScopeMetrics, 2)
+	assert.Equal(t, "io.opentelemetry.metrics", rm.ScopeMetrics[0].Scope.Name)
+	assert.Equal(t, []Metric{{Name: "legacy"}}, rm.ScopeMetrics[1].Metrics)
+
+	ms := rm.ScopeMetrics[0].Metrics
+	require.Len(t, ms, 5)
+	assert.Equal(t, Metric{
+		Name: "process.threads",
+		Unit: "{threads}",
+		Type: MetricTypeGauge,
+		NumberDataPoints: []NumberDataPoint{{
+			Attributes:   common.MapStr{},
+			TimeUnixNano: 1600000000000000000,
+			IsInt:        true,
+			IntValue:     12,
+		}},
+	}, ms[0])
+	assert.Equal(t, Metric{
+		Name:                   "http.server.requests",
+		Type:                   MetricTypeSum,
+		AggregationTemporality: AggregationTemporalityCumulative,
+		IsMonotonic:            true,
+		NumberDataPoints: []NumberDataPoint{{
+			Attributes:        common.MapStr{"http.method": "GET"},
+			StartTimeUnixNano: 1500000000000000000,
+			TimeUnixNano:      1600000000000000000,
+			DoubleValue:       42.5,
+			Flags:             DataPointFlagNoRecordedValue,
+		}},
+	}, ms[1])
+	assert.Equal(t, Metric{
+		Name:                   "http.server.duration",
+		Type:                   MetricTypeHistogram,
+		AggregationTemporality: AggregationTemporalityDelta,
+		HistogramDataPoints: []HistogramDataPoint{{
+			Attributes:     common.MapStr{"http.method": "GET"},
+			TimeUnixNano:   1600000000000000000,
+			Count:          7,
+			Sum:            1.25,
+			HasSum:         true,
+			BucketCounts:   []uint64{1, 4, 2},
+			ExplicitBounds: []float64{0.1, 0.5},
+			Min:            0.01,
+			HasMin:         true,
+			Max:            0.9,
+			HasMax:         true,
+		}},
+	}, ms[2])
+	assert.Equal(t, Metric{
+		Name: "rpc.latency",
+		Type: MetricTypeSummary,
+		SummaryDataPoints: []SummaryDataPoint{{
+			Attributes:     common.MapStr{},
+			TimeUnixNano:   1600000000000000000,
+			Count:          10,
+			Sum:            20,
+			QuantileValues: []ValueAtQuantile{{Quantile: 0.5, Value: 1.5}, {Quantile: 0.99, Value: 4}},
+		}},
+	}, ms[3])
+	assert.Equal(t, Metric{Name: "exponential", Type: MetricTypeExponentialHistogram}, ms[4])
+}
+
+func TestUnmarshalMetricsProtoErrors(t *testing.T) {
+	tests := map[string][]byte{
+		"truncated":      message(nil).string(1, "abc")[:3],
+		"wire type":      message(nil).varint(1, 1),
+		"data point":     message(nil).bytes(1, message(nil).bytes(2, message(nil).bytes(2, message(nil).bytes(5, message(nil).bytes(1, message(nil).varint(3, 1)))))),
+		"packed buckets": message(nil).bytes(1, message(nil).bytes(2, message(nil).bytes(2, message(nil).bytes(9, message(nil).bytes(1, message(nil).bytes(6, []byte{1, 2, 3})))))),
+	}
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := UnmarshalMetricsProto(data)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestMetricsResponse(t *testing.T) {
+	assert.Empty(t, MetricsResponse{}.MarshalProto())
+	assert.Equal(t,
+		[]byte(message(nil).bytes(1, message(nil).varint(1, 3).string(2, "unsupported"))),
+		MetricsResponse{RejectedDataPoints: 3, ErrorMessage: "unsupported"}.MarshalProto())
+}
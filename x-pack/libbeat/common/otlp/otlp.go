@@ -0,0 +1,56 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+// Package otlp decodes the OpenTelemetry protocol (OTLP) messages sent over
+// HTTP, in both their protobuf and JSON encodings, and answers the requests.
+//
+// Only the parts of the protocol needed by the beats are implemented, the
+// messages are decoded directly from the wire format into plain Go types.
+// Attribute and body values are converted to string, bool, int64, float64,
+// []byte, []interface{} and common.MapStr.
+package otlp
+
+import (
+	"mime"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+// Content types of the OTLP/HTTP encodings.
+const (
+	ContentTypeProtobuf = "application/x-protobuf"
+	ContentTypeJSON     = "application/json"
+)
+
+// Resource describes the entity producing the telemetry.
+type Resource struct {
+	Attributes             common.MapStr
+	DroppedAttributesCount uint32
+}
+
+// Scope describes the instrumentation scope, or instrumentation library,
+// that produced the telemetry.
+type Scope struct {
+	Name                   string
+	Version                string
+	Attributes             common.MapStr
+	DroppedAttributesCount uint32
+}
+
+// IsJSON returns true if the content type selects the JSON encoding, and
+// false if it selects protobuf. An error is returned for any other content
+// type.
+func IsJSON(contentType string) (bool, error) {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false, err
+	}
+	switch mediaType {
+	case ContentTypeJSON:
+		return true, nil
+	case ContentTypeProtobuf:
+		return false, nil
+	}
+	return false, errUnsupportedContentType
+}
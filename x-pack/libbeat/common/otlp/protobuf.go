@@ -0,0 +1,242 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package otlp
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+var (
+	errUnsupportedContentType = errors.New("unsupported content type")
+	errWireType               = errors.New("unexpected wire type")
+)
+
+// fieldFunc is called for every field of a message, val contains the
+// encoded value of the field, without its tag.
+type fieldFunc func(num protowire.Number, typ protowire.Type, val []byte) error
+
+// forEachField calls fn for each field in the encoded message b. Unknown
+// fields are expected to be skipped by fn.
+func forEachField(b []byte, fn fieldFunc) error {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+
+		n = protowire.ConsumeFieldValue(num, typ, b)
+		if n < 0 {
+			return fmt.Errorf("field %d: %w", num, protowire.ParseError(n))
+		}
+		if err := fn(num, typ, b[:n]); err != nil {
+			return fmt.Errorf("field %d: %w", num, err)
+		}
+		b = b[n:]
+	}
+	return nil
+}
+
+func bytesValue(typ protowire.Type, val []byte) ([]byte, error) {
+	if typ != protowire.BytesType {
+		return nil, errWireType
+	}
+	v, _ := protowire.ConsumeBytes(val)
+	return v, nil
+}
+
+func stringValue(typ protowire.Type, val []byte) (string, error) {
+	v, err := bytesValue(typ, val)
+	return string(v), err
+}
+
+func varintValue(typ protowire.Type, val []byte) (uint64, error) {
+	if typ != protowire.VarintType {
+		return 0, errWireType
+	}
+	v, _ := protowire.ConsumeVarint(val)
+	return v, nil
+}
+
+func fixed64Value(typ protowire.Type, val []byte) (uint64, error) {
+	if typ != protowire.Fixed64Type {
+		return 0, errWireType
+	}
+	v, _ := protowire.ConsumeFixed64(val)
+	return v, nil
+}
+
+func fixed32Value(typ protowire.Type, val []byte) (uint32, error) {
+	if typ != protowire.Fixed32Type {
+		return 0, errWireType
+	}
+	v, _ := protowire.ConsumeFixed32(val)
+	return v, nil
+}
+
+// decodeResource decodes an opentelemetry.proto.resource.v1.Resource.
+func decodeResource(b []byte) (Resource, error) {
+	res := Resource{Attributes: common.MapStr{}}
+	err := forEachField(b, func(num protowire.Number, typ protowire.Type, val []byte) error {
+		switch num {
+		case 1: // attributes
+			return decodeKeyValueField(res.Attributes, typ, val)
+		case 2: // dropped_attributes_count
+			v, err := varintValue(typ, val)
+			res.DroppedAttributesCount = uint32(v)
+			return err
+		}
+		return nil
+	})
+	return res, err
+}
+
+// decodeScope decodes an opentelemetry.proto.common.v1.InstrumentationScope,
+// or the deprecated InstrumentationLibrary, which shares its first fields.
+func decodeScope(b []byte) (Scope, error) {
+	scope := Scope{Attributes: common.MapStr{}}
+	err := forEachField(b, func(num protowire.Number, typ protowire.Type, val []byte) error {
+		var err error
+		switch num {
+		case 1: // name
+			scope.Name, err = stringValue(typ, val)
+		case 2: // version
+			scope.Version, err = stringValue(typ, val)
+		case 3: // attributes
+			err = decodeKeyValueField(scope.Attributes, typ, val)
+		case 4: // dropped_attributes_count
+			var v uint64
+			v, err = varintValue(typ, val)
+			scope.DroppedAttributesCount = uint32(v)
+		}
+		return err
+	})
+	return scope, err
+}
+
+// decodeKeyValueField decodes a KeyValue field and stores it in m.
+func decodeKeyValueField(m common.MapStr, typ protowire.Type, val []byte) error {
+	b, err := bytesValue(typ, val)
+	if err != nil {
+		return err
+	}
+
+	var key string
+	var value interface{}
+	err = forEachField(b, func(num protowire.Number, typ protowire.Type, val []byte) error {
+		var err error
+		switch num {
+		case 1: // key
+			key, err = stringValue(typ, val)
+		case 2: // value
+			var b []byte
+			if b, err = bytesValue(typ, val); err == nil {
+				value, err = decodeAnyValue(b)
+			}
+		}
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	m[key] = value
+	return nil
+}
+
+// decodeAnyValue decodes an opentelemetry.proto.common.v1.AnyValue. An empty
+// value is decoded as nil.
+func decodeAnyValue(b []byte) (interface{}, error) {
+	var value interface{}
+	err := forEachField(b, func(num protowire.Number, typ protowire.Type, val []byte) error {
+		var err error
+		switch num {
+		case 1: // string_value
+			value, err = stringValue(typ, val)
+		case 2: // bool_value
+			var v uint64
+			v, err = varintValue(typ, val)
+			value = protowire.DecodeBool(v)
+		case 3: // int_value
+			var v uint64
+			v, err = varintValue(typ, val)
+			value = int64(v)
+		case 4: // double_value
+			var v uint64
+			v, err = fixed64Value(typ, val)
+			value = math.Float64frombits(v)
+		case 5: // array_value
+			var b []byte
+			if b, err = bytesValue(typ, val); err == nil {
+				value, err = decodeArrayValue(b)
+			}
+		case 6: // kvlist_value
+			var b []byte
+			if b, err = bytesValue(typ, val); err == nil {
+				value, err = decodeKeyValueList(b)
+			}
+		case 7: // bytes_value
+			var v []byte
+			v, err = bytesValue(typ, val)
+			value = append([]byte(nil), v...)
+		}
+		return err
+	})
+	return value, err
+}
+
+func decodeArrayValue(b []byte) ([]interface{}, error) {
+	values := []interface{}{}
+	err := forEachField(b, func(num protowire.Number, typ protowire.Type, val []byte) error {
+		if num != 1 { // values
+			return nil
+		}
+		b, err := bytesValue(typ, val)
+		if err != nil {
+			return err
+		}
+		v, err := decodeAnyValue(b)
+		values = append(values, v)
+		return err
+	})
+	return values, err
+}
+
+func decodeKeyValueList(b []byte) (common.MapStr, error) {
+	m := common.MapStr{}
+	err := forEachField(b, func(num protowire.Number, typ protowire.Type, val []byte) error {
+		if num != 1 { // values
+			return nil
+		}
+		return decodeKeyValueField(m, typ, val)
+	})
+	return m, err
+}
+
+// appendPartialSuccess encodes the partial_success field shared by the
+// export responses. Nothing is appended when nothing was rejected.
+func appendPartialSuccess(b []byte, rejected int64, errorMessage string) []byte {
+	if rejected == 0 && errorMessage == "" {
+		return b
+	}
+
+	var ps []byte
+	if rejected != 0 {
+		ps = protowire.AppendTag(ps, 1, protowire.VarintType)
+		ps = protowire.AppendVarint(ps, uint64(rejected))
+	}
+	if errorMessage != "" {
+		ps = protowire.AppendTag(ps, 2, protowire.BytesType)
+		ps = protowire.AppendString(ps, errorMessage)
+	}
+
+	b = protowire.AppendTag(b, 1, protowire.BytesType)
+	return protowire.AppendBytes(b, ps)
+}
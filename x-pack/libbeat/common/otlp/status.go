@@ -0,0 +1,38 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package otlp
+
+import (
+	"encoding/json"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// Status is a google.rpc.Status, it is the body of the responses of failed
+// requests.
+type Status struct {
+	Code    int32  `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// MarshalProto encodes the status in protobuf.
+func (s Status) MarshalProto() []byte {
+	var b []byte
+	if s.Code != 0 {
+		b = protowire.AppendTag(b, 1, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(s.Code))
+	}
+	if s.Message != "" {
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		b = protowire.AppendString(b, s.Message)
+	}
+	return b
+}
+
+// MarshalJSON encodes the status in JSON.
+func (s Status) MarshalJSON() ([]byte, error) {
+	type status Status
+	return json.Marshal(status(s))
+}
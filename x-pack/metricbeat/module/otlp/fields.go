@@ -0,0 +1,23 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+// Code generated by beats/dev-tools/cmd/asset/asset.go - DO NOT EDIT.
+
+package otlp
+
+import (
+	"github.com/elastic/beats/v7/libbeat/asset"
+)
+
+func init() {
+	if err := asset.SetFields("metricbeat", "otlp", asset.ModuleFieldsPri, AssetOtlp); err != nil {
+		panic(err)
+	}
+}
+
+// AssetOtlp returns asset data.
+// This is the base64 encoded gzipped contents of module/otlp.
+func AssetOtlp() string {
+	return "eJzMVc1u2zwQvOspFv4uXw3HvetQoCgK5NAiORi9OhQ5trahSHW5tOG3LyhFtou4bgIURcSTuOTuzHB/bugRh5qi+r4iUlaPmmZ3qy/3s4rIIVnhXjmGmj5URERfocI2kcCCd3C0Z21JW9Bdj7CCRweVA/USNdro6f/i7N2yIhJ4mISaGqipiDYM71I9uL2hYDocgZQtPfSoaSsxTzvnF8r6j+7EQYgTcddHUROUWggW5E0Dn8gER4IUs1jQnr2nzqhtacOS9OhnCj6dXM6PpglGbL7Dnm7Q08Z6tD7isI/izswXlJvWR1XhJisSxc2g3BGhtkaLci5buMFUxGSbls+wjgT/NVJn1FAfOegFSBPW+XJnfMYrkbmYG4/L1nVn+p7D9unobD57GYFvBUfBvjV5izEdQgw3XQxRY2BLKXdXmdiYg0LeEhebu+yN8g70ch4Ofqi5N8NiADTIvxiehYOV0h2uMqTEYSgTUC/YcczpLCV/CbRvEejh5GmtcT3EfCjtAsE0Hu6aYi0njVsx3StVu3TvLwh3O7lNC7Ix7CBaWkSkz94kZZtgxLan6FezIeWuM3JYzt9ARnwqFbYozzvkwY9sgrIfW86Ik/F7Mmeuns+LP0SeRllC0KLk+N/A6DjUyuB6f7ta3Z+iPx9i07dheJfq6ucAQgslwA=="
+}
@@ -0,0 +1,37 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package metrics
+
+import (
+	"time"
+
+	"github.com/dustin/go-humanize"
+
+	"github.com/elastic/beats/v7/libbeat/common/cfgtype"
+)
+
+// The HTTP server settings (host, port and ssl) are read by the HTTP server
+// helper from the root of the module settings.
+type config struct {
+	MaxMessageSize    cfgtype.ByteSize        `config:"max_message_size" validate:"nonzero,positive"`
+	CumulativeToDelta cumulativeToDeltaConfig `config:"cumulative_to_delta"`
+}
+
+// cumulativeToDeltaConfig enables the conversion of cumulative sums and
+// histograms to deltas. The last value of each series is kept for the
+// configured TTL.
+type cumulativeToDeltaConfig struct {
+	Enabled bool          `config:"enabled"`
+	TTL     time.Duration `config:"ttl" validate:"nonzero,positive"`
+}
+
+func defaultConfig() config {
+	return config{
+		MaxMessageSize: 10 * humanize.MiByte,
+		CumulativeToDelta: cumulativeToDeltaConfig{
+			TTL: 10 * time.Minute,
+		},
+	}
+}
@@ -0,0 +1,265 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package metrics
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/metricbeat/mb"
+	"github.com/elastic/beats/v7/x-pack/libbeat/common/otlp"
+	"github.com/elastic/beats/v7/x-pack/metricbeat/module/prometheus/collector"
+)
+
+// eventsGenerator converts OTLP metrics to events.
+type eventsGenerator struct {
+	// counters keeps the last values of cumulative series, it is nil if
+	// they are not converted to deltas.
+	counters collector.CounterCache
+}
+
+// dataPoint identifies the group of the data points of an event.
+type dataPoint struct {
+	resource  common.MapStr
+	labels    common.MapStr
+	timestamp time.Time
+}
+
+// generateEvents converts the data points in the request to events. Data
+// points with the same resource, attributes and timestamp are grouped in the
+// same event, as the remote_write metricset does with labels. It also
+// returns the number of data points that were rejected because they are not
+// valid.
+func (g *eventsGenerator) generateEvents(req *otlp.MetricsRequest, received time.Time) (events []mb.Event, rejected int64) {
+	index := map[string]int{}
+	add := func(dp dataPoint, name string, data common.MapStr) {
+		key := dp.resource.String() + dp.labels.String() + dp.timestamp.String()
+		i, found := index[key]
+		if !found {
+			event := mb.Event{
+				ModuleFields: common.MapStr{"metrics": common.MapStr{}},
+				Timestamp:    dp.timestamp,
+			}
+			if len(dp.resource) > 0 {
+				event.ModuleFields["resource"] = dp.resource
+			}
+			if len(dp.labels) > 0 {
+				event.ModuleFields["labels"] = dp.labels
+			}
+			i = len(events)
+			index[key] = i
+			events = append(events, event)
+		}
+
+		// Metric names can contain dots, they are used as a single key.
+		metrics := events[i].ModuleFields["metrics"].(common.MapStr)
+		if m, ok := metrics[name].(common.MapStr); ok {
+			m.Update(data)
+		} else {
+			metrics[name] = data
+		}
+	}
+
+	for _, rm := range req.ResourceMetrics {
+		resource := attributesToLabels(rm.Resource.Attributes)
+		for _, sm := range rm.ScopeMetrics {
+			for _, metric := range sm.Metrics {
+				newDataPoint := func(attributes common.MapStr, ts uint64) dataPoint {
+					dp := dataPoint{resource: resource, labels: attributesToLabels(attributes), timestamp: received}
+					if ts != 0 {
+						dp.timestamp = time.Unix(0, int64(ts)).UTC()
+					}
+					return dp
+				}
+				seriesKey := func(dp dataPoint) string {
+					return metric.Name + dp.resource.String() + dp.labels.String()
+				}
+
+				for _, p := range metric.NumberDataPoints {
+					if p.Flags&otlp.DataPointFlagNoRecordedValue != 0 {
+						continue
+					}
+					dp := newDataPoint(p.Attributes, p.TimeUnixNano)
+					if data := g.numberData(&metric, &p, seriesKey(dp)); data != nil {
+						add(dp, metric.Name, data)
+					}
+				}
+
+				for _, p := range metric.HistogramDataPoints {
+					if p.Flags&otlp.DataPointFlagNoRecordedValue != 0 {
+						continue
+					}
+					if len(p.BucketCounts) == 0 || len(p.BucketCounts) != len(p.ExplicitBounds)+1 {
+						rejected++
+						continue
+					}
+					dp := newDataPoint(p.Attributes, p.TimeUnixNano)
+					if data := g.histogramData(&metric, &p, seriesKey(dp)); data != nil {
+						add(dp, metric.Name, data)
+					}
+				}
+
+				for _, p := range metric.SummaryDataPoints {
+					if p.Flags&otlp.DataPointFlagNoRecordedValue != 0 {
+						continue
+					}
+					add(newDataPoint(p.Attributes, p.TimeUnixNano), metric.Name, summaryData(&p))
+				}
+			}
+		}
+	}
+	return events, rejected
+}
+
+// numberData returns the fields of a gauge or sum data point, or nil if
+// there is nothing to report.
+//
+// Gauges and non-monotonic cumulative sums are reported as values. Monotonic
+// cumulative sums are reported as counters, and also as deltas if they are
+// converted. Delta sums are reported as deltas.
+func (g *eventsGenerator) numberData(metric *otlp.Metric, p *otlp.NumberDataPoint, key string) common.MapStr {
+	var value interface{} = p.IntValue
+	if !p.IsInt {
+		if math.IsNaN(p.DoubleValue) || math.IsInf(p.DoubleValue, 0) {
+			return nil
+		}
+		value = p.DoubleValue
+	}
+
+	switch {
+	case metric.Type == otlp.MetricTypeGauge:
+		return common.MapStr{"value": value}
+	case metric.AggregationTemporality == otlp.AggregationTemporalityDelta:
+		return common.MapStr{"delta": value}
+	case !metric.IsMonotonic:
+		return common.MapStr{"value": value}
+	}
+
+	data := common.MapStr{"counter": value}
+	if g.counters == nil {
+		return data
+	}
+	if p.IsInt {
+		if p.IntValue >= 0 {
+			if delta, found := g.counters.RateUint64(key, uint64(p.IntValue)); found {
+				data["delta"] = int64(delta)
+			}
+		}
+	} else if delta, found := g.counters.RateFloat64(key, p.DoubleValue); found {
+		data["delta"] = delta
+	}
+	return data
+}
+
+// histogramData returns the histogram of a data point, converted to an ES
+// histogram, or nil if there is nothing to report.
+//
+// The counts of cumulative histograms are converted to the counts of the
+// period if they are converted to deltas. Nothing is reported for the
+// first data point of a series, as there is nothing to compare it with.
+func (g *eventsGenerator) histogramData(metric *otlp.Metric, p *otlp.HistogramDataPoint, key string) common.MapStr {
+	counts := p.BucketCounts
+	if g.counters != nil && metric.AggregationTemporality == otlp.AggregationTemporalityCumulative {
+		counts = make([]uint64, len(p.BucketCounts))
+		complete := true
+		for i, count := range p.BucketCounts {
+			bound := math.Inf(1)
+			if i < len(p.ExplicitBounds) {
+				bound = p.ExplicitBounds[i]
+			}
+			delta, found := g.counters.RateUint64(key+fmt.Sprintf("%f", bound), count)
+			counts[i] = delta
+			complete = complete && found
+		}
+		if !complete {
+			return nil
+		}
+	}
+
+	return common.MapStr{
+		"histogram": common.MapStr{
+			"values": histogramValues(p),
+			"counts": counts,
+		},
+	}
+}
+
+// histogramValues calculates the centroids of the buckets of a histogram.
+// The bucket above the last bound is interpolated from the previous one,
+// as the prometheus collector does.
+func histogramValues(p *otlp.HistogramDataPoint) []float64 {
+	bounds := p.ExplicitBounds
+	if len(bounds) == 0 {
+		// Single bucket, use the mean if available.
+		if p.HasSum && p.Count > 0 {
+			return []float64{p.Sum / float64(p.Count)}
+		}
+		return []float64{0}
+	}
+
+	values := make([]float64, 0, len(bounds)+1)
+	if bounds[0] > 0 {
+		values = append(values, bounds[0]/2)
+	} else {
+		values = append(values, bounds[0])
+	}
+	for i := 1; i < len(bounds); i++ {
+		values = append(values, bounds[i-1]+(bounds[i]-bounds[i-1])/2)
+	}
+	last := bounds[len(bounds)-1]
+	if len(bounds) > 1 {
+		values = append(values, last+(last-bounds[len(bounds)-2]))
+	} else {
+		values = append(values, last*2)
+	}
+	return values
+}
+
+// summaryData returns the fields of a summary data point. Quantiles are
+// reported as percentiles, named like p50 or p99_9.
+func summaryData(p *otlp.SummaryDataPoint) common.MapStr {
+	summary := common.MapStr{
+		"count": p.Count,
+		"sum":   p.Sum,
+	}
+	for _, q := range p.QuantileValues {
+		if math.IsNaN(q.Value) || math.IsInf(q.Value, 0) {
+			continue
+		}
+		summary[percentileName(q.Quantile)] = q.Value
+	}
+	return common.MapStr{"summary": summary}
+}
+
+func percentileName(quantile float64) string {
+	p := strconv.FormatFloat(math.Round(quantile*100*1000)/1000, 'f', -1, 64)
+	return "p" + strings.Replace(p, ".", "_", 1)
+}
+
+// attributesToLabels converts attributes to labels with string values, so
+// they can be used to group the data points and stored as keywords.
+func attributesToLabels(attributes common.MapStr) common.MapStr {
+	labels := make(common.MapStr, len(attributes))
+	for k, v := range attributes {
+		switch v := v.(type) {
+		case string:
+			labels[k] = v
+		case []byte:
+			labels[k] = base64.StdEncoding.EncodeToString(v)
+		case []interface{}, common.MapStr:
+			b, _ := json.Marshal(v)
+			labels[k] = string(b)
+		default:
+			labels[k] = fmt.Sprint(v)
+		}
+	}
+	return labels
+}
@@ -0,0 +1,139 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package metrics
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/common/cfgwarn"
+	serverhelper "github.com/elastic/beats/v7/metricbeat/helper/server"
+	httpserver "github.com/elastic/beats/v7/metricbeat/helper/server/http"
+	"github.com/elastic/beats/v7/metricbeat/mb"
+	"github.com/elastic/beats/v7/metricbeat/mb/parse"
+	"github.com/elastic/beats/v7/x-pack/libbeat/common/otlp"
+	"github.com/elastic/beats/v7/x-pack/metricbeat/module/prometheus/collector"
+)
+
+// metricsPath is the path of the OTLP/HTTP metrics endpoint.
+const metricsPath = "/v1/metrics"
+
+func init() {
+	mb.Registry.MustAddMetricSet("otlp", "metrics", New,
+		mb.WithHostParser(parse.EmptyHostParser),
+		mb.DefaultMetricSet(),
+	)
+}
+
+// MetricSet receives metrics sent with OTLP/HTTP in protobuf.
+type MetricSet struct {
+	mb.BaseMetricSet
+	server         serverhelper.Server
+	events         chan mb.Event
+	maxMessageSize int64
+
+	// mutex serializes the generation of events, the counters cache is
+	// not safe for concurrent use.
+	mutex     sync.Mutex
+	generator eventsGenerator
+}
+
+// New creates a new instance of the MetricSet.
+func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
+	cfgwarn.Beta("The otlp metrics metricset is beta.")
+
+	config := defaultConfig()
+	err := base.Module().UnpackConfig(&config)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &MetricSet{
+		BaseMetricSet:  base,
+		events:         make(chan mb.Event),
+		maxMessageSize: int64(config.MaxMessageSize),
+	}
+	if config.CumulativeToDelta.Enabled {
+		m.generator.counters = collector.NewCounterCache(config.CumulativeToDelta.TTL)
+	}
+
+	m.server, err = httpserver.NewHttpServerWithHandler(base, m.handleFunc)
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Run starts the server and reports the events generated from the metrics
+// it receives.
+func (m *MetricSet) Run(reporter mb.PushReporterV2) {
+	if m.generator.counters != nil {
+		m.generator.counters.Start()
+		defer m.generator.counters.Stop()
+	}
+
+	m.server.Start()
+	for {
+		select {
+		case <-reporter.Done():
+			m.server.Stop()
+			return
+		case e := <-m.events:
+			reporter.Event(e)
+		}
+	}
+}
+
+func (m *MetricSet) handleFunc(writer http.ResponseWriter, req *http.Request) {
+	if req.URL.Path != metricsPath {
+		http.Error(writer, fmt.Sprintf("unknown path %s, metrics are received in %s", req.URL.Path, metricsPath), http.StatusNotFound)
+		return
+	}
+	if req.Method != http.MethodPost {
+		writer.Header().Set("Allow", http.MethodPost)
+		http.Error(writer, "only POST requests are supported", http.StatusMethodNotAllowed)
+		return
+	}
+	if isJSON, err := otlp.IsJSON(req.Header.Get("Content-Type")); err != nil || isJSON {
+		http.Error(writer, fmt.Sprintf("unsupported content type, use %s", otlp.ContentTypeProtobuf), http.StatusUnsupportedMediaType)
+		return
+	}
+
+	body, status, err := otlp.ReadBody(req, m.maxMessageSize)
+	if err != nil {
+		otlp.SendStatus(writer, false, status, err)
+		return
+	}
+
+	metrics, err := otlp.UnmarshalMetricsProto(body)
+	if err != nil {
+		otlp.SendStatus(writer, false, http.StatusBadRequest, errors.Wrap(err, "failed to decode request"))
+		return
+	}
+
+	m.mutex.Lock()
+	events, rejected := m.generator.generateEvents(metrics, time.Now())
+	m.mutex.Unlock()
+
+	for _, e := range events {
+		select {
+		case <-req.Context().Done():
+			return
+		case m.events <- e:
+		}
+	}
+
+	var resp otlp.MetricsResponse
+	if rejected > 0 {
+		resp.RejectedDataPoints = rejected
+		resp.ErrorMessage = fmt.Sprintf("%d histogram data points had invalid buckets", rejected)
+		m.Logger().Debugf("Partial success: %s", resp.ErrorMessage)
+	}
+	otlp.SendResponse(writer, otlp.ContentTypeProtobuf, http.StatusOK, resp.MarshalProto())
+}
@@ -0,0 +1,379 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package metrics
+
+import (
+	"bytes"
+	"compress/gzip"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/metricbeat/mb"
+	mbtest "github.com/elastic/beats/v7/metricbeat/mb/testing"
+	"github.com/elastic/beats/v7/x-pack/libbeat/common/otlp"
+	"github.com/elastic/beats/v7/x-pack/metricbeat/module/prometheus/collector"
+)
+
+var (
+	ts       = time.Unix(1609459200, 0).UTC()
+	received = time.Unix(1609459300, 0).UTC()
+)
+
+func TestGenerateEvents(t *testing.T) {
+	get := common.MapStr{"http.method": "GET"}
+	req := &otlp.MetricsRequest{ResourceMetrics: []otlp.ResourceMetrics{{
+		Resource: otlp.Resource{Attributes: common.MapStr{"service.name": "checkout", "host.cpus": int64(8)}},
+		ScopeMetrics: []otlp.ScopeMetrics{{
+			Metrics: []otlp.Metric{
+				{
+					Name: "process.threads",
+					Type: otlp.MetricTypeGauge,
+					NumberDataPoints: []otlp.NumberDataPoint{
+						{TimeUnixNano: uint64(ts.UnixNano()), IsInt: true, IntValue: 12, Attributes: common.MapStr{}},
+						{TimeUnixNano: uint64(ts.UnixNano()), DoubleValue: math.NaN(), Attributes: common.MapStr{"ignored": "nan"}},
+					},
+				},
+				{
+					Name:                   "http.server.requests",
+					Type:                   otlp.MetricTypeSum,
+					AggregationTemporality: otlp.AggregationTemporalityCumulative,
+					IsMonotonic:            true,
+					NumberDataPoints: []otlp.NumberDataPoint{
+						{TimeUnixNano: uint64(ts.UnixNano()), IsInt: true, IntValue: 100, Attributes: get},
+						{TimeUnixNano: uint64(ts.UnixNano()), IsInt: true, IntValue: 1, Attributes: common.MapStr{"http.method": "POST"}, Flags: otlp.DataPointFlagNoRecordedValue},
+					},
+				},
+				{
+					Name:                   "http.server.active_requests",
+					Type:                   otlp.MetricTypeSum,
+					AggregationTemporality: otlp.AggregationTemporalityCumulative,
+					NumberDataPoints: []otlp.NumberDataPoint{
+						{TimeUnixNano: uint64(ts.UnixNano()), IsInt: true, IntValue: 3, Attributes: get},
+					},
+				},
+				{
+					Name:                   "http.server.errors",
+					Type:                   otlp.MetricTypeSum,
+					AggregationTemporality: otlp.AggregationTemporalityDelta,
+					IsMonotonic:            true,
+					NumberDataPoints: []otlp.NumberDataPoint{
+						{TimeUnixNano: uint64(ts.UnixNano()), DoubleValue: 2, Attributes: get},
+					},
+				},
+				{
+					Name:                   "http.server.duration",
+					Type:                   otlp.MetricTypeHistogram,
+					AggregationTemporality: otlp.AggregationTemporalityDelta,
+					HistogramDataPoints: []otlp.HistogramDataPoint{
+						{TimeUnixNano: uint64(ts.UnixNano()), Attributes: get, Count: 7, BucketCounts: []uint64{1, 4, 2}, ExplicitBounds: []float64{1, 5}},
+						{TimeUnixNano: uint64(ts.UnixNano()), Attributes: get, Count: 7, BucketCounts: []uint64{1, 4, 2}, ExplicitBounds: []float64{1}},
+					},
+				},
+				{
+					Name: "rpc.latency",
+					Type: otlp.MetricTypeSummary,
+					SummaryDataPoints: []otlp.SummaryDataPoint{
+						{Attributes: common.MapStr{}, Count: 10, Sum: 20, QuantileValues: []otlp.ValueAtQuantile{{Quantile: 0.5, Value: 1.5}, {Quantile: 0.999, Value: 4}}},
+					},
+				},
+				{Name: "exponential", Type: otlp.MetricTypeExponentialHistogram},
+			},
+		}},
+	}}}
+
+	var g eventsGenerator
+	events, rejected := g.generateEvents(req, received)
+	assert.Equal(t, int64(1), rejected)
+	require.Len(t, events, 3)
+
+	resource := common.MapStr{"service.name": "checkout", "host.cpus": "8"}
+	assert.Equal(t, ts, events[0].Timestamp)
+	assert.Equal(t, common.MapStr{
+		"resource": resource,
+		"metrics": common.MapStr{
+			"process.threads": common.MapStr{"value": int64(12)},
+		},
+	}, events[0].ModuleFields)
+
+	assert.Equal(t, ts, events[1].Timestamp)
+	assert.Equal(t, common.MapStr{
+		"resource": resource,
+		"labels":   common.MapStr{"http.method": "GET"},
+		"metrics": common.MapStr{
+			"http.server.requests":        common.MapStr{"counter": int64(100)},
+			"http.server.active_requests": common.MapStr{"value": int64(3)},
+			"http.server.errors":          common.MapStr{"delta": float64(2)},
+			"http.server.duration": common.MapStr{
+				"histogram": common.MapStr{
+					"values": []float64{0.5, 3, 9},
+					"counts": []uint64{1, 4, 2},
+				},
+			},
+		},
+	}, events[1].ModuleFields)
+
+	// Data points without timestamp take the time they are received.
+	assert.Equal(t, received, events[2].Timestamp)
+	assert.Equal(t, common.MapStr{
+		"resource": resource,
+		"metrics": common.MapStr{
+			"rpc.latency": common.MapStr{
+				"summary": common.MapStr{"count": uint64(10), "sum": float64(20), "p50": 1.5, "p99_9": float64(4)},
+			},
+		},
+	}, events[2].ModuleFields)
+}
+
+func TestGenerateEventsCumulativeToDelta(t *testing.T) {
+	request := func(requests int64, seconds float64, buckets []uint64) *otlp.MetricsRequest {
+		return &otlp.MetricsRequest{ResourceMetrics: []otlp.ResourceMetrics{{
+			Resource: otlp.Resource{Attributes: common.MapStr{}},
+			ScopeMetrics: []otlp.ScopeMetrics{{
+				Metrics: []otlp.Metric{
+					{
+						Name:                   "requests",
+						Type:                   otlp.MetricTypeSum,
+						AggregationTemporality: otlp.AggregationTemporalityCumulative,
+						IsMonotonic:            true,
+						NumberDataPoints: []otlp.NumberDataPoint{
+							{IsInt: true, IntValue: requests, Attributes: common.MapStr{}},
+						},
+					},
+					{
+						Name:                   "cpu.time",
+						Type:                   otlp.MetricTypeSum,
+						AggregationTemporality: otlp.AggregationTemporalityCumulative,
+						IsMonotonic:            true,
+						NumberDataPoints: []otlp.NumberDataPoint{
+							{DoubleValue: seconds, Attributes: common.MapStr{}},
+						},
+					},
+					{
+						Name:                   "duration",
+						Type:                   otlp.MetricTypeHistogram,
+						AggregationTemporality: otlp.AggregationTemporalityCumulative,
+						HistogramDataPoints: []otlp.HistogramDataPoint{
+							{BucketCounts: buckets, ExplicitBounds: []float64{1}, Attributes: common.MapStr{}},
+						},
+					},
+				},
+			}},
+		}}}
+	}
+
+	g := eventsGenerator{counters: collector.NewCounterCache(time.Minute)}
+
+	// The first data points are only used to calculate the next deltas.
+	events, _ := g.generateEvents(request(10, 1.5, []uint64{2, 1}), received)
+	require.Len(t, events, 1)
+	assert.Equal(t, common.MapStr{
+		"requests": common.MapStr{"counter": int64(10)},
+		"cpu.time": common.MapStr{"counter": 1.5},
+	}, events[0].ModuleFields["metrics"])
+
+	events, _ = g.generateEvents(request(15, 2.5, []uint64{5, 1}), received)
+	require.Len(t, events, 1)
+	assert.Equal(t, common.MapStr{
+		"requests": common.MapStr{"counter": int64(15), "delta": int64(5)},
+		"cpu.time": common.MapStr{"counter": 2.5, "delta": 1.0},
+		"duration": common.MapStr{
+			"histogram": common.MapStr{
+				"values": []float64{0.5, 2},
+				"counts": []uint64{3, 0},
+			},
+		},
+	}, events[0].ModuleFields["metrics"])
+
+	// Counter resets report a delta of zero.
+	events, _ = g.generateEvents(request(3, 0.5, []uint64{1, 0}), received)
+	require.Len(t, events, 1)
+	metrics := events[0].ModuleFields["metrics"].(common.MapStr)
+	assert.Equal(t, common.MapStr{"counter": int64(3), "delta": int64(0)}, metrics["requests"])
+	assert.Equal(t, common.MapStr{"counter": 0.5, "delta": 0.0}, metrics["cpu.time"])
+}
+
+func TestHistogramValues(t *testing.T) {
+	for _, test := range []struct {
+		point  otlp.HistogramDataPoint
+		values []float64
+	}{
+		{otlp.HistogramDataPoint{ExplicitBounds: []float64{1, 2, 4}}, []float64{0.5, 1.5, 3, 6}},
+		{otlp.HistogramDataPoint{ExplicitBounds: []float64{-1, 1}}, []float64{-1, 0, 3}},
+		{otlp.HistogramDataPoint{ExplicitBounds: []float64{10}}, []float64{5, 20}},
+		{otlp.HistogramDataPoint{Count: 4, Sum: 10, HasSum: true}, []float64{2.5}},
+		{otlp.HistogramDataPoint{}, []float64{0}},
+	} {
+		assert.Equal(t, test.values, histogramValues(&test.point))
+	}
+}
+
+func TestPercentileName(t *testing.T) {
+	for quantile, name := range map[float64]string{
+		0:      "p0",
+		0.5:    "p50",
+		0.99:   "p99",
+		0.999:  "p99_9",
+		0.9999: "p99_99",
+		1:      "p100",
+	} {
+		assert.Equal(t, name, percentileName(quantile))
+	}
+}
+
+func TestHandle(t *testing.T) {
+	m := newTestMetricSet(t)
+
+	rec, events := handle(m, newRequest(exportRequest()))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, otlp.ContentTypeProtobuf, rec.Header().Get("Content-Type"))
+	assert.Empty(t, rec.Body.Bytes())
+	require.Len(t, events, 1)
+	assert.Equal(t, ts, events[0].Timestamp)
+	assert.Equal(t, common.MapStr{"service.name": "checkout"}, events[0].ModuleFields["resource"])
+	assert.Equal(t, common.MapStr{"http.method": "GET"}, events[0].ModuleFields["labels"])
+	assert.Equal(t, common.MapStr{
+		"http.server.requests": common.MapStr{"counter": int64(42)},
+	}, events[0].ModuleFields["metrics"])
+}
+
+func TestData(t *testing.T) {
+	m := newTestMetricSet(t)
+
+	_, events := handle(m, newRequest(exportRequest()))
+	require.Len(t, events, 1)
+
+	mbtest.WriteEventToDataJSON(t, mbtest.StandardizeEvent(m, events[0], mb.AddMetricSetInfo), "")
+}
+
+func TestHandleGzip(t *testing.T) {
+	m := newTestMetricSet(t)
+
+	var body bytes.Buffer
+	w := gzip.NewWriter(&body)
+	w.Write(exportRequest())
+	w.Close()
+
+	req := newRequest(body.Bytes())
+	req.Header.Set("Content-Encoding", "gzip")
+	rec, events := handle(m, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Len(t, events, 1)
+}
+
+func TestHandleErrors(t *testing.T) {
+	m := newTestMetricSet(t)
+	m.maxMessageSize = 64
+
+	jsonReq := newRequest([]byte("{}"))
+	jsonReq.Header.Set("Content-Type", otlp.ContentTypeJSON)
+	deflateReq := newRequest(exportRequest())
+	deflateReq.Header.Set("Content-Encoding", "deflate")
+
+	for name, test := range map[string]struct {
+		req    *http.Request
+		status int
+	}{
+		"path":     {httptest.NewRequest("POST", "/v1/logs", nil), http.StatusNotFound},
+		"method":   {httptest.NewRequest("GET", metricsPath, nil), http.StatusMethodNotAllowed},
+		"json":     {jsonReq, http.StatusUnsupportedMediaType},
+		"encoding": {deflateReq, http.StatusUnsupportedMediaType},
+		"too big":  {newRequest(make([]byte, 65)), http.StatusRequestEntityTooLarge},
+		"invalid":  {newRequest([]byte{0x0a, 0xff}), http.StatusBadRequest},
+	} {
+		rec, events := handle(m, test.req)
+		assert.Equal(t, test.status, rec.Code, name)
+		assert.Empty(t, events, name)
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	config := defaultConfig()
+	err := common.MustNewConfigFrom(map[string]interface{}{"cumulative_to_delta.ttl": "0s"}).Unpack(&config)
+	assert.Error(t, err)
+}
+
+// message builds protobuf encoded messages for the tests.
+type message []byte
+
+func (m message) bytes(num protowire.Number, v []byte) message {
+	m = protowire.AppendTag(m, num, protowire.BytesType)
+	return protowire.AppendBytes(m, v)
+}
+
+func (m message) string(num protowire.Number, v string) message {
+	return m.bytes(num, []byte(v))
+}
+
+func (m message) varint(num protowire.Number, v uint64) message {
+	m = protowire.AppendTag(m, num, protowire.VarintType)
+	return protowire.AppendVarint(m, v)
+}
+
+func (m message) fixed64(num protowire.Number, v uint64) message {
+	m = protowire.AppendTag(m, num, protowire.Fixed64Type)
+	return protowire.AppendFixed64(m, v)
+}
+
+func keyValue(key, value string) message {
+	return message(nil).string(1, key).bytes(2, message(nil).string(1, value))
+}
+
+// exportRequest returns an ExportMetricsServiceRequest with a cumulative
+// monotonic sum.
+func exportRequest() []byte {
+	point := message(nil).
+		bytes(7, keyValue("http.method", "GET")).
+		fixed64(3, uint64(ts.UnixNano())).
+		fixed64(6, 42)
+	sum := message(nil).bytes(1, point).varint(2, 2).varint(3, 1)
+	metric := message(nil).string(1, "http.server.requests").bytes(7, sum)
+	return message(nil).bytes(1, message(nil).
+		bytes(1, message(nil).bytes(1, keyValue("service.name", "checkout"))).
+		bytes(2, message(nil).bytes(2, metric)))
+}
+
+func newRequest(body []byte) *http.Request {
+	req := httptest.NewRequest("POST", metricsPath, bytes.NewReader(body))
+	req.Header.Set("Content-Type", otlp.ContentTypeProtobuf)
+	return req
+}
+
+func newTestMetricSet(t *testing.T) *MetricSet {
+	return mbtest.NewPushMetricSetV2(t, map[string]interface{}{
+		"module":     "otlp",
+		"metricsets": []string{"metrics"},
+	}).(*MetricSet)
+}
+
+// handle runs a request through the handler of the metricset, and returns the
+// response and the events it generates.
+func handle(m *MetricSet, req *http.Request) (*httptest.ResponseRecorder, []mb.Event) {
+	var events []mb.Event
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for e := range m.events {
+			events = append(events, e)
+		}
+	}()
+
+	rec := httptest.NewRecorder()
+	m.handleFunc(rec, req)
+	close(m.events)
+	<-done
+
+	m.events = make(chan mb.Event)
+	return rec, events
+}
@@ -6,15 +6,15 @@ package collector
 
 import (
 	"math"
+	"sort"
 	"strconv"
 
 	"github.com/elastic/beats/v7/libbeat/common"
 	"github.com/elastic/beats/v7/libbeat/common/cfgwarn"
 	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/metricbeat/helper/prometheus"
 	"github.com/elastic/beats/v7/metricbeat/mb"
 	"github.com/elastic/beats/v7/metricbeat/module/prometheus/collector"
-
-	dto "github.com/prometheus/client_model/go"
 )
 
 func promEventsGeneratorFactory(base mb.BaseMetricSet) (collector.PromEventsGenerator, error) {
@@ -61,12 +61,13 @@ func (g *typedGenerator) Stop() {
 
 // GeneratePromEvents stores all Prometheus metrics using
 // specific Elasticsearch data types.
-func (g *typedGenerator) GeneratePromEvents(mf *dto.MetricFamily) []collector.PromEvent {
+func (g *typedGenerator) GeneratePromEvents(mf *prometheus.MetricFamily) []collector.PromEvent {
 	var events []collector.PromEvent
 
 	name := *mf.Name
 	metrics := mf.Metric
-	for _, metric := range metrics {
+	for i, metric := range metrics {
+		extras := mf.GetExtras(i)
 		labels := common.MapStr{}
 
 		if len(metric.Label) != 0 {
@@ -80,9 +81,11 @@ func (g *typedGenerator) GeneratePromEvents(mf *dto.MetricFamily) []collector.Pr
 		counter := metric.GetCounter()
 		if counter != nil {
 			if !math.IsNaN(counter.GetValue()) && !math.IsInf(counter.GetValue(), 0) {
+				data := g.rateCounterFloat64(name, labels, counter.GetValue())
+				addExtras(data, extras)
 				events = append(events, collector.PromEvent{
 					Data: common.MapStr{
-						name: g.rateCounterFloat64(name, labels, counter.GetValue()),
+						name: data,
 					},
 					Labels: labels,
 				})
@@ -106,11 +109,15 @@ func (g *typedGenerator) GeneratePromEvents(mf *dto.MetricFamily) []collector.Pr
 		summary := metric.GetSummary()
 		if summary != nil {
 			if !math.IsNaN(summary.GetSampleSum()) && !math.IsInf(summary.GetSampleSum(), 0) {
+				data := common.MapStr{
+					name + "_sum":   g.rateCounterFloat64(name, labels, summary.GetSampleSum()),
+					name + "_count": g.rateCounterUint64(name, labels, summary.GetSampleCount()),
+				}
+				if extras != nil && !extras.Created.IsZero() {
+					data[name] = common.MapStr{"created": common.Time(extras.Created)}
+				}
 				events = append(events, collector.PromEvent{
-					Data: common.MapStr{
-						name + "_sum":   g.rateCounterFloat64(name, labels, summary.GetSampleSum()),
-						name + "_count": g.rateCounterUint64(name, labels, summary.GetSampleCount()),
-					},
+					Data:   data,
 					Labels: labels,
 				})
 			}
@@ -135,11 +142,17 @@ func (g *typedGenerator) GeneratePromEvents(mf *dto.MetricFamily) []collector.Pr
 
 		histogram := metric.GetHistogram()
 		if histogram != nil {
+			data := common.MapStr{}
+			if mf.Type == prometheus.OpenMetricsGaugeHistogram {
+				// Buckets of gauge histograms are not counters
+				data["histogram"] = PromGaugeHistogramToES(histogram)
+			} else {
+				data["histogram"] = PromHistogramToES(g.counterCache, name, labels, histogram)
+			}
+			addExtras(data, extras)
 			events = append(events, collector.PromEvent{
 				Data: common.MapStr{
-					name: common.MapStr{
-						"histogram": PromHistogramToES(g.counterCache, name, labels, histogram),
-					},
+					name: data,
 				},
 				Labels: labels,
 			})
@@ -191,3 +204,51 @@ func (g *typedGenerator) rateCounterFloat64(name string, labels common.MapStr, v
 
 	return d
 }
+
+// addExtras adds the creation time and the exemplars of an OpenMetrics metric
+func addExtras(data common.MapStr, extras *prometheus.MetricExtras) {
+	if extras == nil {
+		return
+	}
+
+	if !extras.Created.IsZero() {
+		data["created"] = common.Time(extras.Created)
+	}
+
+	var exemplars []common.MapStr
+	if extras.Exemplar != nil {
+		exemplars = append(exemplars, exemplarToMapStr(extras.Exemplar))
+	}
+	bounds := make([]float64, 0, len(extras.BucketExemplars))
+	for le := range extras.BucketExemplars {
+		bounds = append(bounds, le)
+	}
+	sort.Float64s(bounds)
+	for _, le := range bounds {
+		exemplar := exemplarToMapStr(extras.BucketExemplars[le])
+		if !math.IsInf(le, 0) {
+			exemplar["le"] = le
+		}
+		exemplars = append(exemplars, exemplar)
+	}
+	if len(exemplars) > 0 {
+		data["exemplars"] = exemplars
+	}
+}
+
+func exemplarToMapStr(exemplar *prometheus.Exemplar) common.MapStr {
+	m := common.MapStr{
+		"value": exemplar.Value,
+	}
+	if len(exemplar.Labels) > 0 {
+		labels := common.MapStr{}
+		for k, v := range exemplar.Labels {
+			labels[k] = v
+		}
+		m["labels"] = labels
+	}
+	if !exemplar.Timestamp.IsZero() {
+		m["timestamp"] = common.Time(exemplar.Timestamp)
+	}
+	return m
+}
@@ -0,0 +1,91 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+// +build !integration
+
+package collector
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/metricbeat/helper/prometheus"
+)
+
+const openMetrics = `# TYPE http_requests counter
+http_requests_total{method="get"} 1027 # {trace_id="KOO5S4vxi0o"} 0.67 1395066364.5
+http_requests_created{method="get"} 1395066363
+# TYPE request_duration_seconds histogram
+request_duration_seconds_bucket{le="0.1"} 2 # {trace_id="oHg5SJYRHA0"} 0.05
+request_duration_seconds_bucket{le="1"} 5
+request_duration_seconds_bucket{le="+Inf"} 6 # {trace_id="dQw4w9WgXcQ"} 12
+request_duration_seconds_count 6
+request_duration_seconds_sum 4.5
+# TYPE queue_size gaugehistogram
+queue_size_bucket{le="10"} 3
+queue_size_bucket{le="+Inf"} 4
+queue_size_gcount 4
+queue_size_gsum 25
+# EOF
+`
+
+func TestTypedGeneratorOpenMetrics(t *testing.T) {
+	families, err := prometheus.ParseOpenMetrics(strings.NewReader(openMetrics))
+	require.NoError(t, err)
+	require.Len(t, families, 3)
+
+	g := typedGenerator{
+		counterCache: NewCounterCache(time.Minute),
+	}
+
+	events := g.GeneratePromEvents(families[0])
+	require.Len(t, events, 1)
+	assert.Equal(t, common.MapStr{
+		"http_requests_total": common.MapStr{
+			"counter": float64(1027),
+			"created": common.Time(time.Unix(1395066363, 0).UTC()),
+			"exemplars": []common.MapStr{
+				{
+					"value":     0.67,
+					"timestamp": common.Time(time.Unix(1395066364, 5e8).UTC()),
+					"labels":    common.MapStr{"trace_id": "KOO5S4vxi0o"},
+				},
+			},
+		},
+	}, events[0].Data)
+
+	events = g.GeneratePromEvents(families[1])
+	require.Len(t, events, 1)
+	exemplars, err := events[0].Data.GetValue("request_duration_seconds.exemplars")
+	require.NoError(t, err)
+	assert.Equal(t, []common.MapStr{
+		{
+			"value":  0.05,
+			"le":     0.1,
+			"labels": common.MapStr{"trace_id": "oHg5SJYRHA0"},
+		},
+		{
+			"value":  float64(12),
+			"labels": common.MapStr{"trace_id": "dQw4w9WgXcQ"},
+		},
+	}, exemplars)
+
+	// Buckets of gauge histograms are not rated, so they are reported
+	// since the first collection.
+	events = g.GeneratePromEvents(families[2])
+	require.Len(t, events, 1)
+	assert.Equal(t, common.MapStr{
+		"queue_size": common.MapStr{
+			"histogram": common.MapStr{
+				"values": []float64{5, 20},
+				"counts": []uint64{3, 1},
+			},
+		},
+	}, events[0].Data)
+}
@@ -78,3 +78,39 @@ func PromHistogramToES(cc CounterCache, name string, labels common.MapStr, histo
 
 	return res
 }
+
+// PromGaugeHistogramToES takes an OpenMetrics gauge histogram and converts it to
+// an ES histogram. Unlike in histograms, the counts of the buckets of gauge
+// histograms are not accumulated over time, so they are only deaccumulated
+// between buckets.
+func PromGaugeHistogramToES(histogram *dto.Histogram) common.MapStr {
+	var values []float64
+	var counts []uint64
+
+	var lastUpper, prevUpper float64
+	var prevCount uint64
+	for _, bucket := range histogram.GetBucket() {
+		if bucket.GetUpperBound() == math.Inf(0) {
+			// Report +Inf bucket as a point, interpolating its value
+			values = append(values, lastUpper+(lastUpper-prevUpper))
+		} else {
+			// calculate bucket centroid
+			values = append(values, lastUpper+(bucket.GetUpperBound()-lastUpper)/2.0)
+			prevUpper = lastUpper
+			lastUpper = bucket.GetUpperBound()
+		}
+
+		if bucket.GetCumulativeCount() < prevCount {
+			// Handle it to avoid overflowing when deaccumulating.
+			counts = append(counts, 0)
+		} else {
+			counts = append(counts, bucket.GetCumulativeCount()-prevCount)
+			prevCount = bucket.GetCumulativeCount()
+		}
+	}
+
+	return common.MapStr{
+		"values": values,
+		"counts": counts,
+	}
+}
@@ -19,5 +19,5 @@ func init() {
 // AssetPrometheus returns asset data.
 // This is the base64 encoded gzipped contents of module/prometheus.
 func AssetPrometheus() string {
-	return "eJzEkktqAzEMhvdzih8vQ5MDeNEzFLosJSi24rjxC0tTmtuXZIYw9EGzKMQ7+/+QPgmvceSTRes1sx54lPVHI3ccAI2a2MI8XSPoqbFHZu3RiRkAz+J6bBprsXgcAOBZSQXiOp3Zfa8ZhEUNLr7VWHQzAJ0Tk7BFoAEQVo0liMWLEUnmAeag2szrAOwjJy/20mGNQpmXzpvV5p3SyJcYF02Luntjp/PTdNlOia/jLvH3ZJuptVjCjJmVmZkfxjyfxVSBxsDzZn6XdHUsyv1+mrPAn6Kd9I7LPHf3N7seomgNnfKNwl/5/3G+Vl36Ts7LX/4ZAAD//xMCFRw="
+	return "eJzE1c1um0AUBeA9T3HE0qr9ACz6BK1aqT+bqooG5hhPmT/NvTTx21cQkrhNUyM5qqXZwL2a+x0YxBYDjw1ySYF64Cjbu2y6oQLUqWeD+uNjCXrMtAjU4jqpK8BSuuKyuhQbvK0A4JMaFUhXzNS7LynA4GQPRpuTi7qrgEJPI2zQmwoQqrrYS4NvtYiv36A+qOb6ewXsHb2VZp6wRTSBp+bdZvfT+JFzGTOzQWp/sNPl1v3FzX3FprH1fF65CSZnF/ulrd7US89fYk7rJFVvxp7Lk3kZ2aUxKsv1mAvgLLQY5fWU03S72npwoqkvJqwE/9n/OubHXc96u8Ip30qtfXoTF0A/u0DogfiQGd/PQFmguDWCxYRUUCjUl/W8Y8jeFLnKF/d1mom0f5blgbUG7v+3+kvOLGjTGO2D/em4tGM3UC/NpC5Q1IS8NtrrHasL5d609LLbrIQPPN6mYv/tejfveVY2re1vP6FfAwDh6DpZ"
 }
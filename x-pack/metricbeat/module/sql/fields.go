@@ -19,5 +19,5 @@ func init() {
 // AssetSql returns asset data.
 // This is the base64 encoded gzipped contents of module/sql.
 func AssetSql() string {
-	return "eJy0k0Fu8jAQhfc5xRPLX4IDZPEvqi4REuIAlWM/wMXJgD2mze0rEoJSpa1AVb18npnvy0Se48C2RDqFAlCvgSVmm/VyVgCRgSaxREU1BeCYbPRH9dKU+F8AwGa9RC0uB2JLtXsm1NTobcI2Sg3TVTijpjKJBbD1DC6VXfMcjak5wC9H2yNL7KLk4zUZ1497XPRnxls8tB7Yvkl0o/wL6eE8dzOQEx1UwHfarITuiVNmbBcTahf/Drq+jBhYHddKCLQ6LG5KHS6aXDN6u/g3MZDqlVZHcR+89LdOchV4n96qZ9z+4lWO7nutpNE3u4etHtraSpr59fNxNiHzLrNKJNA0f6v21EN+1Pr8kj4CAAD//07X+ko="
+	return "eJy0k0Fu8jAQhfc5xRPLXz8cIIsuqi5RJcQBkGO/gIsTgz2mze0rEtymoiAQqryJXjzzfTNRptiyKxH3rgDEimOJyXIxnxRAoKOKLFFRVAEYRh3sTqxvSzwVALBczNF4kxxRU/SGEQ0lWB1RB99A9TeMElWpyAKoLZ2JZV88RasaZvjxSLdjiXXwaXdKxvfHNSbYA8NXnEu37N59MKP8F+l8XvoeSJEG4sEP6iSEbIh9YuhmZ9Q+fgy6OLbIrJ6rvXPUkhd3gbo6Pj+GflUN4evvAa9p/IetYQUbFeFbnltl3TY1DFbP/o1Yw8fw1Ru1jOIhWA1vjU+V443mAyMzsyvNZa0owbbru63uW6hvp6fxcVAu8SazyntH1f6t2vMAuar18//+HABvKyYk"
 }
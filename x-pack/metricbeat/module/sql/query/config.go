@@ -0,0 +1,88 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package query
+
+import (
+	"github.com/pkg/errors"
+)
+
+// represents the response format of the query
+const (
+	tableResponseFormat    = "table"
+	variableResponseFormat = "variables"
+)
+
+type config struct {
+	Driver string `config:"driver" validate:"nonzero,required"`
+
+	// Single query, the only option before multiple queries were supported.
+	Query          string `config:"sql_query"`
+	ResponseFormat string `config:"sql_response_format"`
+
+	Queries      []query `config:"sql_queries"`
+	MergeResults bool    `config:"merge_results"`
+}
+
+type query struct {
+	Name           string `config:"name"`
+	Query          string `config:"query" validate:"nonzero,required"`
+	ResponseFormat string `config:"response_format"`
+}
+
+// Validate checks that exactly one of sql_query or sql_queries is set, and
+// that the response formats and the names of the queries are valid.
+func (c *config) Validate() error {
+	if c.Query == "" && len(c.Queries) == 0 {
+		return errors.New("one of sql_query or sql_queries must be set")
+	}
+	if c.Query != "" && len(c.Queries) > 0 {
+		return errors.New("sql_query and sql_queries cannot be used at the same time")
+	}
+	if c.ResponseFormat != "" && len(c.Queries) > 0 {
+		return errors.New("sql_response_format cannot be used with sql_queries, use response_format in each query")
+	}
+
+	names := make(map[string]bool)
+	for _, q := range c.queries() {
+		if q.ResponseFormat != tableResponseFormat && q.ResponseFormat != variableResponseFormat {
+			return errors.Errorf("invalid response_format value: %s", q.ResponseFormat)
+		}
+		if q.Name == "" {
+			continue
+		}
+		if names[q.Name] {
+			return errors.Errorf("duplicated query name: %s", q.Name)
+		}
+		names[q.Name] = true
+	}
+	return nil
+}
+
+// queries returns the configured queries, with the default response format
+// set where it is missing.
+func (c *config) queries() []query {
+	queries := c.Queries
+	if c.Query != "" {
+		queries = []query{{Query: c.Query, ResponseFormat: c.ResponseFormat}}
+	}
+
+	result := make([]query, len(queries))
+	for i, q := range queries {
+		if q.ResponseFormat == "" {
+			q.ResponseFormat = tableResponseFormat
+		}
+		result[i] = q
+	}
+	return result
+}
+
+// describe returns the name of the query, or the query itself if it has no
+// name, to identify it in errors and logs.
+func (q query) describe() string {
+	if q.Name != "" {
+		return q.Name
+	}
+	return "'" + q.Query + "'"
+}
@@ -6,9 +6,7 @@ package query
 
 import (
 	"context"
-	"fmt"
 
-	"github.com/jmoiron/sqlx"
 	"github.com/pkg/errors"
 
 	"github.com/elastic/beats/v7/libbeat/common"
@@ -17,12 +15,6 @@ import (
 	"github.com/elastic/beats/v7/metricbeat/mb"
 )
 
-// represents the response format of the query
-const (
-	tableResponseFormat    = "table"
-	variableResponseFormat = "variables"
-)
-
 // init registers the MetricSet with the central registry as soon as the program
 // starts. The New function will be called later to instantiate an instance of
 // the MetricSet for each host defined in the module's configuration. After the
@@ -33,17 +25,26 @@ func init() {
 	)
 }
 
+// dbClient runs the queries, it is implemented by sql.DbClient.
+type dbClient interface {
+	FetchTableMode(ctx context.Context, q string) ([]common.MapStr, error)
+	FetchVariableMode(ctx context.Context, q string) (common.MapStr, error)
+	Close() error
+}
+
 // MetricSet holds any configuration or state information. It must implement
 // the mb.MetricSet interface. And this is best achieved by embedding
 // mb.BaseMetricSet because it implements all of the required mb.MetricSet
 // interface methods except for Fetch.
 type MetricSet struct {
 	mb.BaseMetricSet
-	Driver         string
-	Query          string
-	ResponseFormat string
+	Driver       string
+	Queries      []query
+	MergeResults bool
 
-	db *sqlx.DB
+	// db is opened on the first fetch and reused by the following ones.
+	db     dbClient
+	openDB func() (dbClient, error)
 }
 
 // New creates a new instance of the MetricSet. New is responsible for unpacking
@@ -51,70 +52,123 @@ type MetricSet struct {
 func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
 	cfgwarn.Beta("The sql query metricset is beta.")
 
-	config := struct {
-		Driver         string `config:"driver" validate:"nonzero,required"`
-		Query          string `config:"sql_query" validate:"nonzero,required"`
-		ResponseFormat string `config:"sql_response_format"`
-	}{ResponseFormat: tableResponseFormat}
-
+	var config config
 	if err := base.Module().UnpackConfig(&config); err != nil {
 		return nil, err
 	}
 
-	if config.ResponseFormat != variableResponseFormat && config.ResponseFormat != tableResponseFormat {
-		return nil, fmt.Errorf("invalid sql_response_format value: %s", config.ResponseFormat)
+	m := &MetricSet{
+		BaseMetricSet: base,
+		Driver:        config.Driver,
+		Queries:       config.queries(),
+		MergeResults:  config.MergeResults,
 	}
-
-	return &MetricSet{
-		BaseMetricSet:  base,
-		Driver:         config.Driver,
-		Query:          config.Query,
-		ResponseFormat: config.ResponseFormat,
-	}, nil
+	m.openDB = func() (dbClient, error) {
+		return sql.NewDBClient(m.Driver, m.HostData().URI, m.Logger())
+	}
+	return m, nil
 }
 
 // Fetch methods implements the data gathering and data conversion to the right
 // format. It publishes the event which is then forwarded to the output. In case
 // of an error set the Error field of mb.Event or simply call report.Error().
-// It calls m.fetchTableMode() or m.fetchVariableMode() depending on the response
-// format of the query.
+// All the queries are run over the same connection pool, and they must finish
+// before the timeout of the module. The results of each query are reported in
+// their own events, or merged in a single event if merge_results is set.
 func (m *MetricSet) Fetch(ctx context.Context, report mb.ReporterV2) error {
-	db, err := sql.NewDBClient(m.Driver, m.HostData().URI, m.Logger())
-	if err != nil {
-		return errors.Wrap(err, "error opening connection")
+	if m.db == nil {
+		db, err := m.openDB()
+		if err != nil {
+			return errors.Wrap(err, "error opening connection")
+		}
+		m.db = db
+	}
+
+	if timeout := m.Module().Config().Timeout; timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
 	}
-	defer db.Close()
 
-	if m.ResponseFormat == tableResponseFormat {
-		mss, err := db.FetchTableMode(ctx, m.Query)
+	merged := common.MapStr{}
+	for _, q := range m.Queries {
+		rows, err := m.fetchQuery(ctx, q)
 		if err != nil {
-			return err
+			report.Error(errors.Wrapf(err, "error running query %s", q.describe()))
+			continue
 		}
 
-		for _, ms := range mss {
-			report.Event(m.getEvent(ms))
+		if !m.MergeResults {
+			for _, row := range rows {
+				report.Event(m.getEvent(q, row))
+			}
+			continue
 		}
 
-		return nil
+		if len(rows) > 1 {
+			report.Error(errors.Errorf("cannot merge the results of query %s, it returned %d rows", q.describe(), len(rows)))
+			continue
+		}
+		for _, row := range rows {
+			m.merge(merged, q, row)
+		}
 	}
 
-	ms, err := db.FetchVariableMode(ctx, m.Query)
-	if err != nil {
-		return err
+	if m.MergeResults && len(merged) > 0 {
+		report.Event(mb.Event{
+			RootFields: common.MapStr{
+				"sql": common.MapStr{
+					"driver":  m.Driver,
+					"metrics": getMetrics(merged),
+				},
+			},
+		})
 	}
-	report.Event(m.getEvent(ms))
 
 	return nil
 }
 
-func (m *MetricSet) getEvent(ms common.MapStr) mb.Event {
+// fetchQuery runs a query and returns its rows. Queries with the variables
+// response format return a single row with all the variables.
+func (m *MetricSet) fetchQuery(ctx context.Context, q query) ([]common.MapStr, error) {
+	if q.ResponseFormat == tableResponseFormat {
+		return m.db.FetchTableMode(ctx, q.Query)
+	}
+
+	ms, err := m.db.FetchVariableMode(ctx, q.Query)
+	if err != nil {
+		return nil, err
+	}
+	return []common.MapStr{ms}, nil
+}
+
+// merge adds the values of a row to the merged result, keyed by their
+// flattened names. The values of named queries are stored under their name, so
+// they don't collide with the values of other queries.
+func (m *MetricSet) merge(merged common.MapStr, q query, row common.MapStr) {
+	for k, v := range row.Flatten() {
+		if q.Name != "" {
+			k = q.Name + "." + k
+		}
+		if _, found := merged[k]; found {
+			m.Logger().Debugf("Value of %s from query %s overrides a previous value", k, q.describe())
+		}
+		merged[k] = v
+	}
+}
+
+func (m *MetricSet) getEvent(q query, ms common.MapStr) mb.Event {
+	fields := common.MapStr{
+		"driver":  m.Driver,
+		"query":   q.Query,
+		"metrics": getMetrics(ms),
+	}
+	if q.Name != "" {
+		fields["query_name"] = q.Name
+	}
 	return mb.Event{
 		RootFields: common.MapStr{
-			"sql": common.MapStr{
-				"driver":  m.Driver,
-				"query":   m.Query,
-				"metrics": getMetrics(ms),
-			},
+			"sql": fields,
 		},
 	}
 }
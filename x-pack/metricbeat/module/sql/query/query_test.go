@@ -0,0 +1,235 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+// +build !integration
+
+package query
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	mbtest "github.com/elastic/beats/v7/metricbeat/mb/testing"
+)
+
+type fakeDB struct {
+	tables    map[string][]common.MapStr
+	variables map[string]common.MapStr
+	deadlines []time.Time
+	closed    bool
+}
+
+func (db *fakeDB) FetchTableMode(ctx context.Context, q string) ([]common.MapStr, error) {
+	db.recordDeadline(ctx)
+	rows, found := db.tables[q]
+	if !found {
+		return nil, errors.New("unknown table")
+	}
+	return rows, nil
+}
+
+func (db *fakeDB) FetchVariableMode(ctx context.Context, q string) (common.MapStr, error) {
+	db.recordDeadline(ctx)
+	vars, found := db.variables[q]
+	if !found {
+		return nil, errors.New("unknown variables")
+	}
+	return vars, nil
+}
+
+func (db *fakeDB) recordDeadline(ctx context.Context) {
+	deadline, _ := ctx.Deadline()
+	db.deadlines = append(db.deadlines, deadline)
+}
+
+func (db *fakeDB) Close() error {
+	db.closed = true
+	return nil
+}
+
+func newFakeDB() *fakeDB {
+	return &fakeDB{
+		tables: map[string][]common.MapStr{
+			"select connections": {
+				{"active": float64(12), "idle": float64(3)},
+			},
+			"select databases": {
+				{"name": "stuff", "size": float64(1024)},
+				{"name": "other", "size": float64(2048)},
+			},
+		},
+		variables: map[string]common.MapStr{
+			"show status": {"uptime": float64(3600), "version": "8.0.21"},
+		},
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	cases := map[string]struct {
+		config map[string]interface{}
+		err    string
+	}{
+		"single query": {
+			config: map[string]interface{}{"sql_query": "select 1"},
+		},
+		"multiple queries": {
+			config: map[string]interface{}{
+				"sql_queries": []map[string]interface{}{
+					{"name": "a", "query": "select 1"},
+					{"name": "b", "query": "select 2", "response_format": "variables"},
+				},
+			},
+		},
+		"no query": {
+			config: map[string]interface{}{},
+			err:    "one of sql_query or sql_queries must be set",
+		},
+		"both single and multiple queries": {
+			config: map[string]interface{}{
+				"sql_query":   "select 1",
+				"sql_queries": []map[string]interface{}{{"query": "select 2"}},
+			},
+			err: "cannot be used at the same time",
+		},
+		"invalid response format": {
+			config: map[string]interface{}{
+				"sql_queries": []map[string]interface{}{{"query": "select 1", "response_format": "rows"}},
+			},
+			err: "invalid response_format value: rows",
+		},
+		"duplicated names": {
+			config: map[string]interface{}{
+				"sql_queries": []map[string]interface{}{
+					{"name": "a", "query": "select 1"},
+					{"name": "a", "query": "select 2"},
+				},
+			},
+			err: "duplicated query name: a",
+		},
+	}
+
+	for title, c := range cases {
+		t.Run(title, func(t *testing.T) {
+			c.config["driver"] = "postgres"
+			var config config
+			err := common.MustNewConfigFrom(c.config).Unpack(&config)
+			if c.err == "" {
+				assert.NoError(t, err)
+			} else if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), c.err)
+			}
+		})
+	}
+}
+
+func TestFetchQueries(t *testing.T) {
+	db := newFakeDB()
+	m := newTestMetricSet(t, db, map[string]interface{}{
+		"timeout": "5s",
+		"sql_queries": []map[string]interface{}{
+			{"name": "databases", "query": "select databases"},
+			{"query": "show status", "response_format": "variables"},
+			{"name": "missing", "query": "select missing"},
+		},
+	})
+
+	events, errs := mbtest.ReportingFetchV2WithContext(m)
+	require.Len(t, errs, 1)
+	assert.Contains(t, errs[0].Error(), "error running query missing")
+
+	require.Len(t, events, 3)
+	for i, expected := range []common.MapStr{
+		{"driver": "postgres", "query": "select databases", "query_name": "databases", "metrics": common.MapStr{
+			"numeric": common.MapStr{"size": float64(1024)},
+			"string":  common.MapStr{"name": "stuff"},
+		}},
+		{"driver": "postgres", "query": "select databases", "query_name": "databases", "metrics": common.MapStr{
+			"numeric": common.MapStr{"size": float64(2048)},
+			"string":  common.MapStr{"name": "other"},
+		}},
+		{"driver": "postgres", "query": "show status", "metrics": common.MapStr{
+			"numeric": common.MapStr{"uptime": float64(3600)},
+			"string":  common.MapStr{"version": "8.0.21"},
+		}},
+	} {
+		assert.Equal(t, expected, events[i].RootFields["sql"])
+	}
+
+	// All the queries share the deadline of the fetch.
+	require.Len(t, db.deadlines, 3)
+	assert.False(t, db.deadlines[0].IsZero())
+	assert.WithinDuration(t, time.Now().Add(5*time.Second), db.deadlines[0], 5*time.Second)
+	assert.Equal(t, db.deadlines[0], db.deadlines[2])
+}
+
+func TestFetchMergeResults(t *testing.T) {
+	db := newFakeDB()
+	m := newTestMetricSet(t, db, map[string]interface{}{
+		"merge_results": true,
+		"sql_queries": []map[string]interface{}{
+			{"name": "connections", "query": "select connections"},
+			{"query": "show status", "response_format": "variables"},
+			{"query": "select databases"},
+		},
+	})
+
+	events, errs := mbtest.ReportingFetchV2WithContext(m)
+	require.Len(t, errs, 1)
+	assert.Contains(t, errs[0].Error(), "cannot merge the results of query 'select databases', it returned 2 rows")
+
+	require.Len(t, events, 1)
+	assert.Equal(t, common.MapStr{
+		"driver": "postgres",
+		"metrics": common.MapStr{
+			"numeric": common.MapStr{
+				"connections": common.MapStr{"active": float64(12), "idle": float64(3)},
+				"uptime":      float64(3600),
+			},
+			"string": common.MapStr{"version": "8.0.21"},
+		},
+	}, events[0].RootFields["sql"])
+}
+
+func TestFetchReusesConnection(t *testing.T) {
+	db := newFakeDB()
+	m := newTestMetricSet(t, db, map[string]interface{}{
+		"sql_query": "select connections",
+	})
+
+	opened := 0
+	m.openDB = func() (dbClient, error) {
+		opened++
+		return db, nil
+	}
+
+	for i := 0; i < 3; i++ {
+		events, errs := mbtest.ReportingFetchV2WithContext(m)
+		require.Empty(t, errs)
+		require.Len(t, events, 1)
+		assert.Equal(t, "select connections", events[0].RootFields["sql"].(common.MapStr)["query"])
+	}
+	assert.Equal(t, 1, opened)
+
+	require.NoError(t, m.Close())
+	assert.True(t, db.closed)
+}
+
+func newTestMetricSet(t *testing.T, db *fakeDB, config map[string]interface{}) *MetricSet {
+	config["module"] = "sql"
+	config["metricsets"] = []string{"query"}
+	config["hosts"] = []string{"postgres://localhost:5432/stuff"}
+	config["driver"] = "postgres"
+
+	m := mbtest.NewReportingMetricSetV2WithContext(t, config).(*MetricSet)
+	m.openDB = func() (dbClient, error) {
+		return db, nil
+	}
+	return m
+}
@@ -19,5 +19,5 @@ func init() {
 // AssetStatsd returns asset data.
 // This is the base64 encoded gzipped contents of module/statsd.
 func AssetStatsd() string {
-	return "eJyUkTtqxTAQRXut4qLGYHAWoCKbyAKMIo2NEv2QxoV3H/wL4uFXeMp7z4gzaMAvrQqVNVcrAHbsSUF+7YEUgKVqisvsUlT4FABwlAjJLp4EUMiTrqQwawFMjrytaicHRB2oeX8bXvPGlrTkM2lX2rWu/zBpidz9N9d2+v4hw018BOPR+hTn+24MOmcX5xOUGykb9Obaa86rdyEq9U62fyo6+aTflK+m/TPNQFycaS3bX/oLAAD//wYwi2Y="
+	return "eJzMlsFq20AQhu96isGXQLBNoTcfCoUcCqWlkN6tzWosbyXtiNlRHL192V05XdvrxAEdii5mZ/T/34xGI6+gwXEDTpS4qgAQIy1uYPEYDhYFQIVOs+nFkN3AlwIAIAaho2posQBgbFE53ECtCoCdwbZym5C5Aqs6TPT9oYy9z2Ua+ukkvSW97e5+rWmwcvcaOd5NT39QS3IcD7Yx2pKt87Ftp/re2HpKXPjMRZKaqfZ4TVUHIGSXg73/KOiuJSW3kd5/DLNDYaMvKfEZbep4+TDe0X+gOnTiIUqtk+D5Y0x9w2CdRI7eDY4H4qo4Cb1F4K/fXg5oB7LHS5DEFl8k65oJvGeJL3KD456ceO/5av02KYJDgacxlKxbc5WgZ0NsZJyP4NekeFL+EkpL3Km2BGIoWzqUeR7VIseBn4/oq9cMAudMyExcLqE8KLbG1v6nsTuKlG7QGp27RlrXjLXyptsGZ2zgdxxhcFiBUFx8cWZdHsPRwBpDx7bzjtJjUL7s27q4YEB+Nhq3eo+6mWdfTJIQJG/dG/PW/1N1r4VfxUmaIEoGt9ZU5RnOvjM3APjVOTjwilmOJeyYOvjkB+Xzm1AzD0bkyiOV1Jy9UJqNGH189QfbWDrY8n9diB06p2qc6TvwI6ple/XPf3Xyr+jvAKkZeBA="
 }
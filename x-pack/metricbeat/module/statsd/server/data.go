@@ -7,19 +7,31 @@ package server
 import (
 	"bytes"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
 
+	"github.com/elastic/beats/v7/libbeat/common"
 	"github.com/elastic/beats/v7/libbeat/logp"
 	"github.com/elastic/beats/v7/metricbeat/helper/server"
+	"github.com/elastic/beats/v7/metricbeat/mb"
 )
 
 var errInvalidPacket = errors.New("invalid statsd packet")
 
+var (
+	// DogStatsD events and service checks are sent in their own lines.
+	eventPrefix        = []byte("_e{")
+	serviceCheckPrefix = []byte("_sc|")
+)
+
 type metricProcessor struct {
-	registry      *registry
-	reservoirSize int
+	registry *registry
+
+	// events contains the DogStatsD events and service checks received since
+	// they were last reported, they are not aggregated.
+	events []mb.Event
 }
 
 type statsdMetric struct {
@@ -35,6 +47,11 @@ func splitTags(rawTags []byte, kvSep []byte) map[string]string {
 	for _, kv := range bytes.Split(rawTags, []byte(",")) {
 		kvSplit := bytes.SplitN(kv, kvSep, 2)
 		if len(kvSplit) != 2 {
+			// DogStatsD tags can have no value.
+			if bytes.Equal(kvSep, []byte(":")) && len(kv) > 0 {
+				tags[string(kv)] = ""
+				continue
+			}
 			logger.Warnf("could not parse tags")
 			continue
 		}
@@ -43,28 +60,39 @@ func splitTags(rawTags []byte, kvSep []byte) map[string]string {
 	return tags
 }
 
+func addTags(tags map[string]string, newTags map[string]string) map[string]string {
+	if tags == nil {
+		return newTags
+	}
+	for k, v := range newTags {
+		tags[k] = v
+	}
+	return tags
+}
+
 func parseSingle(b []byte) (statsdMetric, error) {
 	// format: <metric name>:<value>|<type>[|@samplerate][|#<k>:<v>,<k>:<v>]
 	// alternative: <metric name>[,<k>=<v>,<k>=<v>]:<value>|<type>[|@samplerate]
 	s := statsdMetric{}
 
-	parts := bytes.SplitN(b, []byte("|"), 4)
+	parts := bytes.Split(b, []byte("|"))
 	if len(parts) < 2 {
 		return s, errInvalidPacket
 	}
 
-	if len(parts) > 2 && len(parts[2]) > 0 && parts[2][0] == '@' {
-		s.sampleRate = string(parts[2][1:])
-
-		if len(parts) > 3 {
-			parts = [][]byte{parts[0], parts[1], parts[3]}
-		} else {
-			parts = [][]byte{parts[0], parts[1]}
+	for _, part := range parts[2:] {
+		if len(part) == 0 {
+			continue
+		}
+		switch part[0] {
+		case '@':
+			s.sampleRate = string(part[1:])
+		case '#':
+			s.tags = addTags(s.tags, splitTags(part[1:], []byte(":")))
+		default:
+			// Other DogStatsD fields, as the container ID (c:) or the
+			// timestamp (T), are ignored.
 		}
-	}
-
-	if len(parts) > 2 && len(parts[2]) > 0 && parts[2][0] == '#' {
-		s.tags = splitTags(parts[2][1:], []byte(":"))
 	}
 
 	nameSplit := bytes.SplitN(parts[0], []byte{':'}, 2)
@@ -75,7 +103,7 @@ func parseSingle(b []byte) (statsdMetric, error) {
 	nameTagsSplit := bytes.SplitN(nameSplit[0], []byte(","), 2)
 	s.name = string(nameTagsSplit[0])
 	if len(nameTagsSplit) > 1 {
-		s.tags = splitTags(nameTagsSplit[1], []byte("="))
+		s.tags = addTags(s.tags, splitTags(nameTagsSplit[1], []byte("=")))
 	}
 
 	s.value = string(nameSplit[1])
@@ -84,24 +112,180 @@ func parseSingle(b []byte) (statsdMetric, error) {
 	return s, nil
 }
 
-// parse will parse a statsd metric into its components
-func parse(b []byte) ([]statsdMetric, error) {
+// parseEvent parses a DogStatsD event.
+func parseEvent(b []byte) (mb.Event, error) {
+	// format: _e{<title length>,<text length>}:<title>|<text>[|d:<timestamp>][|h:<hostname>][|p:<priority>]
+	//         [|t:<alert type>][|k:<aggregation key>][|s:<source type name>][|#<k>:<v>,<k>:<v>]
+	header := bytes.SplitN(b[len(eventPrefix):], []byte("}:"), 2)
+	if len(header) != 2 {
+		return mb.Event{}, errInvalidPacket
+	}
+	lengths := bytes.SplitN(header[0], []byte(","), 2)
+	if len(lengths) != 2 {
+		return mb.Event{}, errInvalidPacket
+	}
+	titleLength, err := strconv.Atoi(string(lengths[0]))
+	if err != nil {
+		return mb.Event{}, errInvalidPacket
+	}
+	textLength, err := strconv.Atoi(string(lengths[1]))
+	if err != nil {
+		return mb.Event{}, errInvalidPacket
+	}
+
+	rest := header[1]
+	if titleLength < 0 || textLength < 0 || len(rest) < titleLength+1+textLength || rest[titleLength] != '|' {
+		return mb.Event{}, errInvalidPacket
+	}
+	fields := common.MapStr{
+		"title": string(rest[:titleLength]),
+		"text":  strings.Replace(string(rest[titleLength+1:titleLength+1+textLength]), "\\n", "\n", -1),
+	}
+
+	event := mb.Event{Namespace: "statsd"}
+	var tags map[string]string
+	for _, part := range bytes.Split(rest[titleLength+1+textLength:], []byte("|")) {
+		if len(part) == 0 {
+			continue
+		}
+		if part[0] == '#' {
+			tags = addTags(tags, splitTags(part[1:], []byte(":")))
+			continue
+		}
+		kv := bytes.SplitN(part, []byte(":"), 2)
+		if len(kv) != 2 {
+			return mb.Event{}, errInvalidPacket
+		}
+		value := string(kv[1])
+		switch string(kv[0]) {
+		case "d":
+			ts, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return mb.Event{}, errors.Wrapf(err, "failed to parse timestamp of event `%s`", fields["title"])
+			}
+			event.Timestamp = time.Unix(ts, 0)
+		case "h":
+			fields["hostname"] = value
+		case "p":
+			fields["priority"] = value
+		case "t":
+			fields["alert_type"] = value
+		case "k":
+			fields["aggregation_key"] = value
+		case "s":
+			fields["source_type_name"] = value
+		}
+	}
+
+	event.MetricSetFields = common.MapStr{"event": fields}
+	if tags != nil {
+		event.RootFields = common.MapStr{"labels": tagsToMapStr(tags)}
+	}
+	return event, nil
+}
+
+// serviceCheckStatuses are the names of the statuses of the service checks.
+var serviceCheckStatuses = []string{"ok", "warning", "critical", "unknown"}
+
+// parseServiceCheck parses a DogStatsD service check.
+func parseServiceCheck(b []byte) (mb.Event, error) {
+	// format: _sc|<name>|<status>[|d:<timestamp>][|h:<hostname>][|#<k>:<v>,<k>:<v>][|m:<message>]
+	// The message must be the last field, and can contain any character.
+	var message []byte
+	if i := bytes.Index(b, []byte("|m:")); i >= 0 {
+		b, message = b[:i], b[i+len("|m:"):]
+	}
+
+	parts := bytes.Split(b[len(serviceCheckPrefix):], []byte("|"))
+	if len(parts) < 2 || len(parts[0]) == 0 {
+		return mb.Event{}, errInvalidPacket
+	}
+	status, err := strconv.Atoi(string(parts[1]))
+	if err != nil || status < 0 || status >= len(serviceCheckStatuses) {
+		return mb.Event{}, errors.Errorf("invalid status `%s` of service check `%s`", parts[1], parts[0])
+	}
+
+	fields := common.MapStr{
+		"name": string(parts[0]),
+		"status": common.MapStr{
+			"code": status,
+			"name": serviceCheckStatuses[status],
+		},
+	}
+	if message != nil {
+		fields["message"] = strings.Replace(string(message), "\\n", "\n", -1)
+	}
+
+	event := mb.Event{Namespace: "statsd"}
+	var tags map[string]string
+	for _, part := range parts[2:] {
+		if len(part) == 0 {
+			continue
+		}
+		switch {
+		case part[0] == '#':
+			tags = addTags(tags, splitTags(part[1:], []byte(":")))
+		case bytes.HasPrefix(part, []byte("d:")):
+			ts, err := strconv.ParseInt(string(part[2:]), 10, 64)
+			if err != nil {
+				return mb.Event{}, errors.Wrapf(err, "failed to parse timestamp of service check `%s`", parts[0])
+			}
+			event.Timestamp = time.Unix(ts, 0)
+		case bytes.HasPrefix(part, []byte("h:")):
+			fields["hostname"] = string(part[2:])
+		}
+	}
+
+	event.MetricSetFields = common.MapStr{"service_check": fields}
+	if tags != nil {
+		event.RootFields = common.MapStr{"labels": tagsToMapStr(tags)}
+	}
+	return event, nil
+}
+
+// parse will parse a statsd packet into its metrics, and the DogStatsD events
+// and service checks it contains.
+func parse(b []byte) ([]statsdMetric, []mb.Event, error) {
 	metrics := []statsdMetric{}
-	for _, rawMetric := range bytes.Split(b, []byte("\n")) {
-		if len(rawMetric) > 0 {
-			metric, err := parseSingle(rawMetric)
+	var events []mb.Event
+	for _, line := range bytes.Split(b, []byte("\n")) {
+		if len(line) == 0 {
+			continue
+		}
+
+		switch {
+		case bytes.HasPrefix(line, eventPrefix):
+			event, err := parseEvent(line)
 			if err != nil {
-				return metrics, err
+				return metrics, events, err
+			}
+			events = append(events, event)
+		case bytes.HasPrefix(line, serviceCheckPrefix):
+			event, err := parseServiceCheck(line)
+			if err != nil {
+				return metrics, events, err
+			}
+			events = append(events, event)
+		default:
+			metric, err := parseSingle(line)
+			if err != nil {
+				return metrics, events, err
 			}
 			metrics = append(metrics, metric)
 		}
 	}
-	return metrics, nil
+	return metrics, events, nil
 }
 
-func newMetricProcessor(ttl time.Duration) *metricProcessor {
+func newMetricProcessor(config Config) *metricProcessor {
 	return &metricProcessor{
-		registry: &registry{metrics: map[string]map[string]*metric{}, ttl: ttl},
+		registry: &registry{
+			metrics:       map[string]map[string]*metric{},
+			ttl:           config.TTL,
+			percentiles:   config.Percentiles,
+			buckets:       config.HistogramBuckets,
+			reservoirSize: reservoirSize,
+		},
 	}
 }
 
@@ -110,7 +294,7 @@ func (p *metricProcessor) processSingle(m statsdMetric) error {
 		return nil
 	}
 
-	// parse sample rate. Only applicable for timers and counters
+	// parse sample rate. Only applicable for timers, counters and distributions
 	var sampleRate float64
 	if m.sampleRate == "" {
 		sampleRate = 1.0
@@ -162,6 +346,13 @@ func (p *metricProcessor) processSingle(m statsdMetric) error {
 			return errors.Wrapf(err, "failed to process histogram `%s` with value `%s`", m.name, m.value)
 		}
 		c.Update(v)
+	case "d":
+		c := p.registry.GetOrNewDistribution(m.name, m.tags)
+		v, err := strconv.ParseFloat(m.value, 64)
+		if err != nil {
+			return errors.Wrapf(err, "failed to process distribution `%s` with value `%s`", m.name, m.value)
+		}
+		c.SampledUpdate(v, sampleRate)
 	case "s":
 		c := p.registry.GetOrNewSet(m.name, m.tags)
 		c.Add(m.value)
@@ -182,10 +373,11 @@ func (p *metricProcessor) Process(event server.Event) error {
 		return errors.New("packet has no data")
 	}
 
-	metrics, err := parse(b)
+	metrics, events, err := parse(b)
 	if err != nil {
 		return err
 	}
+	p.events = append(p.events, events...)
 
 	for _, m := range metrics {
 		if err := p.processSingle(m); err != nil {
@@ -199,3 +391,11 @@ func (p *metricProcessor) Process(event server.Event) error {
 func (p *metricProcessor) GetAll() []metricsGroup {
 	return p.registry.GetAll()
 }
+
+// TakeEvents returns the DogStatsD events and service checks received since
+// the last call.
+func (p *metricProcessor) TakeEvents() []mb.Event {
+	events := p.events
+	p.events = nil
+	return events
+}
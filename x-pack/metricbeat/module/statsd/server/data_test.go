@@ -5,6 +5,8 @@
 package server
 
 import (
+	"fmt"
+	"math"
 	"testing"
 	"time"
 
@@ -13,6 +15,7 @@ import (
 
 	"github.com/elastic/beats/v7/libbeat/common"
 	"github.com/elastic/beats/v7/metricbeat/helper/server"
+	"github.com/elastic/beats/v7/metricbeat/mb"
 	mbtest "github.com/elastic/beats/v7/metricbeat/mb/testing"
 )
 
@@ -156,6 +159,34 @@ func TestParseMetrics(t *testing.T) {
 				},
 			},
 		},
+		{ // DogStatsD tags without value, container ID and timestamp
+			input: "page.views:1|c|#env:prod,canary|c:83b1e7a2|T1656581400",
+			expected: []statsdMetric{
+				{
+					name:       "page.views",
+					metricType: "c",
+					value:      "1",
+					tags: map[string]string{
+						"env":    "prod",
+						"canary": "",
+					},
+				},
+			},
+		},
+		{ // DogStatsD distribution
+			input: "request.size:1.5|d|@0.5|#env:prod",
+			expected: []statsdMetric{
+				{
+					name:       "request.size",
+					metricType: "d",
+					value:      "1.5",
+					sampleRate: "0.5",
+					tags: map[string]string{
+						"env": "prod",
+					},
+				},
+			},
+		},
 		/// errors
 		{
 			input:    "meter1-1.4|m",
@@ -168,11 +199,12 @@ func TestParseMetrics(t *testing.T) {
 			err:      errInvalidPacket,
 		},
 	} {
-		actual, err := parse([]byte(test.input))
+		actual, events, err := parse([]byte(test.input))
 		assert.Equal(t, test.err, err, test.input)
 		assert.Equal(t, test.expected, actual, test.input)
+		assert.Empty(t, events, test.input)
 
-		processor := newMetricProcessor(time.Second)
+		processor := newMetricProcessor(Config{TTL: time.Second})
 		for _, e := range actual {
 			err := processor.processSingle(e)
 
@@ -181,6 +213,81 @@ func TestParseMetrics(t *testing.T) {
 	}
 }
 
+func TestParseEvents(t *testing.T) {
+	for _, test := range []struct {
+		input    string
+		err      bool
+		expected mb.Event
+	}{
+		{
+			input: "_e{14,14}:Deploy started|Version\\n1.2.3|d:1656581400|h:web-1|p:low|t:info|k:deploys|s:jenkins|#env:prod,region:eu",
+			expected: mb.Event{
+				Timestamp: time.Unix(1656581400, 0),
+				Namespace: "statsd",
+				MetricSetFields: common.MapStr{
+					"event": common.MapStr{
+						"title":            "Deploy started",
+						"text":             "Version\n1.2.3",
+						"hostname":         "web-1",
+						"priority":         "low",
+						"alert_type":       "info",
+						"aggregation_key":  "deploys",
+						"source_type_name": "jenkins",
+					},
+				},
+				RootFields: common.MapStr{
+					"labels": common.MapStr{"env": "prod", "region": "eu"},
+				},
+			},
+		},
+		{
+			input: "_e{5,9}:title|text|with",
+			expected: mb.Event{
+				Namespace: "statsd",
+				MetricSetFields: common.MapStr{
+					"event": common.MapStr{
+						"title": "title",
+						"text":  "text|with",
+					},
+				},
+			},
+		},
+		{
+			input: "_sc|db.connection|2|d:1656581400|h:db-1|#env:prod|m:connection refused|retrying",
+			expected: mb.Event{
+				Timestamp: time.Unix(1656581400, 0),
+				Namespace: "statsd",
+				MetricSetFields: common.MapStr{
+					"service_check": common.MapStr{
+						"name":     "db.connection",
+						"status":   common.MapStr{"code": 2, "name": "critical"},
+						"hostname": "db-1",
+						"message":  "connection refused|retrying",
+					},
+				},
+				RootFields: common.MapStr{
+					"labels": common.MapStr{"env": "prod"},
+				},
+			},
+		},
+		/// errors
+		{input: "_e{20,4}:title|text", err: true},
+		{input: "_e{a,4}:title|text", err: true},
+		{input: "_sc|db.connection|5", err: true},
+		{input: "_sc|db.connection", err: true},
+	} {
+		metrics, events, err := parse([]byte(test.input))
+		assert.Empty(t, metrics, test.input)
+		if test.err {
+			assert.Error(t, err, test.input)
+			continue
+		}
+		if assert.NoError(t, err, test.input) && assert.Len(t, events, 1, test.input) {
+			assert.Equal(t, test.expected, events[0], test.input)
+		}
+	}
+}
+
 type testUDPEvent struct {
 	event common.MapStr
 	meta  server.Meta
@@ -449,6 +556,122 @@ func TestChangeType(t *testing.T) {
 	}, events[0].MetricSetFields)
 }
 
+func TestReportEvents(t *testing.T) {
+	ms := mbtest.NewMetricSet(t, map[string]interface{}{"module": "statsd"}).(*MetricSet)
+	testData := []string{
+		"metric01:1|c\n_sc|app|0\n_e{5,4}:title|text",
+	}
+	err := process(testData, ms)
+	require.NoError(t, err)
+
+	events := ms.processor.TakeEvents()
+	require.Len(t, events, 2)
+	assert.Contains(t, events[0].MetricSetFields, "service_check")
+	assert.Contains(t, events[1].MetricSetFields, "event")
+	assert.Empty(t, ms.processor.TakeEvents())
+
+	// Events are not aggregated with the metrics.
+	metrics := ms.getEvents()
+	require.Len(t, metrics, 1)
+	assert.Equal(t, common.MapStr{
+		"metric01": map[string]interface{}{"count": int64(1)},
+	}, metrics[0].MetricSetFields)
+}
+
+func TestDistribution(t *testing.T) {
+	ms := mbtest.NewMetricSet(t, map[string]interface{}{
+		"module":            "statsd",
+		"percentiles":       []float64{50, 90},
+		"histogram_buckets": []float64{10, 50.5},
+	}).(*MetricSet)
+
+	var testData []string
+	for i := 1; i <= 100; i++ {
+		testData = append(testData, fmt.Sprintf("metric01:%d|d|#k1:v1", i))
+	}
+	testData = append(testData, "metric01:0.5|d|@0.1|#k1:v1")
+	err := process(testData, ms)
+	require.NoError(t, err)
+
+	events := ms.getEvents()
+	require.Len(t, events, 1)
+	assert.Equal(t, map[string]interface{}{
+		"count":  int64(110),
+		"sum":    float64(5055),
+		"min":    0.5,
+		"max":    float64(100),
+		"mean":   45.955,
+		"median": float64(50),
+		"p90":    90.8,
+		"buckets": map[string]interface{}{
+			"le_10":   int64(20),
+			"le_50_5": int64(60),
+			"le_inf":  int64(110),
+		},
+	}, roundFloats(events[0].MetricSetFields["metric01"].(map[string]interface{})))
+
+	// Distributions are reset on each report.
+	events = ms.getEvents()
+	require.Len(t, events, 1)
+	assert.Equal(t, map[string]interface{}{
+		"count": int64(0),
+		"buckets": map[string]interface{}{
+			"le_10":   int64(0),
+			"le_50_5": int64(0),
+			"le_inf":  int64(0),
+		},
+	}, events[0].MetricSetFields["metric01"])
+}
+
+func TestTimerPercentilesAndBuckets(t *testing.T) {
+	ms := mbtest.NewMetricSet(t, map[string]interface{}{
+		"module":            "statsd",
+		"percentiles":       []float64{90, 99.9},
+		"histogram_buckets": []float64{5},
+	}).(*MetricSet)
+	testData := []string{
+		"metric01:2|ms",
+		"metric01:8|ms|@0.5",
+		"metric02:3|h",
+		"metric02:7|h",
+	}
+	err := process(testData, ms)
+	require.NoError(t, err)
+
+	events := ms.getEvents()
+	require.Len(t, events, 1)
+
+	timer := events[0].MetricSetFields["metric01"].(map[string]interface{})
+	assert.Contains(t, timer, "p90")
+	assert.Contains(t, timer, "p99_9")
+	assert.NotContains(t, timer, "median")
+	assert.Equal(t, map[string]interface{}{"le_5": int64(1), "le_inf": int64(3)}, timer["buckets"])
+
+	histogram := events[0].MetricSetFields["metric02"].(map[string]interface{})
+	assert.Equal(t, float64(7), histogram["p90"])
+	assert.Equal(t, map[string]interface{}{"le_5": int64(1), "le_inf": int64(2)}, histogram["buckets"])
+}
+
+func TestConfigValidate(t *testing.T) {
+	for _, config := range []map[string]interface{}{
+		{"percentiles": []float64{0}},
+		{"percentiles": []float64{101}},
+		{"histogram_buckets": []float64{10, 5}},
+	} {
+		var c Config
+		assert.Error(t, common.MustNewConfigFrom(config).Unpack(&c), config)
+	}
+}
+
+func roundFloats(values map[string]interface{}) map[string]interface{} {
+	for k, v := range values {
+		if f, ok := v.(float64); ok {
+			values[k] = math.Round(f*1000) / 1000
+		}
+	}
+	return values
+}
+
 func BenchmarkIngest(b *testing.B) {
 	tests := []string{
 		"metric01:1.0|g|#k1:v1,k2:v2",
@@ -5,6 +5,11 @@
 package server
 
 import (
+	"math"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/rcrowley/go-metrics"
@@ -28,6 +33,10 @@ type registry struct {
 	metrics    map[string]map[string]*metric
 	ttl        time.Duration
 	lastReport time.Time
+
+	percentiles   []float64 // Percentiles reported for timers, histograms and distributions, from 0 to 100.
+	buckets       []float64 // Upper bounds of the buckets reported for timers, histograms and distributions.
+	reservoirSize int
 }
 
 type setMetric struct {
@@ -68,15 +77,86 @@ func (d *deltaGaugeMetric) Value() float64 {
 	return d.value
 }
 
+// bucketCounts counts the values observed in each bucket since the last time
+// they were reported.
+type bucketCounts struct {
+	bounds []float64
+	counts []int64 // One more than bounds, for the values above the last bound.
+}
+
+// newBucketCounts returns the counts for the given upper bounds, or nil if
+// there are no bounds.
+func newBucketCounts(bounds []float64) *bucketCounts {
+	if len(bounds) == 0 {
+		return nil
+	}
+	return &bucketCounts{
+		bounds: bounds,
+		counts: make([]int64, len(bounds)+1),
+	}
+}
+
+// Observe adds n observations of a value.
+func (b *bucketCounts) Observe(value float64, n int64) {
+	if b == nil {
+		return
+	}
+	i := sort.SearchFloat64s(b.bounds, value)
+	b.counts[i] += n
+}
+
+// Values returns the cumulative counts of the buckets, keyed by their upper
+// bound, and resets them.
+func (b *bucketCounts) Values() map[string]interface{} {
+	values := make(map[string]interface{}, len(b.counts))
+	var total int64
+	for i, count := range b.counts {
+		total += count
+		if i < len(b.bounds) {
+			values["le_"+formatBound(b.bounds[i])] = total
+		} else {
+			values["le_inf"] = total
+		}
+		b.counts[i] = 0
+	}
+	return values
+}
+
+// formatBound formats a bucket bound so it can be used as a field name.
+func formatBound(v float64) string {
+	return strings.Replace(strconv.FormatFloat(v, 'f', -1, 64), ".", "_", -1)
+}
+
+// percentileName returns the name of the field of a percentile, from 0 to 100.
+func percentileName(p float64) string {
+	if p == 50 {
+		return "median"
+	}
+	return "p" + formatBound(p)
+}
+
+// histogramMetric is a histogram that also counts the values in buckets.
+type histogramMetric struct {
+	metrics.Histogram
+	buckets *bucketCounts
+}
+
+// Update adds a value to the histogram.
+func (h *histogramMetric) Update(v int64) {
+	h.Histogram.Update(v)
+	h.buckets.Observe(float64(v), 1)
+}
+
 // SamplingTimer is a timer that supports sampling
 type samplingTimer struct {
 	metrics.Timer
 	meter     metrics.Meter
 	histogram metrics.Histogram
+	buckets   *bucketCounts
 }
 
 // NewSamplingTimer returns a new SamplingTimer
-func newSamplingTimer() *samplingTimer {
+func newSamplingTimer(buckets []float64) *samplingTimer {
 	m := metrics.NewMeter()
 	h := metrics.NewHistogram(metrics.NewExpDecaySample(1028, 0.015))
 
@@ -84,6 +164,7 @@ func newSamplingTimer() *samplingTimer {
 		Timer:     metrics.NewCustomTimer(h, m),
 		meter:     m,
 		histogram: h,
+		buckets:   newBucketCounts(buckets),
 	}
 }
 
@@ -91,6 +172,91 @@ func newSamplingTimer() *samplingTimer {
 func (s *samplingTimer) SampledUpdate(d time.Duration, sampleRate float64) {
 	s.histogram.Update(int64(d))
 	s.meter.Mark(int64(1 / sampleRate))
+	s.buckets.Observe(float64(d), int64(1/sampleRate))
+}
+
+// distributionMetric aggregates the values of a DogStatsD distribution since
+// the last time it was reported. Count, sum, min and max are exact, the
+// percentiles are calculated over a uniform sample of the values.
+type distributionMetric struct {
+	count    float64
+	sum      float64
+	min      float64
+	max      float64
+	observed int
+	sample   []float64
+	size     int
+	buckets  *bucketCounts
+}
+
+func newDistributionMetric(reservoirSize int, buckets []float64) *distributionMetric {
+	return &distributionMetric{
+		size:    reservoirSize,
+		buckets: newBucketCounts(buckets),
+	}
+}
+
+// SampledUpdate adds a value of the distribution, taking into account the
+// rate it was sampled at by the client.
+func (d *distributionMetric) SampledUpdate(v float64, sampleRate float64) {
+	if d.observed == 0 || v < d.min {
+		d.min = v
+	}
+	if d.observed == 0 || v > d.max {
+		d.max = v
+	}
+	d.count += 1 / sampleRate
+	d.sum += v / sampleRate
+	d.buckets.Observe(v, int64(1/sampleRate))
+
+	// Reservoir sampling, so all the values have the same chance of being
+	// in the sample.
+	d.observed++
+	if len(d.sample) < d.size {
+		d.sample = append(d.sample, v)
+	} else if i := rand.Intn(d.observed); i < d.size {
+		d.sample[i] = v
+	}
+}
+
+// Values returns the aggregated values and resets the distribution.
+func (d *distributionMetric) Values(percentiles []float64) map[string]interface{} {
+	values := map[string]interface{}{
+		"count": int64(math.Round(d.count)),
+	}
+	if d.observed > 0 {
+		values["sum"] = d.sum
+		values["min"] = d.min
+		values["max"] = d.max
+		values["mean"] = d.sum / d.count
+
+		sort.Float64s(d.sample)
+		for _, p := range percentiles {
+			values[percentileName(p)] = samplePercentile(d.sample, p/100)
+		}
+	}
+	if d.buckets != nil {
+		values["buckets"] = d.buckets.Values()
+	}
+
+	d.count, d.sum, d.min, d.max, d.observed = 0, 0, 0, 0, 0
+	d.sample = d.sample[:0]
+	return values
+}
+
+// samplePercentile returns a percentile, from 0 to 1, of sorted values. It
+// interpolates between values as go-metrics does for timers and histograms.
+func samplePercentile(sorted []float64, p float64) float64 {
+	pos := p * float64(len(sorted)+1)
+	switch {
+	case pos < 1:
+		return sorted[0]
+	case pos >= float64(len(sorted)):
+		return sorted[len(sorted)-1]
+	}
+	lower := sorted[int(pos)-1]
+	upper := sorted[int(pos)]
+	return lower + (pos-math.Floor(pos))*(upper-lower)
 }
 
 // Snapshot gets a snapshot of the SamplingTimer
@@ -193,36 +359,34 @@ func (r *registry) getMetric(metric interface{}) map[string]interface{} {
 		m.Clear()
 	case *deltaGaugeMetric:
 		values["value"] = m.Value()
-	case metrics.Histogram:
+	case *histogramMetric:
 		h := m.Snapshot()
-		ps := h.Percentiles([]float64{0.5, 0.75, 0.95, 0.99, 0.999})
 		values["count"] = h.Count()
 		values["min"] = h.Min()
 		values["max"] = h.Max()
 		values["mean"] = h.Mean()
 		values["stddev"] = h.StdDev()
-		values["median"] = ps[0]
-		values["p75"] = ps[1]
-		values["p95"] = ps[2]
-		values["p99"] = ps[3]
-		values["p99_9"] = ps[4]
+		r.addPercentiles(values, h.Percentiles)
+		if m.buckets != nil {
+			values["buckets"] = m.buckets.Values()
+		}
 	case *samplingTimer:
 		t := m.Snapshot()
-		ps := t.Percentiles([]float64{0.5, 0.75, 0.95, 0.99, 0.999})
 		values["count"] = t.Count()
 		values["min"] = t.Min()
 		values["max"] = t.Max()
 		values["mean"] = t.Mean()
 		values["stddev"] = t.StdDev()
-		values["median"] = ps[0]
-		values["p75"] = ps[1]
-		values["p95"] = ps[2]
-		values["p99"] = ps[3]
-		values["p99_9"] = ps[4]
+		r.addPercentiles(values, t.Percentiles)
 		values["1m_rate"] = t.Rate1()
 		values["5m_rate"] = t.Rate5()
 		values["15m_rate"] = t.Rate15()
 		values["mean_rate"] = t.RateMean()
+		if m.buckets != nil {
+			values["buckets"] = m.buckets.Values()
+		}
+	case *distributionMetric:
+		values = m.Values(r.percentiles)
 	case *setMetric:
 		values["count"] = m.Count()
 		m.Reset()
@@ -230,6 +394,21 @@ func (r *registry) getMetric(metric interface{}) map[string]interface{} {
 	return values
 }
 
+// addPercentiles adds the configured percentiles using a function that
+// expects them from 0 to 1.
+func (r *registry) addPercentiles(values map[string]interface{}, percentiles func([]float64) []float64) {
+	if len(r.percentiles) == 0 {
+		return
+	}
+	ps := make([]float64, len(r.percentiles))
+	for i, p := range r.percentiles {
+		ps[i] = p / 100
+	}
+	for i, v := range percentiles(ps) {
+		values[percentileName(r.percentiles[i])] = v
+	}
+}
+
 func (r *registry) GetAll() []metricsGroup {
 	var tags map[string]string
 	now := time.Now()
@@ -334,7 +513,7 @@ func (r *registry) GetOrNewCounter(name string, tags map[string]string) metrics.
 }
 
 func (r *registry) GetOrNewTimer(name string, tags map[string]string) *samplingTimer {
-	timer, ok := r.getOrNew(name, tags, func() interface{} { return newSamplingTimer() }).(*samplingTimer)
+	timer, ok := r.getOrNew(name, tags, func() interface{} { return newSamplingTimer(r.buckets) }).(*samplingTimer)
 	if ok {
 		return timer
 	}
@@ -353,8 +532,13 @@ func (r *registry) GetOrNewGauge64(name string, tags map[string]string) *deltaGa
 	return r.GetOrNewGauge64(name, tags)
 }
 
-func (r *registry) GetOrNewHistogram(name string, tags map[string]string) metrics.Histogram {
-	histogram, ok := r.getOrNew(name, tags, func() interface{} { return metrics.NewHistogram(metrics.NewExpDecaySample(1028, 0.015)) }).(metrics.Histogram)
+func (r *registry) GetOrNewHistogram(name string, tags map[string]string) *histogramMetric {
+	histogram, ok := r.getOrNew(name, tags, func() interface{} {
+		return &histogramMetric{
+			Histogram: metrics.NewHistogram(metrics.NewExpDecaySample(1028, 0.015)),
+			buckets:   newBucketCounts(r.buckets),
+		}
+	}).(*histogramMetric)
 	if ok {
 		return histogram
 	}
@@ -363,6 +547,16 @@ func (r *registry) GetOrNewHistogram(name string, tags map[string]string) metric
 	return r.GetOrNewHistogram(name, tags)
 }
 
+func (r *registry) GetOrNewDistribution(name string, tags map[string]string) *distributionMetric {
+	distribution, ok := r.getOrNew(name, tags, func() interface{} { return newDistributionMetric(r.reservoirSize, r.buckets) }).(*distributionMetric)
+	if ok {
+		return distribution
+	}
+
+	r.clearTypeChanged(name, tags)
+	return r.GetOrNewDistribution(name, tags)
+}
+
 func (r *registry) GetOrNewSet(name string, tags map[string]string) *setMetric {
 	setmetric, ok := r.getOrNew(name, tags, func() interface{} { return newSetMetric() }).(*setMetric)
 	if ok {
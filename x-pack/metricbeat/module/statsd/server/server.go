@@ -7,6 +7,8 @@ package server
 import (
 	"time"
 
+	"github.com/pkg/errors"
+
 	"github.com/elastic/beats/v7/libbeat/common"
 	serverhelper "github.com/elastic/beats/v7/metricbeat/helper/server"
 	"github.com/elastic/beats/v7/metricbeat/helper/server/udp"
@@ -19,11 +21,37 @@ func init() {
 	mb.Registry.MustAddMetricSet("statsd", "server", New, mb.DefaultMetricSet())
 }
 
+// reservoirSize is the number of values of each distribution kept to
+// calculate their percentiles.
+const reservoirSize = 1028
+
 // Config for the statsd server metricset.
 type Config struct {
-	TTL time.Duration `config:"ttl"`
+	TTL              time.Duration `config:"ttl"`
+	Percentiles      []float64     `config:"percentiles"`
+	HistogramBuckets []float64     `config:"histogram_buckets"`
+}
+
+// Validate checks that the percentiles and the histogram buckets are valid.
+func (c *Config) Validate() error {
+	for _, p := range c.Percentiles {
+		if p <= 0 || p > 100 {
+			return errors.Errorf("invalid percentile %v, percentiles must be greater than 0 and up to 100", p)
+		}
+	}
+	for i := 1; i < len(c.HistogramBuckets); i++ {
+		if c.HistogramBuckets[i] <= c.HistogramBuckets[i-1] {
+			return errors.New("histogram_buckets must be sorted in increasing order")
+		}
+	}
+	return nil
 }
 
+// defaultPercentiles are the percentiles reported when none are configured.
+// They are not set in the default config because lists in the config are
+// merged with the defaults instead of replacing them.
+var defaultPercentiles = []float64{50, 75, 95, 99, 99.9}
+
 func defaultConfig() Config {
 	return Config{
 		TTL: time.Second * 30,
@@ -48,13 +76,16 @@ func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
 	if err := base.Module().UnpackConfig(&config); err != nil {
 		return nil, err
 	}
+	if config.Percentiles == nil {
+		config.Percentiles = defaultPercentiles
+	}
 
 	svc, err := udp.NewUdpServer(base)
 	if err != nil {
 		return nil, err
 	}
 
-	processor := newMetricProcessor(config.TTL)
+	processor := newMetricProcessor(config)
 	return &MetricSet{
 		BaseMetricSet: base,
 		server:        svc,
@@ -68,10 +99,7 @@ func (m *MetricSet) getEvents() []*mb.Event {
 
 	for idx, tagGroup := range groups {
 
-		mapstrTags := common.MapStr{}
-		for k, v := range tagGroup.tags {
-			mapstrTags[k] = v
-		}
+		mapstrTags := tagsToMapStr(tagGroup.tags)
 
 		sanitizedMetrics := common.MapStr{}
 		for k, v := range tagGroup.metrics {
@@ -87,6 +115,14 @@ func (m *MetricSet) getEvents() []*mb.Event {
 	return events
 }
 
+func tagsToMapStr(tags map[string]string) common.MapStr {
+	mapstrTags := common.MapStr{}
+	for k, v := range tags {
+		mapstrTags[k] = v
+	}
+	return mapstrTags
+}
+
 // Run method provides the module with a reporter with which events can be reported.
 func (m *MetricSet) Run(reporter mb.PushReporterV2) {
 	period := m.Module().Config().Period
@@ -109,6 +145,9 @@ func (m *MetricSet) Run(reporter mb.PushReporterV2) {
 			if err != nil {
 				reporter.Error(err)
 			}
+			for _, e := range m.processor.TakeEvents() {
+				reporter.Event(e)
+			}
 		}
 	}
 }